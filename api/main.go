@@ -7,6 +7,7 @@ import (
 
 	"github.com/openhexes/openhexes/api/src/auth"
 	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/identity"
 	"github.com/openhexes/openhexes/api/src/server"
 	"go.uber.org/zap"
 )
@@ -20,6 +21,10 @@ func main() {
 	if err != nil {
 		log.Fatalf("loading config: %s", err)
 	}
+	controller, err := auth.NewController(cfg, identity.New(cfg))
+	if err != nil {
+		log.Fatalf("setting up auth: %s", err)
+	}
 	if err = cfg.SetUp(ctx); err != nil {
 		zap.L().Fatal("failed to set up: %s", zap.Error(err))
 	}
@@ -33,7 +38,7 @@ func main() {
 	}()
 
 	var srv *server.Server
-	srv, srvErr = server.New(cfg, auth.NewController(cfg))
+	srv, srvErr = server.New(cfg, controller, auth.NewAuthorizer(cfg, auth.DefaultPolicy()))
 	if srvErr != nil {
 		zap.L().Error("creating server: %s", zap.Error(err))
 	} else {
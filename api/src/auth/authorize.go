@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/identity"
+)
+
+// AttemptTTL bounds how long users may take to sign in with the provider.
+const AttemptTTL = 10 * time.Minute
+
+// NewAttemptSecret returns a secret binding authorization attempts to the client, see AttemptCookie.
+func NewAttemptSecret() string {
+	return newNonce()
+}
+
+// Authorize starts an authorization attempt with the named provider & returns the URL users sign in at.
+// The state is signed, so attempts needn't be stored & any replica may exchange the code.
+// Only the client holding the secret may exchange the code, the redirect URI has to be configured.
+func (c *Controller) Authorize(ctx context.Context, provider, redirectURI, secret string) (string, error) {
+	ex, ok := c.providers.Get(provider).(identity.Exchanger)
+	if !ok {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider %q doesn't exchange authorization codes", provider))
+	}
+	if !slices.Contains(c.cfg.Auth.RedirectURIs, redirectURI) {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("redirect uri %q isn't allowed", redirectURI))
+	}
+	claims := &stateClaims{
+		Provider:    provider,
+		RedirectURI: redirectURI,
+		Nonce:       newNonce(),
+		Binding:     hashSecret(secret),
+		Expiry:      time.Now().Add(AttemptTTL).Unix(),
+	}
+	url, err := ex.AuthorizeURL(ctx, redirectURI, c.signer.signState(claims), claims.Nonce)
+	if err != nil {
+		return "", connect.NewError(connect.CodeUnavailable, fmt.Errorf("provider %q: %w", provider, err))
+	}
+	return url, nil
+}
+
+// Exchange turns the authorization code of an attempt started by Authorize into a credential of the provider.
+// The secret has to be the one the attempt was started with. ID tokens have to carry the nonce of the attempt.
+func (c *Controller) Exchange(ctx context.Context, provider, code, redirectURI, state, secret string) (string, error) {
+	ex, ok := c.providers.Get(provider).(identity.Exchanger)
+	if !ok {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider %q doesn't exchange authorization codes", provider))
+	}
+	claims, err := c.signer.verifyState(state, time.Now())
+	if err != nil {
+		return "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if claims.Provider != provider || claims.RedirectURI != redirectURI {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("state belongs to another attempt"))
+	}
+	if secret == "" || subtle.ConstantTimeCompare([]byte(claims.Binding), []byte(hashSecret(secret))) != 1 {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("attempt was started by another client"))
+	}
+
+	credential, err := ex.Exchange(ctx, code, redirectURI)
+	if errors.Is(err, identity.ErrInvalidCredential) {
+		return "", connect.NewError(connect.CodeUnauthenticated, err)
+	} else if err != nil {
+		return "", connect.NewError(connect.CodeUnavailable, err)
+	}
+	if _, err := c.Resolve(identity.WithNonce(ctx, claims.Nonce), provider, credential); err != nil {
+		return "", err
+	}
+	return credential, nil
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/identity"
+)
+
+// exchanger hands out the code as the credential, the state is sent back along with the URL.
+type exchanger struct {
+	*identity.Static
+}
+
+func (e exchanger) ClientID() string {
+	return "client"
+}
+
+func (e exchanger) AuthorizeURL(ctx context.Context, redirectURI, state, nonce string) (string, error) {
+	return "https://provider/authorize?" + url.Values{"state": {state}}.Encode(), nil
+}
+
+func (e exchanger) Exchange(ctx context.Context, code, redirectURI string) (string, error) {
+	return code, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	const callback = "http://localhost/callback"
+	cfg := &config.Config{}
+	cfg.Auth.RedirectURIs = []string{callback}
+	c := &Controller{
+		cfg:       cfg,
+		providers: identity.NewRegistry(exchanger{identity.NewStatic("test", map[string]*identity.Identity{"code": {Subject: "alfa"}})}),
+		signer:    testSigner(t, "key"),
+	}
+	ctx := context.Background()
+
+	if _, err := c.Authorize(ctx, "test", "http://evil/callback", "secret"); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected unknown redirect URI to be rejected, got %v", err)
+	}
+
+	authorizeURL, err := c.Authorize(ctx, "test", callback, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(authorizeURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := parsed.Query().Get("state")
+
+	for name, secret := range map[string]string{"missing secret": "", "secret of another client": "other"} {
+		if _, err := c.Exchange(ctx, "test", "code", callback, state, secret); connect.CodeOf(err) != connect.CodeUnauthenticated {
+			t.Errorf("%s: expected exchange to be rejected, got %v", name, err)
+		}
+	}
+	if credential, err := c.Exchange(ctx, "test", "code", callback, state, "secret"); err != nil || credential != "code" {
+		t.Errorf("expected the credential of the code, got %q, %v", credential, err)
+	}
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"go.uber.org/zap"
+)
+
+const RolesContextKey contextKey = "roles"
+
+// Authorizer rejects callers whose roles lack the permission a procedure requires.
+// It relies on the Controller to put the account into the context first.
+// Roles are loaded on every request, so grants & revocations take effect immediately.
+type Authorizer struct {
+	cfg    *config.Config
+	policy *Policy
+}
+
+func NewAuthorizer(cfg *config.Config, policy *Policy) *Authorizer {
+	return &Authorizer{
+		cfg:    cfg,
+		policy: policy,
+	}
+}
+
+func (a *Authorizer) Policy() *Policy {
+	return a.policy
+}
+
+// RolesFromContext returns roles of the caller, only set for procedures requiring a permission.
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(RolesContextKey).([]string)
+	return roles
+}
+
+func (a *Authorizer) authorize(ctx context.Context, spec connect.Spec) (context.Context, error) {
+	permission, ok := a.policy.Required(spec.Procedure)
+	if !ok {
+		return ctx, nil
+	}
+	account := AccountFromContext(ctx)
+
+	var roles []string
+	err := a.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		roles, err = q.ListAccountRoles(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("listing roles: %w", err)
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	if !a.policy.Allows(roles, permission) {
+		config.GetLogger(ctx).Info(
+			"permission denied",
+			zap.String("account.id", account.ID.String()),
+			zap.String("procedure", spec.Procedure),
+			zap.String("permission", string(permission)),
+		)
+		return nil, ErrDenied
+	}
+	return context.WithValue(ctx, RolesContextKey, roles), nil
+}
+
+func (a *Authorizer) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := a.authorize(ctx, request.Spec())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	})
+}
+
+func (a *Authorizer) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		// noop
+		return next(ctx, spec)
+	})
+}
+
+func (a *Authorizer) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := a.authorize(ctx, conn.Spec())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
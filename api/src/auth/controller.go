@@ -2,152 +2,242 @@ package auth
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
-	"cloud.google.com/go/auth/credentials/idtoken"
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/openhexes/openhexes/api/src/config"
 	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/identity"
 	"go.uber.org/zap"
 )
 
 type contextKey string
 
 const (
-	ContextKey contextKey = "account"
+	ContextKey        contextKey = "account"
+	SessionContextKey contextKey = "session"
+
+	// RoleOwner is granted to accounts configured as owners, see config.Owners.
+	RoleOwner = "owner"
 )
 
 type Controller struct {
 	cfg       *config.Config
-	cache     *expirable.LRU[string, *db.Account]
-	testUsers map[string]*GoogleClaims // credential -> email
+	providers *identity.Registry
+	signer    *signer
+	cache     *expirable.LRU[uuid.UUID, *db.Account] // session id -> account
+
+	mu      sync.Mutex
+	streams map[uuid.UUID]map[*stream]bool // account id -> open streams
 }
 
-func NewController(cfg *config.Config) *Controller {
-	c := &Controller{
-		cfg:       cfg,
-		cache:     expirable.NewLRU[string, *db.Account](cfg.Auth.Storage.MaxSize, nil, cfg.Auth.Storage.TTL),
-		testUsers: make(map[string]*GoogleClaims, 0),
+// NewController fails unless a long enough session key is configured, test mode makes up a random one.
+func NewController(cfg *config.Config, providers *identity.Registry) (*Controller, error) {
+	key := []byte(cfg.Auth.Sessions.Key)
+	if len(key) == 0 && cfg.Test.Enabled {
+		key = make([]byte, minKeyLength)
+		_, _ = rand.Read(key)
 	}
-	return c.setUpTestUsers()
+	s, err := newSigner(key)
+	if err != nil {
+		return nil, fmt.Errorf("session key: %w", err)
+	}
+	return &Controller{
+		cfg:       cfg,
+		providers: providers,
+		signer:    s,
+		cache:     expirable.NewLRU[uuid.UUID, *db.Account](cfg.Auth.Storage.MaxSize, nil, cfg.Auth.Storage.TTL),
+	}, nil
 }
 
 func AccountFromContext(ctx context.Context) *db.Account {
 	return ctx.Value(ContextKey).(*db.Account)
 }
 
+// SessionFromContext returns id of the session of the request.
+func SessionFromContext(ctx context.Context) uuid.UUID {
+	return ctx.Value(SessionContextKey).(uuid.UUID)
+}
+
+func (c *Controller) Providers() *identity.Registry {
+	return c.providers
+}
+
 func (c *Controller) AccountFromRequestHeader(ctx context.Context, header http.Header) (*db.Account, error) {
-	log := config.GetLogger(ctx)
+	account, _, err := c.SessionFromRequestHeader(ctx, header)
+	return account, err
+}
 
-	cookie, err := (&http.Request{Header: header}).Cookie("hexes.auth.google")
+// SessionFromRequestHeader verifies the access token of the request & returns its account & session id.
+// Accounts are cached by session, so ended sessions are noticed once their entry expires.
+func (c *Controller) SessionFromRequestHeader(ctx context.Context, header http.Header) (*db.Account, uuid.UUID, error) {
+	token := accessToken(header)
+	if token == "" {
+		return nil, uuid.Nil, connect.NewError(connect.CodeInvalidArgument, errors.New("access token not set"))
+	}
+	claims, err := c.signer.verify(token, time.Now())
 	if err != nil {
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			fmt.Errorf("parsing authentication cookie: %w", err),
-		)
+		return nil, uuid.Nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
-	bh := sha256.Sum256([]byte(cookie.Value))
-	sh := hex.EncodeToString([]byte(bh[:]))
-
-	account, ok := c.cache.Get(sh)
+	account, ok := c.cache.Get(claims.Session)
 	if !ok {
-		log.Debug("resolving new credentials", zap.String("credentials.hash", sh))
-		account, err = c.authenticate(ctx, cookie.Value)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authenticating: %w", err))
+		var loaded db.Account
+		err := c.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			if _, err := q.GetSession(ctx, claims.Session); err != nil {
+				return err
+			}
+			loaded, err = q.GetAccountByID(ctx, claims.Subject)
+			return err
+		}, config.WithAccessMode(pgx.ReadOnly))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, uuid.Nil, ErrSessionEnded
+		} else if err != nil {
+			return nil, uuid.Nil, fmt.Errorf("getting session: %w", err)
 		}
-		log.Info("user authenticated", zap.String("account.id", account.ID.String()))
-		c.cache.Add(sh, account)
+		account = &loaded
+		c.cache.Add(claims.Session, account)
 	}
-	return account, nil
+	return account, claims.Session, nil
 }
 
-func (c *Controller) resolveCredential(ctx context.Context, credential string) (*GoogleClaims, error) {
-	log := config.GetLogger(ctx)
+// accessToken returns the bearer token of the request, falling back to the session cookie.
+func accessToken(header http.Header) string {
+	if token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer "); ok {
+		return token
+	}
+	if cookie, err := (&http.Request{Header: header}).Cookie(SessionCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
 
-	if c.cfg.Test.Enabled {
-		if claims, ok := c.testUsers[credential]; ok {
-			log.Info("resolved test user", zap.String("email", claims.Email))
-			return claims, nil
+// Evict drops cached sessions of given accounts, so their next request loads the account
+// again & changes such as deactivation take effect immediately. Returns number of dropped entries.
+func (c *Controller) Evict(ids ...uuid.UUID) int {
+	var n int
+	for _, key := range c.cache.Keys() {
+		if account, ok := c.cache.Peek(key); ok && slices.Contains(ids, account.ID) && c.cache.Remove(key) {
+			n++
 		}
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid test user credentials"))
 	}
+	return n
+}
 
-	info, err := idtoken.Validate(ctx, credential, c.cfg.Auth.Google.ClientID)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("validating google credentials: %w", err))
+// Resolve resolves the credential with the named provider.
+func (c *Controller) Resolve(ctx context.Context, provider, credential string) (*identity.Identity, error) {
+	p := c.providers.Get(provider)
+	if p == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown identity provider %q", provider))
+	}
+	i, err := p.Resolve(ctx, credential)
+	if errors.Is(err, identity.ErrInvalidCredential) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("resolving credential: %w", err))
 	}
+	return i, nil
+}
 
-	claims := &GoogleClaims{}
-	for k, v := range info.Claims {
-		if k == "email" {
-			claims.Email = v.(string)
-		}
-		if k == "email_verified" {
-			claims.EmailVerified = v.(bool)
-		}
-		if k == "name" {
-			claims.Name = v.(string)
-		}
-		if k == "picture" {
-			claims.Picture = v.(string)
-		}
+// link returns the account linked to the identity, along with ids of sessions it ended.
+// Unknown identities are linked to the account with the same email, which has to be verified,
+// accounts are created as needed.
+func (c *Controller) link(ctx context.Context, q *db.Queries, i *identity.Identity) (db.Account, []uuid.UUID, error) {
+	log := config.GetLogger(ctx)
+	linked, err := q.GetLinkedIdentity(ctx, db.GetLinkedIdentityParams{Provider: i.Provider, Subject: i.Subject})
+	if err == nil {
+		account, err := q.GetAccountByID(ctx, linked.AccountID)
+		return account, nil, err
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return db.Account{}, nil, fmt.Errorf("getting identity: %w", err)
 	}
-	if claims.Email == "" {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("email not detected"))
+
+	if !i.EmailVerified {
+		return db.Account{}, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("email is not verified: %q", i.Email))
 	}
-	if !claims.EmailVerified {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("email is not verified: %q", claims.Email))
+	var ended []uuid.UUID
+	account, err := q.GetAccount(ctx, i.Email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		account, err = c.createAccount(ctx, q, i)
+	} else if err == nil {
+		ended, err = dropPassword(ctx, q, &account)
+	}
+	if err != nil {
+		return account, nil, err
 	}
-	return claims, nil
-}
 
-func (c *Controller) authenticate(ctx context.Context, credential string) (*db.Account, error) {
-	claims, err := c.resolveCredential(ctx, credential)
+	_, err = q.CreateLinkedIdentity(ctx, db.CreateLinkedIdentityParams{
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		AccountID: account.ID,
+		Email:     i.Email,
+	})
 	if err != nil {
+		return account, nil, fmt.Errorf("linking identity: %w", err)
+	}
+	log.Info(
+		"identity linked",
+		zap.String("account.id", account.ID.String()),
+		zap.String("provider", i.Provider),
+		zap.String("subject", i.Subject),
+	)
+	return account, ended, nil
+}
+
+// dropPassword removes the password login of the account along with its sessions, since nobody
+// verified who set the password, it may have been someone else signing up with the email.
+func dropPassword(ctx context.Context, q *db.Queries, account *db.Account) ([]uuid.UUID, error) {
+	dropped, err := q.DeleteLinkedIdentity(ctx, db.DeleteLinkedIdentityParams{
+		Provider:  identity.PasswordProvider,
+		Subject:   identity.NormalizeEmail(account.Email),
+		AccountID: account.ID,
+	})
+	if err != nil || dropped == 0 {
 		return nil, err
 	}
+	ended, err := q.DeleteSessions(ctx, db.DeleteSessionsParams{
+		AccountID: account.ID,
+		Provider:  pgtype.Text{String: identity.PasswordProvider, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ending sessions: %w", err)
+	}
+	return ended, nil
+}
 
-	var account db.Account
-	err = c.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
-		account, err = q.GetAccount(ctx, claims.Email)
-		if errors.Is(err, pgx.ErrNoRows) {
-			var isOwner bool
-			if slices.Contains(c.cfg.Auth.Owners.Emails, claims.Email) {
-				isOwner = true
-			}
+func (c *Controller) createAccount(ctx context.Context, q *db.Queries, i *identity.Identity) (db.Account, error) {
+	isOwner := slices.Contains(c.cfg.Auth.Owners.Emails, i.Email)
+	account, err := q.CreateAccount(ctx, db.CreateAccountParams{
+		Active:      isOwner,
+		Email:       i.Email,
+		DisplayName: i.Name,
+		Picture:     i.Picture,
+	})
+	if err != nil {
+		return account, fmt.Errorf("creating account: %w", err)
+	}
 
-			account, err = q.CreateAccount(ctx, db.CreateAccountParams{
-				Active:      isOwner,
-				Email:       claims.Email,
-				DisplayName: claims.Name,
-				Picture:     claims.Picture,
+	if isOwner {
+		for _, role := range c.cfg.Auth.Owners.Roles {
+			err = q.GrantRole(ctx, db.GrantRoleParams{
+				AccountID: account.ID,
+				RoleID:    role,
 			})
 			if err != nil {
-				return fmt.Errorf("creating account: %w", err)
-			}
-
-			if isOwner {
-				for _, role := range c.cfg.Auth.Owners.Roles {
-					err = q.GrantRole(ctx, db.GrantRoleParams{
-						AccountID: account.ID,
-						RoleID:    role,
-					})
-					if err != nil {
-						return fmt.Errorf("granting role: %q -> %q: %w", role, claims.Email, err)
-					}
-				}
+				return account, fmt.Errorf("granting role: %q -> %q: %w", role, i.Email, err)
 			}
 		}
-		return err
-	})
-	return &account, err
+	}
+	return account, nil
 }
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/openhexes/openhexes/api/src/db"
+)
+
+func testController() *Controller {
+	return &Controller{cache: expirable.NewLRU[uuid.UUID, *db.Account](10, nil, time.Minute)}
+}
+
+func TestEvict(t *testing.T) {
+	c := testController()
+	alfa, bravo := &db.Account{ID: uuid.New()}, &db.Account{ID: uuid.New()}
+	sessions := map[uuid.UUID]*db.Account{uuid.New(): alfa, uuid.New(): alfa, uuid.New(): bravo}
+	for session, account := range sessions {
+		c.cache.Add(session, account)
+	}
+
+	if n := c.Evict(alfa.ID, uuid.New()); n != 2 {
+		t.Fatalf("expected 2 sessions to be dropped, got %d", n)
+	}
+	for session, account := range sessions {
+		if _, ok := c.cache.Get(session); ok != (account == bravo) {
+			t.Errorf("session of %s: expected cached=%v", account.ID, account == bravo)
+		}
+	}
+}
+
+func TestEndStreams(t *testing.T) {
+	c := testController()
+	alfa, bravo, session := uuid.New(), uuid.New(), uuid.New()
+	first, doneFirst := c.track(context.Background(), alfa, session)
+	second, doneSecond := c.track(context.Background(), alfa, uuid.New())
+	other, doneOther := c.track(context.Background(), bravo, uuid.New())
+	defer doneOther()
+
+	c.EvictSessions(session)
+	if err := context.Cause(first); !errors.Is(err, ErrSessionEnded) {
+		t.Errorf("expected stream of the evicted session to end, got %v", err)
+	}
+	if second.Err() != nil {
+		t.Error("expected stream of another session to stay open")
+	}
+	doneFirst()
+
+	if n := c.EndStreams(ErrDeactivated, alfa); n != 1 {
+		t.Fatalf("expected 1 stream to end, got %d", n)
+	}
+	if err := context.Cause(second); !errors.Is(err, ErrDeactivated) {
+		t.Errorf("expected stream of the account to end, got %v", err)
+	}
+	if other.Err() != nil {
+		t.Error("expected stream of another account to stay open")
+	}
+	conn := &trackedConn{ctx: second}
+	if err := conn.Receive(nil); !errors.Is(err, ErrDeactivated) {
+		t.Errorf("expected receiving on ended stream to fail, got %v", err)
+	}
+	if err := conn.Send(nil); !errors.Is(err, ErrDeactivated) {
+		t.Errorf("expected sending on ended stream to fail, got %v", err)
+	}
+
+	doneSecond()
+	if _, ok := c.streams[alfa]; ok {
+		t.Error("expected returned streams to be dropped")
+	}
+}
@@ -7,6 +7,7 @@ import (
 )
 
 var (
-	ErrDeactivated = connect.NewError(connect.CodePermissionDenied, errors.New("account deactivated"))
-	ErrDenied      = connect.NewError(connect.CodePermissionDenied, errors.New(""))
+	ErrDeactivated  = connect.NewError(connect.CodePermissionDenied, errors.New("account deactivated"))
+	ErrDenied       = connect.NewError(connect.CodePermissionDenied, errors.New(""))
+	ErrSessionEnded = connect.NewError(connect.CodeUnauthenticated, errors.New("session ended"))
 )
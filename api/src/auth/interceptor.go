@@ -4,17 +4,31 @@ import (
 	"context"
 
 	"connectrpc.com/connect"
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
 )
 
+// public procedures are available without signing in, there's no account in their context
+var public = map[string]bool{
+	iamv1connect.IAMServiceListIdentityProvidersProcedure: true,
+	iamv1connect.IAMServiceExchangeCodeProcedure:          true,
+	iamv1connect.IAMServiceSignUpProcedure:                true,
+	iamv1connect.IAMServiceSignInProcedure:                true,
+	iamv1connect.IAMServiceRefreshProcedure:               true,
+}
+
 func (c *Controller) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return connect.UnaryFunc(func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
-		account, err := c.AccountFromRequestHeader(ctx, request.Header())
+		if public[request.Spec().Procedure] {
+			return next(ctx, request)
+		}
+		account, session, err := c.SessionFromRequestHeader(ctx, request.Header())
 		if err != nil {
 			return nil, err
 		}
 		if !account.Active {
 			return nil, ErrDeactivated
 		}
+		ctx = context.WithValue(ctx, SessionContextKey, session)
 		return next(context.WithValue(ctx, ContextKey, account), request)
 	})
 }
@@ -28,13 +42,20 @@ func (c *Controller) WrapStreamingClient(next connect.StreamingClientFunc) conne
 
 func (c *Controller) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		account, err := c.AccountFromRequestHeader(ctx, conn.RequestHeader())
+		if public[conn.Spec().Procedure] {
+			return next(ctx, conn)
+		}
+		account, session, err := c.SessionFromRequestHeader(ctx, conn.RequestHeader())
 		if err != nil {
 			return err
 		}
 		if !account.Active {
 			return ErrDeactivated
 		}
-		return next(context.WithValue(ctx, ContextKey, account), conn)
+		// streams outlive the cached session, they're ended along with it or the account
+		ctx, done := c.track(ctx, account.ID, session)
+		defer done()
+		ctx = context.WithValue(ctx, SessionContextKey, session)
+		return next(context.WithValue(ctx, ContextKey, account), &trackedConn{StreamingHandlerConn: conn, ctx: ctx})
 	})
 }
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
+)
+
+// Permission is granted to roles by the Policy & required by procedures.
+type Permission string
+
+const (
+	PermissionListAccounts     Permission = "accounts.list"
+	PermissionActivateAccounts Permission = "accounts.activate"
+	PermissionManageRoles      Permission = "roles.manage"
+)
+
+// RoleModerator looks after accounts without being able to manage roles.
+const RoleModerator = "moderator"
+
+// Policy tells which permissions roles grant & which permission procedures require.
+// Procedures missing from the policy are available to every active account.
+type Policy struct {
+	roles      map[string][]Permission
+	procedures map[string]Permission
+}
+
+func DefaultPolicy() *Policy {
+	return &Policy{
+		roles: map[string][]Permission{
+			RoleOwner:     {PermissionListAccounts, PermissionActivateAccounts, PermissionManageRoles},
+			RoleModerator: {PermissionListAccounts, PermissionActivateAccounts},
+		},
+		procedures: map[string]Permission{
+			iamv1connect.IAMServiceListAccountsProcedure:            PermissionListAccounts,
+			iamv1connect.IAMServiceUpdateAccountActivationProcedure: PermissionActivateAccounts,
+			iamv1connect.IAMServiceGrantRoleProcedure:               PermissionManageRoles,
+			iamv1connect.IAMServiceRevokeRoleProcedure:              PermissionManageRoles,
+		},
+	}
+}
+
+// Roles returns ids of all roles known to the policy, sorted.
+func (p *Policy) Roles() []string {
+	return slices.Sorted(maps.Keys(p.roles))
+}
+
+// Known reports whether the role is known to the policy.
+func (p *Policy) Known(role string) bool {
+	_, ok := p.roles[role]
+	return ok
+}
+
+// Required returns permission the procedure requires, if any.
+func (p *Policy) Required(procedure string) (Permission, bool) {
+	permission, ok := p.procedures[procedure]
+	return permission, ok
+}
+
+// Allows reports whether any of the roles grants the permission.
+func (p *Policy) Allows(roles []string, permission Permission) bool {
+	for _, role := range roles {
+		if slices.Contains(p.roles[role], permission) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
+)
+
+func TestPolicy(t *testing.T) {
+	p := DefaultPolicy()
+	if !slices.Equal(p.Roles(), []string{RoleModerator, RoleOwner}) {
+		t.Fatalf("unexpected roles: %v", p.Roles())
+	}
+	if _, ok := p.Required(iamv1connect.IAMServiceResolveAccountProcedure); ok {
+		t.Fatal("expected resolving accounts to require no permission")
+	}
+
+	for _, tc := range []struct {
+		procedure string
+		roles     []string
+		allowed   bool
+	}{
+		{iamv1connect.IAMServiceListAccountsProcedure, nil, false},
+		{iamv1connect.IAMServiceListAccountsProcedure, []string{RoleModerator}, true},
+		{iamv1connect.IAMServiceUpdateAccountActivationProcedure, []string{RoleModerator}, true},
+		{iamv1connect.IAMServiceGrantRoleProcedure, []string{RoleModerator}, false},
+		{iamv1connect.IAMServiceRevokeRoleProcedure, []string{"unknown", RoleOwner}, true},
+	} {
+		permission, ok := p.Required(tc.procedure)
+		if !ok {
+			t.Fatalf("expected %s to require a permission", tc.procedure)
+		}
+		if p.Allows(tc.roles, permission) != tc.allowed {
+			t.Errorf("%s by %v: expected allowed=%v", tc.procedure, tc.roles, tc.allowed)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"go.uber.org/zap"
+)
+
+const (
+	// SessionCookie may carry the access token instead of the Authorization header.
+	SessionCookie = "hexes.session"
+	// RefreshCookie carries the refresh token of browsers, scripts can't read it & it's only sent to Refresh.
+	RefreshCookie = "hexes.refresh"
+	// AttemptCookie carries the secret binding authorization attempts to the browser starting them,
+	// so nobody can sign others in with their own authorization code.
+	AttemptCookie = "hexes.attempt"
+)
+
+// Tokens are issued for a session by SignIn & Refresh.
+type Tokens struct {
+	Session          uuid.UUID
+	Access           string
+	AccessExpiresAt  time.Time
+	Refresh          string // empty if the presented one was replaced just before, the replacement stays valid
+	RefreshExpiresAt time.Time
+}
+
+// SignIn resolves the credential with the named provider & starts a session of the linked account.
+func (c *Controller) SignIn(ctx context.Context, provider, credential, userAgent string) (*db.Account, *Tokens, error) {
+	log := config.GetLogger(ctx)
+	i, err := c.Resolve(ctx, provider, credential)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	tokens := &Tokens{Session: uuid.New(), RefreshExpiresAt: now.Add(c.cfg.Auth.Sessions.RefreshTTL)}
+	refresh, hash := newRefreshToken(tokens.Session)
+	tokens.Refresh = refresh
+
+	var (
+		account db.Account
+		ended   []uuid.UUID
+	)
+	err = c.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		account, ended, err = c.link(ctx, q, i)
+		if err != nil {
+			return err
+		}
+		if err := q.DeleteExpiredSessions(ctx, account.ID); err != nil {
+			return fmt.Errorf("deleting expired sessions: %w", err)
+		}
+		err = q.CreateSession(ctx, db.CreateSessionParams{
+			ID:          tokens.Session,
+			AccountID:   account.ID,
+			Provider:    i.Provider,
+			UserAgent:   userAgent,
+			RefreshHash: hash,
+			ExpiresAt:   pgtype.Timestamptz{Time: tokens.RefreshExpiresAt, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("creating session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.EvictSessions(ended...)
+	c.issue(tokens, account.ID, now)
+	c.cache.Add(tokens.Session, &account)
+	log.Info(
+		"signed in",
+		zap.String("account.id", account.ID.String()),
+		zap.String("session.id", tokens.Session.String()),
+		zap.String("provider", i.Provider),
+	)
+	return &account, tokens, nil
+}
+
+// Refresh replaces the refresh token of the session & issues a new access token.
+// Reusing a refresh token ends the session, since it has likely been stolen, unless it was
+// replaced within the grace period, e.g. by another tab refreshing at the same time.
+func (c *Controller) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	log := config.GetLogger(ctx)
+	id, hash, err := parseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	now := time.Now()
+	tokens := &Tokens{Session: id, RefreshExpiresAt: now.Add(c.cfg.Auth.Sessions.RefreshTTL)}
+	refresh, newHash := newRefreshToken(id)
+	tokens.Refresh = refresh
+
+	var (
+		session db.Session
+		graced  bool
+		reused  bool
+	)
+	err = c.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		session, err = q.RefreshSession(ctx, db.RefreshSessionParams{
+			NewRefreshHash: newHash,
+			ExpiresAt:      pgtype.Timestamptz{Time: tokens.RefreshExpiresAt, Valid: true},
+			ID:             id,
+			RefreshHash:    hash,
+		})
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("refreshing session: %w", err)
+		}
+
+		session, err = q.GetSession(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrSessionEnded
+		} else if err != nil {
+			return fmt.Errorf("getting session: %w", err)
+		}
+		if session.PrevRefreshHash.String == hash && now.Sub(session.RefreshedAt.Time) < c.cfg.Auth.Sessions.RefreshGrace {
+			graced = true
+			return nil
+		}
+		reused = true
+		if _, err := q.DeleteSession(ctx, db.DeleteSessionParams{ID: id, AccountID: session.AccountID}); err != nil {
+			return fmt.Errorf("deleting session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		c.EvictSessions(id)
+		log.Warn(
+			"refresh token reused, session ended",
+			zap.String("account.id", session.AccountID.String()),
+			zap.String("session.id", id.String()),
+		)
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("refresh token reused"))
+	}
+	if graced {
+		tokens.Refresh, tokens.RefreshExpiresAt = "", session.ExpiresAt.Time
+	}
+
+	c.issue(tokens, session.AccountID, now)
+	return tokens, nil
+}
+
+// EvictSessions drops cached sessions & ends their open streams,
+// so requests with their access tokens are rejected immediately.
+func (c *Controller) EvictSessions(ids ...uuid.UUID) {
+	ended := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		c.cache.Remove(id)
+		ended[id] = true
+	}
+	c.endSessionStreams(ended)
+}
+
+func (c *Controller) issue(t *Tokens, accountID uuid.UUID, now time.Time) {
+	t.AccessExpiresAt = now.Add(c.cfg.Auth.Sessions.AccessTTL)
+	t.Access = c.signer.sign(&accessClaims{Session: t.Session, Subject: accountID, Expiry: t.AccessExpiresAt.Unix()})
+}
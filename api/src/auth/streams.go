@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// stream is an open stream of a session, streams are authenticated once when they start.
+type stream struct {
+	session uuid.UUID
+	cancel  context.CancelCauseFunc
+}
+
+// track registers a stream of the account, its context is cancelled once the stream is ended
+// by EndStreams or EvictSessions. Call done when the stream returns.
+func (c *Controller) track(ctx context.Context, accountID, session uuid.UUID) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	s := &stream{session: session, cancel: cancel}
+
+	c.mu.Lock()
+	if c.streams == nil {
+		c.streams = map[uuid.UUID]map[*stream]bool{}
+	}
+	if c.streams[accountID] == nil {
+		c.streams[accountID] = map[*stream]bool{}
+	}
+	c.streams[accountID][s] = true
+	c.mu.Unlock()
+
+	return ctx, func() {
+		c.mu.Lock()
+		delete(c.streams[accountID], s)
+		if len(c.streams[accountID]) == 0 {
+			delete(c.streams, accountID)
+		}
+		c.mu.Unlock()
+		cancel(nil)
+	}
+}
+
+// EndStreams ends open streams of given accounts with the cause, e.g. ErrDeactivated.
+// Returns number of ended streams.
+func (c *Controller) EndStreams(cause error, ids ...uuid.UUID) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var n int
+	for _, id := range ids {
+		for s := range c.streams[id] {
+			s.cancel(cause)
+			n++
+		}
+	}
+	return n
+}
+
+// endSessionStreams ends open streams of given sessions.
+func (c *Controller) endSessionStreams(ids map[uuid.UUID]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, streams := range c.streams {
+		for s := range streams {
+			if ids[s.session] {
+				s.cancel(ErrSessionEnded)
+			}
+		}
+	}
+}
+
+// trackedConn fails once its stream is ended, so handlers stop on their next receive or send.
+type trackedConn struct {
+	connect.StreamingHandlerConn
+	ctx context.Context
+}
+
+func (c *trackedConn) Receive(msg any) error {
+	if err := context.Cause(c.ctx); err != nil {
+		return err
+	}
+	return c.StreamingHandlerConn.Receive(msg)
+}
+
+func (c *trackedConn) Send(msg any) error {
+	if err := context.Cause(c.ctx); err != nil {
+		return err
+	}
+	return c.StreamingHandlerConn.Send(msg)
+}
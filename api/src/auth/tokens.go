@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// accessClaims are carried by access tokens, which are JWTs signed with HS256.
+type accessClaims struct {
+	Session uuid.UUID `json:"sid"`
+	Subject uuid.UUID `json:"sub"`
+	Expiry  int64     `json:"exp"`
+}
+
+// stateClaims are carried by the state of an authorization attempt, see Controller.Authorize.
+type stateClaims struct {
+	Provider    string `json:"provider"`
+	RedirectURI string `json:"redirect_uri"`
+	Nonce       string `json:"nonce"`
+	Binding     string `json:"binding"` // hash of the secret in AttemptCookie of the client
+	Expiry      int64  `json:"exp"`
+}
+
+// headers tell kinds of signed tokens apart, so neither is accepted as the other
+var (
+	accessHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	stateHeader  = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"state"}`))
+)
+
+// minKeyLength matches the output of SHA-256, shorter keys weaken HS256.
+const minKeyLength = 32
+
+type signer struct {
+	key []byte
+}
+
+func newSigner(key []byte) (*signer, error) {
+	if len(key) < minKeyLength {
+		return nil, fmt.Errorf("key has to be at least %d bytes long", minKeyLength)
+	}
+	return &signer{key: key}, nil
+}
+
+func (s *signer) sign(c *accessClaims) string {
+	return s.signAs(accessHeader, c)
+}
+
+// verify checks signature & expiry of an access token.
+func (s *signer) verify(token string, now time.Time) (*accessClaims, error) {
+	c := &accessClaims{}
+	if err := s.verifyAs(accessHeader, token, c); err != nil {
+		return nil, err
+	}
+	if !now.Before(time.Unix(c.Expiry, 0)) {
+		return nil, errors.Join(ErrInvalidToken, errors.New("token expired"))
+	}
+	return c, nil
+}
+
+func (s *signer) signState(c *stateClaims) string {
+	return s.signAs(stateHeader, c)
+}
+
+// verifyState checks signature & expiry of the state of an authorization attempt.
+func (s *signer) verifyState(state string, now time.Time) (*stateClaims, error) {
+	c := &stateClaims{}
+	if err := s.verifyAs(stateHeader, state, c); err != nil {
+		return nil, err
+	}
+	if !now.Before(time.Unix(c.Expiry, 0)) {
+		return nil, errors.Join(ErrInvalidToken, errors.New("state expired"))
+	}
+	return c, nil
+}
+
+func (s *signer) signAs(header string, claims any) string {
+	payload, _ := json.Marshal(claims)
+	input := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return input + "." + base64.RawURLEncoding.EncodeToString(s.mac(input))
+}
+
+func (s *signer) verifyAs(header, token string, claims any) error {
+	h, rest, _ := strings.Cut(token, ".")
+	payload, signature, ok := strings.Cut(rest, ".")
+	if !ok || h != header {
+		return ErrInvalidToken
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.mac(h+"."+payload)) {
+		return ErrInvalidToken
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	if err := json.Unmarshal(raw, claims); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+func (s *signer) mac(input string) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(input))
+	return h.Sum(nil)
+}
+
+// newRefreshToken returns "<session id>.<secret>" along with the hash of the secret, which is all that's stored.
+func newRefreshToken(session uuid.UUID) (string, string) {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return session.String() + "." + encoded, hashSecret(encoded)
+}
+
+// parseRefreshToken returns the session id & hash of the secret of a refresh token.
+func parseRefreshToken(token string) (uuid.UUID, string, error) {
+	id, secret, ok := strings.Cut(token, ".")
+	if !ok || secret == "" {
+		return uuid.Nil, "", ErrInvalidToken
+	}
+	session, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidToken
+	}
+	return session, hashSecret(secret), nil
+}
+
+// newNonce returns a random value binding ID tokens to an authorization attempt.
+func newNonce() string {
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	return base64.RawURLEncoding.EncodeToString(nonce)
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func testSigner(t *testing.T, key string) *signer {
+	t.Helper()
+	s, err := newSigner([]byte(strings.Repeat(key, minKeyLength)[:minKeyLength]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestNewSigner(t *testing.T) {
+	if _, err := newSigner(nil); err == nil {
+		t.Fatal("expected missing key to be rejected")
+	}
+	if _, err := newSigner([]byte(strings.Repeat("k", minKeyLength-1))); err == nil {
+		t.Fatal("expected short key to be rejected")
+	}
+}
+
+func TestAccessToken(t *testing.T) {
+	s := testSigner(t, "key")
+	now := time.Now()
+	claims := &accessClaims{Session: uuid.New(), Subject: uuid.New(), Expiry: now.Add(time.Minute).Unix()}
+	token := s.sign(claims)
+
+	verified, err := s.verify(token, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *verified != *claims {
+		t.Fatalf("expected %+v, got %+v", claims, verified)
+	}
+
+	for name, check := range map[string]func() (*accessClaims, error){
+		"expired":   func() (*accessClaims, error) { return s.verify(token, now.Add(time.Hour)) },
+		"other key": func() (*accessClaims, error) { return testSigner(t, "other").verify(token, now) },
+		"tampered":  func() (*accessClaims, error) { return s.verify(token[:len(token)-2]+"AA", now) },
+		"malformed": func() (*accessClaims, error) { return s.verify("token", now) },
+	} {
+		if _, err := check(); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: expected invalid token, got %v", name, err)
+		}
+	}
+}
+
+func TestState(t *testing.T) {
+	s := testSigner(t, "key")
+	now := time.Now()
+	claims := &stateClaims{Provider: "github", RedirectURI: "http://localhost/callback", Nonce: newNonce(), Binding: hashSecret("secret"), Expiry: now.Add(time.Minute).Unix()}
+	state := s.signState(claims)
+
+	verified, err := s.verifyState(state, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *verified != *claims {
+		t.Fatalf("expected %+v, got %+v", claims, verified)
+	}
+
+	if _, err := s.verifyState(state, now.Add(time.Hour)); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected expired state to be rejected, got %v", err)
+	}
+	if _, err := testSigner(t, "other").verifyState(state, now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected state of other key to be rejected, got %v", err)
+	}
+	if _, err := s.verify(state, now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected state to be rejected as access token, got %v", err)
+	}
+	access := s.sign(&accessClaims{Session: uuid.New(), Subject: uuid.New(), Expiry: now.Add(time.Minute).Unix()})
+	if _, err := s.verifyState(access, now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected access token to be rejected as state, got %v", err)
+	}
+}
+
+func TestRefreshToken(t *testing.T) {
+	session := uuid.New()
+	token, hash := newRefreshToken(session)
+	parsed, parsedHash, err := parseRefreshToken(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed != session || parsedHash != hash {
+		t.Fatalf("expected %v & %s, got %v & %s", session, hash, parsed, parsedHash)
+	}
+	if other, otherHash := newRefreshToken(session); other == token || otherHash == hash {
+		t.Fatal("expected every refresh token to have its own secret")
+	}
+	if _, _, err := parseRefreshToken(session.String()); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected token without secret to be rejected, got %v", err)
+	}
+}
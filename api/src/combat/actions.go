@@ -0,0 +1,250 @@
+package combat
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/openhexes/openhexes/api/src/effects"
+	"github.com/openhexes/openhexes/api/src/hex"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+)
+
+type ActionKind string
+
+const (
+	Move   ActionKind = "move"
+	Attack ActionKind = "attack" // optionally moving to To first
+	Shoot  ActionKind = "shoot"
+	Wait   ActionKind = "wait"   // act once everyone else did this round
+	Defend ActionKind = "defend" // +20% defence until the next turn
+	Cast   ActionKind = "cast"   // by the hero of current unit's side, doesn't take unit's turn
+)
+
+// Action is taken by the current unit.
+type Action struct {
+	Kind   ActionKind
+	To     hex.Axial      // destination of Move & Attack, center of area spells
+	Target string         // unit attacked by Attack & Shoot, target of Cast
+	Spell  *magicv1.Spell // cast by Cast
+}
+
+type EventKind string
+
+const (
+	RoundStarted EventKind = "round_started"
+	Moved        EventKind = "moved"
+	Attacked     EventKind = "attacked"
+	Retaliated   EventKind = "retaliated"
+	Shot         EventKind = "shot"
+	Waited       EventKind = "waited"
+	Defended     EventKind = "defended"
+	Died         EventKind = "died"
+	Lucky        EventKind = "lucky"    // next hit deals double damage
+	Unlucky      EventKind = "unlucky"  // next hit deals half damage
+	Boosted      EventKind = "boosted"  // high morale, unit acts again
+	Panicked     EventKind = "panicked" // low morale, unit skips its turn
+	CastSpell    EventKind = "cast_spell"
+	Damaged      EventKind = "damaged" // by a spell
+	Healed       EventKind = "healed"
+	Modified     EventKind = "modified" // stat changed by a spell
+	Expired      EventKind = "expired"  // spell modification wore off
+	Ended        EventKind = "ended"
+)
+
+// Event records a single change of the battle, the log of events fully describes it.
+type Event struct {
+	Round  int
+	Kind   EventKind
+	Unit   string
+	Target string
+	From   hex.Axial
+	To     hex.Axial
+	Damage uint32
+	Killed uint32
+	Side   Side // winner for Ended, caster for CastSpell
+
+	Spell     string
+	Level     int32 // effective spell level
+	Attribute effects.Attribute
+	Delta     int32 // stat change for Modified & Expired, health restored for Healed
+}
+
+// Act performs action of the current unit and returns resulting events.
+func (b *Battle) Act(a Action) ([]Event, error) {
+	if b.over {
+		return nil, ErrOver
+	}
+	u := b.current
+	start := len(b.log)
+
+	if a.Kind == Cast {
+		if err := b.cast(u.Side, a); err != nil {
+			return nil, err
+		}
+		b.checkOver()
+		if !b.over && !u.Alive() {
+			b.advance()
+		}
+		return b.log[start:], nil
+	}
+
+	switch a.Kind {
+	case Move:
+		if err := b.move(u, a.To); err != nil {
+			return nil, err
+		}
+
+	case Attack:
+		target, err := b.target(u, a.Target)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := b.reachable(u)[a.To]; !ok {
+			return nil, fmt.Errorf("hex %v is out of reach", a.To)
+		}
+		if hex.Distance(a.To, target.Position) != 1 {
+			return nil, fmt.Errorf("target %q is not adjacent to %v", target.ID, a.To)
+		}
+		_ = b.move(u, a.To)
+		b.hit(u, target, Attacked)
+		if target.Alive() && !target.retaliated {
+			target.retaliated = true
+			b.hit(target, u, Retaliated)
+		}
+
+	case Shoot:
+		target, err := b.target(u, a.Target)
+		if err != nil {
+			return nil, err
+		}
+		if !b.CanShoot(u) {
+			return nil, fmt.Errorf("unit %q can't shoot", u.ID)
+		}
+		u.Shots--
+		b.hit(u, target, Shot)
+
+	case Wait:
+		if u.waited {
+			return nil, fmt.Errorf("unit %q already waited this round", u.ID)
+		}
+		u.waited = true
+		b.queue = append(b.queue, u)
+		b.emit(Event{Kind: Waited, Unit: u.ID})
+
+	case Defend:
+		u.defending = true
+		b.emit(Event{Kind: Defended, Unit: u.ID})
+
+	default:
+		return nil, fmt.Errorf("unknown action %q", a.Kind)
+	}
+
+	b.checkOver()
+	if b.over {
+		return b.log[start:], nil
+	}
+	switch a.Kind {
+	case Move, Attack, Shoot:
+		if u.Alive() && !b.boosted && b.roll(u.morale(), 24) {
+			b.boosted = true
+			b.emit(Event{Kind: Boosted, Unit: u.ID})
+			return b.log[start:], nil
+		}
+	}
+	b.advance()
+	return b.log[start:], nil
+}
+
+func (b *Battle) target(u *Unit, id string) (*Unit, error) {
+	target := b.Unit(id)
+	if target == nil || !target.Alive() {
+		return nil, fmt.Errorf("unknown target %q", id)
+	}
+	if target.Side == u.Side {
+		return nil, fmt.Errorf("target %q is not an enemy", id)
+	}
+	return target, nil
+}
+
+func (b *Battle) move(u *Unit, to hex.Axial) error {
+	if to == u.Position {
+		return nil
+	}
+	if _, ok := b.reachable(u)[to]; !ok {
+		return fmt.Errorf("hex %v is out of reach", to)
+	}
+	b.emit(Event{Kind: Moved, Unit: u.ID, From: u.Position, To: to})
+	u.Position = to
+	return nil
+}
+
+// Damage returns damage dealt by attacker before luck & random spread, given a roll within damage range.
+// Every point of attack above defence adds 5% (up to +300%), every point below subtracts 2.5% (down to -70%).
+// Damage of huge stacks saturates at math.MaxUint32.
+func Damage(attacker, target *Unit, roll uint32, ranged bool) uint32 {
+	defence := int64(target.Stats.Defence)
+	if target.defending {
+		defence += max(defence/5, 1)
+	}
+	diff := int64(attacker.Stats.Attack) - defence
+
+	permille := uint64(1000)
+	if diff > 0 {
+		permille += 50 * uint64(min(diff, 60))
+	} else {
+		permille -= 25 * uint64(min(-diff, 28))
+	}
+
+	// count & roll fit in 32 bits each, so only scaling by permille may overflow
+	hi, lo := bits.Mul64(uint64(attacker.Count)*uint64(roll), permille)
+	damage := uint64(math.MaxUint64)
+	if hi < 1000 {
+		damage, _ = bits.Div64(hi, lo, 1000)
+	}
+	switch {
+	case ranged && hex.Distance(attacker.Position, target.Position) > RangePenaltyDistance:
+		damage /= 2
+	case !ranged && attacker.Kind.GetStats().GetShots() > 0:
+		damage /= 2 // shooters are poor fighters
+	}
+	return uint32(min(max(damage, 1), math.MaxUint32))
+}
+
+func (b *Battle) hit(attacker, target *Unit, kind EventKind) {
+	roll := attacker.MinDamage + uint32(b.rng.IntN(int(attacker.MaxDamage-attacker.MinDamage)+1))
+	damage := Damage(attacker, target, roll, kind == Shot)
+
+	switch l := attacker.luck(); {
+	case l > 0 && b.roll(l, 24):
+		b.emit(Event{Kind: Lucky, Unit: attacker.ID})
+		damage = uint32(min(uint64(damage)*2, math.MaxUint32))
+	case l < 0 && b.roll(-l, 24):
+		b.emit(Event{Kind: Unlucky, Unit: attacker.ID})
+		damage = max(damage/2, 1)
+	}
+
+	killed := target.damage(damage)
+	b.emit(Event{Kind: kind, Unit: attacker.ID, Target: target.ID, Damage: damage, Killed: killed})
+	if !target.Alive() {
+		b.emit(Event{Kind: Died, Unit: target.ID})
+	}
+}
+
+// damage removes health from the stack, starting with its top creature, and returns number of creatures killed.
+func (u *Unit) damage(amount uint32) uint32 {
+	health := uint64(u.Kind.GetStats().GetHealth())
+	total := uint64(u.Count-1)*health + uint64(u.Health)
+	if uint64(amount) >= total {
+		killed := u.Count
+		u.Count, u.Health = 0, 0
+		return killed
+	}
+
+	left := total - uint64(amount)
+	count := uint32((left + health - 1) / health)
+	killed := u.Count - count
+	u.Count = count
+	u.Health = uint32(left - uint64(count-1)*health)
+	return killed
+}
@@ -0,0 +1,77 @@
+package combat
+
+import (
+	"errors"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+)
+
+// Auto picks a simple action for the current unit: shoot the nearest enemy if possible,
+// otherwise attack the nearest reachable one or approach it.
+func Auto(b *Battle) Action {
+	u := b.Current()
+	if u == nil {
+		return Action{}
+	}
+
+	var enemies []*Unit
+	for _, e := range b.units {
+		if e.Alive() && e.Side != u.Side {
+			enemies = append(enemies, e)
+		}
+	}
+	nearest := func(from hex.Axial) (*Unit, int) {
+		var (
+			best     *Unit
+			distance = hex.Unreachable
+		)
+		for _, e := range enemies {
+			if d := hex.Distance(from, e.Position); d < distance {
+				best, distance = e, d
+			}
+		}
+		return best, distance
+	}
+
+	if b.CanShoot(u) {
+		target, _ := nearest(u.Position)
+		return Action{Kind: Shoot, Target: target.ID}
+	}
+
+	// Reachable is sorted by distance, so the closest hex to stand on wins
+	reachable := b.Reachable(u)
+	for _, h := range reachable {
+		for _, e := range enemies {
+			if hex.Distance(h, e.Position) == 1 {
+				return Action{Kind: Attack, To: h, Target: e.ID}
+			}
+		}
+	}
+
+	best, distance := u.Position, hex.Unreachable
+	for _, h := range reachable {
+		if _, d := nearest(h); d < distance {
+			best, distance = h, d
+		}
+	}
+	if best == u.Position {
+		return Action{Kind: Defend}
+	}
+	return Action{Kind: Move, To: best}
+}
+
+// Run plays the battle using Auto for both sides until it's over or given number of actions is taken.
+func Run(b *Battle, actions int) (Side, error) {
+	for range actions {
+		if winner, over := b.Winner(); over {
+			return winner, nil
+		}
+		if _, err := b.Act(Auto(b)); err != nil {
+			return 0, err
+		}
+	}
+	if winner, over := b.Winner(); over {
+		return winner, nil
+	}
+	return 0, errors.New("battle isn't over")
+}
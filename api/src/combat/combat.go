@@ -0,0 +1,432 @@
+// Package combat runs tactical battles between creature stacks on a hex battlefield.
+//
+// Battles are deterministic: damage, morale & luck rolls come from a RNG seeded
+// when the battle starts, so the same seed & sequence of actions always produce
+// the same outcome. This allows replaying battles exactly & balancing creatures
+// by simulating many of them, see Auto & Run.
+//
+// Stacks act one by one in order of their speed. Effects of the battlefield terrain
+// are applied to every stack when the battle starts, see effects.Engine.Creature.
+package combat
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/effects"
+	"github.com/openhexes/openhexes/api/src/hex"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+const (
+	DefaultRows    = 11
+	DefaultColumns = 15
+
+	// MaxMorale & MaxLuck cap both positive & negative values, each point gives 1/24 chance to trigger.
+	MaxMorale = 3
+	MaxLuck   = 3
+	// RangePenaltyDistance is a distance beyond which ranged attacks deal half damage.
+	RangePenaltyDistance = 10
+)
+
+var ErrOver = errors.New("battle is over")
+
+type Side int
+
+const (
+	Attacker Side = iota
+	Defender
+)
+
+func (s Side) String() string {
+	if s == Attacker {
+		return "attacker"
+	}
+	return "defender"
+}
+
+// Stack enters the battle, stacks are deployed along the edge of their side in given order.
+type Stack struct {
+	ID    string
+	Side  Side
+	Kind  *creaturesv1.Creature_Kind
+	Count uint32
+}
+
+// Unit is a stack taking part in the battle.
+type Unit struct {
+	ID        string
+	Side      Side
+	Kind      *creaturesv1.Creature_Kind
+	Stats     effects.Stats  // effective stats after terrain effects
+	Trace     []effects.Step // explains difference between base & effective stats
+	Flying    bool
+	Count     uint32
+	Health    uint32 // remaining health of the top creature
+	Shots     uint32
+	Position  hex.Axial
+	MinDamage uint32
+	MaxDamage uint32
+
+	order      int
+	retaliated bool // retaliations are limited to one per round
+	waited     bool
+	defending  bool
+	buffs      []buff
+}
+
+func (u *Unit) Alive() bool {
+	return u.Count > 0
+}
+
+func (u *Unit) morale() int {
+	return min(max(int(u.Stats.Morale), -MaxMorale), MaxMorale)
+}
+
+func (u *Unit) luck() int {
+	return min(max(int(u.Stats.Luck), -MaxLuck), MaxLuck)
+}
+
+func (u *Unit) speed() int {
+	return max(int(u.Stats.Speed), 0)
+}
+
+// Battle keeps state of a single battle, it's not safe for concurrent use.
+type Battle struct {
+	seed      int64
+	rng       *rand.Rand
+	engine    *effects.Engine
+	terrain   *mapv1.Terrain
+	rows      int
+	columns   int
+	obstacles map[hex.Axial]bool
+
+	casters [2]*Caster
+	casted  [2]bool // sides that cast a spell this round
+
+	units   []*Unit
+	queue   []*Unit // units yet to act this round
+	current *Unit
+	boosted bool // current unit already acts again thanks to morale
+	round   int
+	over    bool
+	winner  Side
+	log     []Event
+}
+
+type Option func(*Battle)
+
+// WithEngine overrides engine evaluating terrain effects.
+func WithEngine(engine *effects.Engine) Option {
+	return func(b *Battle) {
+		b.engine = engine
+	}
+}
+
+// WithField overrides battlefield dimensions.
+func WithField(rows, columns int) Option {
+	return func(b *Battle) {
+		b.rows, b.columns = rows, columns
+	}
+}
+
+// WithObstacles places impassable hexes on the battlefield, flying units pass over them.
+func WithObstacles(obstacles ...hex.Axial) Option {
+	return func(b *Battle) {
+		for _, o := range obstacles {
+			b.obstacles[o] = true
+		}
+	}
+}
+
+// New deploys stacks on a battlefield of given terrain & starts the first round.
+func New(seed int64, terrain *mapv1.Terrain, stacks []Stack, opts ...Option) (*Battle, error) {
+	b := &Battle{
+		seed:      seed,
+		rng:       rand.New(rand.NewPCG(uint64(seed), uint64(seed)^0x9e3779b97f4a7c15)),
+		terrain:   terrain,
+		rows:      DefaultRows,
+		columns:   DefaultColumns,
+		obstacles: map[hex.Axial]bool{},
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	if b.engine == nil {
+		b.engine = effects.New()
+	}
+	if b.rows <= 0 || b.columns < 2 {
+		return nil, fmt.Errorf("invalid battlefield size %dx%d", b.rows, b.columns)
+	}
+
+	var sides [2][]Stack
+	ids := map[string]bool{}
+	for _, s := range stacks {
+		if s.Side != Attacker && s.Side != Defender {
+			return nil, fmt.Errorf("stack %q: invalid side %d", s.ID, s.Side)
+		}
+		if ids[s.ID] {
+			return nil, fmt.Errorf("duplicate stack %q", s.ID)
+		}
+		ids[s.ID] = true
+		sides[s.Side] = append(sides[s.Side], s)
+	}
+	for side, list := range sides {
+		if len(list) == 0 {
+			return nil, fmt.Errorf("%s has no stacks", Side(side))
+		}
+		if len(list) > b.rows {
+			return nil, fmt.Errorf("%s has more stacks than battlefield rows: %d", Side(side), len(list))
+		}
+	}
+
+	for side, list := range sides {
+		column := 0
+		if Side(side) == Defender {
+			column = b.columns - 1
+		}
+		for i, s := range list {
+			row := (2*i + 1) * b.rows / (2 * len(list))
+			u, err := b.deploy(s, hex.FromOffset(row, column, 0))
+			if err != nil {
+				return nil, fmt.Errorf("stack %q: %w", s.ID, err)
+			}
+			b.units = append(b.units, u)
+		}
+	}
+	for i, u := range b.units {
+		u.order = i
+	}
+
+	b.advance()
+	return b, nil
+}
+
+func (b *Battle) deploy(s Stack, position hex.Axial) (*Unit, error) {
+	stats := s.Kind.GetStats()
+	if s.Count == 0 {
+		return nil, errors.New("stack is empty")
+	}
+	if stats.GetHealth() == 0 {
+		return nil, fmt.Errorf("creature %q has no health", s.Kind.GetId())
+	}
+	if b.obstacles[position] {
+		return nil, fmt.Errorf("deployment hex %v is blocked by an obstacle", position)
+	}
+
+	result := b.engine.Creature(b.terrain, s.Kind, effects.Stats{
+		Attack:  stats.GetAttack(),
+		Defence: stats.GetDefence(),
+		Speed:   stats.GetSpeed(),
+		Morale:  stats.GetMorale(),
+		Luck:    stats.GetLuck(),
+	})
+	return &Unit{
+		ID:        s.ID,
+		Side:      s.Side,
+		Kind:      s.Kind,
+		Stats:     result.Stats,
+		Trace:     result.Trace,
+		Flying:    slices.Contains(result.MovementTypes, creaturesv1.Creature_MOVEMENT_TYPE_FLYING),
+		Count:     s.Count,
+		Health:    stats.GetHealth(),
+		Shots:     stats.GetShots(),
+		Position:  position,
+		MinDamage: stats.GetMinDamage(),
+		MaxDamage: max(stats.GetMinDamage(), stats.GetMaxDamage()),
+	}, nil
+}
+
+func (b *Battle) Seed() int64 {
+	return b.seed
+}
+
+func (b *Battle) Round() int {
+	return b.round
+}
+
+// Current returns unit expected to act, nil once the battle is over.
+func (b *Battle) Current() *Unit {
+	if b.over {
+		return nil
+	}
+	return b.current
+}
+
+// Units returns all units including dead ones, in deployment order.
+func (b *Battle) Units() []*Unit {
+	return b.units
+}
+
+func (b *Battle) Unit(id string) *Unit {
+	for _, u := range b.units {
+		if u.ID == id {
+			return u
+		}
+	}
+	return nil
+}
+
+// Winner returns the winning side once the battle is over.
+func (b *Battle) Winner() (Side, bool) {
+	return b.winner, b.over
+}
+
+// Log returns all events since the start of the battle.
+func (b *Battle) Log() []Event {
+	return b.log
+}
+
+// CanCast evaluates whether a spell may be cast on the battlefield, see effects.Engine.Spell.
+func (b *Battle) CanCast(spell *magicv1.Spell, level int32) *effects.SpellResult {
+	return b.engine.Spell(b.terrain, spell, level)
+}
+
+func (b *Battle) roll(chance, out int) bool {
+	return chance > 0 && b.rng.IntN(out) < chance
+}
+
+func (b *Battle) emit(e Event) {
+	e.Round = b.round
+	b.log = append(b.log, e)
+}
+
+func (b *Battle) startRound() {
+	b.round++
+	b.casted = [2]bool{}
+	b.queue = b.queue[:0]
+	for _, u := range b.units {
+		if !u.Alive() {
+			continue
+		}
+		b.expire(u)
+		u.retaliated = false
+		u.waited = false
+		b.queue = append(b.queue, u)
+	}
+	// faster units act first, attacker wins ties
+	slices.SortStableFunc(b.queue, func(a, c *Unit) int {
+		return cmp.Or(
+			cmp.Compare(c.Stats.Speed, a.Stats.Speed),
+			cmp.Compare(a.Side, c.Side),
+			cmp.Compare(a.order, c.order),
+		)
+	})
+	b.emit(Event{Kind: RoundStarted})
+}
+
+// advance selects unit acting next, starting new rounds as needed.
+func (b *Battle) advance() {
+	b.current, b.boosted = nil, false
+	for !b.over {
+		if len(b.queue) == 0 {
+			b.startRound()
+		}
+		u := b.queue[0]
+		b.queue = b.queue[1:]
+		if !u.Alive() {
+			continue
+		}
+		u.defending = false
+		// units that waited already passed the morale check this round
+		if m := u.morale(); m < 0 && !u.waited && b.roll(-m, 24) {
+			b.emit(Event{Kind: Panicked, Unit: u.ID})
+			continue
+		}
+		b.current = u
+		return
+	}
+}
+
+func (b *Battle) alive(side Side) bool {
+	return slices.ContainsFunc(b.units, func(u *Unit) bool {
+		return u.Side == side && u.Alive()
+	})
+}
+
+func (b *Battle) checkOver() {
+	for _, side := range []Side{Attacker, Defender} {
+		if !b.alive(side) {
+			b.over, b.winner = true, 1-side
+			b.emit(Event{Kind: Ended, Side: b.winner})
+			return
+		}
+	}
+}
+
+// Occupant returns alive unit standing on given hex.
+func (b *Battle) Occupant(h hex.Axial) *Unit {
+	for _, u := range b.units {
+		if u.Alive() && u.Position == h {
+			return u
+		}
+	}
+	return nil
+}
+
+func (b *Battle) free(h hex.Axial) bool {
+	return h.InGrid(uint32(b.rows), uint32(b.columns)) && !b.obstacles[h] && b.Occupant(h) == nil
+}
+
+// Reachable returns hexes unit may move to this turn, sorted by distance.
+// Walking units go around obstacles & other units, flying ones only need a free hex to land.
+func (b *Battle) Reachable(u *Unit) []hex.Axial {
+	steps := b.reachable(u)
+	result := make([]hex.Axial, 0, len(steps))
+	for h := range steps {
+		result = append(result, h)
+	}
+	slices.SortFunc(result, func(x, y hex.Axial) int {
+		return cmp.Or(cmp.Compare(steps[x], steps[y]), cmp.Compare(x.R, y.R), cmp.Compare(x.Q, y.Q))
+	})
+	return result
+}
+
+func (b *Battle) reachable(u *Unit) map[hex.Axial]int {
+	speed := u.speed()
+	steps := map[hex.Axial]int{u.Position: 0}
+	if u.Flying {
+		for _, h := range hex.Range(u.Position, speed) {
+			if b.free(h) {
+				steps[h] = hex.Distance(u.Position, h)
+			}
+		}
+		return steps
+	}
+
+	frontier := []hex.Axial{u.Position}
+	for step := 1; step <= speed && len(frontier) > 0; step++ {
+		var next []hex.Axial
+		for _, h := range frontier {
+			for _, n := range h.Neighbors() {
+				if _, ok := steps[n]; ok || !b.free(n) {
+					continue
+				}
+				steps[n] = step
+				next = append(next, n)
+			}
+		}
+		frontier = next
+	}
+	return steps
+}
+
+// blocked reports whether unit is engaged in melee, which prevents shooting.
+func (b *Battle) blocked(u *Unit) bool {
+	for _, n := range u.Position.Neighbors() {
+		if o := b.Occupant(n); o != nil && o.Side != u.Side {
+			return true
+		}
+	}
+	return false
+}
+
+// CanShoot reports whether unit has shots left & no enemy next to it.
+func (b *Battle) CanShoot(u *Unit) bool {
+	return u.Shots > 0 && !b.blocked(u)
+}
@@ -0,0 +1,439 @@
+package combat
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/magic"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	swordsman = &creaturesv1.Creature_Kind{
+		Id:            "swordsman",
+		MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+		Stats:         &creaturesv1.Creature_Stats{Attack: 10, Defence: 12, MinDamage: 6, MaxDamage: 9, Health: 35, Speed: 5},
+	}
+	archer = &creaturesv1.Creature_Kind{
+		Id:            "archer",
+		MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+		Stats:         &creaturesv1.Creature_Stats{Attack: 6, Defence: 3, MinDamage: 2, MaxDamage: 3, Health: 10, Speed: 4, Shots: 12},
+	}
+	griffin = &creaturesv1.Creature_Kind{
+		Id: "griffin",
+		MovementTypes: []creaturesv1.Creature_MovementType{
+			creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+			creaturesv1.Creature_MOVEMENT_TYPE_FLYING,
+		},
+		Stats: &creaturesv1.Creature_Stats{Attack: 8, Defence: 8, MinDamage: 3, MaxDamage: 6, Health: 25, Speed: 6},
+	}
+
+	grass = &mapv1.Terrain{Id: "grass"}
+)
+
+func battle(t *testing.T, seed int64, terrain *mapv1.Terrain, stacks []Stack, opts ...Option) *Battle {
+	t.Helper()
+	b, err := New(seed, terrain, stacks, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func skirmish() []Stack {
+	return []Stack{
+		{ID: "a1", Side: Attacker, Kind: swordsman, Count: 10},
+		{ID: "a2", Side: Attacker, Kind: archer, Count: 20},
+		{ID: "d1", Side: Defender, Kind: griffin, Count: 12},
+		{ID: "d2", Side: Defender, Kind: swordsman, Count: 8},
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	first := battle(t, 42, grass, skirmish())
+	winner, err := Run(first, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second := battle(t, 42, grass, skirmish())
+	if _, err := Run(second, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first.Log(), second.Log()) {
+		t.Fatal("expected identical battles for the same seed")
+	}
+
+	// replaying recorded actions reproduces the battle too
+	recorded := battle(t, 42, grass, skirmish())
+	var actions []Action
+	for recorded.Current() != nil {
+		a := Auto(recorded)
+		if _, err := recorded.Act(a); err != nil {
+			t.Fatal(err)
+		}
+		actions = append(actions, a)
+	}
+	replay := battle(t, 42, grass, skirmish())
+	for i, a := range actions {
+		if _, err := replay.Act(a); err != nil {
+			t.Fatalf("replaying action #%d: %s", i, err)
+		}
+	}
+	if !reflect.DeepEqual(recorded.Log(), replay.Log()) {
+		t.Fatal("expected replay to reproduce the recorded battle")
+	}
+	if w, over := replay.Winner(); !over || w != winner {
+		t.Fatalf("expected %s to win the replay, got %s (over: %v)", winner, w, over)
+	}
+}
+
+func TestInitiative(t *testing.T) {
+	b := battle(t, 1, grass, skirmish())
+	if b.Round() != 1 || b.Current().ID != "d1" {
+		t.Fatalf("expected the fastest unit to act first, got %q", b.Current().ID)
+	}
+
+	var order []string
+	for b.Round() == 1 {
+		order = append(order, b.Current().ID)
+		if _, err := b.Act(Action{Kind: Defend}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := []string{"d1", "a1", "d2", "a2"}; !reflect.DeepEqual(order, expected) {
+		t.Fatalf("expected order %v, got %v", expected, order)
+	}
+}
+
+func TestWait(t *testing.T) {
+	b := battle(t, 1, grass, skirmish())
+	if _, err := b.Act(Action{Kind: Wait}); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"a1", "d2", "a2"} {
+		if b.Current().ID != id {
+			t.Fatalf("expected %q to act, got %q", id, b.Current().ID)
+		}
+		if _, err := b.Act(Action{Kind: Defend}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if b.Current().ID != "d1" {
+		t.Fatalf("expected waiting unit to act last, got %q", b.Current().ID)
+	}
+	if _, err := b.Act(Action{Kind: Wait}); err == nil {
+		t.Fatal("expected second wait to be rejected")
+	}
+}
+
+func TestMovement(t *testing.T) {
+	// a wall of obstacles with a single gap
+	var wall []hex.Axial
+	for row := range DefaultRows {
+		if row != 0 {
+			wall = append(wall, hex.FromOffset(row, 2, 0))
+		}
+	}
+	stacks := []Stack{
+		{ID: "walker", Side: Attacker, Kind: swordsman, Count: 1},
+		{ID: "flyer", Side: Attacker, Kind: griffin, Count: 1},
+		{ID: "enemy", Side: Defender, Kind: swordsman, Count: 1},
+	}
+	b := battle(t, 1, grass, stacks, WithObstacles(wall...))
+	walker, flyer := b.Unit("walker"), b.Unit("flyer")
+
+	behind := hex.FromOffset(5, 3, 0)
+	if hex.Distance(walker.Position, behind) > walker.speed() || hex.Distance(flyer.Position, behind) > flyer.speed() {
+		t.Fatal("expected target hex to be within speed of both units")
+	}
+	for _, h := range b.Reachable(walker) {
+		if h == behind {
+			t.Fatal("expected walking unit to be stopped by obstacles")
+		}
+	}
+	found := false
+	for _, h := range b.Reachable(flyer) {
+		if b.obstacles[h] {
+			t.Fatalf("expected flying unit not to land on obstacle %v", h)
+		}
+		found = found || h == behind
+	}
+	if !found {
+		t.Fatal("expected flying unit to pass over obstacles")
+	}
+}
+
+func TestAttack(t *testing.T) {
+	stacks := []Stack{
+		{ID: "a", Side: Attacker, Kind: swordsman, Count: 10},
+		{ID: "d", Side: Defender, Kind: swordsman, Count: 10},
+	}
+	b := battle(t, 7, grass, stacks, WithField(1, 3))
+	a, d := b.Unit("a"), b.Unit("d")
+
+	if _, err := b.Act(Action{Kind: Attack, To: a.Position, Target: "d"}); err == nil {
+		t.Fatal("expected attack of distant unit to be rejected")
+	}
+	events, err := b.Act(Action{Kind: Attack, To: hex.FromOffset(0, 1, 0), Target: "d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attacked, retaliated bool
+	for _, e := range events {
+		attacked = attacked || e.Kind == Attacked && e.Unit == "a"
+		retaliated = retaliated || e.Kind == Retaliated && e.Unit == "d"
+	}
+	if !attacked || !retaliated {
+		t.Fatalf("expected attack & retaliation, got %v", events)
+	}
+	if d.Count >= 10 || a.Count >= 10 {
+		t.Fatalf("expected both stacks to take losses, got %d & %d", a.Count, d.Count)
+	}
+	if !d.retaliated {
+		t.Fatal("expected retaliation to be spent for the round")
+	}
+}
+
+func TestShoot(t *testing.T) {
+	stacks := []Stack{
+		{ID: "archer", Side: Attacker, Kind: archer, Count: 10},
+		{ID: "target", Side: Defender, Kind: swordsman, Count: 10},
+	}
+	b := battle(t, 3, grass, stacks, WithField(1, 15))
+	target := b.Unit("target")
+	if b.Current().ID != "target" {
+		t.Fatalf("expected faster unit to act first, got %q", b.Current().ID)
+	}
+	if _, err := b.Act(Action{Kind: Defend}); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := b.Act(Action{Kind: Shoot, Target: "target"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events[0].Kind != Shot || events[0].Damage == 0 {
+		t.Fatalf("expected a shot, got %v", events)
+	}
+	if b.Unit("archer").Shots != 11 {
+		t.Fatalf("expected a shot to be spent, got %d left", b.Unit("archer").Shots)
+	}
+
+	// move the target next to the archer
+	target.Position = hex.FromOffset(0, 1, 0)
+	if b.CanShoot(b.Unit("archer")) {
+		t.Fatal("expected archer engaged in melee not to shoot")
+	}
+}
+
+func TestDamage(t *testing.T) {
+	attacker, target := &Unit{Kind: swordsman, Count: 10}, &Unit{Kind: swordsman, Count: 1}
+
+	for _, tc := range []struct {
+		attack, defence int32
+		want            uint32
+	}{
+		{attack: 10, defence: 10, want: 100},
+		{attack: 20, defence: 10, want: 150},
+		{attack: 100, defence: 0, want: 400},
+		{attack: 10, defence: 20, want: 75},
+		{attack: 0, defence: 100, want: 30},
+	} {
+		attacker.Stats.Attack, target.Stats.Defence = tc.attack, tc.defence
+		if got := Damage(attacker, target, 10, false); got != tc.want {
+			t.Errorf("attack %d vs defence %d: expected %d, got %d", tc.attack, tc.defence, tc.want, got)
+		}
+	}
+
+	target.Stats.Defence = 10
+	target.defending = true
+	attacker.Stats.Attack = 10
+	if got := Damage(attacker, target, 10, false); got != 95 {
+		t.Errorf("expected defending unit to take less damage, got %d", got)
+	}
+
+	target.defending = false
+	attacker.Count = math.MaxUint32
+	if got := Damage(attacker, target, 1, false); got != math.MaxUint32 {
+		t.Errorf("expected damage of a huge stack to be exact, got %d", got)
+	}
+	attacker.Stats.Attack = 100
+	if got := Damage(attacker, target, math.MaxUint32, false); got != math.MaxUint32 {
+		t.Errorf("expected damage to saturate, got %d", got)
+	}
+}
+
+func TestKills(t *testing.T) {
+	u := &Unit{Kind: swordsman, Count: 3, Health: 35}
+	if killed := u.damage(40); killed != 1 || u.Count != 2 || u.Health != 30 {
+		t.Fatalf("expected one creature killed & 30 health left, got %d, %d & %d", killed, u.Count, u.Health)
+	}
+	if killed := u.damage(1000); killed != 2 || u.Alive() {
+		t.Fatalf("expected stack to die, got %d killed & %d left", killed, u.Count)
+	}
+}
+
+func TestTerrainEffects(t *testing.T) {
+	gloom := &mapv1.Terrain{
+		Id: "gloom",
+		Effects: []*mapv1.Terrain_Effect{
+			{Kind: &mapv1.Terrain_Effect_ModifyCreatureMorale_{ModifyCreatureMorale: &mapv1.Terrain_Effect_ModifyCreatureMorale{
+				Filter:       &creaturesv1.Creature_Kind_Filter{All: true},
+				Modification: &creaturesv1.Creature_AttributeModification{Delta: -3},
+			}}},
+			{Kind: &mapv1.Terrain_Effect_PreventSpellCasting_{PreventSpellCasting: &mapv1.Terrain_Effect_PreventSpellCasting{
+				Filter: &magicv1.Spell_Filter{All: true},
+			}}},
+		},
+	}
+	b := battle(t, 5, gloom, skirmish())
+	if m := b.Unit("a1").Stats.Morale; m != -3 {
+		t.Fatalf("expected morale to be lowered by terrain, got %d", m)
+	}
+	if b.CanCast(&magicv1.Spell{Id: "haste"}, 1).Castable {
+		t.Fatal("expected terrain to prevent spell casting")
+	}
+
+	if _, err := Run(b, 1000); err != nil {
+		t.Fatal(err)
+	}
+	panicked := false
+	for _, e := range b.Log() {
+		panicked = panicked || e.Kind == Panicked
+	}
+	if !panicked {
+		t.Fatal("expected low morale units to panic at least once")
+	}
+}
+
+func TestOver(t *testing.T) {
+	stacks := []Stack{
+		{ID: "a", Side: Attacker, Kind: griffin, Count: 50},
+		{ID: "d", Side: Defender, Kind: archer, Count: 1},
+	}
+	b := battle(t, 9, grass, stacks)
+	winner, err := Run(b, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if winner != Attacker {
+		t.Fatalf("expected attacker to win, got %s", winner)
+	}
+	if _, err := b.Act(Action{Kind: Defend}); err != ErrOver {
+		t.Fatalf("expected actions after the end to be rejected, got %v", err)
+	}
+}
+
+func TestCast(t *testing.T) {
+	haste := &magicv1.Spell{
+		Id:        "haste",
+		Level:     1,
+		ManaCost:  5,
+		Scope:     magicv1.Spell_SCOPE_COMBAT,
+		Targeting: magicv1.Spell_TARGETING_ALLY,
+		Effects: []*magicv1.Spell_Effect{{Kind: &magicv1.Spell_Effect_ModifyCreatureStat_{
+			ModifyCreatureStat: &magicv1.Spell_Effect_ModifyCreatureStat{
+				Stat:   magicv1.Spell_STAT_SPEED,
+				Delta:  3,
+				Rounds: &magicv1.Spell_Amount{Base: 1},
+			},
+		}}},
+	}
+	fireball := &magicv1.Spell{
+		Id:        "fireball",
+		Level:     3,
+		ManaCost:  10,
+		Scope:     magicv1.Spell_SCOPE_COMBAT,
+		Targeting: magicv1.Spell_TARGETING_AREA,
+		Radius:    1,
+		Effects: []*magicv1.Spell_Effect{{Kind: &magicv1.Spell_Effect_Damage_{
+			Damage: &magicv1.Spell_Effect_Damage{Amount: &magicv1.Spell_Amount{Base: 10, PerPower: 10, PerLevel: 5}},
+		}}},
+	}
+	caster := &Caster{SpellPower: 2, Mana: 12, Spells: []string{"haste", "fireball"}}
+	b := battle(t, 1, grass, skirmish(), WithCaster(Attacker, caster))
+
+	if _, err := b.Act(Action{Kind: Cast, Spell: haste, Target: "a1"}); err == nil {
+		t.Fatal("expected defender without a caster to be rejected")
+	}
+	if _, err := b.Act(Action{Kind: Defend}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := b.Act(Action{Kind: Cast, Spell: haste, Target: "d1"}); err == nil {
+		t.Fatal("expected haste on an enemy to be rejected")
+	}
+	events, err := b.Act(Action{Kind: Cast, Spell: haste, Target: "a2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events[0].Kind != CastSpell || b.Unit("a2").Stats.Speed != 7 || caster.Mana != 7 {
+		t.Fatalf("expected haste to be cast, got %v", events)
+	}
+	if b.Current().ID != "a1" {
+		t.Fatalf("expected casting not to take unit's turn, got %q", b.Current().ID)
+	}
+	if _, err := b.Act(Action{Kind: Cast, Spell: fireball, To: b.Unit("d1").Position}); err == nil {
+		t.Fatal("expected second spell in a round to be rejected")
+	}
+
+	for b.Round() == 1 {
+		if _, err := b.Act(Action{Kind: Defend}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if speed := b.Unit("a2").Stats.Speed; speed != 4 {
+		t.Fatalf("expected haste to wear off, got speed %d", speed)
+	}
+	if _, err := b.Act(Action{Kind: Defend}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Act(Action{Kind: Cast, Spell: fireball, To: b.Unit("d1").Position}); err == nil {
+		t.Fatal("expected cast without enough mana to be rejected")
+	}
+
+	caster.Mana = 10
+	d1 := b.Unit("d1")
+	events, err = b.Act(Action{Kind: Cast, Spell: fireball, To: d1.Position})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10 + 10*2 + 5*(3-1)
+	if events[1].Kind != Damaged || events[1].Target != "d1" || events[1].Damage != 40 || d1.Count != 11 {
+		t.Fatalf("expected fireball to damage the griffins, got %v", events)
+	}
+}
+
+func TestCastPrevented(t *testing.T) {
+	bolt := &magicv1.Spell{
+		Id:        "bolt",
+		Level:     2,
+		Scope:     magicv1.Spell_SCOPE_COMBAT,
+		Targeting: magicv1.Spell_TARGETING_ENEMY,
+		Effects: []*magicv1.Spell_Effect{{Kind: &magicv1.Spell_Effect_Damage_{
+			Damage: &magicv1.Spell_Effect_Damage{Amount: &magicv1.Spell_Amount{Base: 10}},
+		}}},
+	}
+	ruins := &mapv1.Terrain{
+		Id: "ruins",
+		Effects: []*mapv1.Terrain_Effect{{Kind: &mapv1.Terrain_Effect_PreventSpellCasting_{
+			PreventSpellCasting: &mapv1.Terrain_Effect_PreventSpellCasting{
+				Filter:   &magicv1.Spell_Filter{All: true},
+				LevelGte: proto.Int32(2),
+			},
+		}}},
+	}
+	caster := &Caster{Mana: 10, Spells: []string{"bolt"}}
+	b := battle(t, 1, ruins, skirmish(), WithCaster(Defender, caster))
+	if _, err := b.Act(Action{Kind: Cast, Spell: bolt, Target: "a1"}); !errors.Is(err, magic.ErrPrevented) {
+		t.Fatalf("expected terrain to prevent casting, got %v", err)
+	}
+}
@@ -0,0 +1,166 @@
+package combat
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/effects"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/magic"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+)
+
+// Caster is a hero leading one side of the battle, it may cast a single spell per round.
+type Caster struct {
+	SpellPower int32
+	Mana       uint32
+	Spells     []string // ids of known spells
+}
+
+// WithCaster lets a hero cast spells on behalf of given side.
+func WithCaster(side Side, caster *Caster) Option {
+	return func(b *Battle) {
+		b.casters[side] = caster
+	}
+}
+
+// Caster returns hero casting spells for given side, nil if there is none.
+func (b *Battle) Caster(side Side) *Caster {
+	return b.casters[side]
+}
+
+// buff is a temporary stat modification applied by a spell.
+type buff struct {
+	stat    magicv1.Spell_Stat
+	delta   int32
+	expires int // round it wears off at, 0 if it lasts until the end of battle
+}
+
+func (u *Unit) stat(s magicv1.Spell_Stat) (*int32, effects.Attribute) {
+	switch s {
+	case magicv1.Spell_STAT_ATTACK:
+		return &u.Stats.Attack, effects.Attack
+	case magicv1.Spell_STAT_DEFENCE:
+		return &u.Stats.Defence, effects.Defence
+	case magicv1.Spell_STAT_SPEED:
+		return &u.Stats.Speed, effects.Speed
+	case magicv1.Spell_STAT_MORALE:
+		return &u.Stats.Morale, effects.Morale
+	case magicv1.Spell_STAT_LUCK:
+		return &u.Stats.Luck, effects.Luck
+	}
+	return nil, ""
+}
+
+// expire reverts spell modifications that wore off by the current round.
+func (b *Battle) expire(u *Unit) {
+	u.buffs = slices.DeleteFunc(u.buffs, func(m buff) bool {
+		if m.expires == 0 || m.expires > b.round {
+			return false
+		}
+		field, attribute := u.stat(m.stat)
+		*field -= m.delta
+		b.emit(Event{Kind: Expired, Target: u.ID, Attribute: attribute, Delta: -m.delta})
+		return true
+	})
+}
+
+// cast spends caster's mana and applies spell effects. Casting doesn't take turn of the current unit.
+func (b *Battle) cast(side Side, a Action) error {
+	caster := b.casters[side]
+	spell := a.Spell
+	switch {
+	case caster == nil:
+		return fmt.Errorf("%s has no caster", side)
+	case b.casted[side]:
+		return fmt.Errorf("%s already cast a spell this round", side)
+	case !slices.Contains(caster.Spells, spell.GetId()):
+		return fmt.Errorf("spell %q is unknown to the caster", spell.GetId())
+	}
+
+	result, err := magic.Check(b.engine, b.terrain, spell, magicv1.Spell_SCOPE_COMBAT, caster.Mana)
+	if err != nil {
+		return err
+	}
+	targets, err := b.spellTargets(side, spell, a)
+	if err != nil {
+		return err
+	}
+
+	caster.Mana -= spell.GetManaCost()
+	b.casted[side] = true
+	b.emit(Event{Kind: CastSpell, Side: side, Spell: spell.GetId(), Level: result.Level, Target: a.Target, To: a.To})
+
+	for _, effect := range spell.GetEffects() {
+		for _, u := range targets {
+			if !u.Alive() {
+				continue
+			}
+			b.applySpell(u, effect, caster.SpellPower, result.Level)
+		}
+	}
+	return nil
+}
+
+func (b *Battle) spellTargets(side Side, spell *magicv1.Spell, a Action) ([]*Unit, error) {
+	switch spell.GetTargeting() {
+	case magicv1.Spell_TARGETING_ALLY:
+		target := b.Unit(a.Target)
+		if target == nil || !target.Alive() || target.Side != side {
+			return nil, fmt.Errorf("target %q is not an ally", a.Target)
+		}
+		return []*Unit{target}, nil
+
+	case magicv1.Spell_TARGETING_ENEMY:
+		target := b.Unit(a.Target)
+		if target == nil || !target.Alive() || target.Side == side {
+			return nil, fmt.Errorf("target %q is not an enemy", a.Target)
+		}
+		return []*Unit{target}, nil
+
+	case magicv1.Spell_TARGETING_AREA:
+		if !a.To.InGrid(uint32(b.rows), uint32(b.columns)) {
+			return nil, fmt.Errorf("hex %v is outside of the battlefield", a.To)
+		}
+		var targets []*Unit
+		for _, u := range b.units {
+			if u.Alive() && hex.Distance(a.To, u.Position) <= int(spell.GetRadius()) {
+				targets = append(targets, u)
+			}
+		}
+		return targets, nil
+	}
+	return nil, errors.New("spell can't be cast in combat")
+}
+
+func (b *Battle) applySpell(u *Unit, effect *magicv1.Spell_Effect, power, level int32) {
+	switch {
+	case effect.GetDamage() != nil:
+		damage := max(magic.Amount(effect.GetDamage().GetAmount(), power, level), 1)
+		killed := u.damage(damage)
+		b.emit(Event{Kind: Damaged, Target: u.ID, Damage: damage, Killed: killed})
+		if !u.Alive() {
+			b.emit(Event{Kind: Died, Unit: u.ID})
+		}
+
+	case effect.GetHeal() != nil:
+		healed := min(magic.Amount(effect.GetHeal().GetAmount(), power, level), u.Kind.GetStats().GetHealth()-u.Health)
+		u.Health += healed
+		b.emit(Event{Kind: Healed, Target: u.ID, Delta: int32(healed)})
+
+	case effect.GetModifyCreatureStat() != nil:
+		m := effect.GetModifyCreatureStat()
+		field, attribute := u.stat(m.GetStat())
+		if field == nil || m.GetDelta() == 0 {
+			return
+		}
+		modification := buff{stat: m.GetStat(), delta: m.GetDelta()}
+		if m.Rounds != nil {
+			modification.expires = b.round + int(max(magic.Amount(m.GetRounds(), power, level), 1))
+		}
+		*field += m.GetDelta()
+		u.buffs = append(u.buffs, modification)
+		b.emit(Event{Kind: Modified, Target: u.ID, Attribute: attribute, Delta: m.GetDelta()})
+	}
+}
@@ -3,18 +3,60 @@ package config
 import "time"
 
 type Auth struct {
-	Google  GoogleAuth  `envPrefix:"GOOGLE__"`
-	Storage AuthStorage `envPrefix:"STORAGE__"`
-	Owners  Owners      `envPrefix:"OWNERS__"`
+	Google   GoogleAuth   `envPrefix:"GOOGLE__"`
+	OIDC     OIDCAuth     `envPrefix:"OIDC__"`
+	GitHub   GitHubAuth   `envPrefix:"GITHUB__"`
+	Password PasswordAuth `envPrefix:"PASSWORD__"`
+	Sessions Sessions     `envPrefix:"SESSIONS__"`
+	Storage  AuthStorage  `envPrefix:"STORAGE__"`
+	Owners   Owners       `envPrefix:"OWNERS__"`
+	// RedirectURIs lists where providers may send users back to with authorization codes,
+	// attempts to sign in elsewhere are rejected. Code exchange is unavailable if empty.
+	RedirectURIs []string `env:"REDIRECT_URIS"`
 }
 
 type GoogleAuth struct {
 	ClientID string `env:"CLIENT_ID"`
 }
 
+// OIDCAuth enables a generic OpenID Connect provider when issuer is set.
+type OIDCAuth struct {
+	Name         string `env:"NAME" envDefault:"oidc"`
+	Issuer       string `env:"ISSUER"`
+	ClientID     string `env:"CLIENT_ID"`
+	ClientSecret string `env:"CLIENT_SECRET"`
+}
+
+// GitHubAuth enables GitHub OAuth when client id is set, URLs may point to any server implementing the same API.
+type GitHubAuth struct {
+	ClientID     string `env:"CLIENT_ID"`
+	ClientSecret string `env:"CLIENT_SECRET"`
+	AuthorizeURL string `env:"AUTHORIZE_URL" envDefault:"https://github.com/login/oauth/authorize"`
+	TokenURL     string `env:"TOKEN_URL" envDefault:"https://github.com/login/oauth/access_token"`
+	APIURL       string `env:"API_URL" envDefault:"https://api.github.com"`
+}
+
+type PasswordAuth struct {
+	Enabled   bool   `env:"ENABLED"`
+	Hash      string `env:"HASH" envDefault:"argon2id"` // argon2id or bcrypt, existing hashes of either keep working
+	MinLength int    `env:"MIN_LENGTH" envDefault:"10"`
+}
+
+type Sessions struct {
+	// Key signs access tokens, has to be at least 32 bytes long & shared by all instances.
+	// It's only optional in test mode, a random one is used then.
+	Key        string        `env:"KEY"`
+	AccessTTL  time.Duration `env:"ACCESS_TTL" envDefault:"15m"`
+	RefreshTTL time.Duration `env:"REFRESH_TTL" envDefault:"720h"`
+	// RefreshGrace is how long a replaced refresh token is still accepted, so tabs refreshing
+	// at the same time don't end the session as if the token was stolen.
+	RefreshGrace time.Duration `env:"REFRESH_GRACE" envDefault:"30s"`
+}
+
+// AuthStorage caches accounts of sessions, revocations on other instances take effect within TTL.
 type AuthStorage struct {
 	MaxSize int           `envDefault:"256"`
-	TTL     time.Duration `envDefault:"1h"`
+	TTL     time.Duration `envDefault:"1m"`
 }
 
 type Owners struct {
@@ -17,6 +17,7 @@ type Config struct {
 	Postgres Postgres `envPrefix:"POSTGRES__"`
 	Server   Server   `envPrefix:"SERVER__"`
 	Logging  Logging  `envPrefix:"LOGGING__"`
+	Content  Content  `envPrefix:"CONTENT__"`
 }
 
 type Option func(*Config)
@@ -0,0 +1,8 @@
+package config
+
+// ContentChecksumHeader carries checksum of content loaded by client, see content.Registry.Checksum.
+const ContentChecksumHeader = "Hexes-Content-Checksum"
+
+type Content struct {
+	Dir string `env:"DIR"` // directory with content packs loaded on top of built-in ones, directories or zip archives
+}
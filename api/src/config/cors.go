@@ -11,7 +11,7 @@ func (cfg *Config) AddCORS(h http.Handler) http.Handler {
 	middleware := cors.New(cors.Options{
 		AllowedOrigins:   cfg.Server.AllowedOrigins,
 		AllowedMethods:   connectcors.AllowedMethods(),
-		AllowedHeaders:   connectcors.AllowedHeaders(),
+		AllowedHeaders:   append(connectcors.AllowedHeaders(), ContentChecksumHeader),
 		ExposedHeaders:   connectcors.ExposedHeaders(),
 		AllowCredentials: true,
 	})
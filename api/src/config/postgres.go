@@ -156,7 +156,8 @@ func (cfg *Postgres) ApplyDatabaseMigrations(ctx context.Context) error {
 
 func (cfg *Postgres) SetUpEssentialData(ctx context.Context) error {
 	return cfg.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
-		for _, role := range []string{"owner"} {
+		// roles of auth.DefaultPolicy
+		for _, role := range []string{"moderator", "owner"} {
 			if err := q.CreateRole(ctx, role); err != nil {
 				return fmt.Errorf("creating role: %q: %w", role, err)
 			}
@@ -173,6 +174,12 @@ func WithIsolationLevel(level pgx.TxIsoLevel) TxOption {
 	}
 }
 
+func WithAccessMode(mode pgx.TxAccessMode) TxOption {
+	return func(options *pgx.TxOptions) {
+		options.AccessMode = mode
+	}
+}
+
 func (cfg *Postgres) Tx(ctx context.Context, fn func(tx pgx.Tx, q *db.Queries) error, opts ...TxOption) error {
 	log := GetLogger(ctx)
 
@@ -187,7 +194,7 @@ func (cfg *Postgres) Tx(ctx context.Context, fn func(tx pgx.Tx, q *db.Queries) e
 		opt(options)
 	}
 
-	tx, err := cfg.Pool.BeginTx(ctx, *options)
+	tx, err := conn.BeginTx(ctx, *options)
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, fmt.Errorf("starting transaction: %w", err))
 	}
@@ -198,7 +205,7 @@ func (cfg *Postgres) Tx(ctx context.Context, fn func(tx pgx.Tx, q *db.Queries) e
 		}
 	}()
 
-	err = fn(tx, db.New(conn))
+	err = fn(tx, db.New(tx))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return connect.NewError(connect.CodeNotFound, err)
 	}
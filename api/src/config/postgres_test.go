@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/db"
+)
+
+func TestTxRollback(t *testing.T) {
+	cfg := SetUpTest(t)
+	ctx := context.Background()
+
+	err := cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if err := q.CreateRole(ctx, "rolled-back"); err != nil {
+			return err
+		}
+		return errors.New("failing on purpose")
+	})
+	if connect.CodeOf(err) != connect.CodeInternal {
+		t.Fatalf("expected internal error, got %v", err)
+	}
+
+	var roles []string
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		roles, err = q.ListRoles(ctx)
+		return err
+	}, WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Contains(roles, "rolled-back") {
+		t.Errorf("rolled back transaction left its rows: %v", roles)
+	}
+}
+
+func TestTxCommit(t *testing.T) {
+	cfg := SetUpTest(t)
+	ctx := context.Background()
+
+	err := cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		return q.CreateRole(ctx, "committed")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roles []string
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		roles, err = q.ListRoles(ctx)
+		return err
+	}, WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(roles, "committed") {
+		t.Errorf("committed role is missing: %v", roles)
+	}
+}
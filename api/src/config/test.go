@@ -1,5 +1,10 @@
 package config
 
+import (
+	"context"
+	"testing"
+)
+
 type Test struct {
 	Enabled bool `env:"ENABLED"`
 	ID      string
@@ -12,3 +17,29 @@ type Tokens struct {
 	Alfa       string `env:"ALFA" envDefault:"alfa"`
 	Bravo      string `env:"BRAVO" envDefault:"bravo"`
 }
+
+// SetUpTest sets up config in test mode for a Go test, backed by a temporary database dropped once it ends.
+// The test is skipped if Postgres isn't reachable, e.g. outside of the devcontainer.
+func SetUpTest(t testing.TB) *Config {
+	t.Helper()
+	ctx := context.Background()
+
+	cfg, err := New(ctx, WithTestMode())
+	if err != nil {
+		t.Fatalf("loading config: %s", err)
+	}
+	if err := cfg.SetUp(ctx); err != nil {
+		if pool := cfg.Postgres.ServicePool; pool != nil && pool.Ping(ctx) != nil {
+			cfg.Postgres.Pool.Close()
+			pool.Close()
+			t.Skipf("postgres isn't reachable: %s", err)
+		}
+		t.Fatalf("setting up: %s", err)
+	}
+	t.Cleanup(func() {
+		if err := cfg.TearDown(ctx); err != nil {
+			t.Errorf("tearing down: %s", err)
+		}
+	})
+	return cfg
+}
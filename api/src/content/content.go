@@ -0,0 +1,272 @@
+// Package content keeps definitions of terrains, creatures, spells, towns, resources & map objects
+// loaded from content packs.
+//
+// A pack is a directory or zip archive with manifest.json (content.v1.Manifest) and any number
+// of data files (content.v1.Bundle) in protobuf JSON format, e.g.
+//
+//	{"version": 1, "terrains": [{"id": "core/terrain/grass", "movementPenalty": 100}]}
+//
+// Data files with .yaml or .yml extension are read as YAML with the same field names, e.g.
+//
+//	version: 1
+//	terrains:
+//	  - id: core/terrain/grass
+//	    movementPenalty: 100
+//
+// Packs are loaded after their dependencies and may replace or patch definitions coming from them.
+// Data files of a pack are read in lexical order, nested directories included.
+package content
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+
+	"github.com/openhexes/openhexes/api/src/config"
+	contentv1 "github.com/openhexes/proto/content/v1"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the only supported format version of data files.
+const Version = 1
+
+//go:embed data
+var data embed.FS
+
+// Builtin returns the core pack shipped with the server.
+func Builtin() *Pack {
+	sub, err := fs.Sub(data, "data/core")
+	if err != nil {
+		panic(err)
+	}
+	p, err := OpenPack(sub)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Registry is read-only once loaded and safe for concurrent use.
+type Registry struct {
+	terrains  *table[*mapv1.Terrain]
+	creatures *table[*creaturesv1.Creature_Kind]
+	spells    *table[*magicv1.Spell]
+	towns     *table[*townsv1.Town_Kind]
+	resources *table[*economyv1.Resource]
+	objects   *table[*objectsv1.Object_Kind]
+
+	packs    []*contentv1.Manifest
+	checksum string
+}
+
+// New loads built-in content together with packs from configured directory.
+func New(cfg *config.Config) (*Registry, error) {
+	packs := []*Pack{Builtin()}
+	if cfg.Content.Dir != "" {
+		extra, err := ReadPacks(cfg.Content.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading packs: %w", err)
+		}
+		packs = append(packs, extra...)
+	}
+	return Load(packs...)
+}
+
+// Load resolves load order of packs, applies their data files & validates the result.
+func Load(packs ...*Pack) (*Registry, error) {
+	ordered, deps, err := resolve(packs)
+	if err != nil {
+		return nil, fmt.Errorf("resolving load order: %w", err)
+	}
+
+	r := &Registry{
+		terrains:  newTable[*mapv1.Terrain]("terrain"),
+		creatures: newTable[*creaturesv1.Creature_Kind]("creature"),
+		spells:    newTable[*magicv1.Spell]("spell"),
+		towns:     newTable[*townsv1.Town_Kind]("town"),
+		resources: newTable[*economyv1.Resource]("resource"),
+		objects:   newTable[*objectsv1.Object_Kind]("object"),
+	}
+	for _, p := range ordered {
+		if err := r.add(p, deps[p.Manifest.Id]); err != nil {
+			return nil, fmt.Errorf("loading pack %q: %w", p.Manifest.Id, err)
+		}
+		r.packs = append(r.packs, p.Manifest)
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, fmt.Errorf("validating content: %w", err)
+	}
+	if r.checksum, err = r.computeChecksum(); err != nil {
+		return nil, fmt.Errorf("computing checksum: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Registry) add(p *Pack, deps map[string]bool) error {
+	bundles, err := p.Bundles()
+	if err != nil {
+		return err
+	}
+
+	id := p.Manifest.Id
+	for _, b := range bundles {
+		if err := r.terrains.add(id, b.Terrains); err != nil {
+			return err
+		}
+		if err := r.creatures.add(id, b.Creatures); err != nil {
+			return err
+		}
+		if err := r.spells.add(id, b.Spells); err != nil {
+			return err
+		}
+		if err := r.towns.add(id, b.Towns); err != nil {
+			return err
+		}
+		if err := r.resources.add(id, b.Resources); err != nil {
+			return err
+		}
+		if err := r.objects.add(id, b.Objects); err != nil {
+			return err
+		}
+
+		if err := r.terrains.replace(id, deps, b.GetReplace().GetTerrains()); err != nil {
+			return err
+		}
+		if err := r.creatures.replace(id, deps, b.GetReplace().GetCreatures()); err != nil {
+			return err
+		}
+		if err := r.spells.replace(id, deps, b.GetReplace().GetSpells()); err != nil {
+			return err
+		}
+		if err := r.towns.replace(id, deps, b.GetReplace().GetTowns()); err != nil {
+			return err
+		}
+		if err := r.resources.replace(id, deps, b.GetReplace().GetResources()); err != nil {
+			return err
+		}
+		if err := r.objects.replace(id, deps, b.GetReplace().GetObjects()); err != nil {
+			return err
+		}
+
+		if err := r.terrains.patch(id, deps, b.GetPatch().GetTerrains()); err != nil {
+			return err
+		}
+		if err := r.creatures.patch(id, deps, b.GetPatch().GetCreatures()); err != nil {
+			return err
+		}
+		if err := r.spells.patch(id, deps, b.GetPatch().GetSpells()); err != nil {
+			return err
+		}
+		if err := r.towns.patch(id, deps, b.GetPatch().GetTowns()); err != nil {
+			return err
+		}
+		if err := r.resources.patch(id, deps, b.GetPatch().GetResources()); err != nil {
+			return err
+		}
+		if err := r.objects.patch(id, deps, b.GetPatch().GetObjects()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeChecksum covers loaded packs and resulting definitions,
+// so any difference in content clients & server play with is detected.
+func (r *Registry) computeChecksum() (string, error) {
+	h := sha256.New()
+	for _, m := range r.packs {
+		fmt.Fprintf(h, "%s@%s\n", m.Id, m.Version)
+	}
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(&contentv1.Bundle_Definitions{
+		Terrains:  r.Terrains(),
+		Creatures: r.Creatures(),
+		Spells:    r.Spells(),
+		Towns:     r.Towns(),
+		Resources: r.Resources(),
+		Objects:   r.Objects(),
+	})
+	if err != nil {
+		return "", err
+	}
+	h.Write(raw)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Checksum identifies loaded content.
+func (r *Registry) Checksum() string {
+	return r.checksum
+}
+
+// Packs lists manifests of loaded packs in load order.
+func (r *Registry) Packs() []*contentv1.Manifest {
+	return r.packs
+}
+
+// Terrain returns terrain definition by id, nil if unknown.
+func (r *Registry) Terrain(id string) *mapv1.Terrain {
+	return r.terrains.get(id)
+}
+
+// Terrains lists terrain definitions in load order.
+func (r *Registry) Terrains() []*mapv1.Terrain {
+	return r.terrains.list
+}
+
+// Creature returns creature kind by id, nil if unknown.
+func (r *Registry) Creature(id string) *creaturesv1.Creature_Kind {
+	return r.creatures.get(id)
+}
+
+// Creatures lists creature kinds in load order.
+func (r *Registry) Creatures() []*creaturesv1.Creature_Kind {
+	return r.creatures.list
+}
+
+// Spell returns spell definition by id, nil if unknown.
+func (r *Registry) Spell(id string) *magicv1.Spell {
+	return r.spells.get(id)
+}
+
+// Spells lists spell definitions in load order.
+func (r *Registry) Spells() []*magicv1.Spell {
+	return r.spells.list
+}
+
+// Town returns town kind by id, nil if unknown.
+func (r *Registry) Town(id string) *townsv1.Town_Kind {
+	return r.towns.get(id)
+}
+
+// Towns lists town kinds in load order.
+func (r *Registry) Towns() []*townsv1.Town_Kind {
+	return r.towns.list
+}
+
+// Resource returns resource definition by id, nil if unknown.
+func (r *Registry) Resource(id string) *economyv1.Resource {
+	return r.resources.get(id)
+}
+
+// Resources lists resource definitions in load order.
+func (r *Registry) Resources() []*economyv1.Resource {
+	return r.resources.list
+}
+
+// Object returns map object kind by id, nil if unknown.
+func (r *Registry) Object(id string) *objectsv1.Object_Kind {
+	return r.objects.get(id)
+}
+
+// Objects lists map object kinds in load order.
+func (r *Registry) Objects() []*objectsv1.Object_Kind {
+	return r.objects.list
+}
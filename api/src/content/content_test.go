@@ -0,0 +1,609 @@
+package content
+
+import (
+	"archive/zip"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/mapgen"
+	"github.com/openhexes/proto/content/v1/contentv1connect"
+	"github.com/openhexes/proto/game/v1/gamev1connect"
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
+	"github.com/openhexes/proto/lobby/v1/lobbyv1connect"
+	"github.com/openhexes/proto/map/v1/mapv1connect"
+)
+
+// pack builds an in-memory pack, manifest is given in protobuf JSON.
+func pack(t *testing.T, manifest string, files map[string]string) *Pack {
+	t.Helper()
+	fsys := fstest.MapFS{ManifestFile: {Data: []byte(manifest)}}
+	for name, data := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(data)}
+	}
+	p, err := OpenPack(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func packIDs(r *Registry) string {
+	var ids []string
+	for _, m := range r.Packs() {
+		ids = append(ids, m.Id)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestBuiltin(t *testing.T) {
+	r, err := Load(Builtin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Terrains()) == 0 || len(r.Creatures()) == 0 || len(r.Spells()) == 0 {
+		t.Fatalf("built-in content is incomplete: %d terrains, %d creatures, %d spells",
+			len(r.Terrains()), len(r.Creatures()), len(r.Spells()))
+	}
+	for _, t0 := range r.Terrains() {
+		if t0.GetRenderingSpec().GetClassName() == "" {
+			t.Errorf("terrain %q has no rendering spec", t0.GetId())
+		}
+	}
+	if packIDs(r) != "core" || len(r.Checksum()) != 64 {
+		t.Errorf("got packs %q, checksum %q", packIDs(r), r.Checksum())
+	}
+
+	// map generator relies on core terrains
+	if _, err := mapgen.New(1, mapgen.WithTerrains(r.Terrains()...)); err != nil {
+		t.Errorf("built-in terrains don't fit map generator: %s", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	p := pack(t, `{"id": "volcanic"}`, map[string]string{
+		"b/creatures.json": `{
+			"version": 1,
+			"creatures": [{"id": "imp", "movementTypes": ["MOVEMENT_TYPE_FLYING"], "nativeTerrains": ["lava"]}]
+		}`,
+		"a/terrains.json": `{
+			"version": 1,
+			"terrains": [
+				{"id": "lava", "movementPenalty": 200, "passableWith": ["MOVEMENT_TYPE_FLYING"]},
+				{"id": "ash"}
+			]
+		}`,
+		"README.md": "ignored",
+	})
+
+	r, err := Load(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Terrain("lava").GetMovementPenalty(); got != 200 {
+		t.Errorf("got penalty %d", got)
+	}
+	if r.Creature("imp") == nil || r.Creature("ghost") != nil || r.Spell("imp") != nil {
+		t.Error("lookup by id is broken")
+	}
+	if ids := []string{r.Terrains()[0].GetId(), r.Terrains()[1].GetId()}; ids[0] != "lava" || ids[1] != "ash" {
+		t.Errorf("terrains are not in load order: %v", ids)
+	}
+}
+
+func TestLoadYAML(t *testing.T) {
+	p := pack(t, `{"id": "volcanic"}`, map[string]string{
+		"terrains.yaml": "version: 1\n" +
+			"terrains:\n" +
+			"  - id: lava\n" +
+			"    movementPenalty: 200\n" +
+			"    passableWith: [MOVEMENT_TYPE_FLYING]\n",
+		"creatures.yml": "version: 1\ncreatures: [{id: imp, nativeTerrains: [lava]}]\n",
+	})
+	r, err := Load(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lava := r.Terrain("lava")
+	if lava.GetMovementPenalty() != 200 || len(lava.GetPassableWith()) != 1 || r.Creature("imp") == nil {
+		t.Fatalf("YAML definitions weren't loaded: %v", lava)
+	}
+
+	bad := pack(t, `{"id": "volcanic"}`, map[string]string{"terrains.yaml": "version: 1\nterrain: []\n"})
+	if _, err := Load(bad); err == nil || !strings.Contains(err.Error(), "terrains.yaml") {
+		t.Fatalf("expected unknown field to be rejected, got %v", err)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  []string
+	}{
+		{
+			name:  "unsupported version",
+			files: map[string]string{"a.json": `{"version": 2}`},
+			want:  []string{`"a.json"`, "unsupported version 2"},
+		},
+		{
+			name:  "missing version",
+			files: map[string]string{"a.json": `{"terrains": []}`},
+			want:  []string{"unsupported version 0"},
+		},
+		{
+			name:  "unknown field",
+			files: map[string]string{"a.json": `{"version": 1, "terrains": [{"id": "x", "movementPenalti": 1}]}`},
+			want:  []string{"movementPenalti"},
+		},
+		{
+			name: "duplicate id across files",
+			files: map[string]string{
+				"a.json": `{"version": 1, "spells": [{"id": "fireball"}]}`,
+				"b.json": `{"version": 1, "spells": [{"id": "fireball"}]}`,
+			},
+			want: []string{`spell "fireball" is already defined by pack "test"`},
+		},
+		{
+			name:  "missing id",
+			files: map[string]string{"a.json": `{"version": 1, "creatures": [{"tags": ["x"]}]}`},
+			want:  []string{"creature without id"},
+		},
+		{
+			name: "broken references",
+			files: map[string]string{
+				"a.json": `{
+					"version": 1,
+					"terrains": [{
+						"id": "swamp",
+						"passableWith": ["MOVEMENT_TYPE_UNSPECIFIED"],
+						"effects": [
+							{"modifyCreatureSpeed": {"filter": {"includeIds": ["imp", "ghost"]}, "modification": {"delta": -1}}},
+							{"modifySpellLevel": {"filter": {"all": true, "excludeIds": ["blizzard"]}, "delta": 1}},
+							{"preventSpellCasting": {"filter": {}, "levelGte": 3, "levelLte": 2}},
+							{}
+						]
+					}],
+					"creatures": [{"id": "imp", "nativeTerrains": ["lava"]}]
+				}`,
+			},
+			want: []string{
+				`terrain "swamp": invalid movement type 0`,
+				`terrain "swamp": effect #0: filter refers to unknown creature "ghost"`,
+				`terrain "swamp": effect #1: filter refers to unknown spell "blizzard"`,
+				`terrain "swamp": effect #2: filter matches nothing`,
+				`terrain "swamp": effect #2: level range 3..2 is empty`,
+				`terrain "swamp": effect #3: kind is required`,
+				`creature "imp": unknown native terrain "lava"`,
+			},
+		},
+		{
+			name: "invalid spells",
+			files: map[string]string{
+				"a.json": `{
+					"version": 1,
+					"spells": [
+						{"id": "wish", "level": 9},
+						{"id": "bolt", "scope": "SCOPE_COMBAT", "targeting": "TARGETING_CASTER", "effects": [{"damage": {}}]},
+						{"id": "flight", "scope": "SCOPE_ADVENTURE", "targeting": "TARGETING_CASTER", "effects": [{"heal": {}}]}
+					]
+				}`,
+			},
+			want: []string{
+				`spell "wish": level must be within 0-5, got 9`,
+				`spell "bolt": combat spell must target stacks or area`,
+				`spell "flight": effect #0 doesn't apply in SCOPE_ADVENTURE`,
+			},
+		},
+		{
+			name: "invalid towns",
+			files: map[string]string{
+				"a.json": `{
+					"version": 1,
+					"creatures": [{"id": "imp"}],
+					"towns": [
+						{
+							"id": "inferno",
+							"initialBuildings": ["portal", "hall"],
+							"buildings": [
+								{"id": "hall", "income": {"sulfur": 1}, "spells": ["armageddon"]},
+								{"id": "portal", "requires": ["hall"], "dwelling": {"creatureId": "imp"}},
+								{"id": "castle", "requires": ["citadel"]},
+								{"id": "citadel", "requires": ["castle"]},
+								{"id": "gate", "requires": ["moat"], "dwelling": {"creatureId": "demon", "weeklyGrowth": 1}}
+							],
+							"heroClass": {"cost": {"gold": 2500}, "army": [{"creatureId": "imp"}, {"creatureId": "demon", "count": 1}], "spells": ["inferno"]}
+						}
+					]
+				}`,
+			},
+			want: []string{
+				`town "inferno": initial building "portal" requires "hall" to be built before`,
+				`town "inferno": building "portal": dwelling without weekly growth`,
+				`town "inferno": building "castle" is part of a requirement cycle`,
+				`town "inferno": building "gate" requires unknown building "moat"`,
+				`town "inferno": building "gate": dwelling of unknown creature "demon"`,
+				`town "inferno": building "hall": income: unknown resource "sulfur"`,
+				`town "inferno": hero class: cost: unknown resource "gold"`,
+				`town "inferno": hero class: army stack #0 is empty`,
+				`town "inferno": hero class: army stack #1: unknown creature "demon"`,
+				`town "inferno": hero class: unknown spell "inferno"`,
+				`town "inferno": building "hall": unknown spell "armageddon"`,
+			},
+		},
+		{
+			name: "invalid objects",
+			files: map[string]string{
+				"a.json": `{
+					"version": 1,
+					"objects": [
+						{
+							"id": "gate",
+							"footprint": [{"q": 0, "r": 0}, {"q": 1, "r": 0}, {"q": 1, "r": 0}],
+							"entrances": [{"q": 0, "r": 1}],
+							"handler": "teleporter"
+						},
+						{"id": "chest", "resources": {"gold": 1000}},
+						{"id": "camp", "handler": "guard", "guards": [{"creatureId": "orc", "count": 10}, {"creatureId": "orc"}]}
+					]
+				}`,
+			},
+			want: []string{
+				`object "gate": duplicate footprint offset 1,0`,
+				`object "gate": entrance 0,1 is outside of the footprint`,
+				`object "chest": handler is required`,
+				`object "chest": resources: unknown resource "gold"`,
+				`object "camp": guard stack #0: unknown creature "orc"`,
+				`object "camp": guard stack #1 is empty`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(pack(t, `{"id": "test"}`, tt.files))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q doesn't mention %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestManifestErrors(t *testing.T) {
+	for manifest, want := range map[string]string{
+		`{}`:                                   "id is required",
+		`{"id": "x", "dependencies": ["x"]}`:   "depends on itself",
+		`{"id": "x", "dependencies": "oops"}`:  "parsing manifest",
+		`{"id": "x", "unknown": "dependency"}`: "parsing manifest",
+	} {
+		_, err := OpenPack(fstest.MapFS{ManifestFile: {Data: []byte(manifest)}})
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: got %v, want %q", manifest, err, want)
+		}
+	}
+	if _, err := OpenPack(fstest.MapFS{}); err == nil {
+		t.Error("pack without manifest must not open")
+	}
+}
+
+func TestLoadOrder(t *testing.T) {
+	base := func(t *testing.T) []*Pack {
+		return []*Pack{
+			pack(t, `{"id": "zeta", "dependencies": ["beta"]}`, nil),
+			pack(t, `{"id": "beta", "dependencies": ["core"]}`, nil),
+			pack(t, `{"id": "alpha", "dependencies": ["core"]}`, nil),
+			pack(t, `{"id": "core"}`, nil),
+			pack(t, `{"id": "omega", "dependencies": ["zeta", "alpha"]}`, nil),
+		}
+	}
+
+	r, err := Load(base(t)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := packIDs(r), "core,alpha,beta,zeta,omega"; got != want {
+		t.Errorf("got load order %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		name  string
+		packs []*Pack
+		want  string
+	}{
+		{
+			name:  "missing dependency",
+			packs: []*Pack{pack(t, `{"id": "a", "dependencies": ["b"]}`, nil)},
+			want:  `pack "a" depends on missing pack "b"`,
+		},
+		{
+			name: "cycle",
+			packs: []*Pack{
+				pack(t, `{"id": "a", "dependencies": ["b"]}`, nil),
+				pack(t, `{"id": "b", "dependencies": ["c"]}`, nil),
+				pack(t, `{"id": "c", "dependencies": ["a"]}`, nil),
+				pack(t, `{"id": "d"}`, nil),
+			},
+			want: `dependency cycle among packs ["a" "b" "c"]`,
+		},
+		{
+			name:  "duplicate",
+			packs: []*Pack{pack(t, `{"id": "a"}`, nil), pack(t, `{"id": "a", "version": "2"}`, nil)},
+			want:  `duplicate pack "a"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.packs...)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+const basePack = `{
+	"version": 1,
+	"terrains": [
+		{"id": "grass", "tags": ["land"], "movementPenalty": 100, "passableWith": ["MOVEMENT_TYPE_WALKING"]},
+		{"id": "lava", "movementPenalty": 300}
+	],
+	"spells": [{"id": "fireball", "tags": ["fire"]}]
+}`
+
+func TestOverrides(t *testing.T) {
+	core := func(t *testing.T) *Pack {
+		return pack(t, `{"id": "core"}`, map[string]string{"base.json": basePack})
+	}
+
+	r, err := Load(
+		core(t),
+		pack(t, `{"id": "mod", "dependencies": ["core"]}`, map[string]string{
+			"overrides.json": `{
+				"version": 1,
+				"terrains": [{"id": "ice"}],
+				"replace": {"terrains": [{"id": "lava", "movementPenalty": 500}]},
+				"patch": {
+					"terrains": [{"id": "grass", "tags": ["green"], "movementPenalty": 90}],
+					"spells": [{"id": "fireball", "tags": ["aoe"]}]
+				}
+			}`,
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grass := r.Terrain("grass")
+	if grass.GetMovementPenalty() != 90 || strings.Join(grass.GetTags(), ",") != "land,green" || len(grass.GetPassableWith()) != 1 {
+		t.Errorf("grass is not patched: %v", grass)
+	}
+	if lava := r.Terrain("lava"); lava.GetMovementPenalty() != 500 {
+		t.Errorf("lava is not replaced: %v", lava)
+	}
+	if tags := r.Spell("fireball").GetTags(); strings.Join(tags, ",") != "fire,aoe" {
+		t.Errorf("fireball is not patched: %v", tags)
+	}
+	var ids []string
+	for _, terrain := range r.Terrains() {
+		ids = append(ids, terrain.GetId())
+	}
+	if strings.Join(ids, ",") != "grass,lava,ice" {
+		t.Errorf("overrides must keep load order: %v", ids)
+	}
+
+	tests := []struct {
+		name  string
+		packs []*Pack
+		want  string
+	}{
+		{
+			name: "unknown definition",
+			packs: []*Pack{core(t), pack(t, `{"id": "mod", "dependencies": ["core"]}`, map[string]string{
+				"a.json": `{"version": 1, "patch": {"creatures": [{"id": "imp"}]}}`,
+			})},
+			want: `pack "mod": can't override unknown creature "imp"`,
+		},
+		{
+			name: "not a dependency",
+			packs: []*Pack{core(t), pack(t, `{"id": "mod"}`, map[string]string{
+				"a.json": `{"version": 1, "replace": {"terrains": [{"id": "grass"}]}}`,
+			})},
+			want: `pack "mod": can't override terrain "grass": pack "core" defining it is not a dependency`,
+		},
+		{
+			name: "redefinition",
+			packs: []*Pack{core(t), pack(t, `{"id": "mod", "dependencies": ["core"]}`, map[string]string{
+				"a.json": `{"version": 1, "terrains": [{"id": "grass"}]}`,
+			})},
+			want: `pack "mod": terrain "grass" is already defined by pack "core"`,
+		},
+		{
+			name: "independent overrides",
+			packs: []*Pack{
+				core(t),
+				pack(t, `{"id": "a", "dependencies": ["core"]}`, map[string]string{
+					"a.json": `{"version": 1, "patch": {"spells": [{"id": "fireball", "tags": ["a"]}]}}`,
+				}),
+				pack(t, `{"id": "b", "dependencies": ["core"]}`, map[string]string{
+					"b.json": `{"version": 1, "replace": {"spells": [{"id": "fireball"}]}}`,
+				}),
+			},
+			want: `pack "b": conflicting overrides of spell "fireball": pack "a" overrides it too`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.packs...)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	// declaring dependency resolves the conflict, later pack wins
+	r, err = Load(
+		core(t),
+		pack(t, `{"id": "a", "dependencies": ["core"]}`, map[string]string{
+			"a.json": `{"version": 1, "patch": {"spells": [{"id": "fireball", "tags": ["a"]}]}}`,
+		}),
+		pack(t, `{"id": "b", "dependencies": ["a"]}`, map[string]string{
+			"b.json": `{"version": 1, "patch": {"spells": [{"id": "fireball", "tags": ["b"]}]}}`,
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags := r.Spell("fireball").GetTags(); strings.Join(tags, ",") != "fire,a,b" {
+		t.Errorf("got %v", tags)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	load := func(t *testing.T, modVersion string, modFiles map[string]string) string {
+		t.Helper()
+		r, err := Load(
+			pack(t, `{"id": "mod", "version": "`+modVersion+`", "dependencies": ["core"]}`, modFiles),
+			pack(t, `{"id": "core", "version": "1"}`, map[string]string{"base.json": basePack}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r.Checksum()
+	}
+
+	reference := load(t, "1", nil)
+	if again := load(t, "1", nil); again != reference {
+		t.Error("checksum is not stable")
+	}
+	if load(t, "2", nil) == reference {
+		t.Error("checksum must depend on pack versions")
+	}
+	patched := load(t, "1", map[string]string{
+		"a.json": `{"version": 1, "patch": {"terrains": [{"id": "lava", "movementPenalty": 301}]}}`,
+	})
+	if patched == reference {
+		t.Error("checksum must depend on definitions")
+	}
+}
+
+func TestOpenPackPath(t *testing.T) {
+	dir := t.TempDir()
+
+	// nested in a top-level directory, as archiving tools tend to do
+	f, err := os.Create(filepath.Join(dir, "volcanic.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := zip.NewWriter(f)
+	for name, data := range map[string]string{
+		"volcanic/manifest.json":      `{"id": "volcanic", "dependencies": ["core"]}`,
+		"volcanic/data/terrains.json": `{"version": 1, "terrains": [{"id": "volcanic/terrain/lava"}]}`,
+	} {
+		fw, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "snowy"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"snowy/manifest.json": `{"id": "snowy", "dependencies": ["core"]}`,
+		"snowy/spells.json":   `{"version": 1, "spells": [{"id": "snowy/spell/blizzard"}]}`,
+		"notes.txt":           "not a pack",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	packs, err := ReadPacks(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := Load(append(packs, Builtin())...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := packIDs(r); got != "core,snowy,volcanic" {
+		t.Errorf("got packs %q", got)
+	}
+	if r.Terrain("volcanic/terrain/lava") == nil || r.Spell("snowy/spell/blizzard") == nil {
+		t.Error("pack content is missing")
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+	r, err := Load(Builtin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := NewInterceptor(r)
+
+	game := connect.Spec{Procedure: gamev1connect.GameServiceGetSampleGridProcedure}
+	play := connect.Spec{Procedure: gamev1connect.GameServicePlayProcedure}
+	lobby := connect.Spec{Procedure: lobbyv1connect.LobbyServiceJoinGameProcedure}
+	content := connect.Spec{Procedure: contentv1connect.ContentServiceGetContentProcedure}
+	header := func(checksum string) http.Header {
+		h := http.Header{}
+		if checksum != "" {
+			h.Set(config.ContentChecksumHeader, checksum)
+		}
+		return h
+	}
+
+	if err := i.check(game, header("")); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("without checksum: %v", err)
+	}
+	if err := i.check(game, header(r.Checksum())); err != nil {
+		t.Errorf("matching checksum: %v", err)
+	}
+	if err := i.check(game, header("outdated")); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("mismatching checksum: %v", err)
+	}
+	for _, spec := range []connect.Spec{play, lobby} {
+		if err := i.check(spec, header("")); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("%s without checksum: %v", spec.Procedure, err)
+		}
+	}
+	if err := i.check(content, header("outdated")); err != nil {
+		t.Errorf("content must always be reachable: %v", err)
+	}
+	if err := i.check(content, header("")); err != nil {
+		t.Errorf("content must be reachable without checksum: %v", err)
+	}
+
+	// outdated clients must still be able to sign in & fetch content
+	for _, procedure := range []string{
+		iamv1connect.IAMServiceSignInProcedure,
+		iamv1connect.IAMServiceRefreshProcedure,
+		iamv1connect.IAMServiceListIdentityProvidersProcedure,
+		iamv1connect.IAMServiceListAccountsProcedure,
+		mapv1connect.MapServiceListMapsProcedure,
+	} {
+		if err := i.check(connect.Spec{Procedure: procedure}, header("")); err != nil {
+			t.Errorf("%s must be reachable without checksum: %v", procedure, err)
+		}
+	}
+}
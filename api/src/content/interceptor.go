@@ -0,0 +1,88 @@
+package content
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/proto/economy/v1/economyv1connect"
+	"github.com/openhexes/proto/game/v1/gamev1connect"
+	"github.com/openhexes/proto/heroes/v1/heroesv1connect"
+	"github.com/openhexes/proto/lobby/v1/lobbyv1connect"
+	"github.com/openhexes/proto/objects/v1/objectsv1connect"
+	"github.com/openhexes/proto/towns/v1/townsv1connect"
+)
+
+// checked lists services games are played through, including GameService.Play.
+// Others, e.g. IAMService & MapService, stay reachable by clients with outdated content,
+// so they can still sign in & fetch content to catch up.
+var checked = map[string]bool{
+	gamev1connect.GameServiceName:       true,
+	lobbyv1connect.LobbyServiceName:     true,
+	heroesv1connect.HeroServiceName:     true,
+	townsv1connect.TownServiceName:      true,
+	economyv1connect.EconomyServiceName: true,
+	objectsv1connect.ObjectServiceName:  true,
+}
+
+// Interceptor rejects clients playing with different content than the server.
+// Requests to game services have to carry the checksum, see checked.
+type Interceptor struct {
+	registry *Registry
+}
+
+func NewInterceptor(registry *Registry) *Interceptor {
+	return &Interceptor{
+		registry: registry,
+	}
+}
+
+func (i *Interceptor) check(spec connect.Spec, header http.Header) error {
+	service, _, _ := strings.Cut(strings.TrimPrefix(spec.Procedure, "/"), "/")
+	if !checked[service] {
+		return nil
+	}
+	checksum := header.Get(config.ContentChecksumHeader)
+	if checksum == "" {
+		return connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("content checksum is required, fetch content first"),
+		)
+	}
+	if checksum == i.registry.Checksum() {
+		return nil
+	}
+	return connect.NewError(
+		connect.CodeFailedPrecondition,
+		fmt.Errorf("content mismatch: client has %q, server has %q", checksum, i.registry.Checksum()),
+	)
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.check(request.Spec(), request.Header()); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	})
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return connect.StreamingClientFunc(func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		// noop
+		return next(ctx, spec)
+	})
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.check(conn.Spec(), conn.RequestHeader()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
@@ -0,0 +1,220 @@
+package content
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	contentv1 "github.com/openhexes/proto/content/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestFile is expected in the root of every pack.
+const ManifestFile = "manifest.json"
+
+// Pack is a set of data files described by a manifest.
+type Pack struct {
+	Manifest *contentv1.Manifest
+	fsys     fs.FS
+}
+
+// OpenPack reads pack manifest from the root of fsys.
+func OpenPack(fsys fs.FS) (*Pack, error) {
+	raw, err := fs.ReadFile(fsys, ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	manifest := &contentv1.Manifest{}
+	if err := protojson.Unmarshal(raw, manifest); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	if manifest.Id == "" {
+		return nil, fmt.Errorf("manifest: id is required")
+	}
+	if slices.Contains(manifest.Dependencies, manifest.Id) {
+		return nil, fmt.Errorf("manifest: pack %q depends on itself", manifest.Id)
+	}
+	return &Pack{Manifest: manifest, fsys: fsys}, nil
+}
+
+// OpenPackPath opens pack stored in a directory or zip archive. Archives may keep
+// pack files in the root or in a single top-level directory.
+func OpenPackPath(name string) (*Pack, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return OpenPack(os.DirFS(name))
+	}
+
+	raw, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+
+	var fsys fs.FS = archive
+	if _, err := fs.Stat(archive, ManifestFile); errors.Is(err, fs.ErrNotExist) {
+		entries, err := fs.ReadDir(archive, ".")
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if len(entries) == 1 && entries[0].IsDir() {
+			if fsys, err = fs.Sub(archive, entries[0].Name()); err != nil {
+				return nil, fmt.Errorf("reading archive: %w", err)
+			}
+		}
+	}
+	return OpenPack(fsys)
+}
+
+// ReadPacks opens every pack stored in a directory, either as subdirectory or zip archive.
+func ReadPacks(dir string) ([]*Pack, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var packs []*Pack
+	for _, entry := range entries {
+		if !entry.IsDir() && !strings.EqualFold(filepath.Ext(entry.Name()), ".zip") {
+			continue
+		}
+		p, err := OpenPackPath(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("opening pack %q: %w", entry.Name(), err)
+		}
+		packs = append(packs, p)
+	}
+	return packs, nil
+}
+
+// Bundles reads all data files of the pack in lexical order.
+func (p *Pack) Bundles() ([]*contentv1.Bundle, error) {
+	var bundles []*contentv1.Bundle
+	err := fs.WalkDir(p.fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !dataFile(name) || name == ManifestFile {
+			return nil
+		}
+
+		bundle, err := ReadBundle(p.fsys, name)
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", name, err)
+		}
+		bundles = append(bundles, bundle)
+		return nil
+	})
+	return bundles, err
+}
+
+func dataFile(name string) bool {
+	switch path.Ext(name) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// ReadBundle parses a single JSON or YAML data file. Unknown fields are rejected to catch typos early.
+func ReadBundle(fsys fs.FS, name string) (*contentv1.Bundle, error) {
+	raw, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if ext := path.Ext(name); ext == ".yaml" || ext == ".yml" {
+		// YAML shares field names & value formats with protobuf JSON
+		if raw, err = yaml.YAMLToJSON(raw); err != nil {
+			return nil, fmt.Errorf("parsing: %w", err)
+		}
+	}
+	bundle := &contentv1.Bundle{}
+	if err := protojson.Unmarshal(raw, bundle); err != nil {
+		return nil, fmt.Errorf("parsing: %w", err)
+	}
+	if bundle.Version != Version {
+		return nil, fmt.Errorf("unsupported version %d, expected %d", bundle.Version, Version)
+	}
+	return bundle, nil
+}
+
+// resolve orders packs so that dependencies come first, independent packs are ordered by id.
+// Returns transitive dependencies of every pack as well.
+func resolve(packs []*Pack) ([]*Pack, map[string]map[string]bool, error) {
+	byID := make(map[string]*Pack, len(packs))
+	for _, p := range packs {
+		if _, ok := byID[p.Manifest.Id]; ok {
+			return nil, nil, fmt.Errorf("duplicate pack %q", p.Manifest.Id)
+		}
+		byID[p.Manifest.Id] = p
+	}
+
+	pending := make(map[string]int, len(packs)) // number of dependencies not loaded yet
+	dependents := map[string][]string{}
+	for _, p := range packs {
+		for _, dep := range p.Manifest.Dependencies {
+			if _, ok := byID[dep]; !ok {
+				return nil, nil, fmt.Errorf("pack %q depends on missing pack %q", p.Manifest.Id, dep)
+			}
+			dependents[dep] = append(dependents[dep], p.Manifest.Id)
+		}
+		pending[p.Manifest.Id] = len(p.Manifest.Dependencies)
+	}
+
+	var ready []string
+	for id, n := range pending {
+		if n == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	ordered := make([]*Pack, 0, len(packs))
+	deps := make(map[string]map[string]bool, len(packs))
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		id := ready[0]
+		ready = ready[1:]
+
+		p := byID[id]
+		deps[id] = map[string]bool{}
+		for _, dep := range p.Manifest.Dependencies {
+			deps[id][dep] = true
+			for transitive := range deps[dep] {
+				deps[id][transitive] = true
+			}
+		}
+		ordered = append(ordered, p)
+
+		for _, dependent := range dependents[id] {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(ordered) != len(packs) {
+		var cycle []string
+		for id, n := range pending {
+			if n > 0 {
+				cycle = append(cycle, id)
+			}
+		}
+		slices.Sort(cycle)
+		return nil, nil, fmt.Errorf("dependency cycle among packs %q", cycle)
+	}
+	return ordered, deps, nil
+}
@@ -0,0 +1,95 @@
+package content
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type definition interface {
+	proto.Message
+	GetId() string
+}
+
+// table keeps definitions of a single kind in load order and tracks packs they came from.
+type table[T definition] struct {
+	kind       string
+	list       []T
+	index      map[string]int
+	origin     map[string]string // id -> pack that defined it
+	overridden map[string]string // id -> last pack that overrode it
+}
+
+func newTable[T definition](kind string) *table[T] {
+	return &table[T]{
+		kind:       kind,
+		index:      map[string]int{},
+		origin:     map[string]string{},
+		overridden: map[string]string{},
+	}
+}
+
+func (t *table[T]) get(id string) T {
+	if i, ok := t.index[id]; ok {
+		return t.list[i]
+	}
+	var zero T
+	return zero
+}
+
+func (t *table[T]) add(pack string, items []T) error {
+	for _, item := range items {
+		id := item.GetId()
+		if id == "" {
+			return fmt.Errorf("%s without id", t.kind)
+		}
+		if owner, ok := t.origin[id]; ok {
+			return fmt.Errorf("%s %q is already defined by pack %q", t.kind, id, owner)
+		}
+		t.index[id] = len(t.list)
+		t.origin[id] = pack
+		t.list = append(t.list, item)
+	}
+	return nil
+}
+
+func (t *table[T]) replace(pack string, deps map[string]bool, items []T) error {
+	for _, item := range items {
+		i, err := t.override(pack, deps, item.GetId())
+		if err != nil {
+			return err
+		}
+		t.list[i] = item
+	}
+	return nil
+}
+
+func (t *table[T]) patch(pack string, deps map[string]bool, items []T) error {
+	for _, item := range items {
+		i, err := t.override(pack, deps, item.GetId())
+		if err != nil {
+			return err
+		}
+		patched := proto.Clone(t.list[i]).(T)
+		proto.Merge(patched, item)
+		t.list[i] = patched
+	}
+	return nil
+}
+
+// override checks whether pack may override definition: it must come from a dependency,
+// and packs overriding the same definition must depend on each other to make order explicit.
+func (t *table[T]) override(pack string, deps map[string]bool, id string) (int, error) {
+	i, ok := t.index[id]
+	if !ok {
+		return 0, fmt.Errorf("can't override unknown %s %q", t.kind, id)
+	}
+	if owner := t.origin[id]; !deps[owner] {
+		return 0, fmt.Errorf("can't override %s %q: pack %q defining it is not a dependency", t.kind, id, owner)
+	}
+	if previous, ok := t.overridden[id]; ok && previous != pack && !deps[previous] {
+		return 0, fmt.Errorf("conflicting overrides of %s %q: pack %q overrides it too and is not a dependency", t.kind, id, previous)
+	}
+	t.overridden[id] = pack
+	return i, nil
+}
@@ -0,0 +1,389 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/filter"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+)
+
+// Validate checks definitions and references between them, reporting all problems at once.
+func (r *Registry) Validate() error {
+	var errs []error
+	for _, t := range r.Terrains() {
+		errs = append(errs, prefixed(fmt.Sprintf("terrain %q", t.GetId()), r.validateTerrain(t))...)
+	}
+	for _, k := range r.Creatures() {
+		errs = append(errs, prefixed(fmt.Sprintf("creature %q", k.GetId()), r.validateCreature(k))...)
+	}
+	for _, s := range r.Spells() {
+		if s.GetId() == "" {
+			errs = append(errs, fmt.Errorf("spell without id"))
+		}
+		errs = append(errs, prefixed(fmt.Sprintf("spell %q", s.GetId()), validateSpell(s))...)
+	}
+	for _, res := range r.Resources() {
+		if res.GetValue() == 0 {
+			errs = append(errs, fmt.Errorf("resource %q: value is required", res.GetId()))
+		}
+		if res.GetStartingAmount() > math.MaxInt64 {
+			errs = append(errs, fmt.Errorf("resource %q: starting amount exceeds %d", res.GetId(), int64(math.MaxInt64)))
+		}
+	}
+	for _, k := range r.Towns() {
+		errs = append(errs, prefixed(fmt.Sprintf("town %q", k.GetId()), r.validateTown(k))...)
+	}
+	for _, k := range r.Objects() {
+		errs = append(errs, prefixed(fmt.Sprintf("object %q", k.GetId()), r.validateObject(k))...)
+	}
+	return errors.Join(errs...)
+}
+
+// prefixed qualifies every error, so each line of a joined error is self-explanatory.
+func prefixed(prefix string, errs []error) []error {
+	result := make([]error, 0, len(errs))
+	for _, err := range errs {
+		result = append(result, fmt.Errorf("%s: %w", prefix, err))
+	}
+	return result
+}
+
+func (r *Registry) validateTerrain(t *mapv1.Terrain) []error {
+	var errs []error
+	if t.GetId() == "" {
+		errs = append(errs, fmt.Errorf("id is required"))
+	}
+	for _, m := range t.GetPassableWith() {
+		if _, ok := mapv1.Terrain_MovementType_name[int32(m)]; !ok || m == mapv1.Terrain_MOVEMENT_TYPE_UNSPECIFIED {
+			errs = append(errs, fmt.Errorf("invalid movement type %d", m))
+		}
+	}
+
+	for i, effect := range t.GetEffects() {
+		var effectErrs []error
+		switch kind := effect.GetKind().(type) {
+		case nil:
+			effectErrs = append(effectErrs, fmt.Errorf("kind is required"))
+		case *mapv1.Terrain_Effect_ModifySpellLevel_:
+			effectErrs = r.validateSpellFilter(kind.ModifySpellLevel.GetFilter())
+		case *mapv1.Terrain_Effect_PreventSpellCasting_:
+			p := kind.PreventSpellCasting
+			effectErrs = r.validateSpellFilter(p.GetFilter())
+			if p.LevelGte != nil && p.LevelLte != nil && p.GetLevelGte() > p.GetLevelLte() {
+				effectErrs = append(effectErrs, fmt.Errorf("level range %d..%d is empty", p.GetLevelGte(), p.GetLevelLte()))
+			}
+		case *mapv1.Terrain_Effect_DisableNativeTerrainBonuses_:
+		case *mapv1.Terrain_Effect_ModifyCreatureMovementType_:
+			m := kind.ModifyCreatureMovementType
+			effectErrs = r.validateCreatureFilter(m.GetFilter())
+			for _, mt := range slices.Concat(m.GetRemove(), m.GetAdd()) {
+				if err := validateMovementType(mt); err != nil {
+					effectErrs = append(effectErrs, err)
+				}
+			}
+		case *mapv1.Terrain_Effect_ModifyCreatureMorale_:
+			effectErrs = r.validateCreatureFilter(kind.ModifyCreatureMorale.GetFilter())
+		case *mapv1.Terrain_Effect_ModifyCreatureLuck_:
+			effectErrs = r.validateCreatureFilter(kind.ModifyCreatureLuck.GetFilter())
+		case *mapv1.Terrain_Effect_ModifyCreatureAttack_:
+			effectErrs = r.validateCreatureFilter(kind.ModifyCreatureAttack.GetFilter())
+		case *mapv1.Terrain_Effect_ModifyCreatureDefence_:
+			effectErrs = r.validateCreatureFilter(kind.ModifyCreatureDefence.GetFilter())
+		case *mapv1.Terrain_Effect_ModifyCreatureSpeed_:
+			effectErrs = r.validateCreatureFilter(kind.ModifyCreatureSpeed.GetFilter())
+		}
+		errs = append(errs, prefixed(fmt.Sprintf("effect #%d", i), effectErrs)...)
+	}
+	return errs
+}
+
+func (r *Registry) validateCreature(k *creaturesv1.Creature_Kind) []error {
+	var errs []error
+	if k.GetId() == "" {
+		errs = append(errs, fmt.Errorf("id is required"))
+	}
+	for _, mt := range k.GetMovementTypes() {
+		if err := validateMovementType(mt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, id := range k.GetNativeTerrains() {
+		if r.Terrain(id) == nil {
+			errs = append(errs, fmt.Errorf("unknown native terrain %q", id))
+		}
+	}
+	if s := k.GetStats(); s.GetMinDamage() > s.GetMaxDamage() {
+		errs = append(errs, fmt.Errorf("min damage %d exceeds max damage %d", s.GetMinDamage(), s.GetMaxDamage()))
+	}
+	return errs
+}
+
+func validateMovementType(mt creaturesv1.Creature_MovementType) error {
+	if _, ok := creaturesv1.Creature_MovementType_name[int32(mt)]; !ok || mt == creaturesv1.Creature_MOVEMENT_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid movement type %d", mt)
+	}
+	return nil
+}
+
+// MaxSpellLevel is the highest base level of a spell.
+const MaxSpellLevel = 5
+
+func validateSpell(s *magicv1.Spell) []error {
+	var errs []error
+	if s.GetLevel() < 0 || s.GetLevel() > MaxSpellLevel {
+		errs = append(errs, fmt.Errorf("level must be within 0-%d, got %d", MaxSpellLevel, s.GetLevel()))
+	}
+	if _, ok := magicv1.Spell_School_name[int32(s.GetSchool())]; !ok {
+		errs = append(errs, fmt.Errorf("invalid school %d", s.GetSchool()))
+	}
+	if len(s.GetEffects()) == 0 {
+		return errs
+	}
+
+	switch s.GetScope() {
+	case magicv1.Spell_SCOPE_COMBAT:
+		switch s.GetTargeting() {
+		case magicv1.Spell_TARGETING_ALLY, magicv1.Spell_TARGETING_ENEMY, magicv1.Spell_TARGETING_AREA:
+		default:
+			errs = append(errs, fmt.Errorf("combat spell must target stacks or area, got %s", s.GetTargeting()))
+		}
+	case magicv1.Spell_SCOPE_ADVENTURE:
+		if s.GetTargeting() != magicv1.Spell_TARGETING_CASTER {
+			errs = append(errs, fmt.Errorf("adventure spell must target its caster, got %s", s.GetTargeting()))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("spell with effects requires scope"))
+	}
+
+	for i, effect := range s.GetEffects() {
+		combat := true
+		switch {
+		case effect.GetModifyMovementPoints() != nil:
+			combat = false
+		case effect.GetModifyCreatureStat() != nil:
+			if effect.GetModifyCreatureStat().GetStat() == magicv1.Spell_STAT_UNSPECIFIED {
+				errs = append(errs, fmt.Errorf("effect #%d: stat is required", i))
+			}
+		case effect.GetDamage() == nil && effect.GetHeal() == nil:
+			errs = append(errs, fmt.Errorf("effect #%d: unknown kind", i))
+			continue
+		}
+		if combat != (s.GetScope() == magicv1.Spell_SCOPE_COMBAT) {
+			errs = append(errs, fmt.Errorf("effect #%d doesn't apply in %s", i, s.GetScope()))
+		}
+	}
+	return errs
+}
+
+func (r *Registry) validateTown(k *townsv1.Town_Kind) []error {
+	var errs []error
+	for _, id := range k.GetNativeTerrains() {
+		if r.Terrain(id) == nil {
+			errs = append(errs, fmt.Errorf("unknown native terrain %q", id))
+		}
+	}
+
+	buildings := map[string]*townsv1.Town_Building{}
+	for i, b := range k.GetBuildings() {
+		switch {
+		case b.GetId() == "":
+			errs = append(errs, fmt.Errorf("building #%d without id", i))
+		case buildings[b.GetId()] != nil:
+			errs = append(errs, fmt.Errorf("duplicate building %q", b.GetId()))
+		}
+		buildings[b.GetId()] = b
+	}
+	for _, b := range k.GetBuildings() {
+		for _, id := range b.GetRequires() {
+			if buildings[id] == nil {
+				errs = append(errs, fmt.Errorf("building %q requires unknown building %q", b.GetId(), id))
+			}
+		}
+		errs = append(errs, prefixed(fmt.Sprintf("building %q: cost", b.GetId()), r.validateAmounts(b.GetCost()))...)
+		errs = append(errs, prefixed(fmt.Sprintf("building %q: income", b.GetId()), r.validateAmounts(b.GetIncome()))...)
+		errs = append(errs, prefixed(fmt.Sprintf("building %q", b.GetId()), r.validateSpells(b.GetSpells()))...)
+		if d := b.GetDwelling(); d != nil {
+			errs = append(errs, prefixed(fmt.Sprintf("building %q: dwelling cost", b.GetId()), r.validateAmounts(d.GetCost()))...)
+			if r.Creature(d.GetCreatureId()) == nil {
+				errs = append(errs, fmt.Errorf("building %q: dwelling of unknown creature %q", b.GetId(), d.GetCreatureId()))
+			}
+			if d.GetWeeklyGrowth() == 0 {
+				errs = append(errs, fmt.Errorf("building %q: dwelling without weekly growth", b.GetId()))
+			}
+		}
+	}
+	if id, ok := cyclic(buildings); ok {
+		errs = append(errs, fmt.Errorf("building %q is part of a requirement cycle", id))
+	}
+
+	// initial buildings are built in listed order, so prerequisites must come first
+	built := map[string]bool{}
+	for _, id := range k.GetInitialBuildings() {
+		b := buildings[id]
+		if b == nil {
+			errs = append(errs, fmt.Errorf("unknown initial building %q", id))
+			continue
+		}
+		for _, required := range b.GetRequires() {
+			if !built[required] {
+				errs = append(errs, fmt.Errorf("initial building %q requires %q to be built before", id, required))
+			}
+		}
+		built[id] = true
+	}
+
+	if c := k.GetHeroClass(); c != nil {
+		errs = append(errs, prefixed("hero class: cost", r.validateAmounts(c.GetCost()))...)
+		errs = append(errs, prefixed("hero class", r.validateArmy(c.GetArmy()))...)
+		errs = append(errs, prefixed("hero class", r.validateSpells(c.GetSpells()))...)
+	}
+	return errs
+}
+
+func (r *Registry) validateSpells(ids []string) []error {
+	var errs []error
+	for _, id := range ids {
+		if r.Spell(id) == nil {
+			errs = append(errs, fmt.Errorf("unknown spell %q", id))
+		}
+	}
+	return errs
+}
+
+// validateArmy checks stacks a hero starts with, limits are the same as for recruited ones.
+func (r *Registry) validateArmy(army []*heroesv1.Hero_Stack) []error {
+	var errs []error
+	if len(army) > heroes.ArmySlots {
+		errs = append(errs, fmt.Errorf("army can't have more than %d stacks", heroes.ArmySlots))
+	}
+	for i, stack := range army {
+		if stack.GetCount() == 0 {
+			errs = append(errs, fmt.Errorf("army stack #%d is empty", i))
+		}
+		if r.Creature(stack.GetCreatureId()) == nil {
+			errs = append(errs, fmt.Errorf("army stack #%d: unknown creature %q", i, stack.GetCreatureId()))
+		}
+	}
+	return errs
+}
+
+func (r *Registry) validateAmounts(amounts map[string]uint32) []error {
+	var errs []error
+	for _, id := range slices.Sorted(maps.Keys(amounts)) {
+		if r.Resource(id) == nil {
+			errs = append(errs, fmt.Errorf("unknown resource %q", id))
+		}
+	}
+	return errs
+}
+
+// validateObject checks shape & parameters of an object kind, handlers are checked by the server registering them.
+func (r *Registry) validateObject(k *objectsv1.Object_Kind) []error {
+	var errs []error
+	if k.GetHandler() == "" {
+		errs = append(errs, fmt.Errorf("handler is required"))
+	}
+
+	type offset struct{ q, r int32 }
+	footprint := map[offset]bool{}
+	for _, o := range k.GetFootprint() {
+		if footprint[offset{o.GetQ(), o.GetR()}] {
+			errs = append(errs, fmt.Errorf("duplicate footprint offset %d,%d", o.GetQ(), o.GetR()))
+		}
+		footprint[offset{o.GetQ(), o.GetR()}] = true
+	}
+	if len(footprint) == 0 {
+		footprint[offset{}] = true
+	}
+	for _, o := range k.GetEntrances() {
+		if !footprint[offset{o.GetQ(), o.GetR()}] {
+			errs = append(errs, fmt.Errorf("entrance %d,%d is outside of the footprint", o.GetQ(), o.GetR()))
+		}
+	}
+
+	errs = append(errs, prefixed("resources", r.validateAmounts(k.GetResources()))...)
+	errs = append(errs, prefixed("cost", r.validateAmounts(k.GetCost()))...)
+	for _, id := range k.GetSpellIds() {
+		if r.Spell(id) == nil {
+			errs = append(errs, fmt.Errorf("unknown spell %q", id))
+		}
+	}
+	if id := k.GetCreatureId(); id != "" && r.Creature(id) == nil {
+		errs = append(errs, fmt.Errorf("unknown creature %q", id))
+	}
+	if k.GetWeeklyGrowth() > 0 && k.GetCreatureId() == "" {
+		errs = append(errs, fmt.Errorf("weekly growth without creature"))
+	}
+	for i, stack := range k.GetGuards() {
+		if stack.GetCount() == 0 {
+			errs = append(errs, fmt.Errorf("guard stack #%d is empty", i))
+		}
+		if r.Creature(stack.GetCreatureId()) == nil {
+			errs = append(errs, fmt.Errorf("guard stack #%d: unknown creature %q", i, stack.GetCreatureId()))
+		}
+	}
+	return errs
+}
+
+// cyclic finds a building whose requirements lead back to it.
+func cyclic(buildings map[string]*townsv1.Town_Building) (string, bool) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		switch state[id] {
+		case visiting:
+			return true
+		case done:
+			return false
+		}
+		state[id] = visiting
+		for _, required := range buildings[id].GetRequires() {
+			if visit(required) {
+				return true
+			}
+		}
+		state[id] = done
+		return false
+	}
+	for _, id := range slices.Sorted(maps.Keys(buildings)) {
+		if visit(id) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (r *Registry) validateCreatureFilter(f filter.Filter) []error {
+	return validateFilter(f, "creature", func(id string) bool { return r.Creature(id) != nil })
+}
+
+func (r *Registry) validateSpellFilter(f filter.Filter) []error {
+	return validateFilter(f, "spell", func(id string) bool { return r.Spell(id) != nil })
+}
+
+func validateFilter(f filter.Filter, kind string, exists func(id string) bool) []error {
+	var errs []error
+	if filter.Compile(f).Empty() {
+		errs = append(errs, fmt.Errorf("filter matches nothing"))
+	}
+	for _, id := range slices.Concat(f.GetIncludeIds(), f.GetExcludeIds()) {
+		if !exists(id) {
+			errs = append(errs, fmt.Errorf("filter refers to unknown %s %q", kind, id))
+		}
+	}
+	return errs
+}
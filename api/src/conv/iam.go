@@ -22,3 +22,23 @@ func AccountToProto(account *db.Account) *v1.Account {
 		Email: account.Email,
 	}
 }
+
+func IdentityToProto(i *db.LinkedIdentity) *v1.Identity {
+	return &v1.Identity{
+		Provider:  i.Provider,
+		Subject:   i.Subject,
+		Email:     i.Email,
+		CreatedAt: timestamppb.New(i.CreatedAt.Time),
+	}
+}
+
+func SessionToProto(s *db.Session) *v1.Session {
+	return &v1.Session{
+		Id:          s.ID.String(),
+		Provider:    s.Provider,
+		UserAgent:   s.UserAgent,
+		CreatedAt:   timestamppb.New(s.CreatedAt.Time),
+		RefreshedAt: timestamppb.New(s.RefreshedAt.Time),
+		ExpiresAt:   timestamppb.New(s.ExpiresAt.Time),
+	}
+}
@@ -0,0 +1,51 @@
+package conv
+
+import (
+	"fmt"
+
+	"github.com/openhexes/openhexes/api/src/db"
+	lobbyv1 "github.com/openhexes/proto/lobby/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GameToProto expects players of the game ordered by slot, players of other games are skipped.
+func GameToProto(g *db.Game, players []db.ListGamePlayersRow) (*lobbyv1.Game, error) {
+	if g == nil {
+		return nil, nil
+	}
+
+	settings := &lobbyv1.Settings{}
+	if err := proto.Unmarshal(g.Settings, settings); err != nil {
+		return nil, fmt.Errorf("decoding settings of game %q: %w", g.ID, err)
+	}
+
+	game := &lobbyv1.Game{
+		Id:              g.ID.String(),
+		HostId:          g.HostID.String(),
+		Name:            g.Name,
+		MapId:           g.MapID.String(),
+		State:           lobbyv1.Game_State(lobbyv1.Game_State_value[g.State]),
+		MaxPlayers:      uint32(g.MaxPlayers),
+		Settings:        settings,
+		ContentChecksum: g.ContentChecksum,
+		CreatedAt:       timestamppb.New(g.CreatedAt.Time),
+	}
+	if g.StartedAt.Valid {
+		game.StartedAt = timestamppb.New(g.StartedAt.Time)
+	}
+	for _, p := range players {
+		if p.GameID != g.ID {
+			continue
+		}
+		game.Players = append(game.Players, &lobbyv1.Game_Player{
+			Slot:        uint32(p.Slot),
+			AccountId:   p.AccountID.String(),
+			DisplayName: p.DisplayName,
+			Picture:     p.Picture,
+			Ready:       p.Ready,
+			JoinedAt:    timestamppb.New(p.JoinedAt.Time),
+		})
+	}
+	return game, nil
+}
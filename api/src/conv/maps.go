@@ -0,0 +1,38 @@
+package conv
+
+import (
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapToProto(m *db.Map) *mapv1.Map {
+	if m == nil {
+		return nil
+	}
+
+	return &mapv1.Map{
+		Id:                   m.ID.String(),
+		OwnerId:              m.OwnerID.String(),
+		Name:                 m.Name,
+		TotalRows:            uint32(m.TotalRows),
+		TotalColumns:         uint32(m.TotalColumns),
+		MaxRowsPerSegment:    uint32(m.RowsPerSegment),
+		MaxColumnsPerSegment: uint32(m.ColumnsPerSegment),
+		Seed:                 m.Seed,
+		CreatedAt:            timestamppb.New(m.CreatedAt.Time),
+		UpdatedAt:            timestamppb.New(m.UpdatedAt.Time),
+		Depths:               uint32(m.Depths),
+	}
+}
+
+func MapLayout(m *db.Map) grid.Layout {
+	return grid.Layout{
+		TotalRows:         uint32(m.TotalRows),
+		TotalColumns:      uint32(m.TotalColumns),
+		RowsPerSegment:    uint32(m.RowsPerSegment),
+		ColumnsPerSegment: uint32(m.ColumnsPerSegment),
+		Depths:            uint32(m.Depths),
+	}
+}
@@ -18,6 +18,132 @@ type Account struct {
 	Picture     string
 }
 
+type AccountActivation struct {
+	ID        int64
+	AccountID uuid.UUID
+	Active    bool
+	ChangedBy pgtype.UUID
+	CreatedAt pgtype.Timestamptz
+}
+
+type Game struct {
+	ID              uuid.UUID
+	HostID          uuid.UUID
+	MapID           uuid.UUID
+	Name            string
+	State           string
+	MaxPlayers      int32
+	Settings        []byte
+	ContentChecksum string
+	CreatedAt       pgtype.Timestamptz
+	StartedAt       pgtype.Timestamptz
+}
+
+type GameMapSegment struct {
+	GameID        uuid.UUID
+	Depth         int32
+	SegmentRow    int32
+	SegmentColumn int32
+	Data          []byte
+}
+
+type GamePlayer struct {
+	GameID    uuid.UUID
+	Slot      int32
+	AccountID uuid.UUID
+	Ready     bool
+	JoinedAt  pgtype.Timestamptz
+}
+
+type GameState struct {
+	GameID    uuid.UUID
+	Day       int32
+	Turn      []byte
+	UpdatedAt pgtype.Timestamptz
+	Deadline  pgtype.Timestamptz
+}
+
+type GameVisibility struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+	Explored  []byte
+	UpdatedAt pgtype.Timestamptz
+}
+
+type Hero struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	OwnerID   uuid.UUID
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type LinkedIdentity struct {
+	Provider     string
+	Subject      string
+	AccountID    uuid.UUID
+	Email        string
+	PasswordHash pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+}
+
+type Map struct {
+	ID                uuid.UUID
+	OwnerID           uuid.UUID
+	Name              string
+	TotalRows         int32
+	TotalColumns      int32
+	RowsPerSegment    int32
+	ColumnsPerSegment int32
+	Seed              int64
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	Depths            int32
+}
+
+type MapFeature struct {
+	ID        uuid.UUID
+	MapID     uuid.UUID
+	Kind      string
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type MapObject struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type MapSegment struct {
+	MapID         uuid.UUID
+	Depth         int32
+	SegmentRow    int32
+	SegmentColumn int32
+	Data          []byte
+}
+
+type ResourceSite struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type ResourceTransaction struct {
+	ID         int64
+	GameID     uuid.UUID
+	AccountID  uuid.UUID
+	Day        int32
+	ResourceID string
+	Delta      int64
+	Balance    int64
+	Reason     string
+	Reference  string
+	CreatedAt  pgtype.Timestamptz
+}
+
 type Role struct {
 	ID string
 }
@@ -26,3 +152,36 @@ type RoleBinding struct {
 	AccountID uuid.UUID
 	RoleID    string
 }
+
+type Session struct {
+	ID              uuid.UUID
+	AccountID       uuid.UUID
+	Provider        string
+	UserAgent       string
+	RefreshHash     string
+	CreatedAt       pgtype.Timestamptz
+	RefreshedAt     pgtype.Timestamptz
+	ExpiresAt       pgtype.Timestamptz
+	PrevRefreshHash pgtype.Text
+}
+
+type Town struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type Trade struct {
+	ID        uuid.UUID
+	GameID    uuid.UUID
+	Data      []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type Treasury struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+	Data      []byte
+	UpdatedAt pgtype.Timestamptz
+}
@@ -12,6 +12,49 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addGamePlayer = `-- name: AddGamePlayer :exec
+insert into game_players (game_id, slot, account_id, ready, joined_at)
+values ($1, $2, $3, false, now())
+`
+
+type AddGamePlayerParams struct {
+	GameID    uuid.UUID
+	Slot      int32
+	AccountID uuid.UUID
+}
+
+func (q *Queries) AddGamePlayer(ctx context.Context, arg AddGamePlayerParams) error {
+	_, err := q.db.Exec(ctx, addGamePlayer, arg.GameID, arg.Slot, arg.AccountID)
+	return err
+}
+
+const copyGameMapSegments = `-- name: CopyGameMapSegments :exec
+insert into game_map_segments (game_id, depth, segment_row, segment_column, data)
+select $1::uuid, depth, segment_row, segment_column, data from map_segments
+where map_id = $2
+`
+
+type CopyGameMapSegmentsParams struct {
+	GameID uuid.UUID
+	MapID  uuid.UUID
+}
+
+func (q *Queries) CopyGameMapSegments(ctx context.Context, arg CopyGameMapSegmentsParams) error {
+	_, err := q.db.Exec(ctx, copyGameMapSegments, arg.GameID, arg.MapID)
+	return err
+}
+
+const countMapGames = `-- name: CountMapGames :one
+select count(*) from games where map_id = $1
+`
+
+func (q *Queries) CountMapGames(ctx context.Context, mapID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countMapGames, mapID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
 insert into accounts (active, created_at, email, display_name, picture)
 values ($1, now(), $2, $3, $4)
@@ -44,121 +87,461 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 	return i, err
 }
 
-const createRole = `-- name: CreateRole :exec
-insert into roles (id)
-values ($1)
-on conflict do nothing
+const createAccountActivation = `-- name: CreateAccountActivation :exec
+insert into account_activations (account_id, active, changed_by, created_at)
+values ($1, $2, $3, now())
 `
 
-func (q *Queries) CreateRole(ctx context.Context, id string) error {
-	_, err := q.db.Exec(ctx, createRole, id)
+type CreateAccountActivationParams struct {
+	AccountID uuid.UUID
+	Active    bool
+	ChangedBy pgtype.UUID
+}
+
+func (q *Queries) CreateAccountActivation(ctx context.Context, arg CreateAccountActivationParams) error {
+	_, err := q.db.Exec(ctx, createAccountActivation, arg.AccountID, arg.Active, arg.ChangedBy)
 	return err
 }
 
-const getAccount = `-- name: GetAccount :one
-select id, active, created_at, email, display_name, picture from accounts where email = $1
+const createGame = `-- name: CreateGame :one
+insert into games (host_id, map_id, name, state, max_players, settings, content_checksum, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, now())
+returning id, host_id, map_id, name, state, max_players, settings, content_checksum, created_at, started_at
 `
 
-func (q *Queries) GetAccount(ctx context.Context, email string) (Account, error) {
-	row := q.db.QueryRow(ctx, getAccount, email)
-	var i Account
+type CreateGameParams struct {
+	HostID          uuid.UUID
+	MapID           uuid.UUID
+	Name            string
+	State           string
+	MaxPlayers      int32
+	Settings        []byte
+	ContentChecksum string
+}
+
+func (q *Queries) CreateGame(ctx context.Context, arg CreateGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, createGame,
+		arg.HostID,
+		arg.MapID,
+		arg.Name,
+		arg.State,
+		arg.MaxPlayers,
+		arg.Settings,
+		arg.ContentChecksum,
+	)
+	var i Game
 	err := row.Scan(
 		&i.ID,
-		&i.Active,
+		&i.HostID,
+		&i.MapID,
+		&i.Name,
+		&i.State,
+		&i.MaxPlayers,
+		&i.Settings,
+		&i.ContentChecksum,
 		&i.CreatedAt,
+		&i.StartedAt,
+	)
+	return i, err
+}
+
+const createGameState = `-- name: CreateGameState :exec
+insert into game_states (game_id, day, turn, updated_at, deadline)
+values ($1, $2, $3, now(), $4)
+`
+
+type CreateGameStateParams struct {
+	GameID   uuid.UUID
+	Day      int32
+	Turn     []byte
+	Deadline pgtype.Timestamptz
+}
+
+func (q *Queries) CreateGameState(ctx context.Context, arg CreateGameStateParams) error {
+	_, err := q.db.Exec(ctx, createGameState,
+		arg.GameID,
+		arg.Day,
+		arg.Turn,
+		arg.Deadline,
+	)
+	return err
+}
+
+const createHero = `-- name: CreateHero :exec
+insert into heroes (id, game_id, owner_id, data, created_at)
+values ($1, $2, $3, $4, now())
+`
+
+type CreateHeroParams struct {
+	ID      uuid.UUID
+	GameID  uuid.UUID
+	OwnerID uuid.UUID
+	Data    []byte
+}
+
+func (q *Queries) CreateHero(ctx context.Context, arg CreateHeroParams) error {
+	_, err := q.db.Exec(ctx, createHero,
+		arg.ID,
+		arg.GameID,
+		arg.OwnerID,
+		arg.Data,
+	)
+	return err
+}
+
+const createLinkedIdentity = `-- name: CreateLinkedIdentity :one
+insert into linked_identities (provider, subject, account_id, email, password_hash, created_at)
+values ($1, $2, $3, $4, $5, now())
+returning provider, subject, account_id, email, password_hash, created_at
+`
+
+type CreateLinkedIdentityParams struct {
+	Provider     string
+	Subject      string
+	AccountID    uuid.UUID
+	Email        string
+	PasswordHash pgtype.Text
+}
+
+func (q *Queries) CreateLinkedIdentity(ctx context.Context, arg CreateLinkedIdentityParams) (LinkedIdentity, error) {
+	row := q.db.QueryRow(ctx, createLinkedIdentity,
+		arg.Provider,
+		arg.Subject,
+		arg.AccountID,
+		arg.Email,
+		arg.PasswordHash,
+	)
+	var i LinkedIdentity
+	err := row.Scan(
+		&i.Provider,
+		&i.Subject,
+		&i.AccountID,
 		&i.Email,
-		&i.DisplayName,
-		&i.Picture,
+		&i.PasswordHash,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const grantRole = `-- name: GrantRole :exec
-insert into role_bindings (role_id, account_id)
-values ($1, $2)
+const createMap = `-- name: CreateMap :one
+insert into maps (owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, depths, seed, created_at, updated_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, now(), now())
+returning id, owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, seed, created_at, updated_at, depths
+`
+
+type CreateMapParams struct {
+	OwnerID           uuid.UUID
+	Name              string
+	TotalRows         int32
+	TotalColumns      int32
+	RowsPerSegment    int32
+	ColumnsPerSegment int32
+	Depths            int32
+	Seed              int64
+}
+
+func (q *Queries) CreateMap(ctx context.Context, arg CreateMapParams) (Map, error) {
+	row := q.db.QueryRow(ctx, createMap,
+		arg.OwnerID,
+		arg.Name,
+		arg.TotalRows,
+		arg.TotalColumns,
+		arg.RowsPerSegment,
+		arg.ColumnsPerSegment,
+		arg.Depths,
+		arg.Seed,
+	)
+	var i Map
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TotalRows,
+		&i.TotalColumns,
+		&i.RowsPerSegment,
+		&i.ColumnsPerSegment,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Depths,
+	)
+	return i, err
+}
+
+const createMapFeature = `-- name: CreateMapFeature :exec
+insert into map_features (id, map_id, kind, data, created_at)
+values ($1, $2, $3, $4, now())
+`
+
+type CreateMapFeatureParams struct {
+	ID    uuid.UUID
+	MapID uuid.UUID
+	Kind  string
+	Data  []byte
+}
+
+func (q *Queries) CreateMapFeature(ctx context.Context, arg CreateMapFeatureParams) error {
+	_, err := q.db.Exec(ctx, createMapFeature,
+		arg.ID,
+		arg.MapID,
+		arg.Kind,
+		arg.Data,
+	)
+	return err
+}
+
+const createMapObject = `-- name: CreateMapObject :exec
+insert into map_objects (id, game_id, data, created_at)
+values ($1, $2, $3, now())
+`
+
+type CreateMapObjectParams struct {
+	ID     uuid.UUID
+	GameID uuid.UUID
+	Data   []byte
+}
+
+func (q *Queries) CreateMapObject(ctx context.Context, arg CreateMapObjectParams) error {
+	_, err := q.db.Exec(ctx, createMapObject, arg.ID, arg.GameID, arg.Data)
+	return err
+}
+
+const createResourceSite = `-- name: CreateResourceSite :exec
+insert into resource_sites (id, game_id, data, created_at)
+values ($1, $2, $3, now())
+`
+
+type CreateResourceSiteParams struct {
+	ID     uuid.UUID
+	GameID uuid.UUID
+	Data   []byte
+}
+
+func (q *Queries) CreateResourceSite(ctx context.Context, arg CreateResourceSiteParams) error {
+	_, err := q.db.Exec(ctx, createResourceSite, arg.ID, arg.GameID, arg.Data)
+	return err
+}
+
+const createResourceTransaction = `-- name: CreateResourceTransaction :exec
+insert into resource_transactions (game_id, account_id, day, resource_id, delta, balance, reason, reference, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, now())
+`
+
+type CreateResourceTransactionParams struct {
+	GameID     uuid.UUID
+	AccountID  uuid.UUID
+	Day        int32
+	ResourceID string
+	Delta      int64
+	Balance    int64
+	Reason     string
+	Reference  string
+}
+
+func (q *Queries) CreateResourceTransaction(ctx context.Context, arg CreateResourceTransactionParams) error {
+	_, err := q.db.Exec(ctx, createResourceTransaction,
+		arg.GameID,
+		arg.AccountID,
+		arg.Day,
+		arg.ResourceID,
+		arg.Delta,
+		arg.Balance,
+		arg.Reason,
+		arg.Reference,
+	)
+	return err
+}
+
+const createRole = `-- name: CreateRole :exec
+insert into roles (id)
+values ($1)
 on conflict do nothing
 `
 
-type GrantRoleParams struct {
-	RoleID    string
-	AccountID uuid.UUID
+func (q *Queries) CreateRole(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, createRole, id)
+	return err
 }
 
-func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) error {
-	_, err := q.db.Exec(ctx, grantRole, arg.RoleID, arg.AccountID)
+const createSession = `-- name: CreateSession :exec
+insert into sessions (id, account_id, provider, user_agent, refresh_hash, created_at, refreshed_at, expires_at)
+values ($1, $2, $3, $4, $5, now(), now(), $6)
+`
+
+type CreateSessionParams struct {
+	ID          uuid.UUID
+	AccountID   uuid.UUID
+	Provider    string
+	UserAgent   string
+	RefreshHash string
+	ExpiresAt   pgtype.Timestamptz
+}
+
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.Exec(ctx, createSession,
+		arg.ID,
+		arg.AccountID,
+		arg.Provider,
+		arg.UserAgent,
+		arg.RefreshHash,
+		arg.ExpiresAt,
+	)
 	return err
 }
 
-const listAccountRoles = `-- name: ListAccountRoles :many
-select role_id from role_bindings where account_id = $1
+const createTown = `-- name: CreateTown :exec
+insert into towns (id, game_id, data, created_at)
+values ($1, $2, $3, now())
 `
 
-func (q *Queries) ListAccountRoles(ctx context.Context, id uuid.UUID) ([]string, error) {
-	rows, err := q.db.Query(ctx, listAccountRoles, id)
+type CreateTownParams struct {
+	ID     uuid.UUID
+	GameID uuid.UUID
+	Data   []byte
+}
+
+func (q *Queries) CreateTown(ctx context.Context, arg CreateTownParams) error {
+	_, err := q.db.Exec(ctx, createTown, arg.ID, arg.GameID, arg.Data)
+	return err
+}
+
+const createTrade = `-- name: CreateTrade :exec
+insert into trades (id, game_id, data, created_at)
+values ($1, $2, $3, now())
+`
+
+type CreateTradeParams struct {
+	ID     uuid.UUID
+	GameID uuid.UUID
+	Data   []byte
+}
+
+func (q *Queries) CreateTrade(ctx context.Context, arg CreateTradeParams) error {
+	_, err := q.db.Exec(ctx, createTrade, arg.ID, arg.GameID, arg.Data)
+	return err
+}
+
+const deleteExpiredSessions = `-- name: DeleteExpiredSessions :exec
+delete from sessions where account_id = $1 and expires_at <= now()
+`
+
+func (q *Queries) DeleteExpiredSessions(ctx context.Context, accountID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExpiredSessions, accountID)
+	return err
+}
+
+const deleteGame = `-- name: DeleteGame :exec
+delete from games where id = $1
+`
+
+func (q *Queries) DeleteGame(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGame, id)
+	return err
+}
+
+const deleteHero = `-- name: DeleteHero :exec
+delete from heroes where id = $1
+`
+
+func (q *Queries) DeleteHero(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteHero, id)
+	return err
+}
+
+const deleteLinkedIdentity = `-- name: DeleteLinkedIdentity :execrows
+delete from linked_identities
+where provider = $1 and subject = $2 and account_id = $3
+`
+
+type DeleteLinkedIdentityParams struct {
+	Provider  string
+	Subject   string
+	AccountID uuid.UUID
+}
+
+func (q *Queries) DeleteLinkedIdentity(ctx context.Context, arg DeleteLinkedIdentityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLinkedIdentity, arg.Provider, arg.Subject, arg.AccountID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []string
-	for rows.Next() {
-		var role_id string
-		if err := rows.Scan(&role_id); err != nil {
-			return nil, err
-		}
-		items = append(items, role_id)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const listAccounts = `-- name: ListAccounts :many
-select id, active, created_at, email, display_name, picture from accounts 
-where (active = $1 or $1 is null)
-order by id
+const deleteMap = `-- name: DeleteMap :execrows
+delete from maps where id = $1 and owner_id = $2
 `
 
-func (q *Queries) ListAccounts(ctx context.Context, active pgtype.Bool) ([]Account, error) {
-	rows, err := q.db.Query(ctx, listAccounts, active)
+type DeleteMapParams struct {
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+}
+
+func (q *Queries) DeleteMap(ctx context.Context, arg DeleteMapParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMap, arg.ID, arg.OwnerID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Account
-	for rows.Next() {
-		var i Account
-		if err := rows.Scan(
-			&i.ID,
-			&i.Active,
-			&i.CreatedAt,
-			&i.Email,
-			&i.DisplayName,
-			&i.Picture,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const deleteMapObject = `-- name: DeleteMapObject :exec
+delete from map_objects where id = $1
+`
+
+func (q *Queries) DeleteMapObject(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMapObject, id)
+	return err
+}
+
+const deleteResourceSite = `-- name: DeleteResourceSite :exec
+delete from resource_sites where id = $1
+`
+
+func (q *Queries) DeleteResourceSite(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteResourceSite, id)
+	return err
+}
+
+const deleteSession = `-- name: DeleteSession :execrows
+delete from sessions where id = $1 and account_id = $2
+`
+
+type DeleteSessionParams struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSession, arg.ID, arg.AccountID)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const listRoles = `-- name: ListRoles :many
-select id from roles order by id
+const deleteSessions = `-- name: DeleteSessions :many
+delete from sessions
+where account_id = $1
+and ($2::uuid is null or id <> $2::uuid)
+and ($3::varchar is null or provider = $3::varchar)
+returning id
 `
 
-func (q *Queries) ListRoles(ctx context.Context) ([]string, error) {
-	rows, err := q.db.Query(ctx, listRoles)
+type DeleteSessionsParams struct {
+	AccountID uuid.UUID
+	KeepID    pgtype.UUID
+	Provider  pgtype.Text
+}
+
+func (q *Queries) DeleteSessions(ctx context.Context, arg DeleteSessionsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, deleteSessions, arg.AccountID, arg.KeepID, arg.Provider)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []uuid.UUID
 	for rows.Next() {
-		var id string
+		var id uuid.UUID
 		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
@@ -170,31 +553,1350 @@ func (q *Queries) ListRoles(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
-const revokeRole = `-- name: RevokeRole :exec
-delete from role_bindings
-where role_id = $1 and account_id = $2
+const getAccount = `-- name: GetAccount :one
+select id, active, created_at, email, display_name, picture from accounts where email = $1
 `
 
-type RevokeRoleParams struct {
-	RoleID    string
-	AccountID uuid.UUID
-}
-
-func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) error {
-	_, err := q.db.Exec(ctx, revokeRole, arg.RoleID, arg.AccountID)
-	return err
+func (q *Queries) GetAccount(ctx context.Context, email string) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccount, email)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Active,
+		&i.CreatedAt,
+		&i.Email,
+		&i.DisplayName,
+		&i.Picture,
+	)
+	return i, err
 }
 
-const updateAccountActivation = `-- name: UpdateAccountActivation :exec
-update accounts set active = $1 where id = any($2::uuid[])
+const getAccountByID = `-- name: GetAccountByID :one
+select id, active, created_at, email, display_name, picture from accounts where id = $1
 `
 
-type UpdateAccountActivationParams struct {
-	Active bool
-	Ids    []uuid.UUID
+func (q *Queries) GetAccountByID(ctx context.Context, id uuid.UUID) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByID, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Active,
+		&i.CreatedAt,
+		&i.Email,
+		&i.DisplayName,
+		&i.Picture,
+	)
+	return i, err
+}
+
+const getGame = `-- name: GetGame :one
+select id, host_id, map_id, name, state, max_players, settings, content_checksum, created_at, started_at from games where id = $1
+`
+
+func (q *Queries) GetGame(ctx context.Context, id uuid.UUID) (Game, error) {
+	row := q.db.QueryRow(ctx, getGame, id)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostID,
+		&i.MapID,
+		&i.Name,
+		&i.State,
+		&i.MaxPlayers,
+		&i.Settings,
+		&i.ContentChecksum,
+		&i.CreatedAt,
+		&i.StartedAt,
+	)
+	return i, err
+}
+
+const getGameForUpdate = `-- name: GetGameForUpdate :one
+select id, host_id, map_id, name, state, max_players, settings, content_checksum, created_at, started_at from games where id = $1 for update
+`
+
+func (q *Queries) GetGameForUpdate(ctx context.Context, id uuid.UUID) (Game, error) {
+	row := q.db.QueryRow(ctx, getGameForUpdate, id)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostID,
+		&i.MapID,
+		&i.Name,
+		&i.State,
+		&i.MaxPlayers,
+		&i.Settings,
+		&i.ContentChecksum,
+		&i.CreatedAt,
+		&i.StartedAt,
+	)
+	return i, err
+}
+
+const getGameMapSegment = `-- name: GetGameMapSegment :one
+select data from game_map_segments
+where game_id = $1 and depth = $2 and segment_row = $3 and segment_column = $4
+`
+
+type GetGameMapSegmentParams struct {
+	GameID        uuid.UUID
+	Depth         int32
+	SegmentRow    int32
+	SegmentColumn int32
+}
+
+func (q *Queries) GetGameMapSegment(ctx context.Context, arg GetGameMapSegmentParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getGameMapSegment,
+		arg.GameID,
+		arg.Depth,
+		arg.SegmentRow,
+		arg.SegmentColumn,
+	)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const getGamePlayer = `-- name: GetGamePlayer :one
+select game_id, slot, account_id, ready, joined_at from game_players where game_id = $1 and account_id = $2
+`
+
+type GetGamePlayerParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) GetGamePlayer(ctx context.Context, arg GetGamePlayerParams) (GamePlayer, error) {
+	row := q.db.QueryRow(ctx, getGamePlayer, arg.GameID, arg.AccountID)
+	var i GamePlayer
+	err := row.Scan(
+		&i.GameID,
+		&i.Slot,
+		&i.AccountID,
+		&i.Ready,
+		&i.JoinedAt,
+	)
+	return i, err
+}
+
+const getGameState = `-- name: GetGameState :one
+select game_id, day, turn, updated_at, deadline from game_states where game_id = $1
+`
+
+func (q *Queries) GetGameState(ctx context.Context, gameID uuid.UUID) (GameState, error) {
+	row := q.db.QueryRow(ctx, getGameState, gameID)
+	var i GameState
+	err := row.Scan(
+		&i.GameID,
+		&i.Day,
+		&i.Turn,
+		&i.UpdatedAt,
+		&i.Deadline,
+	)
+	return i, err
+}
+
+const getGameStateForUpdate = `-- name: GetGameStateForUpdate :one
+select game_id, day, turn, updated_at, deadline from game_states where game_id = $1 for update
+`
+
+func (q *Queries) GetGameStateForUpdate(ctx context.Context, gameID uuid.UUID) (GameState, error) {
+	row := q.db.QueryRow(ctx, getGameStateForUpdate, gameID)
+	var i GameState
+	err := row.Scan(
+		&i.GameID,
+		&i.Day,
+		&i.Turn,
+		&i.UpdatedAt,
+		&i.Deadline,
+	)
+	return i, err
+}
+
+const getGameVisibility = `-- name: GetGameVisibility :one
+select explored from game_visibility where game_id = $1 and account_id = $2
+`
+
+type GetGameVisibilityParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) GetGameVisibility(ctx context.Context, arg GetGameVisibilityParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getGameVisibility, arg.GameID, arg.AccountID)
+	var explored []byte
+	err := row.Scan(&explored)
+	return explored, err
+}
+
+const getLinkedIdentity = `-- name: GetLinkedIdentity :one
+select provider, subject, account_id, email, password_hash, created_at from linked_identities where provider = $1 and subject = $2
+`
+
+type GetLinkedIdentityParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetLinkedIdentity(ctx context.Context, arg GetLinkedIdentityParams) (LinkedIdentity, error) {
+	row := q.db.QueryRow(ctx, getLinkedIdentity, arg.Provider, arg.Subject)
+	var i LinkedIdentity
+	err := row.Scan(
+		&i.Provider,
+		&i.Subject,
+		&i.AccountID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMap = `-- name: GetMap :one
+select id, owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, seed, created_at, updated_at, depths from maps where id = $1 and owner_id = $2
+`
+
+type GetMapParams struct {
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+}
+
+func (q *Queries) GetMap(ctx context.Context, arg GetMapParams) (Map, error) {
+	row := q.db.QueryRow(ctx, getMap, arg.ID, arg.OwnerID)
+	var i Map
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TotalRows,
+		&i.TotalColumns,
+		&i.RowsPerSegment,
+		&i.ColumnsPerSegment,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Depths,
+	)
+	return i, err
+}
+
+const getMapForUpdate = `-- name: GetMapForUpdate :one
+select id, owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, seed, created_at, updated_at, depths from maps where id = $1 and owner_id = $2 for update
+`
+
+type GetMapForUpdateParams struct {
+	ID      uuid.UUID
+	OwnerID uuid.UUID
+}
+
+func (q *Queries) GetMapForUpdate(ctx context.Context, arg GetMapForUpdateParams) (Map, error) {
+	row := q.db.QueryRow(ctx, getMapForUpdate, arg.ID, arg.OwnerID)
+	var i Map
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TotalRows,
+		&i.TotalColumns,
+		&i.RowsPerSegment,
+		&i.ColumnsPerSegment,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Depths,
+	)
+	return i, err
+}
+
+const getMapSegment = `-- name: GetMapSegment :one
+select data from map_segments
+where map_id = $1 and depth = $2 and segment_row = $3 and segment_column = $4
+`
+
+type GetMapSegmentParams struct {
+	MapID         uuid.UUID
+	Depth         int32
+	SegmentRow    int32
+	SegmentColumn int32
+}
+
+func (q *Queries) GetMapSegment(ctx context.Context, arg GetMapSegmentParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getMapSegment,
+		arg.MapID,
+		arg.Depth,
+		arg.SegmentRow,
+		arg.SegmentColumn,
+	)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const getSession = `-- name: GetSession :one
+select id, account_id, provider, user_agent, refresh_hash, created_at, refreshed_at, expires_at, prev_refresh_hash from sessions where id = $1 and expires_at > now()
+`
+
+func (q *Queries) GetSession(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRow(ctx, getSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.UserAgent,
+		&i.RefreshHash,
+		&i.CreatedAt,
+		&i.RefreshedAt,
+		&i.ExpiresAt,
+		&i.PrevRefreshHash,
+	)
+	return i, err
+}
+
+const getTreasury = `-- name: GetTreasury :one
+select data from treasuries where game_id = $1 and account_id = $2
+`
+
+type GetTreasuryParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) GetTreasury(ctx context.Context, arg GetTreasuryParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getTreasury, arg.GameID, arg.AccountID)
+	var data []byte
+	err := row.Scan(&data)
+	return data, err
+}
+
+const grantRole = `-- name: GrantRole :exec
+insert into role_bindings (role_id, account_id)
+values ($1, $2)
+on conflict do nothing
+`
+
+type GrantRoleParams struct {
+	RoleID    string
+	AccountID uuid.UUID
+}
+
+func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) error {
+	_, err := q.db.Exec(ctx, grantRole, arg.RoleID, arg.AccountID)
+	return err
+}
+
+const listAccountRoles = `-- name: ListAccountRoles :many
+select role_id from role_bindings where account_id = $1
+`
+
+func (q *Queries) ListAccountRoles(ctx context.Context, id uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listAccountRoles, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role_id string
+		if err := rows.Scan(&role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, role_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccounts = `-- name: ListAccounts :many
+select id, active, created_at, email, display_name, picture from accounts a
+where (a.active = $1 or $1 is null)
+and ($2::text is null or a.email ilike '%' || $2::text || '%')
+and ($3::varchar is null or exists (
+    select 1 from role_bindings b where b.account_id = a.id and b.role_id = $3::varchar
+))
+and ($4::timestamptz is null or (a.created_at, a.id) < ($4::timestamptz, $5::uuid))
+order by a.created_at desc, a.id desc
+limit $6
+`
+
+type ListAccountsParams struct {
+	Active          pgtype.Bool
+	Email           pgtype.Text
+	RoleID          pgtype.Text
+	BeforeCreatedAt pgtype.Timestamptz
+	BeforeID        pgtype.UUID
+	RowLimit        int32
+}
+
+func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccounts,
+		arg.Active,
+		arg.Email,
+		arg.RoleID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Active,
+			&i.CreatedAt,
+			&i.Email,
+			&i.DisplayName,
+			&i.Picture,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByEmail = `-- name: ListAccountsByEmail :many
+select id, active, created_at, email, display_name, picture from accounts a
+where (a.active = $1 or $1 is null)
+and ($2::text is null or a.email ilike '%' || $2::text || '%')
+and ($3::varchar is null or exists (
+    select 1 from role_bindings b where b.account_id = a.id and b.role_id = $3::varchar
+))
+and ($4::varchar is null or a.email > $4::varchar)
+order by a.email
+limit $5
+`
+
+type ListAccountsByEmailParams struct {
+	Active     pgtype.Bool
+	Email      pgtype.Text
+	RoleID     pgtype.Text
+	AfterEmail pgtype.Text
+	RowLimit   int32
+}
+
+func (q *Queries) ListAccountsByEmail(ctx context.Context, arg ListAccountsByEmailParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsByEmail,
+		arg.Active,
+		arg.Email,
+		arg.RoleID,
+		arg.AfterEmail,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Active,
+			&i.CreatedAt,
+			&i.Email,
+			&i.DisplayName,
+			&i.Picture,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredGameStates = `-- name: ListExpiredGameStates :many
+select game_id from game_states
+where deadline <= now()
+order by deadline
+limit $1
+`
+
+func (q *Queries) ListExpiredGameStates(ctx context.Context, rowLimit int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listExpiredGameStates, rowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var game_id uuid.UUID
+		if err := rows.Scan(&game_id); err != nil {
+			return nil, err
+		}
+		items = append(items, game_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGamePlayers = `-- name: ListGamePlayers :many
+select p.game_id, p.slot, p.account_id, p.ready, p.joined_at, a.display_name, a.picture
+from game_players p join accounts a on a.id = p.account_id
+where p.game_id = any($1::uuid[])
+order by p.game_id, p.slot
+`
+
+type ListGamePlayersRow struct {
+	GameID      uuid.UUID
+	Slot        int32
+	AccountID   uuid.UUID
+	Ready       bool
+	JoinedAt    pgtype.Timestamptz
+	DisplayName string
+	Picture     string
+}
+
+func (q *Queries) ListGamePlayers(ctx context.Context, gameIds []uuid.UUID) ([]ListGamePlayersRow, error) {
+	rows, err := q.db.Query(ctx, listGamePlayers, gameIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGamePlayersRow
+	for rows.Next() {
+		var i ListGamePlayersRow
+		if err := rows.Scan(
+			&i.GameID,
+			&i.Slot,
+			&i.AccountID,
+			&i.Ready,
+			&i.JoinedAt,
+			&i.DisplayName,
+			&i.Picture,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGames = `-- name: ListGames :many
+select id, host_id, map_id, name, state, max_players, settings, content_checksum, created_at, started_at from games g
+where (g.state = $1 or $1 is null)
+and ($2::uuid is null or exists (
+    select 1 from game_players p where p.game_id = g.id and p.account_id = $2::uuid
+))
+and ($3::timestamptz is null or (g.created_at, g.id) < ($3::timestamptz, $4::uuid))
+order by g.created_at desc, g.id desc
+limit $5
+`
+
+type ListGamesParams struct {
+	State           pgtype.Text
+	AccountID       pgtype.UUID
+	BeforeCreatedAt pgtype.Timestamptz
+	BeforeID        pgtype.UUID
+	RowLimit        int32
+}
+
+func (q *Queries) ListGames(ctx context.Context, arg ListGamesParams) ([]Game, error) {
+	rows, err := q.db.Query(ctx, listGames,
+		arg.State,
+		arg.AccountID,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.HostID,
+			&i.MapID,
+			&i.Name,
+			&i.State,
+			&i.MaxPlayers,
+			&i.Settings,
+			&i.ContentChecksum,
+			&i.CreatedAt,
+			&i.StartedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHeroes = `-- name: ListHeroes :many
+select id, game_id, owner_id, data, created_at from heroes where game_id = $1 order by created_at, id
+`
+
+func (q *Queries) ListHeroes(ctx context.Context, gameID uuid.UUID) ([]Hero, error) {
+	rows, err := q.db.Query(ctx, listHeroes, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Hero
+	for rows.Next() {
+		var i Hero
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.OwnerID,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLinkedIdentities = `-- name: ListLinkedIdentities :many
+select provider, subject, account_id, email, password_hash, created_at from linked_identities where account_id = $1 order by created_at, provider
+`
+
+func (q *Queries) ListLinkedIdentities(ctx context.Context, accountID uuid.UUID) ([]LinkedIdentity, error) {
+	rows, err := q.db.Query(ctx, listLinkedIdentities, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LinkedIdentity
+	for rows.Next() {
+		var i LinkedIdentity
+		if err := rows.Scan(
+			&i.Provider,
+			&i.Subject,
+			&i.AccountID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMapFeatures = `-- name: ListMapFeatures :many
+select id, map_id, kind, data, created_at from map_features where map_id = $1 and kind = $2 order by created_at, id
+`
+
+type ListMapFeaturesParams struct {
+	MapID uuid.UUID
+	Kind  string
+}
+
+func (q *Queries) ListMapFeatures(ctx context.Context, arg ListMapFeaturesParams) ([]MapFeature, error) {
+	rows, err := q.db.Query(ctx, listMapFeatures, arg.MapID, arg.Kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MapFeature
+	for rows.Next() {
+		var i MapFeature
+		if err := rows.Scan(
+			&i.ID,
+			&i.MapID,
+			&i.Kind,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMapObjects = `-- name: ListMapObjects :many
+select id, game_id, data, created_at from map_objects where game_id = $1 order by created_at, id
+`
+
+func (q *Queries) ListMapObjects(ctx context.Context, gameID uuid.UUID) ([]MapObject, error) {
+	rows, err := q.db.Query(ctx, listMapObjects, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MapObject
+	for rows.Next() {
+		var i MapObject
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMapSegments = `-- name: ListMapSegments :many
+select map_id, depth, segment_row, segment_column, data from map_segments
+where map_id = $1
+order by depth, segment_row, segment_column
+`
+
+func (q *Queries) ListMapSegments(ctx context.Context, mapID uuid.UUID) ([]MapSegment, error) {
+	rows, err := q.db.Query(ctx, listMapSegments, mapID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MapSegment
+	for rows.Next() {
+		var i MapSegment
+		if err := rows.Scan(
+			&i.MapID,
+			&i.Depth,
+			&i.SegmentRow,
+			&i.SegmentColumn,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMaps = `-- name: ListMaps :many
+select id, owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, seed, created_at, updated_at, depths from maps where owner_id = $1 order by created_at desc, id
+`
+
+func (q *Queries) ListMaps(ctx context.Context, ownerID uuid.UUID) ([]Map, error) {
+	rows, err := q.db.Query(ctx, listMaps, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Map
+	for rows.Next() {
+		var i Map
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.TotalRows,
+			&i.TotalColumns,
+			&i.RowsPerSegment,
+			&i.ColumnsPerSegment,
+			&i.Seed,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Depths,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceSites = `-- name: ListResourceSites :many
+select id, game_id, data, created_at from resource_sites where game_id = $1 order by created_at, id
+`
+
+func (q *Queries) ListResourceSites(ctx context.Context, gameID uuid.UUID) ([]ResourceSite, error) {
+	rows, err := q.db.Query(ctx, listResourceSites, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceSite
+	for rows.Next() {
+		var i ResourceSite
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceTransactions = `-- name: ListResourceTransactions :many
+select id, game_id, account_id, day, resource_id, delta, balance, reason, reference, created_at from resource_transactions
+where game_id = $1 and account_id = $2 and id < $3
+order by id desc
+limit $4
+`
+
+type ListResourceTransactionsParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+	BeforeID  int64
+	RowLimit  int32
+}
+
+func (q *Queries) ListResourceTransactions(ctx context.Context, arg ListResourceTransactionsParams) ([]ResourceTransaction, error) {
+	rows, err := q.db.Query(ctx, listResourceTransactions,
+		arg.GameID,
+		arg.AccountID,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceTransaction
+	for rows.Next() {
+		var i ResourceTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.AccountID,
+			&i.Day,
+			&i.ResourceID,
+			&i.Delta,
+			&i.Balance,
+			&i.Reason,
+			&i.Reference,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoles = `-- name: ListRoles :many
+select id from roles order by id
+`
+
+func (q *Queries) ListRoles(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessions = `-- name: ListSessions :many
+select id, account_id, provider, user_agent, refresh_hash, created_at, refreshed_at, expires_at, prev_refresh_hash from sessions where account_id = $1 and expires_at > now()
+order by refreshed_at desc
+`
+
+func (q *Queries) ListSessions(ctx context.Context, accountID uuid.UUID) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listSessions, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Provider,
+			&i.UserAgent,
+			&i.RefreshHash,
+			&i.CreatedAt,
+			&i.RefreshedAt,
+			&i.ExpiresAt,
+			&i.PrevRefreshHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTowns = `-- name: ListTowns :many
+select id, game_id, data, created_at from towns where game_id = $1 order by created_at, id
+`
+
+func (q *Queries) ListTowns(ctx context.Context, gameID uuid.UUID) ([]Town, error) {
+	rows, err := q.db.Query(ctx, listTowns, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Town
+	for rows.Next() {
+		var i Town
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrades = `-- name: ListTrades :many
+select id, game_id, data, created_at from trades where game_id = $1 order by created_at, id
+`
+
+func (q *Queries) ListTrades(ctx context.Context, gameID uuid.UUID) ([]Trade, error) {
+	rows, err := q.db.Query(ctx, listTrades, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trade
+	for rows.Next() {
+		var i Trade
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Data,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const postponeGameState = `-- name: PostponeGameState :exec
+update game_states set deadline = $1 where game_id = $2
+`
+
+type PostponeGameStateParams struct {
+	Deadline pgtype.Timestamptz
+	GameID   uuid.UUID
+}
+
+func (q *Queries) PostponeGameState(ctx context.Context, arg PostponeGameStateParams) error {
+	_, err := q.db.Exec(ctx, postponeGameState, arg.Deadline, arg.GameID)
+	return err
+}
+
+const refreshSession = `-- name: RefreshSession :one
+update sessions set prev_refresh_hash = refresh_hash, refresh_hash = $1, refreshed_at = now(), expires_at = $2
+where id = $3 and refresh_hash = $4 and expires_at > now()
+returning id, account_id, provider, user_agent, refresh_hash, created_at, refreshed_at, expires_at, prev_refresh_hash
+`
+
+type RefreshSessionParams struct {
+	NewRefreshHash string
+	ExpiresAt      pgtype.Timestamptz
+	ID             uuid.UUID
+	RefreshHash    string
+}
+
+func (q *Queries) RefreshSession(ctx context.Context, arg RefreshSessionParams) (Session, error) {
+	row := q.db.QueryRow(ctx, refreshSession,
+		arg.NewRefreshHash,
+		arg.ExpiresAt,
+		arg.ID,
+		arg.RefreshHash,
+	)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Provider,
+		&i.UserAgent,
+		&i.RefreshHash,
+		&i.CreatedAt,
+		&i.RefreshedAt,
+		&i.ExpiresAt,
+		&i.PrevRefreshHash,
+	)
+	return i, err
+}
+
+const removeGamePlayer = `-- name: RemoveGamePlayer :execrows
+delete from game_players where game_id = $1 and account_id = $2
+`
+
+type RemoveGamePlayerParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) RemoveGamePlayer(ctx context.Context, arg RemoveGamePlayerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeGamePlayer, arg.GameID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeRole = `-- name: RevokeRole :execrows
+delete from role_bindings
+where role_id = $1 and account_id = $2
+`
+
+type RevokeRoleParams struct {
+	RoleID    string
+	AccountID uuid.UUID
+}
+
+func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeRole, arg.RoleID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setGamePlayerReady = `-- name: SetGamePlayerReady :execrows
+update game_players set ready = $1 where game_id = $2 and account_id = $3
+`
+
+type SetGamePlayerReadyParams struct {
+	Ready     bool
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+}
+
+func (q *Queries) SetGamePlayerReady(ctx context.Context, arg SetGamePlayerReadyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setGamePlayerReady, arg.Ready, arg.GameID, arg.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const startGame = `-- name: StartGame :one
+update games set state = $1, started_at = now() where id = $2
+returning id, host_id, map_id, name, state, max_players, settings, content_checksum, created_at, started_at
+`
+
+type StartGameParams struct {
+	State string
+	ID    uuid.UUID
+}
+
+func (q *Queries) StartGame(ctx context.Context, arg StartGameParams) (Game, error) {
+	row := q.db.QueryRow(ctx, startGame, arg.State, arg.ID)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.HostID,
+		&i.MapID,
+		&i.Name,
+		&i.State,
+		&i.MaxPlayers,
+		&i.Settings,
+		&i.ContentChecksum,
+		&i.CreatedAt,
+		&i.StartedAt,
+	)
+	return i, err
+}
+
+const touchMap = `-- name: TouchMap :one
+update maps set updated_at = now() where id = $1
+returning id, owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, seed, created_at, updated_at, depths
+`
+
+func (q *Queries) TouchMap(ctx context.Context, id uuid.UUID) (Map, error) {
+	row := q.db.QueryRow(ctx, touchMap, id)
+	var i Map
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.TotalRows,
+		&i.TotalColumns,
+		&i.RowsPerSegment,
+		&i.ColumnsPerSegment,
+		&i.Seed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Depths,
+	)
+	return i, err
+}
+
+const updateAccountActivation = `-- name: UpdateAccountActivation :many
+update accounts set active = $1 where id = any($2::uuid[]) and active <> $1
+returning id
+`
+
+type UpdateAccountActivationParams struct {
+	Active bool
+	Ids    []uuid.UUID
+}
+
+func (q *Queries) UpdateAccountActivation(ctx context.Context, arg UpdateAccountActivationParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, updateAccountActivation, arg.Active, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateGameState = `-- name: UpdateGameState :exec
+update game_states set day = $1, turn = $2, updated_at = now(), deadline = $3
+where game_id = $4
+`
+
+type UpdateGameStateParams struct {
+	Day      int32
+	Turn     []byte
+	Deadline pgtype.Timestamptz
+	GameID   uuid.UUID
+}
+
+func (q *Queries) UpdateGameState(ctx context.Context, arg UpdateGameStateParams) error {
+	_, err := q.db.Exec(ctx, updateGameState,
+		arg.Day,
+		arg.Turn,
+		arg.Deadline,
+		arg.GameID,
+	)
+	return err
+}
+
+const updateHero = `-- name: UpdateHero :exec
+update heroes set data = $1 where id = $2
+`
+
+type UpdateHeroParams struct {
+	Data []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateHero(ctx context.Context, arg UpdateHeroParams) error {
+	_, err := q.db.Exec(ctx, updateHero, arg.Data, arg.ID)
+	return err
+}
+
+const updateLinkedIdentityPassword = `-- name: UpdateLinkedIdentityPassword :one
+update linked_identities set password_hash = $1
+where provider = $2 and subject = $3 and account_id = $4
+returning provider, subject, account_id, email, password_hash, created_at
+`
+
+type UpdateLinkedIdentityPasswordParams struct {
+	PasswordHash pgtype.Text
+	Provider     string
+	Subject      string
+	AccountID    uuid.UUID
+}
+
+func (q *Queries) UpdateLinkedIdentityPassword(ctx context.Context, arg UpdateLinkedIdentityPasswordParams) (LinkedIdentity, error) {
+	row := q.db.QueryRow(ctx, updateLinkedIdentityPassword,
+		arg.PasswordHash,
+		arg.Provider,
+		arg.Subject,
+		arg.AccountID,
+	)
+	var i LinkedIdentity
+	err := row.Scan(
+		&i.Provider,
+		&i.Subject,
+		&i.AccountID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateMapObject = `-- name: UpdateMapObject :exec
+update map_objects set data = $1 where id = $2
+`
+
+type UpdateMapObjectParams struct {
+	Data []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateMapObject(ctx context.Context, arg UpdateMapObjectParams) error {
+	_, err := q.db.Exec(ctx, updateMapObject, arg.Data, arg.ID)
+	return err
+}
+
+const updateResourceSite = `-- name: UpdateResourceSite :exec
+update resource_sites set data = $1 where id = $2
+`
+
+type UpdateResourceSiteParams struct {
+	Data []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateResourceSite(ctx context.Context, arg UpdateResourceSiteParams) error {
+	_, err := q.db.Exec(ctx, updateResourceSite, arg.Data, arg.ID)
+	return err
+}
+
+const updateTown = `-- name: UpdateTown :exec
+update towns set data = $1 where id = $2
+`
+
+type UpdateTownParams struct {
+	Data []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateTown(ctx context.Context, arg UpdateTownParams) error {
+	_, err := q.db.Exec(ctx, updateTown, arg.Data, arg.ID)
+	return err
+}
+
+const updateTrade = `-- name: UpdateTrade :exec
+update trades set data = $1 where id = $2
+`
+
+type UpdateTradeParams struct {
+	Data []byte
+	ID   uuid.UUID
+}
+
+func (q *Queries) UpdateTrade(ctx context.Context, arg UpdateTradeParams) error {
+	_, err := q.db.Exec(ctx, updateTrade, arg.Data, arg.ID)
+	return err
+}
+
+const upsertGameVisibility = `-- name: UpsertGameVisibility :exec
+insert into game_visibility (game_id, account_id, explored, updated_at)
+values ($1, $2, $3, now())
+on conflict (game_id, account_id) do update set explored = excluded.explored, updated_at = excluded.updated_at
+`
+
+type UpsertGameVisibilityParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+	Explored  []byte
+}
+
+func (q *Queries) UpsertGameVisibility(ctx context.Context, arg UpsertGameVisibilityParams) error {
+	_, err := q.db.Exec(ctx, upsertGameVisibility, arg.GameID, arg.AccountID, arg.Explored)
+	return err
+}
+
+const upsertMapSegment = `-- name: UpsertMapSegment :exec
+insert into map_segments (map_id, depth, segment_row, segment_column, data)
+values ($1, $2, $3, $4, $5)
+on conflict (map_id, depth, segment_row, segment_column) do update set data = excluded.data
+`
+
+type UpsertMapSegmentParams struct {
+	MapID         uuid.UUID
+	Depth         int32
+	SegmentRow    int32
+	SegmentColumn int32
+	Data          []byte
+}
+
+func (q *Queries) UpsertMapSegment(ctx context.Context, arg UpsertMapSegmentParams) error {
+	_, err := q.db.Exec(ctx, upsertMapSegment,
+		arg.MapID,
+		arg.Depth,
+		arg.SegmentRow,
+		arg.SegmentColumn,
+		arg.Data,
+	)
+	return err
+}
+
+const upsertTreasury = `-- name: UpsertTreasury :exec
+insert into treasuries (game_id, account_id, data, updated_at)
+values ($1, $2, $3, now())
+on conflict (game_id, account_id) do update set data = excluded.data, updated_at = excluded.updated_at
+`
+
+type UpsertTreasuryParams struct {
+	GameID    uuid.UUID
+	AccountID uuid.UUID
+	Data      []byte
 }
 
-func (q *Queries) UpdateAccountActivation(ctx context.Context, arg UpdateAccountActivationParams) error {
-	_, err := q.db.Exec(ctx, updateAccountActivation, arg.Active, arg.Ids)
+func (q *Queries) UpsertTreasury(ctx context.Context, arg UpsertTreasuryParams) error {
+	_, err := q.db.Exec(ctx, upsertTreasury, arg.GameID, arg.AccountID, arg.Data)
 	return err
 }
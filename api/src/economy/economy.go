@@ -0,0 +1,230 @@
+// Package economy keeps resources of players: treasuries, daily income of mines & towns,
+// trading & a ledger of every change, so disputes & bugs can be audited.
+//
+// Treasuries never go negative: a set of changes is either applied as a whole or not at all,
+// see Apply. Every applied change is recorded as a transaction, see Transact.
+package economy
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/towns"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+)
+
+// Reasons of transactions.
+const (
+	ReasonStart    = "start"
+	ReasonIncome   = "income"
+	ReasonPickup   = "pickup"
+	ReasonBuild    = "build"
+	ReasonRecruit  = "recruit"
+	ReasonExchange = "exchange"
+	ReasonTrade    = "trade"
+)
+
+const (
+	// BaseMarketFee is the share of value lost exchanging resources with a single marketplace, in permille.
+	BaseMarketFee = 300
+	// MinMarketFee is the lowest fee no matter how many marketplaces a player owns, in permille.
+	MinMarketFee = 50
+)
+
+// MaxAmount is the largest amount of a resource a treasury may hold or a single change may move,
+// balances are stored as signed 64-bit integers.
+const MaxAmount = math.MaxInt64
+
+var (
+	ErrInsufficient = errors.New("not enough resources")
+	ErrOverflow     = errors.New("amount is too large")
+)
+
+// Change of a single resource in a treasury.
+type Change struct {
+	ResourceID string
+	Delta      int64
+	Balance    uint64 // after the change
+}
+
+// Apply changes the treasury all at once, nothing changes if any resource would go negative
+// or above MaxAmount. Changes are returned in order of resource ids, zero deltas are skipped.
+func Apply(t *economyv1.Treasury, deltas map[string]int64) ([]Change, error) {
+	var changes []Change
+	for _, id := range slices.Sorted(maps.Keys(deltas)) {
+		delta := deltas[id]
+		if delta == 0 {
+			continue
+		}
+		balance := t.GetAmounts()[id]
+		if delta < 0 {
+			// negating math.MinInt64 wraps to itself, which still converts to its magnitude
+			spent := uint64(-delta)
+			if spent > balance {
+				return nil, fmt.Errorf("%w: %d of %q, %d required", ErrInsufficient, balance, id, spent)
+			}
+			balance -= spent
+		} else {
+			if balance > MaxAmount-uint64(delta) {
+				return nil, fmt.Errorf("%w: %d of %q can't grow by %d", ErrOverflow, balance, id, delta)
+			}
+			balance += uint64(delta)
+		}
+		changes = append(changes, Change{ResourceID: id, Delta: delta, Balance: balance})
+	}
+
+	if t.Amounts == nil {
+		t.Amounts = map[string]uint64{}
+	}
+	for _, c := range changes {
+		t.Amounts[c.ResourceID] = c.Balance
+	}
+	return changes, nil
+}
+
+// Cost returns deltas paying the cost count times, e.g. for several recruited creatures.
+// Costs beyond MaxAmount saturate, no treasury can afford them.
+func Cost(cost map[string]uint32, count uint32) map[string]int64 {
+	deltas := make(map[string]int64, len(cost))
+	for id, amount := range cost {
+		total := uint64(amount) * uint64(count) // can't overflow, both fit in 32 bits
+		if total > MaxAmount {
+			deltas[id] = math.MinInt64
+		} else {
+			deltas[id] = -int64(total)
+		}
+	}
+	return deltas
+}
+
+// Gain returns deltas adding given amounts, each at most MaxAmount.
+func Gain(amounts map[string]uint64) (map[string]int64, error) {
+	return signed(amounts, 1)
+}
+
+// Spend returns deltas taking given amounts, each at most MaxAmount.
+func Spend(amounts map[string]uint64) (map[string]int64, error) {
+	return signed(amounts, -1)
+}
+
+func signed(amounts map[string]uint64, sign int64) (map[string]int64, error) {
+	deltas := make(map[string]int64, len(amounts))
+	for _, id := range slices.Sorted(maps.Keys(amounts)) {
+		amount := amounts[id]
+		if amount > MaxAmount {
+			return nil, fmt.Errorf("%w: %d of %q", ErrOverflow, amount, id)
+		}
+		deltas[id] = sign * int64(amount)
+	}
+	return deltas, nil
+}
+
+// Income is daily income coming from a single source.
+type Income struct {
+	Reference string // id of the mine or town
+	Deltas    map[string]int64
+}
+
+// Incomes returns daily income of the player from owned mines & buildings of owned towns.
+func Incomes(ownerID string, sites []*economyv1.Site, list []*townsv1.Town, kinds towns.Kinds) []Income {
+	var incomes []Income
+	for _, s := range sites {
+		if s.Kind == economyv1.Site_KIND_MINE && s.OwnerId == ownerID && s.Amount > 0 {
+			incomes = append(incomes, Income{Reference: s.Id, Deltas: map[string]int64{s.ResourceId: int64(s.Amount)}})
+		}
+	}
+	for _, t := range list {
+		if t.OwnerId != ownerID {
+			continue
+		}
+		deltas := map[string]int64{}
+		for _, id := range t.Buildings {
+			for resource, amount := range towns.Building(kinds.Town(t.KindId), id).GetIncome() {
+				deltas[resource] += int64(amount)
+			}
+		}
+		if len(deltas) > 0 {
+			incomes = append(incomes, Income{Reference: t.Id, Deltas: deltas})
+		}
+	}
+	return incomes
+}
+
+// Total sums incomes by resource.
+func Total(incomes []Income) map[string]uint64 {
+	total := map[string]uint64{}
+	for _, income := range incomes {
+		for id, delta := range income.Deltas {
+			total[id] += uint64(delta)
+		}
+	}
+	return total
+}
+
+// Markets counts marketplaces in towns owned by the player.
+func Markets(ownerID string, list []*townsv1.Town, kinds towns.Kinds) int {
+	var n int
+	for _, t := range list {
+		if t.OwnerId != ownerID {
+			continue
+		}
+		for _, id := range t.Buildings {
+			if towns.Building(kinds.Town(t.KindId), id).GetMarketplace() {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Fee returns the marketplace fee in permille, every marketplace lowers it.
+func Fee(markets int) uint64 {
+	return max(BaseMarketFee/uint64(max(markets, 1)), MinMarketFee)
+}
+
+// Exchange returns amount of a resource received for amount of another one at the marketplace.
+// Both amounts are at most MaxAmount.
+func Exchange(give, take *economyv1.Resource, amount uint64, markets int) (uint64, error) {
+	switch {
+	case markets <= 0:
+		return 0, errors.New("no marketplace")
+	case give.GetId() == take.GetId():
+		return 0, errors.New("resources must differ")
+	case give.GetValue() == 0 || take.GetValue() == 0:
+		return 0, errors.New("resource has no value")
+	case amount > MaxAmount:
+		return 0, fmt.Errorf("%w: %d of %q", ErrOverflow, amount, give.GetId())
+	}
+
+	// amount * value * (1000 - fee) takes up to 105 bits: amount is below 2^63, value below 2^32
+	// and the fee share below 2^10, so it's computed in 128 bits & divided at once
+	hi, lo := bits.Mul64(amount, uint64(give.GetValue()))
+	hi2, lo2 := bits.Mul64(lo, 1000-Fee(markets))
+	hi = hi*(1000-Fee(markets)) + hi2
+	divisor := 1000 * uint64(take.GetValue())
+	if hi >= divisor {
+		return 0, fmt.Errorf("%w: %d of %q are worth too much %q", ErrOverflow, amount, give.GetId(), take.GetId())
+	}
+	received, _ := bits.Div64(hi, lo2, divisor)
+	if received > MaxAmount {
+		return 0, fmt.Errorf("%w: %d of %q are worth too much %q", ErrOverflow, amount, give.GetId(), take.GetId())
+	}
+	return received, nil
+}
+
+// Visit applies a hero stepping on a site: mines change hands, pickups yield once.
+// Returns deltas gained by the owner of the hero & whether the site is used up.
+func Visit(s *economyv1.Site, ownerID string) (map[string]int64, bool) {
+	switch s.Kind {
+	case economyv1.Site_KIND_MINE:
+		s.OwnerId = ownerID
+	case economyv1.Site_KIND_PICKUP:
+		return map[string]int64{s.ResourceId: int64(s.Amount)}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,146 @@
+package economy
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+)
+
+func TestApply(t *testing.T) {
+	treasury := &economyv1.Treasury{Amounts: map[string]uint64{"gold": 1000, "wood": 5}}
+
+	changes, err := Apply(treasury, map[string]int64{"gold": -300, "wood": 0, "ore": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Change{{ResourceID: "gold", Delta: -300, Balance: 700}, {ResourceID: "ore", Delta: 2, Balance: 2}}
+	if len(changes) != len(expected) || changes[0] != expected[0] || changes[1] != expected[1] {
+		t.Fatalf("expected %v, got %v", expected, changes)
+	}
+
+	if _, err := Apply(treasury, map[string]int64{"gold": 100, "wood": -6}); !errors.Is(err, ErrInsufficient) {
+		t.Fatalf("expected missing wood to be reported, got %v", err)
+	}
+	if treasury.Amounts["gold"] != 700 || treasury.Amounts["wood"] != 5 {
+		t.Fatalf("expected rejected changes to leave treasury intact, got %v", treasury.Amounts)
+	}
+
+	full := &economyv1.Treasury{Amounts: map[string]uint64{"gold": MaxAmount - 1}}
+	if changes, err := Apply(full, map[string]int64{"gold": 1}); err != nil || changes[0].Balance != MaxAmount {
+		t.Fatalf("expected treasury to fill up to the maximum, got %v & %v", changes, err)
+	}
+	if _, err := Apply(full, map[string]int64{"gold": 2}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected balance beyond the maximum to be rejected, got %v", err)
+	}
+	if _, err := Apply(full, map[string]int64{"gold": math.MinInt64}); !errors.Is(err, ErrInsufficient) {
+		t.Fatalf("expected the smallest delta to be reported as missing gold, got %v", err)
+	}
+}
+
+func TestDeltas(t *testing.T) {
+	if deltas := Cost(map[string]uint32{"gold": 1 << 31}, 1<<31); deltas["gold"] != -(1 << 62) {
+		t.Fatalf("expected cost to fit, got %v", deltas)
+	}
+	if deltas := Cost(map[string]uint32{"gold": math.MaxUint32}, math.MaxUint32); deltas["gold"] != math.MinInt64 {
+		t.Fatalf("expected cost beyond the maximum to saturate, got %v", deltas)
+	}
+
+	if deltas, err := Spend(map[string]uint64{"gold": MaxAmount}); err != nil || deltas["gold"] != -MaxAmount {
+		t.Fatalf("expected the maximum to be spent, got %v & %v", deltas, err)
+	}
+	if deltas, err := Gain(map[string]uint64{"gold": MaxAmount}); err != nil || deltas["gold"] != MaxAmount {
+		t.Fatalf("expected the maximum to be gained, got %v & %v", deltas, err)
+	}
+	if _, err := Spend(map[string]uint64{"gold": MaxAmount + 1}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected spending beyond the maximum to be rejected, got %v", err)
+	}
+	if _, err := Gain(map[string]uint64{"gold": math.MaxUint64}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected gaining beyond the maximum to be rejected, got %v", err)
+	}
+}
+
+type kinds map[string]*townsv1.Town_Kind
+
+func (k kinds) Town(id string) *townsv1.Town_Kind {
+	return k[id]
+}
+
+func TestIncomes(t *testing.T) {
+	castle := &townsv1.Town_Kind{Id: "castle", Buildings: []*townsv1.Town_Building{
+		{Id: "hall", Income: map[string]uint32{"gold": 500}},
+		{Id: "market", Marketplace: true},
+	}}
+	list := []*townsv1.Town{
+		{Id: "t1", OwnerId: "a", KindId: "castle", Buildings: []string{"hall", "market"}},
+		{Id: "t2", OwnerId: "b", KindId: "castle", Buildings: []string{"hall"}},
+	}
+	sites := []*economyv1.Site{
+		{Id: "m1", Kind: economyv1.Site_KIND_MINE, ResourceId: "ore", Amount: 2, OwnerId: "a"},
+		{Id: "m2", Kind: economyv1.Site_KIND_MINE, ResourceId: "gold", Amount: 1000},
+		{Id: "p1", Kind: economyv1.Site_KIND_PICKUP, ResourceId: "gold", Amount: 500, OwnerId: "a"},
+	}
+
+	total := Total(Incomes("a", sites, list, kinds{"castle": castle}))
+	if len(total) != 2 || total["gold"] != 500 || total["ore"] != 2 {
+		t.Fatalf("unexpected income: %v", total)
+	}
+	if n := Markets("a", list, kinds{"castle": castle}); n != 1 {
+		t.Fatalf("expected a single marketplace, got %d", n)
+	}
+}
+
+func TestExchange(t *testing.T) {
+	gold := &economyv1.Resource{Id: "gold", Value: 1}
+	wood := &economyv1.Resource{Id: "wood", Value: 250}
+
+	for _, tc := range []struct {
+		markets int
+		want    uint64
+	}{
+		{markets: 1, want: 2800},
+		{markets: 2, want: 3400},
+		{markets: 10, want: 3800},
+	} {
+		got, err := Exchange(wood, gold, 16, tc.markets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("%d markets: expected %d, got %d", tc.markets, tc.want, got)
+		}
+	}
+	if got, _ := Exchange(gold, wood, 300, 1); got != 0 {
+		t.Fatalf("expected amount worth less than a unit to yield nothing, got %d", got)
+	}
+	if _, err := Exchange(gold, wood, 1000, 0); err == nil {
+		t.Fatal("expected exchange without marketplace to fail")
+	}
+
+	// intermediate product exceeds 64 bits, but the result fits
+	if got, err := Exchange(wood, gold, 1<<55, 1); err != nil || got != 175<<55 {
+		t.Fatalf("expected %d, got %d & %v", uint64(175<<55), got, err)
+	}
+	if _, err := Exchange(wood, gold, 1<<60, 1); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected result beyond the maximum to be rejected, got %v", err)
+	}
+	if _, err := Exchange(wood, gold, MaxAmount+1, 1); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("expected amount beyond the maximum to be rejected, got %v", err)
+	}
+	if got, err := Exchange(gold, wood, MaxAmount, 10); err != nil || got != 35048813740048148 {
+		t.Fatalf("expected the maximum to be exchanged, got %d & %v", got, err)
+	}
+}
+
+func TestVisit(t *testing.T) {
+	mine := &economyv1.Site{Kind: economyv1.Site_KIND_MINE, ResourceId: "ore", Amount: 2, OwnerId: "b"}
+	if deltas, used := Visit(mine, "a"); deltas != nil || used || mine.OwnerId != "a" {
+		t.Fatalf("expected mine to change hands, got %v", mine)
+	}
+	pickup := &economyv1.Site{Kind: economyv1.Site_KIND_PICKUP, ResourceId: "gold", Amount: 500}
+	if deltas, used := Visit(pickup, "a"); deltas["gold"] != 500 || !used {
+		t.Fatalf("expected pickup to yield once, got %v", deltas)
+	}
+}
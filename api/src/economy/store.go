@@ -0,0 +1,308 @@
+package economy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/towns"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Load returns treasury of a player, empty if nothing was ever added to it.
+func Load(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID) (*economyv1.Treasury, error) {
+	raw, err := q.GetTreasury(ctx, db.GetTreasuryParams{GameID: gameID, AccountID: accountID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &economyv1.Treasury{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting treasury: %w", err)
+	}
+	t := &economyv1.Treasury{}
+	if err := proto.Unmarshal(raw, t); err != nil {
+		return nil, fmt.Errorf("decoding treasury: %w", err)
+	}
+	return t, nil
+}
+
+// Transact applies changes to a treasury & records them in the ledger, see Apply.
+// Callers are expected to hold the lock of the game state, see turns.Load.
+func Transact(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, day uint32, reason, reference string, deltas map[string]int64) (*economyv1.Treasury, error) {
+	t, err := Load(ctx, q, gameID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := Apply(t, deltas)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return t, nil
+	}
+
+	for _, c := range changes {
+		err := q.CreateResourceTransaction(ctx, db.CreateResourceTransactionParams{
+			GameID:     gameID,
+			AccountID:  accountID,
+			Day:        int32(day),
+			ResourceID: c.ResourceID,
+			Delta:      c.Delta,
+			Balance:    int64(c.Balance),
+			Reason:     reason,
+			Reference:  reference,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("recording transaction: %w", err)
+		}
+	}
+	raw, err := proto.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("encoding treasury: %w", err)
+	}
+	if err := q.UpsertTreasury(ctx, db.UpsertTreasuryParams{GameID: gameID, AccountID: accountID, Data: raw}); err != nil {
+		return nil, fmt.Errorf("saving treasury: %w", err)
+	}
+	return t, nil
+}
+
+// Start grants starting amounts of resources to players of a new game.
+func Start(ctx context.Context, q *db.Queries, gameID uuid.UUID, accountIDs []uuid.UUID, resources []*economyv1.Resource) error {
+	deltas := map[string]int64{}
+	for _, r := range resources {
+		deltas[r.Id] = int64(r.StartingAmount)
+	}
+	for _, id := range accountIDs {
+		if _, err := Transact(ctx, q, gameID, id, 1, ReasonStart, gameID.String(), deltas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewDay adds daily income of mines & towns to treasuries of their owners.
+func NewDay(ctx context.Context, q *db.Queries, gameID uuid.UUID, day uint32, kinds towns.Kinds) error {
+	sites, err := Sites(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+	list, err := towns.List(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+
+	owners := map[string]bool{}
+	for _, s := range sites {
+		owners[s.OwnerId] = true
+	}
+	for _, t := range list {
+		owners[t.OwnerId] = true
+	}
+	delete(owners, "")
+
+	for _, owner := range slices.Sorted(maps.Keys(owners)) {
+		accountID, err := uuid.Parse(owner)
+		if err != nil {
+			return fmt.Errorf("invalid owner %q: %w", owner, err)
+		}
+		for _, income := range Incomes(owner, sites, list, kinds) {
+			if _, err := Transact(ctx, q, gameID, accountID, day, ReasonIncome, income.Reference, income.Deltas); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Transactions lists transactions of a player older than given id, newest first.
+func Transactions(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, beforeID int64, limit int32) ([]*economyv1.Transaction, error) {
+	if beforeID <= 0 {
+		beforeID = math.MaxInt64
+	}
+	rows, err := q.ListResourceTransactions(ctx, db.ListResourceTransactionsParams{
+		GameID:    gameID,
+		AccountID: accountID,
+		BeforeID:  beforeID,
+		RowLimit:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing transactions: %w", err)
+	}
+	list := make([]*economyv1.Transaction, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, &economyv1.Transaction{
+			Id:         row.ID,
+			AccountId:  row.AccountID.String(),
+			Day:        uint32(row.Day),
+			ResourceId: row.ResourceID,
+			Delta:      row.Delta,
+			Balance:    uint64(row.Balance),
+			Reason:     row.Reason,
+			Reference:  row.Reference,
+			Time:       timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+	return list, nil
+}
+
+// Sites returns mines & pickups of a game in order of placement.
+func Sites(ctx context.Context, q *db.Queries, gameID uuid.UUID) ([]*economyv1.Site, error) {
+	rows, err := q.ListResourceSites(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("listing sites: %w", err)
+	}
+	list := make([]*economyv1.Site, 0, len(rows))
+	for _, row := range rows {
+		s := &economyv1.Site{}
+		if err := proto.Unmarshal(row.Data, s); err != nil {
+			return nil, fmt.Errorf("decoding site %q: %w", row.ID, err)
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+func CreateSite(ctx context.Context, q *db.Queries, s *economyv1.Site) error {
+	raw, err := proto.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("encoding site: %w", err)
+	}
+	err = q.CreateResourceSite(ctx, db.CreateResourceSiteParams{
+		ID:     uuid.MustParse(s.Id),
+		GameID: uuid.MustParse(s.GameId),
+		Data:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("creating site: %w", err)
+	}
+	return nil
+}
+
+// VisitSites applies a hero of the player traveling across sites, see Visit.
+// Returns visited sites, pickups among them are removed from the map.
+func VisitSites(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, day uint32, traveled []hex.Axial) ([]*economyv1.Site, error) {
+	sites, err := Sites(ctx, q, gameID)
+	if err != nil {
+		return nil, err
+	}
+	var visited []*economyv1.Site
+	for _, s := range sites {
+		if !slices.Contains(traveled, hex.FromCoordinate(s.Position)) {
+			continue
+		}
+		visited = append(visited, s)
+
+		deltas, used := Visit(s, accountID.String())
+		if _, err := Transact(ctx, q, gameID, accountID, day, ReasonPickup, s.Id, deltas); err != nil {
+			return nil, err
+		}
+		if used {
+			if err := q.DeleteResourceSite(ctx, uuid.MustParse(s.Id)); err != nil {
+				return nil, fmt.Errorf("removing site %q: %w", s.Id, err)
+			}
+			continue
+		}
+		raw, err := proto.Marshal(s)
+		if err != nil {
+			return nil, fmt.Errorf("encoding site: %w", err)
+		}
+		if err := q.UpdateResourceSite(ctx, db.UpdateResourceSiteParams{ID: uuid.MustParse(s.Id), Data: raw}); err != nil {
+			return nil, fmt.Errorf("saving site %q: %w", s.Id, err)
+		}
+	}
+	return visited, nil
+}
+
+// Trades returns trades of a game in order of proposal.
+func Trades(ctx context.Context, q *db.Queries, gameID uuid.UUID) ([]*economyv1.Trade, error) {
+	rows, err := q.ListTrades(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("listing trades: %w", err)
+	}
+	list := make([]*economyv1.Trade, 0, len(rows))
+	for _, row := range rows {
+		t := &economyv1.Trade{}
+		if err := proto.Unmarshal(row.Data, t); err != nil {
+			return nil, fmt.Errorf("decoding trade %q: %w", row.ID, err)
+		}
+		list = append(list, t)
+	}
+	return list, nil
+}
+
+func CreateTrade(ctx context.Context, q *db.Queries, t *economyv1.Trade) error {
+	raw, err := proto.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("encoding trade: %w", err)
+	}
+	err = q.CreateTrade(ctx, db.CreateTradeParams{
+		ID:     uuid.MustParse(t.Id),
+		GameID: uuid.MustParse(t.GameId),
+		Data:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("creating trade: %w", err)
+	}
+	return nil
+}
+
+func SaveTrade(ctx context.Context, q *db.Queries, t *economyv1.Trade) error {
+	raw, err := proto.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("encoding trade: %w", err)
+	}
+	if err := q.UpdateTrade(ctx, db.UpdateTradeParams{ID: uuid.MustParse(t.Id), Data: raw}); err != nil {
+		return fmt.Errorf("saving trade %q: %w", t.Id, err)
+	}
+	return nil
+}
+
+// Settle moves resources of an accepted trade between treasuries of both players.
+func Settle(ctx context.Context, q *db.Queries, t *economyv1.Trade, day uint32) error {
+	gameID, from, to := uuid.MustParse(t.GameId), uuid.MustParse(t.FromId), uuid.MustParse(t.ToId)
+	spendGive, err := Spend(t.Give)
+	if err != nil {
+		return err
+	}
+	spendTake, err := Spend(t.Take)
+	if err != nil {
+		return err
+	}
+	gainGive, gainTake := negate(spendGive), negate(spendTake)
+	for _, side := range []struct {
+		accountID uuid.UUID
+		deltas    map[string]int64
+	}{
+		{accountID: from, deltas: merge(spendGive, gainTake)},
+		{accountID: to, deltas: merge(gainGive, spendTake)},
+	} {
+		if _, err := Transact(ctx, q, gameID, side.accountID, day, ReasonTrade, t.Id, side.deltas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func negate(deltas map[string]int64) map[string]int64 {
+	result := make(map[string]int64, len(deltas))
+	for id, delta := range deltas {
+		result[id] = -delta
+	}
+	return result
+}
+
+// merge adds deltas of opposite signs, so sums can't overflow.
+func merge(a, b map[string]int64) map[string]int64 {
+	result := maps.Clone(a)
+	for id, delta := range b {
+		result[id] += delta
+	}
+	return result
+}
@@ -0,0 +1,158 @@
+package effects
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+type CreatureResult struct {
+	Stats         Stats
+	MovementTypes []creaturesv1.Creature_MovementType
+	Native        bool // native terrain bonus was granted
+	Trace         []Step
+}
+
+type modification interface {
+	GetFilter() *creaturesv1.Creature_Kind_Filter
+	GetModification() *creaturesv1.Creature_AttributeModification
+}
+
+func attributeModification(effect *mapv1.Terrain_Effect) (Attribute, modification) {
+	switch {
+	case effect.GetModifyCreatureAttack() != nil:
+		return Attack, effect.GetModifyCreatureAttack()
+	case effect.GetModifyCreatureDefence() != nil:
+		return Defence, effect.GetModifyCreatureDefence()
+	case effect.GetModifyCreatureSpeed() != nil:
+		return Speed, effect.GetModifyCreatureSpeed()
+	case effect.GetModifyCreatureMorale() != nil:
+		return Morale, effect.GetModifyCreatureMorale()
+	case effect.GetModifyCreatureLuck() != nil:
+		return Luck, effect.GetModifyCreatureLuck()
+	}
+	return "", nil
+}
+
+// Creature evaluates effective stats & movement types of a creature standing on given terrain.
+func (e *Engine) Creature(terrain *mapv1.Terrain, kind *creaturesv1.Creature_Kind, base Stats) *CreatureResult {
+	result := &CreatureResult{
+		Stats:         base,
+		MovementTypes: slices.Clone(kind.GetMovementTypes()),
+	}
+
+	var (
+		id             = terrain.GetId()
+		effects        = terrain.GetEffects()
+		negatePositive = map[Attribute]bool{}
+		negateNegative = map[Attribute]bool{}
+	)
+
+	disabled := slices.ContainsFunc(effects, func(effect *mapv1.Terrain_Effect) bool {
+		return effect.GetDisableNativeTerrainBonuses() != nil
+	})
+	if slices.Contains(kind.GetNativeTerrains(), id) && !disabled {
+		result.Native = true
+		for _, a := range []Attribute{Attack, Defence, Speed, Morale, Luck} {
+			if delta := e.native.Get(a); delta != 0 {
+				result.Trace = append(result.Trace, Step{TerrainID: id, Effect: NativeEffect, Attribute: a, Delta: delta})
+			}
+		}
+	}
+
+	for i, effect := range effects {
+		if effect.GetDisableNativeTerrainBonuses() != nil {
+			if slices.Contains(kind.GetNativeTerrains(), id) {
+				result.Trace = append(result.Trace, Step{
+					TerrainID: id,
+					Effect:    i,
+					Message:   fmt.Sprintf("native terrain bonus disabled by %s", source(id, i)),
+				})
+			}
+			continue
+		}
+
+		if m := effect.GetModifyCreatureMovementType(); m != nil {
+			if !e.matches(m.GetFilter(), kind) {
+				continue
+			}
+			for _, t := range m.GetRemove() {
+				if !slices.Contains(result.MovementTypes, t) {
+					continue
+				}
+				result.MovementTypes = slices.DeleteFunc(result.MovementTypes, func(mt creaturesv1.Creature_MovementType) bool {
+					return mt == t
+				})
+				result.Trace = append(result.Trace, Step{
+					TerrainID: id,
+					Effect:    i,
+					Attribute: Movement,
+					Message:   fmt.Sprintf("%s movement removed by %s", movementName(t), source(id, i)),
+				})
+			}
+			for _, t := range m.GetAdd() {
+				if slices.Contains(result.MovementTypes, t) {
+					continue
+				}
+				result.MovementTypes = append(result.MovementTypes, t)
+				result.Trace = append(result.Trace, Step{
+					TerrainID: id,
+					Effect:    i,
+					Attribute: Movement,
+					Message:   fmt.Sprintf("%s movement added by %s", movementName(t), source(id, i)),
+				})
+			}
+			continue
+		}
+
+		a, m := attributeModification(effect)
+		if m == nil || !e.matches(m.GetFilter(), kind) {
+			continue
+		}
+		mod := m.GetModification()
+		if mod.GetNegatePositiveEffects() {
+			negatePositive[a] = true
+			result.Trace = append(result.Trace, Step{
+				TerrainID: id,
+				Effect:    i,
+				Attribute: a,
+				Message:   fmt.Sprintf("positive %s effects negated by %s", a, source(id, i)),
+			})
+		}
+		if mod.GetNegateNegativeEffects() {
+			negateNegative[a] = true
+			result.Trace = append(result.Trace, Step{
+				TerrainID: id,
+				Effect:    i,
+				Attribute: a,
+				Message:   fmt.Sprintf("negative %s effects negated by %s", a, source(id, i)),
+			})
+		}
+		if mod.GetDelta() != 0 {
+			result.Trace = append(result.Trace, Step{TerrainID: id, Effect: i, Attribute: a, Delta: mod.GetDelta()})
+		}
+	}
+
+	// negation applies to effects listed both before & after the negating one
+	for i := range result.Trace {
+		step := &result.Trace[i]
+		if step.Delta == 0 {
+			continue
+		}
+		if step.Delta > 0 && negatePositive[step.Attribute] || step.Delta < 0 && negateNegative[step.Attribute] {
+			step.Message = fmt.Sprintf("%+d %s from %s (negated)", step.Delta, step.Attribute, source(id, step.Effect))
+			step.Delta, step.Negated = 0, true
+			continue
+		}
+		step.Message = fmt.Sprintf("%+d %s from %s", step.Delta, step.Attribute, source(id, step.Effect))
+		*result.Stats.field(step.Attribute) += step.Delta
+	}
+	return result
+}
+
+func movementName(t creaturesv1.Creature_MovementType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "MOVEMENT_TYPE_"))
+}
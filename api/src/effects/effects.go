@@ -0,0 +1,113 @@
+// Package effects evaluates terrain effects on creatures & spells.
+//
+// Effects of a terrain are applied in the order they are listed. Attribute
+// modifications accumulate, while negate_positive_effects & negate_negative_effects
+// cancel every bonus or penalty of that attribute on the tile, native terrain bonus included.
+// Every change is recorded in a trace, so players can see why a stat differs from its base value.
+package effects
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/openhexes/openhexes/api/src/filter"
+)
+
+type Attribute string
+
+const (
+	Attack   Attribute = "attack"
+	Defence  Attribute = "defence"
+	Speed    Attribute = "speed"
+	Morale   Attribute = "morale"
+	Luck     Attribute = "luck"
+	Movement Attribute = "movement"
+	Level    Attribute = "level"
+	Casting  Attribute = "casting"
+)
+
+// matcherCacheSize bounds compiled filters kept by an engine, there are a few per terrain.
+const matcherCacheSize = 1024
+
+// NativeEffect marks trace steps caused by native terrain bonus rather than a terrain effect.
+const NativeEffect = -1
+
+type Stats struct {
+	Attack  int32
+	Defence int32
+	Speed   int32
+	Morale  int32
+	Luck    int32
+}
+
+func (s *Stats) field(a Attribute) *int32 {
+	switch a {
+	case Attack:
+		return &s.Attack
+	case Defence:
+		return &s.Defence
+	case Speed:
+		return &s.Speed
+	case Morale:
+		return &s.Morale
+	case Luck:
+		return &s.Luck
+	}
+	return nil
+}
+
+// Get returns value of given attribute, 0 for attributes that aren't stats.
+func (s Stats) Get(a Attribute) int32 {
+	if f := s.field(a); f != nil {
+		return *f
+	}
+	return 0
+}
+
+// Step explains a single change made while evaluating effects.
+type Step struct {
+	TerrainID string
+	Effect    int // index in terrain effects, NativeEffect for native terrain bonus
+	Attribute Attribute
+	Delta     int32 // change actually applied, 0 if negated
+	Negated   bool  // change was canceled by a negating effect
+	Message   string
+}
+
+func (s Step) String() string {
+	return s.Message
+}
+
+// Engine evaluates terrain effects, see New.
+type Engine struct {
+	native   Stats
+	matchers *lru.Cache[filter.Filter, *filter.Matcher]
+}
+
+type Option func(*Engine)
+
+// WithNativeBonus overrides bonus granted to creatures standing on their native terrain.
+func WithNativeBonus(bonus Stats) Option {
+	return func(e *Engine) {
+		e.native = bonus
+	}
+}
+
+// DefaultNativeBonus is granted to creatures standing on their native terrain.
+var DefaultNativeBonus = Stats{Attack: 1, Defence: 1, Speed: 1}
+
+func New(opts ...Option) *Engine {
+	matchers, _ := lru.New[filter.Filter, *filter.Matcher](matcherCacheSize)
+	e := &Engine{native: DefaultNativeBonus, matchers: matchers}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+func source(terrainID string, effect int) string {
+	if effect == NativeEffect {
+		return fmt.Sprintf("native terrain %q", terrainID)
+	}
+	return fmt.Sprintf("terrain %q effect #%d", terrainID, effect)
+}
@@ -0,0 +1,266 @@
+package effects
+
+import (
+	"slices"
+	"testing"
+
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	everyone = &creaturesv1.Creature_Kind_Filter{All: true}
+	undead   = &creaturesv1.Creature_Kind_Filter{IncludeTags: []string{"undead"}}
+
+	skeleton = &creaturesv1.Creature_Kind{
+		Id:             "skeleton",
+		Tags:           []string{"undead"},
+		MovementTypes:  []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+		NativeTerrains: []string{"dirt"},
+	}
+	angel = &creaturesv1.Creature_Kind{
+		Id:   "angel",
+		Tags: []string{"holy"},
+		MovementTypes: []creaturesv1.Creature_MovementType{
+			creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+			creaturesv1.Creature_MOVEMENT_TYPE_FLYING,
+		},
+		NativeTerrains: []string{"grass"},
+	}
+
+	base = Stats{Attack: 5, Defence: 4, Speed: 6, Morale: 1, Luck: 0}
+)
+
+func morale(filter *creaturesv1.Creature_Kind_Filter, m *creaturesv1.Creature_AttributeModification) *mapv1.Terrain_Effect {
+	return &mapv1.Terrain_Effect{Kind: &mapv1.Terrain_Effect_ModifyCreatureMorale_{
+		ModifyCreatureMorale: &mapv1.Terrain_Effect_ModifyCreatureMorale{Filter: filter, Modification: m},
+	}}
+}
+
+func attack(filter *creaturesv1.Creature_Kind_Filter, delta int32) *mapv1.Terrain_Effect {
+	return &mapv1.Terrain_Effect{Kind: &mapv1.Terrain_Effect_ModifyCreatureAttack_{
+		ModifyCreatureAttack: &mapv1.Terrain_Effect_ModifyCreatureAttack{
+			Filter:       filter,
+			Modification: &creaturesv1.Creature_AttributeModification{Delta: delta},
+		},
+	}}
+}
+
+var disableNative = &mapv1.Terrain_Effect{Kind: &mapv1.Terrain_Effect_DisableNativeTerrainBonuses_{
+	DisableNativeTerrainBonuses: &mapv1.Terrain_Effect_DisableNativeTerrainBonuses{},
+}}
+
+func TestCreature(t *testing.T) {
+	tests := []struct {
+		name    string
+		terrain *mapv1.Terrain
+		kind    *creaturesv1.Creature_Kind
+		want    Stats
+		native  bool
+	}{
+		{
+			name:    "no effects",
+			terrain: &mapv1.Terrain{Id: "sand"},
+			kind:    skeleton,
+			want:    base,
+		},
+		{
+			name:    "native terrain",
+			terrain: &mapv1.Terrain{Id: "dirt"},
+			kind:    skeleton,
+			want:    Stats{Attack: 6, Defence: 5, Speed: 7, Morale: 1},
+			native:  true,
+		},
+		{
+			name:    "native terrain bonus disabled",
+			terrain: &mapv1.Terrain{Id: "dirt", Effects: []*mapv1.Terrain_Effect{attack(everyone, 2), disableNative}},
+			kind:    skeleton,
+			want:    Stats{Attack: 7, Defence: 4, Speed: 6, Morale: 1},
+		},
+		{
+			name:    "effects accumulate",
+			terrain: &mapv1.Terrain{Id: "sand", Effects: []*mapv1.Terrain_Effect{attack(everyone, 2), attack(everyone, -3)}},
+			kind:    skeleton,
+			want:    Stats{Attack: 4, Defence: 4, Speed: 6, Morale: 1},
+		},
+		{
+			name:    "filtered out",
+			terrain: &mapv1.Terrain{Id: "sand", Effects: []*mapv1.Terrain_Effect{attack(undead, 2), attack(nil, 2)}},
+			kind:    angel,
+			want:    base,
+		},
+		{
+			name: "positive effects negated before & after",
+			terrain: &mapv1.Terrain{Id: "grass", Effects: []*mapv1.Terrain_Effect{
+				morale(everyone, &creaturesv1.Creature_AttributeModification{Delta: 2}),
+				morale(everyone, &creaturesv1.Creature_AttributeModification{Delta: -1, NegatePositiveEffects: true}),
+				morale(everyone, &creaturesv1.Creature_AttributeModification{Delta: 3}),
+			}},
+			kind: angel,
+			want: Stats{Attack: 6, Defence: 5, Speed: 7, Morale: 0},
+			// native bonus to other attributes is unaffected
+			native: true,
+		},
+		{
+			name: "negative effects negated",
+			terrain: &mapv1.Terrain{Id: "sand", Effects: []*mapv1.Terrain_Effect{
+				morale(everyone, &creaturesv1.Creature_AttributeModification{Delta: -2}),
+				morale(undead, &creaturesv1.Creature_AttributeModification{NegateNegativeEffects: true}),
+				morale(everyone, &creaturesv1.Creature_AttributeModification{Delta: 1}),
+			}},
+			kind: skeleton,
+			want: Stats{Attack: 5, Defence: 4, Speed: 6, Morale: 2},
+		},
+		{
+			name: "native bonus is negated too",
+			terrain: &mapv1.Terrain{Id: "dirt", Effects: []*mapv1.Terrain_Effect{
+				{Kind: &mapv1.Terrain_Effect_ModifyCreatureSpeed_{
+					ModifyCreatureSpeed: &mapv1.Terrain_Effect_ModifyCreatureSpeed{
+						Filter:       everyone,
+						Modification: &creaturesv1.Creature_AttributeModification{NegatePositiveEffects: true},
+					},
+				}},
+			}},
+			kind:   skeleton,
+			want:   Stats{Attack: 6, Defence: 5, Speed: 6, Morale: 1},
+			native: true,
+		},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := e.Creature(tt.terrain, tt.kind, base)
+			if result.Stats != tt.want {
+				t.Errorf("got %+v, want %+v: %v", result.Stats, tt.want, result.Trace)
+			}
+			if result.Native != tt.native {
+				t.Errorf("native: got %v, want %v", result.Native, tt.native)
+			}
+
+			// trace must explain the difference
+			var sum Stats
+			for _, step := range result.Trace {
+				if step.Message == "" {
+					t.Errorf("step without explanation: %+v", step)
+				}
+				if f := sum.field(step.Attribute); f != nil {
+					*f += step.Delta
+				}
+			}
+			for _, a := range []Attribute{Attack, Defence, Speed, Morale, Luck} {
+				if base.Get(a)+sum.Get(a) != result.Stats.Get(a) {
+					t.Errorf("%s: trace adds up to %+d, got %d", a, sum.Get(a), result.Stats.Get(a))
+				}
+			}
+		})
+	}
+}
+
+func TestCreatureMovement(t *testing.T) {
+	terrain := &mapv1.Terrain{Id: "storm", Effects: []*mapv1.Terrain_Effect{
+		{Kind: &mapv1.Terrain_Effect_ModifyCreatureMovementType_{
+			ModifyCreatureMovementType: &mapv1.Terrain_Effect_ModifyCreatureMovementType{
+				Filter: everyone,
+				Remove: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_FLYING},
+			},
+		}},
+		{Kind: &mapv1.Terrain_Effect_ModifyCreatureMovementType_{
+			ModifyCreatureMovementType: &mapv1.Terrain_Effect_ModifyCreatureMovementType{
+				Filter: undead,
+				Add:    []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_PORTALING},
+			},
+		}},
+	}}
+
+	e := New()
+	angelResult := e.Creature(terrain, angel, base)
+	if want := []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING}; !slices.Equal(angelResult.MovementTypes, want) {
+		t.Errorf("angel: got %v", angelResult.MovementTypes)
+	}
+	if len(angelResult.Trace) != 1 || angelResult.Trace[0].Message != `flying movement removed by terrain "storm" effect #0` {
+		t.Errorf("angel: got trace %v", angelResult.Trace)
+	}
+	if len(angel.GetMovementTypes()) != 2 {
+		t.Error("creature kind must not be modified")
+	}
+
+	skeletonResult := e.Creature(terrain, skeleton, base)
+	want := []creaturesv1.Creature_MovementType{
+		creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+		creaturesv1.Creature_MOVEMENT_TYPE_PORTALING,
+	}
+	if !slices.Equal(skeletonResult.MovementTypes, want) {
+		t.Errorf("skeleton: got %v", skeletonResult.MovementTypes)
+	}
+}
+
+func TestNativeBonusOption(t *testing.T) {
+	e := New(WithNativeBonus(Stats{Morale: 1}))
+	result := e.Creature(&mapv1.Terrain{Id: "grass"}, angel, base)
+	if want := (Stats{Attack: 5, Defence: 4, Speed: 6, Morale: 2}); result.Stats != want {
+		t.Errorf("got %+v, want %+v", result.Stats, want)
+	}
+}
+
+func TestSpell(t *testing.T) {
+	fire := &magicv1.Spell{Id: "fireball", Tags: []string{"fire"}}
+	water := &magicv1.Spell{Id: "ice-bolt", Tags: []string{"water"}}
+
+	terrain := &mapv1.Terrain{Id: "volcano", Effects: []*mapv1.Terrain_Effect{
+		{Kind: &mapv1.Terrain_Effect_ModifySpellLevel_{ModifySpellLevel: &mapv1.Terrain_Effect_ModifySpellLevel{
+			Filter: &magicv1.Spell_Filter{IncludeTags: []string{"fire"}},
+			Delta:  1,
+		}}},
+		{Kind: &mapv1.Terrain_Effect_PreventSpellCasting_{PreventSpellCasting: &mapv1.Terrain_Effect_PreventSpellCasting{
+			Filter:   &magicv1.Spell_Filter{All: true},
+			LevelGte: proto.Int32(4),
+		}}},
+		{Kind: &mapv1.Terrain_Effect_PreventSpellCasting_{PreventSpellCasting: &mapv1.Terrain_Effect_PreventSpellCasting{
+			Filter:   &magicv1.Spell_Filter{All: true, ExcludeTags: []string{"fire"}},
+			LevelLte: proto.Int32(1),
+		}}},
+	}}
+
+	tests := []struct {
+		name     string
+		spell    *magicv1.Spell
+		level    int32
+		want     int32
+		castable bool
+	}{
+		{"boosted", fire, 2, 3, true},
+		{"boosted past limit", fire, 3, 4, false},
+		{"low level fire", fire, 1, 2, true},
+		{"unaffected", water, 3, 3, true},
+		{"above limit", water, 5, 5, false},
+		{"below limit", water, 1, 1, false},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := e.Spell(terrain, tt.spell, tt.level)
+			if result.Level != tt.want || result.Castable != tt.castable {
+				t.Errorf("got (%d, %v), want (%d, %v): %v", result.Level, result.Castable, tt.want, tt.castable, result.Trace)
+			}
+			if !result.Castable && result.Trace[len(result.Trace)-1].Attribute != Casting {
+				t.Errorf("prevention is not explained: %v", result.Trace)
+			}
+		})
+	}
+}
+
+func TestMatcherCacheIsBounded(t *testing.T) {
+	e := New()
+	for range matcherCacheSize * 2 {
+		if !e.matches(&creaturesv1.Creature_Kind_Filter{All: true}, skeleton) {
+			t.Fatal("expected filter to match")
+		}
+	}
+	if n := e.matchers.Len(); n > matcherCacheSize {
+		t.Fatalf("expected at most %d cached matchers, got %d", matcherCacheSize, n)
+	}
+}
@@ -0,0 +1,17 @@
+package effects
+
+import (
+	"github.com/openhexes/openhexes/api/src/filter"
+)
+
+// matches evaluates effect filter, compiling it once per filter message.
+// Content definitions are long-lived, so cache is keyed by message pointer;
+// it's bounded, since reloaded content brings new messages.
+func (e *Engine) matches(f filter.Filter, item filter.Item) bool {
+	m, ok := e.matchers.Get(f)
+	if !ok {
+		m = filter.Compile(f)
+		e.matchers.Add(f, m)
+	}
+	return m.MatchItem(item)
+}
@@ -0,0 +1,59 @@
+package effects
+
+import (
+	"fmt"
+
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+type SpellResult struct {
+	Level    int32
+	Castable bool
+	Trace    []Step
+}
+
+// Spell evaluates effective level of a spell cast on given terrain and whether it can be cast at all.
+// Casting restrictions are checked against the level as modified by preceding effects.
+func (e *Engine) Spell(terrain *mapv1.Terrain, spell *magicv1.Spell, level int32) *SpellResult {
+	result := &SpellResult{Level: level, Castable: true}
+	id := terrain.GetId()
+
+	for i, effect := range terrain.GetEffects() {
+		switch {
+		case effect.GetModifySpellLevel() != nil:
+			m := effect.GetModifySpellLevel()
+			if m.GetDelta() == 0 || !e.matches(m.GetFilter(), spell) {
+				continue
+			}
+			result.Level += m.GetDelta()
+			result.Trace = append(result.Trace, Step{
+				TerrainID: id,
+				Effect:    i,
+				Attribute: Level,
+				Delta:     m.GetDelta(),
+				Message:   fmt.Sprintf("%+d spell level from %s", m.GetDelta(), source(id, i)),
+			})
+
+		case effect.GetPreventSpellCasting() != nil:
+			p := effect.GetPreventSpellCasting()
+			if !e.matches(p.GetFilter(), spell) {
+				continue
+			}
+			if p.LevelGte != nil && result.Level < p.GetLevelGte() {
+				continue
+			}
+			if p.LevelLte != nil && result.Level > p.GetLevelLte() {
+				continue
+			}
+			result.Castable = false
+			result.Trace = append(result.Trace, Step{
+				TerrainID: id,
+				Effect:    i,
+				Attribute: Casting,
+				Message:   fmt.Sprintf("level %d spell casting prevented by %s", result.Level, source(id, i)),
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+// Package filter matches content against creatures.v1.Creature.Kind.Filter & magic.v1.Spell.Filter,
+// which share the same shape & semantics:
+//
+//   - an item is excluded if its id is listed in exclude_ids or any of its tags matches exclude_tags;
+//     exclusion always wins over inclusion
+//   - otherwise it is included if `all` is set, its id is listed in include_ids
+//     or any of its tags matches include_tags
+//   - an empty (or missing) filter matches nothing, exclusions alone only narrow down `all`
+//
+// Ids are compared exactly. Tag patterns may contain `*` wildcards matching any run of characters,
+// e.g. `core/*` matches `core/undead` & `core/elemental/fire`, while `*` matches every tag.
+package filter
+
+import (
+	"strings"
+)
+
+// Filter is implemented by both filter messages.
+type Filter interface {
+	GetAll() bool
+	GetIncludeIds() []string
+	GetExcludeIds() []string
+	GetIncludeTags() []string
+	GetExcludeTags() []string
+}
+
+// Item is implemented by content that can be filtered, e.g. creature kinds & spells.
+type Item interface {
+	GetId() string
+	GetTags() []string
+}
+
+// Matcher is a compiled filter, safe for concurrent use.
+type Matcher struct {
+	all         bool
+	includeIDs  map[string]bool
+	excludeIDs  map[string]bool
+	includeTags patterns
+	excludeTags patterns
+}
+
+// Compile prepares filter for repeated evaluation. Nil filter compiles to a matcher matching nothing.
+func Compile(f Filter) *Matcher {
+	if f == nil {
+		return &Matcher{}
+	}
+	return &Matcher{
+		all:         f.GetAll(),
+		includeIDs:  set(f.GetIncludeIds()),
+		excludeIDs:  set(f.GetExcludeIds()),
+		includeTags: compilePatterns(f.GetIncludeTags()),
+		excludeTags: compilePatterns(f.GetExcludeTags()),
+	}
+}
+
+// Matches is a shorthand for one-off evaluation, compile filters that are evaluated repeatedly.
+func Matches(f Filter, item Item) bool {
+	return Compile(f).MatchItem(item)
+}
+
+// Empty reports whether matcher can't match anything.
+func (m *Matcher) Empty() bool {
+	return !m.all && len(m.includeIDs) == 0 && m.includeTags.empty()
+}
+
+func (m *Matcher) Match(id string, tags []string) bool {
+	if m.excludeIDs[id] || m.excludeTags.any(tags) {
+		return false
+	}
+	return m.all || m.includeIDs[id] || m.includeTags.any(tags)
+}
+
+func (m *Matcher) MatchItem(item Item) bool {
+	return m.Match(item.GetId(), item.GetTags())
+}
+
+func set(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]bool, len(values))
+	for _, v := range values {
+		result[v] = true
+	}
+	return result
+}
+
+type patterns struct {
+	exact    map[string]bool
+	wildcard [][]string // pattern split around `*`
+}
+
+func compilePatterns(values []string) patterns {
+	var p patterns
+	for _, v := range values {
+		if strings.Contains(v, "*") {
+			p.wildcard = append(p.wildcard, strings.Split(v, "*"))
+			continue
+		}
+		if p.exact == nil {
+			p.exact = make(map[string]bool, len(values))
+		}
+		p.exact[v] = true
+	}
+	return p
+}
+
+func (p patterns) empty() bool {
+	return len(p.exact) == 0 && len(p.wildcard) == 0
+}
+
+func (p patterns) any(tags []string) bool {
+	for _, tag := range tags {
+		if p.exact[tag] {
+			return true
+		}
+		for _, parts := range p.wildcard {
+			if glob(parts, tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// glob matches s against pattern parts separated by `*`.
+func glob(parts []string, s string) bool {
+	first, last := parts[0], parts[len(parts)-1]
+	if len(s) < len(first)+len(last) || !strings.HasPrefix(s, first) || !strings.HasSuffix(s, last) {
+		return false
+	}
+	s = s[len(first) : len(s)-len(last)]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return true
+}
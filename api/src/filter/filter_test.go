@@ -0,0 +1,150 @@
+package filter
+
+import (
+	"testing"
+
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+)
+
+func TestMatch(t *testing.T) {
+	type item struct {
+		id   string
+		tags []string
+	}
+	var (
+		skeleton = item{"core/creature/skeleton", []string{"core/undead", "core/necropolis"}}
+		angel    = item{"core/creature/angel", []string{"core/holy", "core/castle"}}
+		golem    = item{"mod/creature/golem", []string{"mod/construct/stone"}}
+		slime    = item{"mod/creature/slime", nil}
+	)
+
+	items := []item{skeleton, angel, golem, slime}
+
+	tests := []struct {
+		name   string
+		filter *creaturesv1.Creature_Kind_Filter
+		want   map[string]bool // by id
+	}{
+		{
+			name:   "missing",
+			filter: nil,
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: false, slime.id: false},
+		},
+		{
+			name:   "empty",
+			filter: &creaturesv1.Creature_Kind_Filter{},
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: false, slime.id: false},
+		},
+		{
+			name:   "all",
+			filter: &creaturesv1.Creature_Kind_Filter{All: true},
+			want:   map[string]bool{skeleton.id: true, angel.id: true, golem.id: true, slime.id: true},
+		},
+		{
+			name:   "exclusions alone select nothing",
+			filter: &creaturesv1.Creature_Kind_Filter{ExcludeTags: []string{"core/undead"}},
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: false, slime.id: false},
+		},
+		{
+			name:   "all except",
+			filter: &creaturesv1.Creature_Kind_Filter{All: true, ExcludeIds: []string{"core/creature/angel"}, ExcludeTags: []string{"mod/*"}},
+			want:   map[string]bool{skeleton.id: true, angel.id: false, golem.id: false, slime.id: true},
+		},
+		{
+			name:   "include by id",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeIds: []string{"mod/creature/slime"}},
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: false, slime.id: true},
+		},
+		{
+			name:   "include by tag",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeTags: []string{"core/holy"}},
+			want:   map[string]bool{skeleton.id: false, angel.id: true, golem.id: false, slime.id: false},
+		},
+		{
+			name: "exclusion wins",
+			filter: &creaturesv1.Creature_Kind_Filter{
+				IncludeIds:  []string{"core/creature/skeleton"},
+				IncludeTags: []string{"core/*"},
+				ExcludeTags: []string{"core/undead"},
+			},
+			want: map[string]bool{skeleton.id: false, angel.id: true, golem.id: false, slime.id: false},
+		},
+		{
+			name:   "wildcard spans separators",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeTags: []string{"mod/*"}},
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: true, slime.id: false},
+		},
+		{
+			name:   "wildcard in the middle",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeTags: []string{"*/construct/*", "core/*is"}},
+			want:   map[string]bool{skeleton.id: true, angel.id: false, golem.id: true, slime.id: false},
+		},
+		{
+			name:   "wildcard matches every tag",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeTags: []string{"*"}},
+			want:   map[string]bool{skeleton.id: true, angel.id: true, golem.id: true, slime.id: false},
+		},
+		{
+			name:   "ids are exact",
+			filter: &creaturesv1.Creature_Kind_Filter{IncludeIds: []string{"core/*"}},
+			want:   map[string]bool{skeleton.id: false, angel.id: false, golem.id: false, slime.id: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Compile(tt.filter)
+			for _, it := range items {
+				want := tt.want[it.id]
+				if got := m.Match(it.id, it.tags); got != want {
+					t.Errorf("%s: got %v, want %v", it.id, got, want)
+				}
+				kind := &creaturesv1.Creature_Kind{Id: it.id, Tags: it.tags}
+				if got := Matches(tt.filter, kind); got != want {
+					t.Errorf("%s: one-off evaluation got %v, want %v", it.id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSpellFilter(t *testing.T) {
+	f := &magicv1.Spell_Filter{All: true, ExcludeTags: []string{"*fire*"}}
+	if Matches(f, &magicv1.Spell{Id: "fireball", Tags: []string{"school/fire"}}) {
+		t.Error("fire spell must be excluded")
+	}
+	if !Matches(f, &magicv1.Spell{Id: "ice-bolt", Tags: []string{"school/water"}}) {
+		t.Error("water spell must be included")
+	}
+	if Compile(f).Empty() {
+		t.Error("filter is not empty")
+	}
+	if !Compile(&magicv1.Spell_Filter{ExcludeIds: []string{"fireball"}}).Empty() {
+		t.Error("filter without inclusions is empty")
+	}
+}
+
+func TestGlob(t *testing.T) {
+	tests := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"*", "", true},
+		{"a*", "a", true},
+		{"*a", "a", true},
+		{"a*a", "a", false},
+		{"a*a", "aa", true},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "acb", false},
+		{"a**c", "ac", true},
+		{"a*bc*bc", "abcbc", true},
+		{"a*bc*bc", "abc", false},
+	}
+	for _, tt := range tests {
+		p := compilePatterns([]string{tt.pattern})
+		if got := p.any([]string{tt.s}); got != tt.want {
+			t.Errorf("%q ~ %q: got %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
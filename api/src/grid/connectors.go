@@ -0,0 +1,50 @@
+package grid
+
+import (
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Connectors are stored within segments of both of their ends, so looking up
+// where a tile leads to only takes the segment of that tile.
+
+// Connect adds connector to the segment, replacing one with the same ends.
+func Connect(s *mapv1.Segment, c *mapv1.Connector) {
+	for i, existing := range s.Connectors {
+		if sameEnds(existing, c) {
+			s.Connectors[i] = c
+			return
+		}
+	}
+	s.Connectors = append(s.Connectors, c)
+}
+
+// Disconnect removes connector with the same ends from the segment, reporting whether there was one.
+func Disconnect(s *mapv1.Segment, c *mapv1.Connector) bool {
+	for i, existing := range s.Connectors {
+		if sameEnds(existing, c) {
+			s.Connectors = append(s.Connectors[:i], s.Connectors[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Exits returns tiles connectors of the segment lead to from given tile.
+func Exits(s *mapv1.Segment, from *mapv1.Tile_Coordinate) []*mapv1.Tile_Coordinate {
+	var result []*mapv1.Tile_Coordinate
+	for _, c := range s.GetConnectors() {
+		switch {
+		case proto.Equal(c.From, from):
+			result = append(result, c.To)
+		case proto.Equal(c.To, from) && !c.OneWay:
+			result = append(result, c.From)
+		}
+	}
+	return result
+}
+
+func sameEnds(a, b *mapv1.Connector) bool {
+	return proto.Equal(a.From, b.From) && proto.Equal(a.To, b.To) ||
+		proto.Equal(a.From, b.To) && proto.Equal(a.To, b.From)
+}
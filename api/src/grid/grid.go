@@ -0,0 +1,112 @@
+// Package grid splits maps into rectangular segments, the unit maps are stored & streamed in.
+package grid
+
+import (
+	"fmt"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Layout describes how a map of given size is split into segments.
+// Segments in the last segment row & column may be smaller than the rest.
+// Every level of the map is split the same way.
+type Layout struct {
+	TotalRows         uint32
+	TotalColumns      uint32
+	RowsPerSegment    uint32
+	ColumnsPerSegment uint32
+	Depths            uint32 // number of levels, zero is treated as a single one
+}
+
+func (l Layout) Validate() error {
+	if l.TotalRows == 0 || l.TotalColumns == 0 {
+		return fmt.Errorf("map size must be positive, got %dx%d", l.TotalRows, l.TotalColumns)
+	}
+	if l.RowsPerSegment == 0 || l.ColumnsPerSegment == 0 {
+		return fmt.Errorf("segment size must be positive, got %dx%d", l.RowsPerSegment, l.ColumnsPerSegment)
+	}
+	return nil
+}
+
+// Levels returns number of levels, at least one.
+func (l Layout) Levels() uint32 {
+	return max(l.Depths, 1)
+}
+
+// SegmentRows returns number of segment rows.
+func (l Layout) SegmentRows() uint32 {
+	return (l.TotalRows + l.RowsPerSegment - 1) / l.RowsPerSegment
+}
+
+// SegmentColumns returns number of segments in every segment row.
+func (l Layout) SegmentColumns() uint32 {
+	return (l.TotalColumns + l.ColumnsPerSegment - 1) / l.ColumnsPerSegment
+}
+
+func (l Layout) Contains(c *mapv1.Tile_Coordinate) bool {
+	return c.GetRow() < l.TotalRows && c.GetColumn() < l.TotalColumns && c.GetDepth() < l.Levels()
+}
+
+// Locate returns position of the segment containing given coordinate.
+func (l Layout) Locate(c *mapv1.Tile_Coordinate) (segmentRow, segmentColumn uint32) {
+	return c.GetRow() / l.RowsPerSegment, c.GetColumn() / l.ColumnsPerSegment
+}
+
+// Bounds of a segment, max values are exclusive and never exceed map size.
+func (l Layout) Bounds(p Position) *mapv1.Segment_Bounds {
+	minRow := p.Row * l.RowsPerSegment
+	minColumn := p.Column * l.ColumnsPerSegment
+	return &mapv1.Segment_Bounds{
+		MinRow:    int32(minRow),
+		MaxRow:    int32(min(minRow+l.RowsPerSegment, l.TotalRows)),
+		MinColumn: int32(minColumn),
+		MaxColumn: int32(min(minColumn+l.ColumnsPerSegment, l.TotalColumns)),
+		Depth:     p.Depth,
+	}
+}
+
+// Positions lists all segments of the map level by level, each in row-major order.
+func (l Layout) Positions() []Position {
+	positions := make([]Position, 0, l.Levels()*l.SegmentRows()*l.SegmentColumns())
+	for depth := range l.Levels() {
+		for row := range l.SegmentRows() {
+			for column := range l.SegmentColumns() {
+				positions = append(positions, Position{Depth: depth, Row: row, Column: column})
+			}
+		}
+	}
+	return positions
+}
+
+// Segment builds a segment with tiles in row-major order, see Index.
+func (l Layout) Segment(p Position, tile func(c *mapv1.Tile_Coordinate) *mapv1.Tile) *mapv1.Segment {
+	bounds := l.Bounds(p)
+	segment := &mapv1.Segment{
+		Bounds: bounds,
+		Tiles:  make([]*mapv1.Tile, 0, (bounds.MaxRow-bounds.MinRow)*(bounds.MaxColumn-bounds.MinColumn)),
+	}
+	for row := bounds.MinRow; row < bounds.MaxRow; row++ {
+		for column := bounds.MinColumn; column < bounds.MaxColumn; column++ {
+			segment.Tiles = append(segment.Tiles, tile(&mapv1.Tile_Coordinate{
+				Row:    uint32(row),
+				Column: uint32(column),
+				Depth:  p.Depth,
+			}))
+		}
+	}
+	return segment
+}
+
+// Index returns position of the tile at given coordinate within segment tiles.
+func Index(s *mapv1.Segment, c *mapv1.Tile_Coordinate) (int, bool) {
+	b := s.GetBounds()
+	row, column := int32(c.GetRow()), int32(c.GetColumn())
+	if c.GetDepth() != b.GetDepth() || row < b.GetMinRow() || row >= b.GetMaxRow() || column < b.GetMinColumn() || column >= b.GetMaxColumn() {
+		return 0, false
+	}
+	i := int((row-b.GetMinRow())*(b.GetMaxColumn()-b.GetMinColumn()) + column - b.GetMinColumn())
+	if i >= len(s.GetTiles()) {
+		return 0, false
+	}
+	return i, true
+}
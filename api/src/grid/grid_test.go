@@ -0,0 +1,177 @@
+package grid
+
+import (
+	"testing"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLayout(t *testing.T) {
+	l := Layout{TotalRows: 10, TotalColumns: 7, RowsPerSegment: 4, ColumnsPerSegment: 3}
+	if err := l.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if l.SegmentRows() != 3 || l.SegmentColumns() != 3 {
+		t.Fatalf("unexpected segment count: %dx%d", l.SegmentRows(), l.SegmentColumns())
+	}
+
+	last := l.Bounds(Position{Row: 2, Column: 2})
+	expected := &mapv1.Segment_Bounds{MinRow: 8, MaxRow: 10, MinColumn: 6, MaxColumn: 7}
+	if !proto.Equal(last, expected) {
+		t.Fatalf("expected %v, got %v", expected, last)
+	}
+
+	row, column := l.Locate(&mapv1.Tile_Coordinate{Row: 9, Column: 3})
+	if row != 2 || column != 1 {
+		t.Fatalf("expected segment 2:1, got %d:%d", row, column)
+	}
+	if l.Contains(&mapv1.Tile_Coordinate{Row: 10}) {
+		t.Fatal("coordinate outside of the map is reported as contained")
+	}
+
+	if err := (Layout{TotalRows: 1, TotalColumns: 1}).Validate(); err == nil {
+		t.Fatal("expected error for zero segment size")
+	}
+}
+
+func TestSegment(t *testing.T) {
+	l := Layout{TotalRows: 10, TotalColumns: 7, RowsPerSegment: 4, ColumnsPerSegment: 3}
+	s := l.Segment(Position{Depth: 1, Row: 1, Column: 2}, func(c *mapv1.Tile_Coordinate) *mapv1.Tile {
+		return &mapv1.Tile{Coordinate: c}
+	})
+	if len(s.Tiles) != 4 {
+		t.Fatalf("expected 4 tiles, got %d", len(s.Tiles))
+	}
+
+	for _, tile := range s.Tiles {
+		if tile.Coordinate.Depth != 1 {
+			t.Fatalf("unexpected depth: %v", tile.Coordinate)
+		}
+		i, ok := Index(s, tile.Coordinate)
+		if !ok || s.Tiles[i] != tile {
+			t.Fatalf("tile %v is not found at its index", tile.Coordinate)
+		}
+	}
+	if _, ok := Index(s, &mapv1.Tile_Coordinate{Row: 4, Column: 5}); ok {
+		t.Fatal("found coordinate outside of the segment")
+	}
+}
+
+func TestLevels(t *testing.T) {
+	l := Layout{TotalRows: 10, TotalColumns: 7, RowsPerSegment: 4, ColumnsPerSegment: 3, Depths: 2}
+	if !l.Contains(&mapv1.Tile_Coordinate{Row: 9, Column: 6, Depth: 1}) || l.Contains(&mapv1.Tile_Coordinate{Depth: 2}) {
+		t.Fatal("expected coordinates to be contained by their level only")
+	}
+	if (Layout{TotalRows: 1, TotalColumns: 1}).Contains(&mapv1.Tile_Coordinate{Depth: 1}) {
+		t.Fatal("expected layout without depths to have a single level")
+	}
+
+	positions := l.Positions()
+	if len(positions) != 18 || positions[9] != (Position{Depth: 1}) {
+		t.Fatalf("expected 9 segments per level, got %v", positions)
+	}
+
+	s := l.Segment(positions[9], func(c *mapv1.Tile_Coordinate) *mapv1.Tile {
+		return &mapv1.Tile{Coordinate: c}
+	})
+	if s.Bounds.Depth != 1 {
+		t.Fatalf("expected bounds to carry depth, got %v", s.Bounds)
+	}
+	if _, ok := Index(s, &mapv1.Tile_Coordinate{}); ok {
+		t.Fatal("found coordinate of another level")
+	}
+
+	viewport := &mapv1.Segment_Bounds{MaxRow: 4, MaxColumn: 3, Depth: 1}
+	if positions := l.Intersecting(viewport, 10); len(positions) != 9 || positions[0] != (Position{Depth: 1}) {
+		t.Fatalf("expected margin to stay on the level, got %v", positions)
+	}
+	viewport.Depth = 2
+	if positions := l.Intersecting(viewport, 0); positions != nil {
+		t.Fatalf("expected no segments below the deepest level, got %v", positions)
+	}
+}
+
+func TestIntersecting(t *testing.T) {
+	l := Layout{TotalRows: 30, TotalColumns: 30, RowsPerSegment: 10, ColumnsPerSegment: 10}
+
+	positions := l.Intersecting(&mapv1.Segment_Bounds{MinRow: 12, MaxRow: 18, MinColumn: 12, MaxColumn: 18}, 0)
+	if len(positions) != 1 || positions[0] != (Position{Row: 1, Column: 1}) {
+		t.Fatalf("unexpected segments: %v", positions)
+	}
+
+	positions = l.Intersecting(&mapv1.Segment_Bounds{MinRow: 12, MaxRow: 18, MinColumn: 12, MaxColumn: 18}, 3)
+	if len(positions) != 9 {
+		t.Fatalf("expected margin to reach all neighbours, got %v", positions)
+	}
+	if positions[0] != (Position{Row: 1, Column: 1}) {
+		t.Fatalf("expected central segment first, got %v", positions[0])
+	}
+
+	positions = l.Intersecting(&mapv1.Segment_Bounds{MinRow: -5, MaxRow: 5, MinColumn: 25, MaxColumn: 40}, 0)
+	if len(positions) != 1 || positions[0] != (Position{Row: 0, Column: 2}) {
+		t.Fatalf("expected viewport to be clipped, got %v", positions)
+	}
+
+	if positions := l.Intersecting(&mapv1.Segment_Bounds{MinRow: 40, MaxRow: 50, MaxColumn: 10}, 5); positions != nil {
+		t.Fatalf("expected no segments outside of the map, got %v", positions)
+	}
+}
+
+func TestCheckViewport(t *testing.T) {
+	if err := CheckViewport(&mapv1.Segment_Bounds{MinRow: 10, MaxRow: 200, MaxColumn: 250}, 3); err != nil {
+		t.Fatalf("expected viewport to fit, got %v", err)
+	}
+	if err := CheckViewport(&mapv1.Segment_Bounds{MaxRow: 250, MaxColumn: 250}, 4); err == nil {
+		t.Fatal("expected margin to count towards the span")
+	}
+	if err := CheckViewport(&mapv1.Segment_Bounds{MinRow: -1000, MaxRow: 1000}, 0); err == nil {
+		t.Fatal("expected huge viewport to be rejected")
+	}
+}
+
+func TestTracker(t *testing.T) {
+	l := Layout{TotalRows: 30, TotalColumns: 30, RowsPerSegment: 10, ColumnsPerSegment: 10}
+	tracker := NewTracker(l)
+
+	first := tracker.Update(&mapv1.Segment_Bounds{MinRow: 0, MaxRow: 15, MinColumn: 0, MaxColumn: 10}, 0)
+	if len(first) != 2 {
+		t.Fatalf("expected 2 segments, got %v", first)
+	}
+	moved := tracker.Update(&mapv1.Segment_Bounds{MinRow: 5, MaxRow: 15, MinColumn: 5, MaxColumn: 15}, 0)
+	if len(moved) != 2 {
+		t.Fatalf("expected only new segments, got %v", moved)
+	}
+	if again := tracker.Update(&mapv1.Segment_Bounds{MinRow: 5, MaxRow: 15, MinColumn: 5, MaxColumn: 15}, 0); len(again) != 0 {
+		t.Fatalf("expected nothing new, got %v", again)
+	}
+
+	tracker.Forget(Position{Row: 1, Column: 1})
+	if again := tracker.Update(&mapv1.Segment_Bounds{MinRow: 5, MaxRow: 15, MinColumn: 5, MaxColumn: 15}, 0); len(again) != 1 {
+		t.Fatalf("expected forgotten segment to be sent again, got %v", again)
+	}
+}
+
+func TestConnectors(t *testing.T) {
+	top, bottom := &mapv1.Tile_Coordinate{Row: 1, Column: 1}, &mapv1.Tile_Coordinate{Row: 1, Column: 1, Depth: 1}
+	s := &mapv1.Segment{}
+	Connect(s, &mapv1.Connector{Kind: mapv1.Connector_KIND_STAIRWAY, From: top, To: bottom})
+	Connect(s, &mapv1.Connector{Kind: mapv1.Connector_KIND_PORTAL, From: bottom, To: top, OneWay: true})
+	if len(s.Connectors) != 1 || s.Connectors[0].Kind != mapv1.Connector_KIND_PORTAL {
+		t.Fatalf("expected connector with the same ends to be replaced, got %v", s.Connectors)
+	}
+
+	if exits := Exits(s, bottom); len(exits) != 1 || !proto.Equal(exits[0], top) {
+		t.Fatalf("expected portal to lead up, got %v", exits)
+	}
+	if exits := Exits(s, top); len(exits) != 0 {
+		t.Fatalf("expected one way portal not to lead down, got %v", exits)
+	}
+
+	if !Disconnect(s, &mapv1.Connector{From: top, To: bottom}) || len(s.Connectors) != 0 {
+		t.Fatalf("expected connector to be removed, got %v", s.Connectors)
+	}
+	if Disconnect(s, &mapv1.Connector{From: top, To: bottom}) {
+		t.Fatal("expected nothing to remove")
+	}
+}
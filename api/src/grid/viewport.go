@@ -0,0 +1,97 @@
+package grid
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Position identifies a segment within a map.
+type Position struct {
+	Depth  uint32
+	Row    uint32
+	Column uint32
+}
+
+// MaxViewportSpan is the number of rows & columns a viewport extended by its margin may span.
+const MaxViewportSpan = int64(256)
+
+// CheckViewport limits segments a single request may ask for.
+func CheckViewport(viewport *mapv1.Segment_Bounds, margin int32) error {
+	rows := int64(viewport.GetMaxRow()) - int64(viewport.GetMinRow()) + 2*int64(margin)
+	columns := int64(viewport.GetMaxColumn()) - int64(viewport.GetMinColumn()) + 2*int64(margin)
+	if rows > MaxViewportSpan || columns > MaxViewportSpan {
+		return fmt.Errorf("viewport with margin can't span more than %d rows & columns, got %dx%d", MaxViewportSpan, rows, columns)
+	}
+	return nil
+}
+
+// Intersecting lists segments intersecting viewport extended by margin tiles on every side,
+// closest to viewport center first. Margin never reaches other levels.
+func (l Layout) Intersecting(viewport *mapv1.Segment_Bounds, margin int32) []Position {
+	depth := viewport.GetDepth()
+	if depth >= l.Levels() {
+		return nil
+	}
+	minRow := max(int64(viewport.GetMinRow())-int64(margin), 0)
+	maxRow := min(int64(viewport.GetMaxRow())+int64(margin), int64(l.TotalRows))
+	minColumn := max(int64(viewport.GetMinColumn())-int64(margin), 0)
+	maxColumn := min(int64(viewport.GetMaxColumn())+int64(margin), int64(l.TotalColumns))
+	if minRow >= maxRow || minColumn >= maxColumn {
+		return nil
+	}
+
+	rows, columns := int64(l.RowsPerSegment), int64(l.ColumnsPerSegment)
+	var positions []Position
+	for row := minRow / rows; row <= (maxRow-1)/rows; row++ {
+		for column := minColumn / columns; column <= (maxColumn-1)/columns; column++ {
+			positions = append(positions, Position{Depth: depth, Row: uint32(row), Column: uint32(column)})
+		}
+	}
+
+	// distances are compared in tiles, doubled to stay in integers
+	centerRow := int64(viewport.GetMinRow()) + int64(viewport.GetMaxRow())
+	centerColumn := int64(viewport.GetMinColumn()) + int64(viewport.GetMaxColumn())
+	distance := func(p Position) int64 {
+		b := l.Bounds(p)
+		dr := int64(b.MinRow+b.MaxRow) - centerRow
+		dc := int64(b.MinColumn+b.MaxColumn) - centerColumn
+		return dr*dr + dc*dc
+	}
+	slices.SortStableFunc(positions, func(a, b Position) int {
+		return cmp.Compare(distance(a), distance(b))
+	})
+	return positions
+}
+
+// Tracker remembers segments already sent to a client, so moving viewport only yields new ones.
+type Tracker struct {
+	layout Layout
+	sent   map[Position]bool
+}
+
+func NewTracker(layout Layout) *Tracker {
+	return &Tracker{
+		layout: layout,
+		sent:   map[Position]bool{},
+	}
+}
+
+// Update returns segments intersecting viewport that weren't returned before.
+func (t *Tracker) Update(viewport *mapv1.Segment_Bounds, margin int32) []Position {
+	var positions []Position
+	for _, p := range t.layout.Intersecting(viewport, margin) {
+		if !t.sent[p] {
+			t.sent[p] = true
+			positions = append(positions, p)
+		}
+	}
+	return positions
+}
+
+// Forget makes segment eligible for sending again, e.g. after it was modified.
+func (t *Tracker) Forget(p Position) {
+	delete(t.sent, p)
+}
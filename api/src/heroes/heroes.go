@@ -0,0 +1,136 @@
+// Package heroes moves heroes & their armies across the adventure map.
+package heroes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ArmySlots limits number of stacks a hero may lead.
+	ArmySlots = 7
+	// DefaultMovementPoints let hero cross 15 tiles of regular terrain a day.
+	DefaultMovementPoints = 15 * pathfinding.DefaultPenalty
+	// ManaPerKnowledge is the amount of mana restored every day per point of knowledge.
+	ManaPerKnowledge = 10
+)
+
+var (
+	ErrArmyFull  = fmt.Errorf("army can't have more than %d stacks", ArmySlots)
+	ErrStackFull = fmt.Errorf("stack can't have more than %d creatures", uint32(math.MaxUint32))
+)
+
+// DefaultStats are primary skills of a newly recruited hero.
+var DefaultStats = &heroesv1.Hero_Stats{Attack: 1, Defence: 1, SpellPower: 1, Knowledge: 1}
+
+// Walker describes how heroes travel across the adventure map.
+var Walker = &creaturesv1.Creature_Kind{
+	MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+}
+
+// New returns a hero with default stats, full movement points & mana.
+func New(id, gameID, ownerID, name string, position *mapv1.Tile_Coordinate, army []*heroesv1.Hero_Stack) *heroesv1.Hero {
+	return &heroesv1.Hero{
+		Id:                id,
+		GameId:            gameID,
+		OwnerId:           ownerID,
+		Name:              name,
+		Position:          position,
+		MovementPoints:    DefaultMovementPoints,
+		MaxMovementPoints: DefaultMovementPoints,
+		Stats:             proto.Clone(DefaultStats).(*heroesv1.Hero_Stats),
+		Army:              army,
+		Mana:              MaxMana(DefaultStats),
+	}
+}
+
+// MaxMana returns mana restored every day.
+func MaxMana(stats *heroesv1.Hero_Stats) uint32 {
+	return uint32(max(stats.GetKnowledge(), 0)) * ManaPerKnowledge
+}
+
+// ValidateArmy checks number of stacks, their sizes and creature kinds.
+func ValidateArmy(army []*heroesv1.Hero_Stack, exists func(creatureID string) bool) error {
+	if len(army) > ArmySlots {
+		return ErrArmyFull
+	}
+	var errs []error
+	for i, stack := range army {
+		if stack.GetCount() == 0 {
+			errs = append(errs, fmt.Errorf("stack #%d is empty", i))
+		}
+		if !exists(stack.GetCreatureId()) {
+			errs = append(errs, fmt.Errorf("stack #%d: unknown creature %q", i, stack.GetCreatureId()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Reinforce adds creatures to an army, joining a stack of the same kind if there's one.
+func Reinforce(army []*heroesv1.Hero_Stack, creatureID string, count uint32) ([]*heroesv1.Hero_Stack, error) {
+	for _, stack := range army {
+		if stack.CreatureId == creatureID {
+			if stack.Count > math.MaxUint32-count {
+				return army, ErrStackFull
+			}
+			stack.Count += count
+			return army, nil
+		}
+	}
+	if len(army) >= ArmySlots {
+		return army, ErrArmyFull
+	}
+	return append(army, &heroesv1.Hero_Stack{CreatureId: creatureID, Count: count}), nil
+}
+
+// StartDay restores movement points & mana, mana above maximum is kept.
+func StartDay(hero *heroesv1.Hero) {
+	hero.MovementPoints = hero.MaxMovementPoints
+	hero.Mana = max(hero.Mana, MaxMana(hero.Stats))
+}
+
+// Block hides occupied tiles from pathfinding, e.g. ones other heroes stand on.
+func Block(m pathfinding.Map, occupied ...*mapv1.Tile_Coordinate) pathfinding.Map {
+	set := make(map[hex.Axial]bool, len(occupied))
+	for _, c := range occupied {
+		set[hex.FromCoordinate(c)] = true
+	}
+	return pathfinding.Filter(m, func(h hex.Axial) bool { return set[h] })
+}
+
+// Move walks hero along a path found by pathfinding while movement points last, every step costs
+// as much as the pathfinder accounted for it, e.g. terrain movement penalty or portaling cost.
+// Returns traveled steps including start; hero stops short if points run out.
+func Move(hero *heroesv1.Hero, path *pathfinding.Path) ([]hex.Axial, error) {
+	if len(path.Steps) == 0 || path.Steps[0] != hex.FromCoordinate(hero.Position) {
+		return nil, errors.New("path doesn't start at hero position")
+	}
+	if len(path.Costs) != len(path.Steps) {
+		return nil, errors.New("path is missing costs of its steps")
+	}
+
+	traveled := []hex.Axial{path.Steps[0]}
+	for i, step := range path.Steps[1:] {
+		cost := path.Costs[i+1]
+		if cost > hero.MovementPoints {
+			break
+		}
+		hero.MovementPoints -= cost
+		traveled = append(traveled, step)
+	}
+
+	c, ok := traveled[len(traveled)-1].Coordinate()
+	if !ok {
+		return nil, errors.New("path leaves the map")
+	}
+	hero.Position = c
+	return traveled, nil
+}
@@ -0,0 +1,180 @@
+package heroes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+var terrains = []*mapv1.Terrain{
+	{Id: "g", MovementPenalty: 100, PassableWith: []mapv1.Terrain_MovementType{mapv1.Terrain_MOVEMENT_TYPE_WALKING}},
+	{Id: "s", MovementPenalty: 300, PassableWith: []mapv1.Terrain_MovementType{mapv1.Terrain_MOVEMENT_TYPE_WALKING}},
+	{Id: "p", MovementPenalty: 300, PassableWith: []mapv1.Terrain_MovementType{mapv1.Terrain_MOVEMENT_TYPE_WALKING, mapv1.Terrain_MOVEMENT_TYPE_PORTALING}},
+}
+
+// row builds a single row map out of terrain ids, one character per tile.
+func row(ids string) *pathfinding.Grid {
+	var tiles []*mapv1.Tile
+	for column, id := range ids {
+		tiles = append(tiles, &mapv1.Tile{
+			Coordinate: &mapv1.Tile_Coordinate{Column: uint32(column)},
+			TerrainId:  string(id),
+		})
+	}
+	return pathfinding.NewGrid(terrains, tiles...)
+}
+
+func TestMove(t *testing.T) {
+	m := row("ggsgg")
+	hero := New("h", "g", "a", "Hero", &mapv1.Tile_Coordinate{}, nil)
+	hero.MovementPoints = 450
+
+	finder := pathfinding.NewFinder(m, Walker)
+	path, err := finder.Find(context.Background(), hex.FromOffset(0, 0, 0), hex.FromOffset(0, 4, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traveled, err := Move(hero, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traveled) != 3 || len(path.Steps) != 5 {
+		t.Fatalf("expected hero to stop before last step it can't afford, traveled %v", traveled)
+	}
+	if hero.Position.Column != 2 || hero.MovementPoints != 50 {
+		t.Fatalf("unexpected hero state: %v", hero)
+	}
+
+	StartDay(hero)
+	if hero.MovementPoints != DefaultMovementPoints {
+		t.Fatalf("expected movement points to be restored, got %d", hero.MovementPoints)
+	}
+}
+
+type connected struct {
+	*pathfinding.Grid
+	links map[hex.Axial][]hex.Axial
+}
+
+func (c *connected) Connections(h hex.Axial) []hex.Axial {
+	return c.links[h]
+}
+
+// levels builds a map of two single row levels out of terrain ids, one character per tile.
+func levels(top, bottom string) *pathfinding.Grid {
+	var tiles []*mapv1.Tile
+	for depth, ids := range []string{top, bottom} {
+		for column, id := range ids {
+			tiles = append(tiles, &mapv1.Tile{
+				Coordinate: &mapv1.Tile_Coordinate{Column: uint32(column), Depth: uint32(depth)},
+				TerrainId:  string(id),
+			})
+		}
+	}
+	return pathfinding.NewGrid(terrains, tiles...)
+}
+
+func TestMoveAcrossLevels(t *testing.T) {
+	start, goal := hex.FromOffset(0, 0, 0), hex.FromOffset(0, 1, 1)
+	portaling := &creaturesv1.Creature_Kind{MovementTypes: []creaturesv1.Creature_MovementType{
+		creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+		creaturesv1.Creature_MOVEMENT_TYPE_PORTALING,
+	}}
+	stairs := &connected{
+		Grid:  levels("gx", "sg"),
+		links: map[hex.Axial][]hex.Axial{start: {hex.FromOffset(0, 0, 1)}},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		m     pathfinding.Map
+		kind  *creaturesv1.Creature_Kind
+		costs []uint32
+	}{
+		// connectors cost entering the tile they lead to
+		{"connector", stairs, Walker, []uint32{0, 300, 100}},
+		// portaling costs the same whatever terrain is below
+		{"portal", levels("gx", "pg"), portaling, []uint32{0, pathfinding.PortalCost, 100}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hero := New("h", "g", "a", "Hero", &mapv1.Tile_Coordinate{}, nil)
+			path, err := pathfinding.Find(context.Background(), tc.m, tc.kind, start, goal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(path.Costs, tc.costs) {
+				t.Fatalf("expected step costs %v, got %v", tc.costs, path.Costs)
+			}
+
+			traveled, err := Move(hero, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(traveled) != len(path.Steps) || hex.FromCoordinate(hero.Position) != goal {
+				t.Fatalf("expected hero to arrive, traveled %v", traveled)
+			}
+			if spent := DefaultMovementPoints - hero.MovementPoints; spent != path.Cost {
+				t.Fatalf("expected hero to spend %d like pathfinding accounted, spent %d", path.Cost, spent)
+			}
+		})
+	}
+}
+
+func TestBlock(t *testing.T) {
+	m := Block(row("ggg"), &mapv1.Tile_Coordinate{Column: 1})
+	if _, err := pathfinding.Find(context.Background(), m, Walker, hex.FromOffset(0, 0, 0), hex.FromOffset(0, 2, 0)); !errors.Is(err, pathfinding.ErrNoPath) {
+		t.Fatalf("expected occupied tile to block the way, got %v", err)
+	}
+}
+
+func TestValidateArmy(t *testing.T) {
+	exists := func(id string) bool { return id == "peasant" }
+
+	if err := ValidateArmy([]*heroesv1.Hero_Stack{{CreatureId: "peasant", Count: 10}}, exists); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateArmy([]*heroesv1.Hero_Stack{{CreatureId: "dragon", Count: 1}, {CreatureId: "peasant"}}, exists); err == nil {
+		t.Fatal("expected unknown creature & empty stack to be rejected")
+	}
+	army := make([]*heroesv1.Hero_Stack, ArmySlots+1)
+	if err := ValidateArmy(army, exists); err == nil {
+		t.Fatal("expected oversized army to be rejected")
+	}
+}
+
+func TestReinforce(t *testing.T) {
+	army, err := Reinforce([]*heroesv1.Hero_Stack{{CreatureId: "peasant", Count: 10}}, "peasant", 5)
+	if err != nil || len(army) != 1 || army[0].Count != 15 {
+		t.Fatalf("expected creatures to join existing stack, got %v, %v", army, err)
+	}
+	army, err = Reinforce(army, "griffin", 2)
+	if err != nil || len(army) != 2 {
+		t.Fatalf("expected a new stack, got %v, %v", army, err)
+	}
+
+	full := make([]*heroesv1.Hero_Stack, ArmySlots)
+	for i := range full {
+		full[i] = &heroesv1.Hero_Stack{CreatureId: fmt.Sprint(i), Count: 1}
+	}
+	if _, err := Reinforce(full, "griffin", 1); !errors.Is(err, ErrArmyFull) {
+		t.Fatalf("expected full army to be rejected, got %v", err)
+	}
+
+	army = []*heroesv1.Hero_Stack{{CreatureId: "peasant", Count: math.MaxUint32 - 1}}
+	if _, err := Reinforce(army, "peasant", 2); !errors.Is(err, ErrStackFull) || army[0].Count != math.MaxUint32-1 {
+		t.Fatalf("expected overflowing stack to be rejected, got %v & %d", err, army[0].Count)
+	}
+	if _, err := Reinforce(army, "peasant", 1); err != nil || army[0].Count != math.MaxUint32 {
+		t.Fatalf("expected stack to fill up, got %v & %d", err, army[0].Count)
+	}
+}
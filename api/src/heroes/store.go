@@ -0,0 +1,79 @@
+package heroes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// List returns heroes of a game in order of recruitment.
+func List(ctx context.Context, q *db.Queries, gameID uuid.UUID) ([]*heroesv1.Hero, error) {
+	rows, err := q.ListHeroes(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("listing heroes: %w", err)
+	}
+	list := make([]*heroesv1.Hero, 0, len(rows))
+	for _, row := range rows {
+		hero := &heroesv1.Hero{}
+		if err := proto.Unmarshal(row.Data, hero); err != nil {
+			return nil, fmt.Errorf("decoding hero %q: %w", row.ID, err)
+		}
+		list = append(list, hero)
+	}
+	return list, nil
+}
+
+func Create(ctx context.Context, q *db.Queries, hero *heroesv1.Hero) error {
+	raw, err := proto.Marshal(hero)
+	if err != nil {
+		return fmt.Errorf("encoding hero: %w", err)
+	}
+	err = q.CreateHero(ctx, db.CreateHeroParams{
+		ID:      uuid.MustParse(hero.Id),
+		GameID:  uuid.MustParse(hero.GameId),
+		OwnerID: uuid.MustParse(hero.OwnerId),
+		Data:    raw,
+	})
+	if err != nil {
+		return fmt.Errorf("creating hero: %w", err)
+	}
+	return nil
+}
+
+func Save(ctx context.Context, q *db.Queries, hero *heroesv1.Hero) error {
+	raw, err := proto.Marshal(hero)
+	if err != nil {
+		return fmt.Errorf("encoding hero: %w", err)
+	}
+	if err := q.UpdateHero(ctx, db.UpdateHeroParams{ID: uuid.MustParse(hero.Id), Data: raw}); err != nil {
+		return fmt.Errorf("saving hero %q: %w", hero.Id, err)
+	}
+	return nil
+}
+
+// Delete removes a hero from the game, e.g. once defeated.
+func Delete(ctx context.Context, q *db.Queries, hero *heroesv1.Hero) error {
+	if err := q.DeleteHero(ctx, uuid.MustParse(hero.Id)); err != nil {
+		return fmt.Errorf("removing hero %q: %w", hero.Id, err)
+	}
+	return nil
+}
+
+// NewDay restores movement points & mana of every hero in a game.
+func NewDay(ctx context.Context, q *db.Queries, gameID uuid.UUID) error {
+	list, err := List(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+	for _, hero := range list {
+		StartDay(hero)
+		if err := Save(ctx, q, hero); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package hex implements geometry of the hexagonal grid used by map.v1 tiles.
+//
+// Tiles are pointy-topped and addressed by offset coordinates (row, column)
+// with every odd row shifted right by half a tile ("odd-r" layout).
+// Most computations happen in axial or cube coordinates instead,
+// which are converted from & to offset ones at the boundaries.
+// Different depths (map levels) never share any geometry:
+// neighbors, rings, lines, etc. always stay on the level of their origin.
+package hex
+
+import (
+	"math"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Axial is a hex position in axial coordinates, comparable & usable as map key.
+type Axial struct {
+	Q     int
+	R     int
+	Depth uint32
+}
+
+// Cube is a hex position in cube coordinates, where Q + R + S == 0.
+type Cube struct {
+	Q     int
+	R     int
+	S     int
+	Depth uint32
+}
+
+// Direction enumerates neighbors of a pointy-topped hex.
+type Direction int
+
+const (
+	East Direction = iota
+	NorthEast
+	NorthWest
+	West
+	SouthWest
+	SouthEast
+)
+
+var directions = [6]Axial{
+	East:      {Q: 1, R: 0},
+	NorthEast: {Q: 1, R: -1},
+	NorthWest: {Q: 0, R: -1},
+	West:      {Q: -1, R: 0},
+	SouthWest: {Q: -1, R: 1},
+	SouthEast: {Q: 0, R: 1},
+}
+
+// Directions lists all directions counter-clockwise, starting east.
+var Directions = [6]Direction{East, NorthEast, NorthWest, West, SouthWest, SouthEast}
+
+// Unreachable is a distance between hexes on different levels.
+const Unreachable = math.MaxInt
+
+func FromOffset(row, column int, depth uint32) Axial {
+	return Axial{
+		Q:     column - (row-(row&1))/2,
+		R:     row,
+		Depth: depth,
+	}
+}
+
+func FromCoordinate(c *mapv1.Tile_Coordinate) Axial {
+	return FromOffset(int(c.GetRow()), int(c.GetColumn()), c.GetDepth())
+}
+
+func (a Axial) Offset() (row, column int) {
+	return a.R, a.Q + (a.R-(a.R&1))/2
+}
+
+// Coordinate converts hex into a tile coordinate.
+// Returns false if hex lies outside of representable (non-negative) range.
+func (a Axial) Coordinate() (*mapv1.Tile_Coordinate, bool) {
+	row, column := a.Offset()
+	if row < 0 || column < 0 || row > math.MaxUint32 || column > math.MaxUint32 {
+		return nil, false
+	}
+	return &mapv1.Tile_Coordinate{
+		Row:    uint32(row),
+		Column: uint32(column),
+		Depth:  a.Depth,
+	}, true
+}
+
+// InGrid reports whether hex belongs to a grid of given dimensions.
+func (a Axial) InGrid(totalRows, totalColumns uint32) bool {
+	row, column := a.Offset()
+	return row >= 0 && column >= 0 && row < int(totalRows) && column < int(totalColumns)
+}
+
+func (a Axial) Cube() Cube {
+	return Cube{Q: a.Q, R: a.R, S: -a.Q - a.R, Depth: a.Depth}
+}
+
+func (c Cube) Axial() Axial {
+	return Axial{Q: c.Q, R: c.R, Depth: c.Depth}
+}
+
+func (a Axial) Add(b Axial) Axial {
+	return Axial{Q: a.Q + b.Q, R: a.R + b.R, Depth: a.Depth}
+}
+
+func (a Axial) Scale(k int) Axial {
+	return Axial{Q: a.Q * k, R: a.R * k, Depth: a.Depth}
+}
+
+func (a Axial) Neighbor(d Direction) Axial {
+	return a.Add(directions[d])
+}
+
+func (a Axial) Neighbors() [6]Axial {
+	var result [6]Axial
+	for i, d := range Directions {
+		result[i] = a.Neighbor(d)
+	}
+	return result
+}
+
+// Distance returns number of steps between hexes, or Unreachable for different levels.
+func Distance(a, b Axial) int {
+	if a.Depth != b.Depth {
+		return Unreachable
+	}
+	dq := a.Q - b.Q
+	dr := a.R - b.R
+	return (abs(dq) + abs(dr) + abs(dq+dr)) / 2
+}
+
+// Ring returns hexes exactly radius steps away from center, counter-clockwise.
+func Ring(center Axial, radius int) []Axial {
+	if radius < 0 {
+		return nil
+	}
+	if radius == 0 {
+		return []Axial{center}
+	}
+
+	result := make([]Axial, 0, 6*radius)
+	h := center.Add(directions[SouthWest].Scale(radius))
+	for _, d := range Directions {
+		for range radius {
+			result = append(result, h)
+			h = h.Neighbor(d)
+		}
+	}
+	return result
+}
+
+// Spiral returns hexes within radius steps from center, ordered ring by ring starting with center.
+func Spiral(center Axial, radius int) []Axial {
+	if radius < 0 {
+		return nil
+	}
+	result := make([]Axial, 0, 1+3*radius*(radius+1))
+	for r := range radius + 1 {
+		result = append(result, Ring(center, r)...)
+	}
+	return result
+}
+
+// Range returns hexes within radius steps from center, ordered by row & column.
+func Range(center Axial, radius int) []Axial {
+	if radius < 0 {
+		return nil
+	}
+	result := make([]Axial, 0, 1+3*radius*(radius+1))
+	for dr := -radius; dr <= radius; dr++ {
+		for dq := max(-radius, -dr-radius); dq <= min(radius, -dr+radius); dq++ {
+			result = append(result, Axial{Q: center.Q + dq, R: center.R + dr, Depth: center.Depth})
+		}
+	}
+	return result
+}
+
+// Line returns hexes on a straight line between a & b, inclusive.
+// Returns nil for hexes on different levels.
+func Line(a, b Axial) []Axial {
+	n := Distance(a, b)
+	if n == Unreachable {
+		return nil
+	}
+	if n == 0 {
+		return []Axial{a}
+	}
+
+	// nudge endpoints so that lines along hex edges are resolved consistently
+	const epsilon = 1e-6
+	aq, ar := float64(a.Q)+epsilon, float64(a.R)+epsilon
+	bq, br := float64(b.Q)+epsilon, float64(b.R)+epsilon
+
+	result := make([]Axial, 0, n+1)
+	for i := range n + 1 {
+		t := float64(i) / float64(n)
+		result = append(result, round(aq+(bq-aq)*t, ar+(br-ar)*t, a.Depth))
+	}
+	return result
+}
+
+// Clip drops hexes not belonging to a grid of given dimensions.
+func Clip(hexes []Axial, totalRows, totalColumns uint32) []Axial {
+	result := make([]Axial, 0, len(hexes))
+	for _, h := range hexes {
+		if h.InGrid(totalRows, totalColumns) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// Coordinates converts hexes into tile coordinates, skipping unrepresentable ones.
+func Coordinates(hexes []Axial) []*mapv1.Tile_Coordinate {
+	result := make([]*mapv1.Tile_Coordinate, 0, len(hexes))
+	for _, h := range hexes {
+		if c, ok := h.Coordinate(); ok {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func round(q, r float64, depth uint32) Axial {
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	}
+	return Axial{Q: int(rq), R: int(rr), Depth: depth}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
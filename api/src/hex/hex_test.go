@@ -0,0 +1,349 @@
+package hex
+
+import (
+	"slices"
+	"testing"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+func TestOffsetRoundTrip(t *testing.T) {
+	for depth := range uint32(3) {
+		for row := range 40 {
+			for column := range 40 {
+				a := FromOffset(row, column, depth)
+				if a.Cube().Q+a.Cube().R+a.Cube().S != 0 {
+					t.Fatalf("cube invariant broken for %+v", a.Cube())
+				}
+				if got := a.Cube().Axial(); got != a {
+					t.Fatalf("cube round trip: got %+v, want %+v", got, a)
+				}
+
+				c, ok := a.Coordinate()
+				if !ok {
+					t.Fatalf("coordinate not representable: %+v", a)
+				}
+				if int(c.Row) != row || int(c.Column) != column || c.Depth != depth {
+					t.Fatalf("offset round trip: got %v, want (%d, %d, %d)", c, row, column, depth)
+				}
+				if got := FromCoordinate(c); got != a {
+					t.Fatalf("coordinate round trip: got %+v, want %+v", got, a)
+				}
+			}
+		}
+	}
+}
+
+func TestNegativeOffsets(t *testing.T) {
+	for row := -9; row < 10; row++ {
+		for column := -9; column < 10; column++ {
+			gotRow, gotColumn := FromOffset(row, column, 0).Offset()
+			if gotRow != row || gotColumn != column {
+				t.Fatalf("got (%d, %d), want (%d, %d)", gotRow, gotColumn, row, column)
+			}
+		}
+	}
+
+	if _, ok := FromOffset(-1, 0, 0).Coordinate(); ok {
+		t.Error("negative row must not be representable")
+	}
+	if _, ok := FromOffset(0, -1, 0).Coordinate(); ok {
+		t.Error("negative column must not be representable")
+	}
+}
+
+func TestNeighborsOffset(t *testing.T) {
+	type offset struct{ row, column int }
+
+	tests := []struct {
+		name   string
+		origin offset
+		want   [6]offset
+	}{
+		{
+			name:   "even row",
+			origin: offset{2, 2},
+			want: [6]offset{
+				East:      {2, 3},
+				NorthEast: {1, 2},
+				NorthWest: {1, 1},
+				West:      {2, 1},
+				SouthWest: {3, 1},
+				SouthEast: {3, 2},
+			},
+		},
+		{
+			name:   "odd row",
+			origin: offset{3, 2},
+			want: [6]offset{
+				East:      {3, 3},
+				NorthEast: {2, 3},
+				NorthWest: {2, 2},
+				West:      {3, 1},
+				SouthWest: {4, 2},
+				SouthEast: {4, 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origin := FromOffset(tt.origin.row, tt.origin.column, 1)
+			for i, n := range origin.Neighbors() {
+				row, column := n.Offset()
+				if (offset{row, column}) != tt.want[i] {
+					t.Errorf("direction %d: got (%d, %d), want %v", i, row, column, tt.want[i])
+				}
+				if n.Depth != 1 {
+					t.Errorf("direction %d: depth changed to %d", i, n.Depth)
+				}
+				if d := Distance(origin, n); d != 1 {
+					t.Errorf("direction %d: distance %d", i, d)
+				}
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b Axial
+		want int
+	}{
+		{FromOffset(0, 0, 0), FromOffset(0, 0, 0), 0},
+		{FromOffset(0, 0, 0), FromOffset(0, 5, 0), 5},
+		{FromOffset(0, 0, 0), FromOffset(5, 0, 0), 5},
+		{FromOffset(0, 0, 0), FromOffset(4, 2, 0), 4},
+		{FromOffset(0, 0, 0), FromOffset(4, 3, 0), 5},
+		{FromOffset(1, 0, 0), FromOffset(2, 1, 0), 1},
+		{FromOffset(1, 1, 0), FromOffset(2, 0, 0), 2},
+		{FromOffset(0, 0, 0), FromOffset(0, 0, 1), Unreachable},
+	}
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%+v, %+v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Distance(tt.b, tt.a); got != tt.want {
+			t.Errorf("Distance(%+v, %+v) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestDistanceProperties(t *testing.T) {
+	var hexes []Axial
+	for row := range 8 {
+		for column := range 8 {
+			hexes = append(hexes, FromOffset(row, column, 0))
+		}
+	}
+
+	for _, a := range hexes {
+		for _, b := range hexes {
+			dab := Distance(a, b)
+			if dab != Distance(b, a) {
+				t.Fatalf("not symmetric: %+v, %+v", a, b)
+			}
+			if (dab == 0) != (a == b) {
+				t.Fatalf("zero distance between distinct hexes: %+v, %+v", a, b)
+			}
+			for _, c := range hexes {
+				if dab > Distance(a, c)+Distance(c, b) {
+					t.Fatalf("triangle inequality broken: %+v, %+v, %+v", a, b, c)
+				}
+			}
+		}
+	}
+}
+
+func TestRing(t *testing.T) {
+	center := FromOffset(10, 10, 2)
+
+	if got := Ring(center, -1); got != nil {
+		t.Errorf("negative radius: got %v", got)
+	}
+	if got := Ring(center, 0); !slices.Equal(got, []Axial{center}) {
+		t.Errorf("zero radius: got %v", got)
+	}
+
+	for radius := 1; radius <= 6; radius++ {
+		ring := Ring(center, radius)
+		if len(ring) != 6*radius {
+			t.Fatalf("radius %d: got %d hexes", radius, len(ring))
+		}
+
+		seen := make(map[Axial]bool, len(ring))
+		for i, h := range ring {
+			if d := Distance(center, h); d != radius {
+				t.Fatalf("radius %d: %+v is %d steps away", radius, h, d)
+			}
+			if seen[h] {
+				t.Fatalf("radius %d: duplicate %+v", radius, h)
+			}
+			seen[h] = true
+
+			next := ring[(i+1)%len(ring)]
+			if d := Distance(h, next); d != 1 {
+				t.Fatalf("radius %d: ring is not contiguous at %d", radius, i)
+			}
+		}
+	}
+}
+
+func TestSpiral(t *testing.T) {
+	center := FromOffset(5, 4, 1)
+
+	if got := Spiral(center, -1); got != nil {
+		t.Errorf("negative radius: got %v", got)
+	}
+
+	for radius := range 6 {
+		spiral := Spiral(center, radius)
+		if want := 1 + 3*radius*(radius+1); len(spiral) != want {
+			t.Fatalf("radius %d: got %d hexes, want %d", radius, len(spiral), want)
+		}
+		if spiral[0] != center {
+			t.Fatalf("radius %d: spiral does not start at center", radius)
+		}
+
+		last := 0
+		for _, h := range spiral {
+			d := Distance(center, h)
+			if d < last {
+				t.Fatalf("radius %d: spiral is not ordered by distance", radius)
+			}
+			last = d
+		}
+
+		if !sameSet(spiral, Range(center, radius)) {
+			t.Fatalf("radius %d: spiral differs from range", radius)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	center := FromOffset(7, 3, 0)
+
+	if got := Range(center, -1); got != nil {
+		t.Errorf("negative radius: got %v", got)
+	}
+
+	for radius := range 6 {
+		result := Range(center, radius)
+
+		var want []Axial
+		for row := -10; row < 25; row++ {
+			for column := -10; column < 25; column++ {
+				h := FromOffset(row, column, 0)
+				if Distance(center, h) <= radius {
+					want = append(want, h)
+				}
+			}
+		}
+		if !sameSet(result, want) {
+			t.Fatalf("radius %d: got %v, want %v", radius, result, want)
+		}
+		for _, h := range result {
+			if h.Depth != center.Depth {
+				t.Fatalf("radius %d: depth changed for %+v", radius, h)
+			}
+		}
+	}
+}
+
+func TestLine(t *testing.T) {
+	if got := Line(FromOffset(0, 0, 0), FromOffset(3, 3, 1)); got != nil {
+		t.Errorf("line across levels: got %v", got)
+	}
+	if got := Line(FromOffset(2, 2, 0), FromOffset(2, 2, 0)); len(got) != 1 {
+		t.Errorf("degenerate line: got %v", got)
+	}
+
+	straight := Line(FromOffset(0, 0, 0), FromOffset(0, 4, 0))
+	for i, h := range straight {
+		row, column := h.Offset()
+		if row != 0 || column != i {
+			t.Errorf("horizontal line: step %d is at (%d, %d)", i, row, column)
+		}
+	}
+
+	var hexes []Axial
+	for row := range 9 {
+		for column := range 9 {
+			hexes = append(hexes, FromOffset(row, column, 3))
+		}
+	}
+	for _, a := range hexes {
+		for _, b := range hexes {
+			line := Line(a, b)
+			n := Distance(a, b)
+			if len(line) != n+1 {
+				t.Fatalf("line %+v -> %+v: got %d hexes, want %d", a, b, len(line), n+1)
+			}
+			if line[0] != a || line[n] != b {
+				t.Fatalf("line %+v -> %+v: wrong endpoints %v", a, b, line)
+			}
+			for i := 1; i < len(line); i++ {
+				if Distance(line[i-1], line[i]) != 1 {
+					t.Fatalf("line %+v -> %+v: not contiguous at %d", a, b, i)
+				}
+				if line[i].Depth != 3 {
+					t.Fatalf("line %+v -> %+v: depth changed", a, b)
+				}
+			}
+		}
+	}
+}
+
+func TestClip(t *testing.T) {
+	clipped := Clip(Spiral(FromOffset(0, 0, 0), 2), 10, 10)
+	for _, h := range clipped {
+		if !h.InGrid(10, 10) {
+			t.Errorf("%+v is outside of grid", h)
+		}
+	}
+	// (0,0), (0,1), (0,2), (1,0), (1,1), (2,0), (2,1)
+	if len(clipped) != 7 {
+		t.Errorf("got %d hexes: %v", len(clipped), clipped)
+	}
+
+	clipped = Clip(Range(FromOffset(9, 9, 0), 1), 10, 10)
+	if len(clipped) != 3 {
+		t.Errorf("got %d hexes at bottom-right corner: %v", len(clipped), clipped)
+	}
+}
+
+func TestCoordinates(t *testing.T) {
+	coordinates := Coordinates([]Axial{
+		FromOffset(0, 0, 4),
+		FromOffset(-1, 0, 4),
+		FromOffset(3, 5, 4),
+	})
+	want := []*mapv1.Tile_Coordinate{
+		{Row: 0, Column: 0, Depth: 4},
+		{Row: 3, Column: 5, Depth: 4},
+	}
+	if len(coordinates) != len(want) {
+		t.Fatalf("got %v, want %v", coordinates, want)
+	}
+	for i := range want {
+		if coordinates[i].Row != want[i].Row || coordinates[i].Column != want[i].Column || coordinates[i].Depth != want[i].Depth {
+			t.Errorf("got %v, want %v", coordinates[i], want[i])
+		}
+	}
+}
+
+func sameSet(a, b []Axial) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[Axial]bool, len(a))
+	for _, h := range a {
+		set[h] = true
+	}
+	for _, h := range b {
+		if !set[h] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package identity
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const GitHubProvider = "github"
+
+// GitHub resolves OAuth access tokens of GitHub or any server implementing the same API.
+type GitHub struct {
+	name         string
+	clientID     string
+	clientSecret string
+	authorizeURL string
+	tokenURL     string
+	apiURL       string
+}
+
+type GitHubOption func(*GitHub)
+
+func WithGitHubURLs(authorizeURL, tokenURL, apiURL string) GitHubOption {
+	return func(g *GitHub) {
+		g.authorizeURL = authorizeURL
+		g.tokenURL = tokenURL
+		g.apiURL = apiURL
+	}
+}
+
+func NewGitHub(name, clientID, clientSecret string, opts ...GitHubOption) *GitHub {
+	g := &GitHub{
+		name:         name,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		authorizeURL: "https://github.com/login/oauth/authorize",
+		tokenURL:     "https://github.com/login/oauth/access_token",
+		apiURL:       "https://api.github.com",
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+func (g *GitHub) Name() string {
+	return g.name
+}
+
+func (g *GitHub) Kind() Kind {
+	return KindAccessToken
+}
+
+func (g *GitHub) ClientID() string {
+	return g.clientID
+}
+
+// Resolve looks up the user of the token, their primary email has to be verified to create accounts.
+// Tokens issued to other applications are rejected, they'd let those sign their users in here.
+func (g *GitHub) Resolve(ctx context.Context, credential string) (*Identity, error) {
+	// responds with 404 unless the token was issued to our client
+	check := http.Header{"Authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte(g.clientID+":"+g.clientSecret))}}
+	var app struct{}
+	err := postJSON(ctx, g.apiURL+"/applications/"+url.PathEscape(g.clientID)+"/token", check, map[string]string{"access_token": credential}, &app)
+	if rejected(err) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("checking token: %w", err)
+	}
+
+	header := http.Header{"Authorization": {"Bearer " + credential}}
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, g.apiURL+"/user", header, &user); rejected(err) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, g.apiURL+"/user/emails", header, &emails); err != nil {
+		return nil, fmt.Errorf("listing emails: %w", err)
+	}
+
+	i := &Identity{
+		Provider: g.name,
+		Subject:  strconv.FormatInt(user.ID, 10),
+		Name:     cmp.Or(user.Name, user.Login),
+		Picture:  user.AvatarURL,
+	}
+	for _, e := range emails {
+		if e.Primary {
+			i.Email, i.EmailVerified = e.Email, e.Verified
+		}
+	}
+	if user.ID == 0 || i.Email == "" {
+		return nil, fmt.Errorf("%w: user or email missing", ErrInvalidCredential)
+	}
+	return i, nil
+}
+
+// AuthorizeURL ignores the nonce, GitHub doesn't issue ID tokens.
+func (g *GitHub) AuthorizeURL(ctx context.Context, redirectURI, state, nonce string) (string, error) {
+	query := url.Values{
+		"client_id":    {g.clientID},
+		"scope":        {"read:user user:email"},
+		"redirect_uri": {redirectURI},
+		"state":        {state},
+	}
+	return g.authorizeURL + "?" + query.Encode(), nil
+}
+
+// Exchange returns the access token issued for the code.
+func (g *GitHub) Exchange(ctx context.Context, code, redirectURI string) (string, error) {
+	// errors are reported with 200 OK
+	var response struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	err := postForm(ctx, g.tokenURL, url.Values{
+		"client_id":     {g.clientID},
+		"client_secret": {g.clientSecret},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+	}, &response)
+	if rejected(err) {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	} else if err != nil {
+		return "", fmt.Errorf("exchanging code: %w", err)
+	}
+	if response.Error != "" || response.AccessToken == "" {
+		return "", fmt.Errorf("%w: %s: %s", ErrInvalidCredential, response.Error, response.ErrorDescription)
+	}
+	return response.AccessToken, nil
+}
@@ -0,0 +1,90 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestGitHub(t *testing.T) {
+	ctx := context.Background()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		response := map[string]string{"access_token": "token"}
+		if r.FormValue("code") != "good" {
+			response = map[string]string{"error": "bad_verification_code"}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	mux.HandleFunc("/applications/client/token", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AccessToken string `json:"access_token"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if id, secret, _ := r.BasicAuth(); r.Method != http.MethodPost || id != "client" || secret != "secret" || body.AccessToken != "token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"token": body.AccessToken})
+	})
+	authorized := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			// tokens of other applications work with the API too
+			if token := r.Header.Get("Authorization"); token != "Bearer token" && token != "Bearer foreign" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next(w, r)
+		}
+	}
+	mux.HandleFunc("/user", authorized(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 7, "login": "bravo"})
+	}))
+	mux.HandleFunc("/user/emails", authorized(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"email": "old@test.com", "verified": true},
+			{"email": "bravo@test.com", "primary": true, "verified": true},
+		})
+	}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	g := NewGitHub(GitHubProvider, "client", "secret", WithGitHubURLs(server.URL+"/authorize", server.URL+"/token", server.URL))
+	token, err := g.Exchange(ctx, "good", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	identity, err := g.Resolve(ctx, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Subject != "7" || identity.Email != "bravo@test.com" || !identity.EmailVerified || identity.Name != "bravo" {
+		t.Fatalf("unexpected identity: %+v", identity)
+	}
+
+	if _, err := g.Exchange(ctx, "bad", ""); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected rejected code to be invalid, got %v", err)
+	}
+	if _, err := g.Resolve(ctx, "revoked"); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected rejected token to be invalid, got %v", err)
+	}
+	if _, err := g.Resolve(ctx, "foreign"); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected token of another application to be invalid, got %v", err)
+	}
+
+	raw, err := g.AuthorizeURL(ctx, "http://localhost/callback", "state", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorize, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query := authorize.Query(); query.Get("state") != "state" || query.Get("redirect_uri") != "http://localhost/callback" {
+		t.Fatalf("expected attempt to be carried by %s", raw)
+	}
+}
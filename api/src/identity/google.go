@@ -0,0 +1,41 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/auth/credentials/idtoken"
+)
+
+const GoogleProvider = "google"
+
+// Google resolves ID tokens issued by Google Identity Services.
+type Google struct {
+	clientID string
+}
+
+func NewGoogle(clientID string) *Google {
+	return &Google{clientID: clientID}
+}
+
+func (g *Google) Name() string {
+	return GoogleProvider
+}
+
+func (g *Google) Kind() Kind {
+	return KindIDToken
+}
+
+func (g *Google) Resolve(ctx context.Context, credential string) (*Identity, error) {
+	payload, err := idtoken.Validate(ctx, credential, g.clientID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	}
+	c := &claims{Subject: payload.Subject}
+	c.Email, _ = payload.Claims["email"].(string)
+	verified, _ := payload.Claims["email_verified"].(bool)
+	c.EmailVerified = flexBool(verified)
+	c.Name, _ = payload.Claims["name"].(string)
+	c.Picture, _ = payload.Claims["picture"].(string)
+	return c.identity(GoogleProvider)
+}
@@ -0,0 +1,83 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var client = &http.Client{Timeout: 10 * time.Second}
+
+// statusError is returned for unexpected responses of providers.
+type statusError struct {
+	url  string
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s responded with %d", e.url, e.code)
+}
+
+// rejected reports whether the provider refused the request rather than failed to handle it.
+func rejected(err error) bool {
+	var se *statusError
+	return errors.As(err, &se) && se.code < http.StatusInternalServerError
+}
+
+func getJSON(ctx context.Context, target string, header http.Header, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	for k, values := range header {
+		request.Header[k] = values
+	}
+	return do(request, v)
+}
+
+func postForm(ctx context.Context, target string, form url.Values, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, target, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return do(request, v)
+}
+
+func postJSON(ctx context.Context, target string, header http.Header, body, v any) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	for k, values := range header {
+		request.Header[k] = values
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return do(request, v)
+}
+
+func do(request *http.Request, v any) error {
+	request.Header.Set("Accept", "application/json")
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return &statusError{url: request.URL.String(), code: response.StatusCode}
+	}
+	if err := json.NewDecoder(response.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding response of %s: %w", request.URL, err)
+	}
+	return nil
+}
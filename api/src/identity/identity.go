@@ -0,0 +1,104 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openhexes/openhexes/api/src/config"
+)
+
+var ErrInvalidCredential = errors.New("invalid credential")
+
+// Identity is a login resolved by a Provider, linked to an account on first use.
+type Identity struct {
+	Provider      string
+	Subject       string // stable id of the login within the provider
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+type Kind int
+
+const (
+	KindIDToken     Kind = iota + 1 // credential is an ID token signed by the provider
+	KindAccessToken                 // credential is an OAuth access token
+	KindPassword                    // credential is base64 of "email:password"
+)
+
+// Provider resolves credentials presented by clients, failing with ErrInvalidCredential for rejected ones.
+type Provider interface {
+	Name() string
+	Kind() Kind
+	Resolve(ctx context.Context, credential string) (*Identity, error)
+}
+
+// Exchanger is implemented by providers supporting the authorization code flow,
+// the exchanged credential is resolved by the same provider.
+type Exchanger interface {
+	ClientID() string
+	// AuthorizeURL starts an attempt sending users back to redirectURI along with the state,
+	// providers issuing ID tokens put the nonce in them, see WithNonce.
+	AuthorizeURL(ctx context.Context, redirectURI, state, nonce string) (string, error)
+	Exchange(ctx context.Context, code, redirectURI string) (string, error)
+}
+
+type nonceKey struct{}
+
+// WithNonce makes ID tokens resolved with the context carry the nonce of an authorization attempt.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	return context.WithValue(ctx, nonceKey{}, nonce)
+}
+
+func nonceFromContext(ctx context.Context) string {
+	nonce, _ := ctx.Value(nonceKey{}).(string)
+	return nonce
+}
+
+// Registry holds configured providers in the order credentials are looked up.
+type Registry struct {
+	providers []Provider
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	return &Registry{providers: providers}
+}
+
+// New sets up providers enabled by the config, test users replace Google in test mode.
+func New(cfg *config.Config) *Registry {
+	r := NewRegistry()
+	switch {
+	case cfg.Test.Enabled:
+		r.Register(NewStatic(GoogleProvider, testUsers(cfg)))
+	case cfg.Auth.Google.ClientID != "":
+		r.Register(NewGoogle(cfg.Auth.Google.ClientID))
+	}
+	if c := cfg.Auth.OIDC; c.Issuer != "" {
+		r.Register(NewOIDC(c.Name, c.Issuer, c.ClientID, WithClientSecret(c.ClientSecret)))
+	}
+	if c := cfg.Auth.GitHub; c.ClientID != "" {
+		r.Register(NewGitHub(GitHubProvider, c.ClientID, c.ClientSecret, WithGitHubURLs(c.AuthorizeURL, c.TokenURL, c.APIURL)))
+	}
+	if cfg.Auth.Password.Enabled {
+		r.Register(NewPassword(cfg))
+	}
+	return r
+}
+
+func (r *Registry) Register(p Provider) {
+	r.providers = append(r.providers, p)
+}
+
+func (r *Registry) Get(name string) Provider {
+	for _, p := range r.providers {
+		if p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (r *Registry) List() []Provider {
+	return r.providers
+}
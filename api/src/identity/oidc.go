@@ -0,0 +1,193 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// leeway tolerates clock skew between us & the issuer
+	leeway = time.Minute
+	// keys are refetched for unknown key ids at most this often
+	keysRefreshInterval = time.Minute
+)
+
+// OIDC resolves ID tokens of any OpenID Connect issuer, discovering its endpoints & signing keys.
+type OIDC struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+
+	mu        sync.Mutex
+	discovery *discovery
+	keys      map[string]crypto.PublicKey
+	refreshed time.Time
+}
+
+type OIDCOption func(*OIDC)
+
+// WithClientSecret enables exchanging authorization codes.
+func WithClientSecret(secret string) OIDCOption {
+	return func(o *OIDC) {
+		o.clientSecret = secret
+	}
+}
+
+func NewOIDC(name, issuer, clientID string, opts ...OIDCOption) *OIDC {
+	o := &OIDC{
+		name:     name,
+		issuer:   strings.TrimSuffix(issuer, "/"),
+		clientID: clientID,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func (o *OIDC) Name() string {
+	return o.name
+}
+
+func (o *OIDC) Kind() Kind {
+	return KindIDToken
+}
+
+func (o *OIDC) ClientID() string {
+	return o.clientID
+}
+
+func (o *OIDC) Resolve(ctx context.Context, credential string) (*Identity, error) {
+	d, err := o.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := &claims{}
+	err = verifyJWT(credential, func(kid string) (crypto.PublicKey, error) {
+		return o.key(ctx, d, kid)
+	}, c)
+	if err != nil {
+		return nil, err
+	}
+
+	now, nonce := time.Now(), nonceFromContext(ctx)
+	switch {
+	case c.Issuer != d.Issuer:
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidCredential, c.Issuer)
+	case !slices.Contains(c.Audience, o.clientID):
+		return nil, fmt.Errorf("%w: token is meant for %v", ErrInvalidCredential, c.Audience)
+	case now.After(time.Unix(c.Expiry, 0).Add(leeway)):
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidCredential)
+	case c.NotBefore != 0 && now.Add(leeway).Before(time.Unix(c.NotBefore, 0)):
+		return nil, fmt.Errorf("%w: token not valid yet", ErrInvalidCredential)
+	case nonce != "" && c.Nonce != nonce:
+		return nil, fmt.Errorf("%w: token was issued for another attempt", ErrInvalidCredential)
+	}
+	return c.identity(o.name)
+}
+
+func (o *OIDC) AuthorizeURL(ctx context.Context, redirectURI, state, nonce string) (string, error) {
+	d, err := o.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{
+		"client_id":     {o.clientID},
+		"response_type": {"code"},
+		"scope":         {"openid email profile"},
+		"redirect_uri":  {redirectURI},
+		"state":         {state},
+		"nonce":         {nonce},
+	}
+	return d.AuthorizationEndpoint + "?" + query.Encode(), nil
+}
+
+// Exchange returns the ID token issued for the code.
+func (o *OIDC) Exchange(ctx context.Context, code, redirectURI string) (string, error) {
+	d, err := o.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	var response struct {
+		IDToken string `json:"id_token"`
+	}
+	err = postForm(ctx, d.TokenEndpoint, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {o.clientID},
+		"client_secret": {o.clientSecret},
+	}, &response)
+	if rejected(err) {
+		return "", fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	} else if err != nil {
+		return "", fmt.Errorf("exchanging code: %w", err)
+	}
+	if response.IDToken == "" {
+		return "", fmt.Errorf("%w: no ID token issued", ErrInvalidCredential)
+	}
+	return response.IDToken, nil
+}
+
+// discover fetches the issuer configuration once, failed attempts are retried with the next call.
+func (o *OIDC) discover(ctx context.Context) (*discovery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.discovery != nil {
+		return o.discovery, nil
+	}
+
+	d := &discovery{}
+	if err := getJSON(ctx, o.issuer+"/.well-known/openid-configuration", nil, d); err != nil {
+		return nil, fmt.Errorf("discovering %q: %w", o.issuer, err)
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != o.issuer {
+		return nil, fmt.Errorf("discovering %q: issuer mismatch: %q", o.issuer, d.Issuer)
+	}
+	o.discovery = d
+	return d, nil
+}
+
+// key returns the signing key with given id, refetching keys of the issuer when it's unknown.
+func (o *OIDC) key(ctx context.Context, d *discovery, kid string) (crypto.PublicKey, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+	if o.keys != nil && time.Since(o.refreshed) < keysRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidCredential, kid)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, d.JWKSURI, nil, &set); err != nil {
+		return nil, fmt.Errorf("fetching keys of %q: %w", o.issuer, err)
+	}
+	o.keys, o.refreshed = map[string]crypto.PublicKey{}, time.Now()
+	for _, k := range set.Keys {
+		// keys of unsupported types are skipped, tokens signed with them are rejected
+		if public, err := k.publicKey(); err == nil {
+			o.keys[k.Kid] = public
+		}
+	}
+	if key, ok := o.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidCredential, kid)
+}
@@ -0,0 +1,166 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// issuer is a local stand-in for an OpenID Connect provider.
+type issuer struct {
+	*httptest.Server
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newIssuer(t *testing.T) *issuer {
+	t.Helper()
+	i := &issuer{}
+	var err error
+	if i.rsa, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+		t.Fatal(err)
+	}
+	if i.ec, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(discovery{
+			Issuer:                i.URL,
+			AuthorizationEndpoint: i.URL + "/authorize",
+			TokenEndpoint:         i.URL + "/token",
+			JWKSURI:               i.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		encode := func(n *big.Int) string { return base64.RawURLEncoding.EncodeToString(n.Bytes()) }
+		_ = json.NewEncoder(w).Encode(map[string][]jwk{"keys": {
+			{Kty: "RSA", Kid: "r", N: encode(i.rsa.N), E: encode(big.NewInt(int64(i.rsa.E)))},
+			{Kty: "EC", Kid: "e", Crv: "P-256", X: encode(i.ec.X), Y: encode(i.ec.Y)},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		claims := i.claims("client")
+		claims["nonce"] = "nonce"
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": i.sign(t, "r", claims)})
+	})
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+	return i
+}
+
+func (i *issuer) claims(audience string) map[string]any {
+	return map[string]any{
+		"iss":            i.URL,
+		"sub":            "42",
+		"aud":            audience,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"email":          "alfa@test.com",
+		"email_verified": "true",
+		"name":           "Alfa",
+	}
+}
+
+func (i *issuer) sign(t *testing.T, kid string, claims map[string]any) string {
+	t.Helper()
+	alg := map[string]string{"r": "RS256", "e": "ES256", "x": "RS256"}[kid]
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid})
+	payload, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+
+	var signature []byte
+	if alg == "RS256" {
+		var err error
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, i.rsa, crypto.SHA256, digest[:]); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		r, s, err := ecdsa.Sign(rand.Reader, i.ec, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestOIDC(t *testing.T) {
+	ctx := context.Background()
+	i := newIssuer(t)
+	o := NewOIDC("test", i.URL+"/", "client", WithClientSecret("secret"))
+
+	for _, kid := range []string{"r", "e"} {
+		identity, err := o.Resolve(ctx, i.sign(t, kid, i.claims("client")))
+		if err != nil {
+			t.Fatalf("key %q: %v", kid, err)
+		}
+		if identity.Provider != "test" || identity.Subject != "42" || !identity.EmailVerified {
+			t.Fatalf("unexpected identity: %+v", identity)
+		}
+	}
+
+	expired := i.claims("client")
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	tampered := i.sign(t, "r", i.claims("client"))
+	tampered = tampered[:len(tampered)-4] + "AAAA"
+	for name, token := range map[string]string{
+		"audience":    i.sign(t, "r", i.claims("other")),
+		"expired":     i.sign(t, "r", expired),
+		"unknown key": i.sign(t, "x", i.claims("client")),
+		"signature":   tampered,
+		"malformed":   "token",
+	} {
+		if _, err := o.Resolve(ctx, token); !errors.Is(err, ErrInvalidCredential) {
+			t.Errorf("%s: expected invalid credential, got %v", name, err)
+		}
+	}
+
+	raw, err := o.AuthorizeURL(ctx, "http://localhost/callback", "state", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorize, err := url.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := authorize.Query()
+	if query.Get("state") != "state" || query.Get("nonce") != "nonce" || query.Get("redirect_uri") != "http://localhost/callback" {
+		t.Fatalf("expected attempt to be carried by %s", raw)
+	}
+
+	token, err := o.Exchange(ctx, "good", "http://localhost/callback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Resolve(WithNonce(ctx, "nonce"), token); err != nil {
+		t.Fatalf("expected exchanged token to resolve, got %v", err)
+	}
+	if _, err := o.Resolve(WithNonce(ctx, "other"), token); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected token of another attempt to be invalid, got %v", err)
+	}
+	if _, err := o.Resolve(WithNonce(ctx, "nonce"), i.sign(t, "r", i.claims("client"))); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected token without nonce to be invalid, got %v", err)
+	}
+	if _, err := o.Exchange(ctx, "bad", "http://localhost/callback"); !errors.Is(err, ErrInvalidCredential) {
+		t.Fatalf("expected rejected code to be invalid, got %v", err)
+	}
+}
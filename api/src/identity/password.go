@@ -0,0 +1,157 @@
+package identity
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const PasswordProvider = "password"
+
+const (
+	HashArgon2id = "argon2id"
+	HashBcrypt   = "bcrypt"
+
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	argon2KeyLen  = 32
+)
+
+var ErrUnknownHash = errors.New("unknown password hash")
+
+// dummyHashes are checked against when the email is unknown, so it takes as long as a wrong password
+// & timing doesn't tell which emails have a password login.
+var dummyHashes = map[string]string{
+	HashArgon2id: "$argon2id$v=19$m=65536,t=1,p=4$b5y/jgQVdcf+RW6vGu1L6w$Z26NTtoYSpOvwc4G8dwGLXKcjZBCT8rABpVkb2W4fTU",
+	HashBcrypt:   "$2a$10$1Qpx81Xc5oripJJjpnNRQexDk7v2P9KZn3r/KhdtbeqSZEhFDO/ya",
+}
+
+// Password resolves email & password pairs against hashes of linked identities,
+// the email of an account is the subject of its password login.
+type Password struct {
+	cfg *config.Config
+}
+
+func NewPassword(cfg *config.Config) *Password {
+	return &Password{cfg: cfg}
+}
+
+func (p *Password) Name() string {
+	return PasswordProvider
+}
+
+func (p *Password) Kind() Kind {
+	return KindPassword
+}
+
+func (p *Password) Resolve(ctx context.Context, credential string) (*Identity, error) {
+	raw, err := base64.StdEncoding.DecodeString(credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredential, err)
+	}
+	email, password, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("%w: password missing", ErrInvalidCredential)
+	}
+
+	var linked db.LinkedIdentity
+	err = p.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		linked, err = q.GetLinkedIdentity(ctx, db.GetLinkedIdentityParams{Provider: PasswordProvider, Subject: NormalizeEmail(email)})
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if errors.Is(err, pgx.ErrNoRows) {
+		dummy, ok := dummyHashes[p.cfg.Auth.Password.Hash]
+		if !ok {
+			dummy = dummyHashes[HashBcrypt]
+		}
+		_, _ = CheckPassword(dummy, password)
+		return nil, ErrInvalidCredential
+	} else if err != nil {
+		return nil, fmt.Errorf("getting identity: %w", err)
+	}
+	if ok, err := CheckPassword(linked.PasswordHash.String, password); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, ErrInvalidCredential
+	}
+	return &Identity{
+		Provider: PasswordProvider,
+		Subject:  linked.Subject,
+		Email:    linked.Email,
+	}, nil
+}
+
+// NormalizeEmail makes emails differing in case or surrounding spaces the same subject.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// HashPassword hashes with a salt, algorithm is either HashArgon2id or HashBcrypt.
+func HashPassword(algorithm, password string) (string, error) {
+	switch algorithm {
+	case HashBcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		return string(hash), err
+	case HashArgon2id:
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+		return fmt.Sprintf(
+			"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version, argon2Memory, argon2Time, argon2Threads,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(key),
+		), nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownHash, algorithm)
+}
+
+// CheckPassword reports whether the password matches a hash made by HashPassword with any algorithm.
+func CheckPassword(hash, password string) (bool, error) {
+	if strings.HasPrefix(hash, "$2") {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != HashArgon2id {
+		return false, ErrUnknownHash
+	}
+	var (
+		version, memory uint32
+		time            uint32
+		threads         uint8
+	)
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, fmt.Errorf("%w: unsupported version", ErrUnknownHash)
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrUnknownHash, err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrUnknownHash, err)
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrUnknownHash, err)
+	}
+	key := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(expected)))
+	return subtle.ConstantTimeCompare(key, expected) == 1, nil
+}
@@ -0,0 +1,59 @@
+package identity
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPassword(t *testing.T) {
+	for _, algorithm := range []string{HashArgon2id, HashBcrypt} {
+		hash, err := HashPassword(algorithm, "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for password, expected := range map[string]bool{"correct horse": true, "battery staple": false} {
+			ok, err := CheckPassword(hash, password)
+			if err != nil {
+				t.Fatalf("%s: %v", algorithm, err)
+			}
+			if ok != expected {
+				t.Errorf("%s: expected %q to match=%v", algorithm, password, expected)
+			}
+		}
+	}
+
+	if _, err := HashPassword("md5", "x"); !errors.Is(err, ErrUnknownHash) {
+		t.Fatalf("expected unknown algorithm to be rejected, got %v", err)
+	}
+	if _, err := CheckPassword("plain", "plain"); !errors.Is(err, ErrUnknownHash) {
+		t.Fatalf("expected unknown hash to be rejected, got %v", err)
+	}
+}
+
+func TestDummyHashes(t *testing.T) {
+	for _, algorithm := range []string{HashArgon2id, HashBcrypt} {
+		hash, err := HashPassword(algorithm, "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		dummy := dummyHashes[algorithm]
+		// same parameters as fresh hashes, so checking takes as long
+		switch algorithm {
+		case HashArgon2id:
+			if params := strings.Split(hash, "$")[:4]; !slices.Equal(strings.Split(dummy, "$")[:4], params) {
+				t.Errorf("%s: expected dummy hash with parameters %v, got %q", algorithm, params, dummy)
+			}
+		case HashBcrypt:
+			if cost, err := bcrypt.Cost([]byte(dummy)); err != nil || cost != bcrypt.DefaultCost {
+				t.Errorf("%s: expected dummy hash of cost %d, got %d, %v", algorithm, bcrypt.DefaultCost, cost, err)
+			}
+		}
+		if ok, err := CheckPassword(dummy, "correct horse"); ok || err != nil {
+			t.Errorf("%s: expected dummy hash to be checked without matching, got %v, %v", algorithm, ok, err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openhexes/openhexes/api/src/config"
+)
+
+// Static resolves a fixed set of credentials, used for test users.
+type Static struct {
+	name       string
+	identities map[string]*Identity // credential -> identity
+}
+
+func NewStatic(name string, identities map[string]*Identity) *Static {
+	for _, i := range identities {
+		i.Provider = name
+	}
+	return &Static{
+		name:       name,
+		identities: identities,
+	}
+}
+
+func (s *Static) Name() string {
+	return s.name
+}
+
+func (s *Static) Kind() Kind {
+	return KindIDToken
+}
+
+func (s *Static) Resolve(ctx context.Context, credential string) (*Identity, error) {
+	i, ok := s.identities[credential]
+	if !ok {
+		return nil, ErrInvalidCredential
+	}
+	copied := *i
+	return &copied, nil
+}
+
+func testUser(email, name string, verified bool) *Identity {
+	return &Identity{
+		Subject:       email,
+		Email:         email,
+		EmailVerified: verified,
+		Name:          name,
+		Picture:       fmt.Sprintf("https://i.pravatar.cc/300?u=%s", email),
+	}
+}
+
+func testUsers(cfg *config.Config) map[string]*Identity {
+	return map[string]*Identity{
+		cfg.Test.Tokens.Owner:      testUser("owner@test.com", "Test Owner", true),
+		cfg.Test.Tokens.Unverified: testUser("unverified@test.com", "Test Unverified", false),
+		cfg.Test.Tokens.Alfa:       testUser("alfa@test.com", "Test Alfa", true),
+		cfg.Test.Tokens.Bravo:      testUser("bravo@test.com", "Test Bravo", true),
+	}
+}
@@ -0,0 +1,81 @@
+// Package magic checks whether spells may be cast and applies their effects on the adventure map.
+//
+// Terrain the caster stands on may raise or lower effective spell level and prevent casting
+// altogether, see effects.Engine.Spell. Effects in combat are applied by combat.Battle.
+package magic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/openhexes/openhexes/api/src/effects"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+var (
+	ErrPrevented     = errors.New("spell casting is prevented by terrain")
+	ErrNotEnoughMana = errors.New("not enough mana")
+	ErrWrongScope    = errors.New("spell can't be cast here")
+	ErrNoEffects     = errors.New("spell has no effects")
+)
+
+// Check validates a cast on given terrain by a caster having given mana and returns effective spell level.
+func Check(engine *effects.Engine, terrain *mapv1.Terrain, spell *magicv1.Spell, scope magicv1.Spell_Scope, mana uint32) (*effects.SpellResult, error) {
+	if spell.GetScope() != scope {
+		return nil, fmt.Errorf("%w: %q is a %s spell", ErrWrongScope, spell.GetId(), scopeName(spell.GetScope()))
+	}
+	if len(spell.GetEffects()) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrNoEffects, spell.GetId())
+	}
+	if mana < spell.GetManaCost() {
+		return nil, fmt.Errorf("%w: %q costs %d, %d left", ErrNotEnoughMana, spell.GetId(), spell.GetManaCost(), mana)
+	}
+
+	result := engine.Spell(terrain, spell, max(spell.GetLevel(), 1))
+	if !result.Castable {
+		var reasons []string
+		for _, step := range result.Trace {
+			if step.Attribute == effects.Casting {
+				reasons = append(reasons, step.Message)
+			}
+		}
+		return result, fmt.Errorf("%w: %s", ErrPrevented, strings.Join(reasons, ", "))
+	}
+	return result, nil
+}
+
+// Amount evaluates amount of a spell effect for given caster spell power & effective spell level.
+func Amount(a *magicv1.Spell_Amount, power, level int32) uint32 {
+	total := int64(a.GetBase()) +
+		int64(a.GetPerPower())*int64(max(power, 0)) +
+		int64(a.GetPerLevel())*int64(max(level-1, 0))
+	return uint32(min(total, int64(^uint32(0))))
+}
+
+// Adventure spends hero's mana and applies effects of an adventure spell cast at given level.
+func Adventure(hero *heroesv1.Hero, spell *magicv1.Spell, level int32) error {
+	if spell.GetTargeting() != magicv1.Spell_TARGETING_CASTER {
+		return fmt.Errorf("unsupported targeting %s", spell.GetTargeting())
+	}
+	for i, effect := range spell.GetEffects() {
+		if effect.GetModifyMovementPoints() == nil {
+			return fmt.Errorf("effect #%d doesn't apply on the adventure map", i)
+		}
+	}
+
+	hero.Mana -= spell.GetManaCost()
+	power := hero.GetStats().GetSpellPower()
+	for _, effect := range spell.GetEffects() {
+		gained := uint64(Amount(effect.GetModifyMovementPoints().GetAmount(), power, level))
+		hero.MovementPoints = uint32(min(uint64(hero.MovementPoints)+gained, math.MaxUint32))
+	}
+	return nil
+}
+
+func scopeName(scope magicv1.Spell_Scope) string {
+	return strings.ToLower(strings.TrimPrefix(scope.String(), "SCOPE_"))
+}
@@ -0,0 +1,131 @@
+package magic
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/effects"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var tailwind = &magicv1.Spell{
+	Id:        "tailwind",
+	Tags:      []string{"air"},
+	Level:     1,
+	ManaCost:  5,
+	Scope:     magicv1.Spell_SCOPE_ADVENTURE,
+	Targeting: magicv1.Spell_TARGETING_CASTER,
+	Effects: []*magicv1.Spell_Effect{{Kind: &magicv1.Spell_Effect_ModifyMovementPoints_{
+		ModifyMovementPoints: &magicv1.Spell_Effect_ModifyMovementPoints{
+			Amount: &magicv1.Spell_Amount{Base: 300, PerLevel: 100},
+		},
+	}}},
+}
+
+func TestCheck(t *testing.T) {
+	air := &magicv1.Spell_Filter{IncludeTags: []string{"air"}}
+	tests := []struct {
+		name    string
+		terrain *mapv1.Terrain
+		scope   magicv1.Spell_Scope
+		mana    uint32
+		level   int32
+		err     error
+	}{
+		{
+			name:    "plain terrain",
+			terrain: &mapv1.Terrain{Id: "grass"},
+			scope:   magicv1.Spell_SCOPE_ADVENTURE,
+			mana:    5,
+			level:   1,
+		},
+		{
+			name:    "wrong scope",
+			terrain: &mapv1.Terrain{Id: "grass"},
+			scope:   magicv1.Spell_SCOPE_COMBAT,
+			mana:    5,
+			err:     ErrWrongScope,
+		},
+		{
+			name:    "not enough mana",
+			terrain: &mapv1.Terrain{Id: "grass"},
+			scope:   magicv1.Spell_SCOPE_ADVENTURE,
+			mana:    4,
+			err:     ErrNotEnoughMana,
+		},
+		{
+			name: "level raised",
+			terrain: &mapv1.Terrain{Id: "peaks", Effects: []*mapv1.Terrain_Effect{
+				{Kind: &mapv1.Terrain_Effect_ModifySpellLevel_{ModifySpellLevel: &mapv1.Terrain_Effect_ModifySpellLevel{Filter: air, Delta: 2}}},
+			}},
+			scope: magicv1.Spell_SCOPE_ADVENTURE,
+			mana:  5,
+			level: 3,
+		},
+		{
+			name: "prevented at raised level",
+			terrain: &mapv1.Terrain{Id: "storm", Effects: []*mapv1.Terrain_Effect{
+				{Kind: &mapv1.Terrain_Effect_ModifySpellLevel_{ModifySpellLevel: &mapv1.Terrain_Effect_ModifySpellLevel{Filter: air, Delta: 1}}},
+				{Kind: &mapv1.Terrain_Effect_PreventSpellCasting_{PreventSpellCasting: &mapv1.Terrain_Effect_PreventSpellCasting{
+					Filter:   air,
+					LevelGte: proto.Int32(2),
+				}}},
+			}},
+			scope: magicv1.Spell_SCOPE_ADVENTURE,
+			mana:  5,
+			err:   ErrPrevented,
+		},
+	}
+
+	engine := effects.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Check(engine, tt.terrain, tailwind, tt.scope, tt.mana)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && result.Level != tt.level {
+				t.Fatalf("expected level %d, got %d", tt.level, result.Level)
+			}
+		})
+	}
+}
+
+func TestAmount(t *testing.T) {
+	a := &magicv1.Spell_Amount{Base: 10, PerPower: 5, PerLevel: 3}
+	for _, tc := range []struct {
+		power, level int32
+		want         uint32
+	}{
+		{power: 0, level: 1, want: 10},
+		{power: 2, level: 1, want: 20},
+		{power: 2, level: 3, want: 26},
+		{power: -4, level: 0, want: 10},
+	} {
+		if got := Amount(a, tc.power, tc.level); got != tc.want {
+			t.Errorf("power %d, level %d: expected %d, got %d", tc.power, tc.level, tc.want, got)
+		}
+	}
+}
+
+func TestAdventure(t *testing.T) {
+	hero := &heroesv1.Hero{Mana: 10, MovementPoints: 100}
+	if err := Adventure(hero, tailwind, 2); err != nil {
+		t.Fatal(err)
+	}
+	if hero.Mana != 5 || hero.MovementPoints != 500 {
+		t.Fatalf("unexpected hero state: %v", hero)
+	}
+
+	hero.MovementPoints = math.MaxUint32 - 1
+	if err := Adventure(hero, tailwind, 2); err != nil {
+		t.Fatal(err)
+	}
+	if hero.MovementPoints != math.MaxUint32 {
+		t.Fatalf("expected movement points to saturate, got %d", hero.MovementPoints)
+	}
+}
@@ -0,0 +1,177 @@
+package mapgen
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Biome maps a range of elevation & moisture to a terrain.
+// Zero max values are treated as unbounded.
+type Biome struct {
+	TerrainID    string
+	MinElevation float64
+	MaxElevation float64
+	MinMoisture  float64
+	MaxMoisture  float64
+
+	FeatureID      string  // optional landscape feature, e.g. trees
+	FeatureDensity float64 // share of tiles carrying the feature, [0, 1]
+}
+
+func (b *Biome) Includes(elevation, moisture float64) bool {
+	if elevation < b.MinElevation || (b.MaxElevation > 0 && elevation >= b.MaxElevation) {
+		return false
+	}
+	if moisture < b.MinMoisture || (b.MaxMoisture > 0 && moisture >= b.MaxMoisture) {
+		return false
+	}
+	return true
+}
+
+type Generator struct {
+	seed     int64
+	scale    float64
+	octaves  int
+	terrains map[string]*mapv1.Terrain
+	list     []*mapv1.Terrain
+	biomes   []Biome
+	levels   []Biome // below the surface
+
+	elevation *Noise
+	moisture  *Noise
+}
+
+type Option func(*Generator)
+
+// WithTerrains replaces the registered set of terrains biomes may refer to.
+func WithTerrains(terrains ...*mapv1.Terrain) Option {
+	return func(g *Generator) {
+		g.terrains = make(map[string]*mapv1.Terrain, len(terrains))
+		g.list = terrains
+		for _, t := range terrains {
+			g.terrains[t.GetId()] = t
+		}
+	}
+}
+
+// WithBiomes replaces biome classification rules of the surface, first matching biome wins.
+func WithBiomes(biomes ...Biome) Option {
+	return func(g *Generator) {
+		g.biomes = biomes
+	}
+}
+
+// WithUndergroundBiomes replaces biome classification rules of every level below the surface.
+func WithUndergroundBiomes(biomes ...Biome) Option {
+	return func(g *Generator) {
+		g.levels = biomes
+	}
+}
+
+// WithScale sets approximate size of landmasses, measured in tiles.
+func WithScale(scale float64) Option {
+	return func(g *Generator) {
+		g.scale = scale
+	}
+}
+
+func New(seed int64, opts ...Option) (*Generator, error) {
+	g := &Generator{
+		seed:    seed,
+		scale:   24,
+		octaves: 4,
+		biomes:  DefaultBiomes(),
+		levels:  DefaultUndergroundBiomes(),
+	}
+	WithTerrains(DefaultTerrains()...)(g)
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	if g.scale <= 0 {
+		return nil, fmt.Errorf("invalid scale: %f", g.scale)
+	}
+	if len(g.biomes) == 0 || len(g.levels) == 0 {
+		return nil, fmt.Errorf("no biomes configured")
+	}
+	for _, b := range append(slices.Clip(g.biomes), g.levels...) {
+		if _, ok := g.terrains[b.TerrainID]; !ok {
+			return nil, fmt.Errorf("biome refers to unknown terrain: %q", b.TerrainID)
+		}
+	}
+
+	g.elevation = NewNoise(splitMix(uint64(seed), 1))
+	g.moisture = NewNoise(splitMix(uint64(seed), 2))
+	return g, nil
+}
+
+func (g *Generator) Seed() int64 {
+	return g.seed
+}
+
+func (g *Generator) Terrain(id string) *mapv1.Terrain {
+	return g.terrains[id]
+}
+
+func (g *Generator) Terrains() []*mapv1.Terrain {
+	return g.list
+}
+
+// Tile generates a tile at given coordinate. Result only depends on seed & options.
+func (g *Generator) Tile(c *mapv1.Tile_Coordinate) *mapv1.Tile {
+	x, y := position(c)
+	elevation := g.elevation.Fractal(x/g.scale, y/g.scale, g.octaves, 0.5)
+	moisture := g.moisture.Fractal(x/g.scale, y/g.scale, g.octaves, 0.5)
+
+	tile := &mapv1.Tile{
+		Coordinate:    c,
+		RenderingSpec: &mapv1.Tile_RenderingSpec{},
+	}
+	biomes := g.biomes
+	if c.GetDepth() > 0 {
+		biomes = g.levels
+	}
+	for _, b := range biomes {
+		if !b.Includes(elevation, moisture) {
+			continue
+		}
+		tile.TerrainId = b.TerrainID
+		if b.FeatureID != "" && g.roll(c) < b.FeatureDensity {
+			tile.RenderingSpec.FeatureIds = append(tile.RenderingSpec.FeatureIds, b.FeatureID)
+		}
+		break
+	}
+	return tile
+}
+
+// roll returns a uniformly distributed value in [0, 1) range, unique per tile.
+func (g *Generator) roll(c *mapv1.Tile_Coordinate) float64 {
+	h := splitMix(uint64(g.seed), 3)
+	h = splitMix(h, uint64(c.GetRow()))
+	h = splitMix(h, uint64(c.GetColumn()))
+	h = splitMix(h, uint64(c.GetDepth()))
+	return float64(h>>11) / (1 << 53)
+}
+
+// position converts offset coordinates into a cartesian point,
+// taking into account odd rows being shifted by half a tile.
+func position(c *mapv1.Tile_Coordinate) (x, y float64) {
+	row := c.GetRow()
+	x = float64(c.GetColumn())
+	if row%2 == 1 {
+		x += 0.5
+	}
+	// separate levels into distinct noise regions
+	y = float64(row)*math.Sqrt(3)/2 + float64(c.GetDepth())*1024
+	return x, y
+}
+
+func splitMix(seed, stream uint64) uint64 {
+	z := seed + stream*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
@@ -0,0 +1,59 @@
+package mapgen
+
+import (
+	"testing"
+
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func generate(t *testing.T, seed int64, opts ...Option) []*mapv1.Tile {
+	t.Helper()
+	g, err := New(seed, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tiles []*mapv1.Tile
+	for depth := range uint32(2) {
+		for row := range uint32(32) {
+			for column := range uint32(32) {
+				tiles = append(tiles, g.Tile(&mapv1.Tile_Coordinate{Depth: depth, Row: row, Column: column}))
+			}
+		}
+	}
+	return tiles
+}
+
+func TestDeterministic(t *testing.T) {
+	for name, opts := range map[string][]Option{
+		"defaults": nil,
+		"scaled":   {WithScale(8)},
+	} {
+		first, second := generate(t, 42, opts...), generate(t, 42, opts...)
+		for i := range first {
+			if !proto.Equal(first[i], second[i]) {
+				t.Fatalf("%s: same seed generated different tiles: %v & %v", name, first[i], second[i])
+			}
+		}
+
+		other := generate(t, 43, opts...)
+		same := 0
+		for i := range first {
+			if proto.Equal(first[i], other[i]) {
+				same++
+			}
+		}
+		if same == len(first) {
+			t.Fatalf("%s: different seeds generated the same map", name)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(1, WithScale(0)); err == nil {
+		t.Fatal("expected error for zero scale")
+	}
+	if _, err := New(1, WithBiomes(Biome{TerrainID: "unknown"})); err == nil {
+		t.Fatal("expected error for biome with unknown terrain")
+	}
+}
@@ -0,0 +1,96 @@
+package mapgen
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// Noise is a seeded 2D gradient (Perlin) noise source.
+type Noise struct {
+	perm [512]uint8
+}
+
+func NewNoise(seed uint64) *Noise {
+	n := &Noise{}
+	rng := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+
+	p := rng.Perm(256)
+	for i := range 512 {
+		n.perm[i] = uint8(p[i&255])
+	}
+	return n
+}
+
+// At returns noise value in [-1, 1] range.
+func (n *Noise) At(x, y float64) float64 {
+	xf := math.Floor(x)
+	yf := math.Floor(y)
+	xi := int(xf) & 255
+	yi := int(yf) & 255
+	x -= xf
+	y -= yf
+
+	u := fade(x)
+	v := fade(y)
+
+	aa := n.perm[int(n.perm[xi])+yi]
+	ab := n.perm[int(n.perm[xi])+yi+1]
+	ba := n.perm[int(n.perm[xi+1])+yi]
+	bb := n.perm[int(n.perm[xi+1])+yi+1]
+
+	value := lerp(v,
+		lerp(u, grad(aa, x, y), grad(ba, x-1, y)),
+		lerp(u, grad(ab, x, y-1), grad(bb, x-1, y-1)),
+	)
+	return clamp(value, -1, 1)
+}
+
+// Fractal sums several octaves of noise, returning value in [0, 1] range.
+func (n *Noise) Fractal(x, y float64, octaves int, persistence float64) float64 {
+	var (
+		total     float64
+		amplitude = 1.0
+		frequency = 1.0
+		maxValue  float64
+	)
+	for range octaves {
+		total += n.At(x*frequency, y*frequency) * amplitude
+		maxValue += amplitude
+		amplitude *= persistence
+		frequency *= 2
+	}
+	return (total/maxValue + 1) / 2
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func grad(hash uint8, x, y float64) float64 {
+	switch hash & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
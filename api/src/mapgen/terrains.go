@@ -0,0 +1,71 @@
+package mapgen
+
+import (
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+const (
+	TerrainWater = "core/terrain/water"
+	TerrainSand  = "core/terrain/sand"
+	TerrainGrass = "core/terrain/grass"
+	TerrainSwamp = "core/terrain/swamp"
+	TerrainDirt  = "core/terrain/dirt"
+	TerrainRough = "core/terrain/rough"
+	TerrainSnow  = "core/terrain/snow"
+
+	TerrainSubterranean = "core/terrain/subterranean"
+	TerrainRock         = "core/terrain/rock"
+
+	FeatureTree = "core/feature/tree"
+	FeatureRock = "core/feature/rock"
+)
+
+var (
+	overland = []mapv1.Terrain_MovementType{
+		mapv1.Terrain_MOVEMENT_TYPE_WALKING,
+		mapv1.Terrain_MOVEMENT_TYPE_FLYING,
+		mapv1.Terrain_MOVEMENT_TYPE_PORTALING,
+	}
+	waterborne = []mapv1.Terrain_MovementType{
+		mapv1.Terrain_MOVEMENT_TYPE_SWIMMING,
+		mapv1.Terrain_MOVEMENT_TYPE_FLYING,
+		mapv1.Terrain_MOVEMENT_TYPE_PORTALING,
+	}
+)
+
+// DefaultTerrains is the set of terrains the generator can place out of the box.
+func DefaultTerrains() []*mapv1.Terrain {
+	return []*mapv1.Terrain{
+		{Id: TerrainWater, Tags: []string{"water"}, MovementPenalty: 100, PassableWith: waterborne},
+		{Id: TerrainSand, Tags: []string{"land"}, MovementPenalty: 150, PassableWith: overland},
+		{Id: TerrainGrass, Tags: []string{"land"}, MovementPenalty: 100, PassableWith: overland},
+		{Id: TerrainSwamp, Tags: []string{"land"}, MovementPenalty: 175, PassableWith: overland},
+		{Id: TerrainDirt, Tags: []string{"land"}, MovementPenalty: 100, PassableWith: overland},
+		{Id: TerrainRough, Tags: []string{"land"}, MovementPenalty: 125, PassableWith: overland},
+		{Id: TerrainSnow, Tags: []string{"land"}, MovementPenalty: 150, PassableWith: overland},
+		{Id: TerrainSubterranean, Tags: []string{"land", "underground"}, MovementPenalty: 100, PassableWith: overland},
+		{Id: TerrainRock, Tags: []string{"underground"}, MovementPenalty: 100},
+	}
+}
+
+// DefaultBiomes classifies tiles into DefaultTerrains.
+func DefaultBiomes() []Biome {
+	return []Biome{
+		{TerrainID: TerrainWater, MaxElevation: 0.43},
+		{TerrainID: TerrainSand, MaxElevation: 0.45},
+		{TerrainID: TerrainSnow, MinElevation: 0.61, FeatureID: FeatureRock, FeatureDensity: 0.1},
+		{TerrainID: TerrainRough, MinElevation: 0.57, FeatureID: FeatureRock, FeatureDensity: 0.25},
+		{TerrainID: TerrainSwamp, MinMoisture: 0.56, FeatureID: FeatureTree, FeatureDensity: 0.2},
+		{TerrainID: TerrainDirt, MaxMoisture: 0.45, FeatureID: FeatureRock, FeatureDensity: 0.05},
+		{TerrainID: TerrainGrass, FeatureID: FeatureTree, FeatureDensity: 0.3},
+	}
+}
+
+// DefaultUndergroundBiomes classifies tiles below the surface into caves carved out of solid rock.
+func DefaultUndergroundBiomes() []Biome {
+	return []Biome{
+		{TerrainID: TerrainRock, MaxElevation: 0.42},
+		{TerrainID: TerrainWater, MinMoisture: 0.62},
+		{TerrainID: TerrainSubterranean, FeatureID: FeatureRock, FeatureDensity: 0.1},
+	}
+}
@@ -0,0 +1,157 @@
+package mapstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/towns"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// kinds of features placed on maps, see CopyToGame
+const (
+	featureTown   = "town"
+	featureSite   = "site"
+	featureObject = "object"
+)
+
+func listFeatures(ctx context.Context, q *db.Queries, mapID uuid.UUID, kind string, decode func(raw []byte) error) error {
+	rows, err := q.ListMapFeatures(ctx, db.ListMapFeaturesParams{MapID: mapID, Kind: kind})
+	if err != nil {
+		return fmt.Errorf("listing %ss of map: %w", kind, err)
+	}
+	for _, row := range rows {
+		if err := decode(row.Data); err != nil {
+			return fmt.Errorf("decoding %s %q: %w", kind, row.ID, err)
+		}
+	}
+	return nil
+}
+
+func createFeature(ctx context.Context, q *db.Queries, mapID uuid.UUID, kind, id string, feature proto.Message) error {
+	raw, err := proto.Marshal(feature)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", kind, err)
+	}
+	err = q.CreateMapFeature(ctx, db.CreateMapFeatureParams{ID: uuid.MustParse(id), MapID: mapID, Kind: kind, Data: raw})
+	if err != nil {
+		return fmt.Errorf("creating %s of map: %w", kind, err)
+	}
+	return nil
+}
+
+// Towns returns towns placed on a map in order of placement.
+func Towns(ctx context.Context, q *db.Queries, mapID uuid.UUID) ([]*townsv1.Town, error) {
+	var list []*townsv1.Town
+	err := listFeatures(ctx, q, mapID, featureTown, func(raw []byte) error {
+		town := &townsv1.Town{}
+		list = append(list, town)
+		return proto.Unmarshal(raw, town)
+	})
+	return list, err
+}
+
+// CreateTown places a town on a map, its slot tells who owns it once a game starts.
+func CreateTown(ctx context.Context, q *db.Queries, mapID uuid.UUID, town *townsv1.Town) error {
+	return createFeature(ctx, q, mapID, featureTown, town.Id, town)
+}
+
+// copyTowns gives every town of the map to the player of its slot, towns of empty slots are neutral.
+func copyTowns(ctx context.Context, q *db.Queries, gameID, mapID uuid.UUID, slots map[uint32]uuid.UUID) error {
+	list, err := Towns(ctx, q, mapID)
+	if err != nil {
+		return err
+	}
+	for _, town := range list {
+		town.Id = uuid.NewString()
+		town.GameId = gameID.String()
+		if town.Slot != nil {
+			if owner, ok := slots[*town.Slot]; ok {
+				town.OwnerId = owner.String()
+			}
+		}
+		town.Slot = nil
+		if err := towns.Create(ctx, q, town); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sites returns mines & pickups placed on a map in order of placement.
+func Sites(ctx context.Context, q *db.Queries, mapID uuid.UUID) ([]*economyv1.Site, error) {
+	var list []*economyv1.Site
+	err := listFeatures(ctx, q, mapID, featureSite, func(raw []byte) error {
+		site := &economyv1.Site{}
+		list = append(list, site)
+		return proto.Unmarshal(raw, site)
+	})
+	return list, err
+}
+
+// CreateSite places a mine or pickup on a map.
+func CreateSite(ctx context.Context, q *db.Queries, mapID uuid.UUID, site *economyv1.Site) error {
+	return createFeature(ctx, q, mapID, featureSite, site.Id, site)
+}
+
+// copySites copies mines & pickups of the map, all of them start without owners.
+func copySites(ctx context.Context, q *db.Queries, gameID, mapID uuid.UUID) error {
+	list, err := Sites(ctx, q, mapID)
+	if err != nil {
+		return err
+	}
+	for _, site := range list {
+		site.Id = uuid.NewString()
+		site.GameId = gameID.String()
+		if err := economy.CreateSite(ctx, q, site); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Objects returns adventure objects placed on a map in order of placement.
+func Objects(ctx context.Context, q *db.Queries, mapID uuid.UUID) ([]*objectsv1.Object, error) {
+	var list []*objectsv1.Object
+	err := listFeatures(ctx, q, mapID, featureObject, func(raw []byte) error {
+		object := &objectsv1.Object{}
+		list = append(list, object)
+		return proto.Unmarshal(raw, object)
+	})
+	return list, err
+}
+
+// CreateObject places an adventure object on a map.
+func CreateObject(ctx context.Context, q *db.Queries, mapID uuid.UUID, object *objectsv1.Object) error {
+	return createFeature(ctx, q, mapID, featureObject, object.Id, object)
+}
+
+// copyObjects copies adventure objects of the map, teleporters keep pointing at copies of their targets.
+func copyObjects(ctx context.Context, q *db.Queries, gameID, mapID uuid.UUID) error {
+	list, err := Objects(ctx, q, mapID)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]string, len(list))
+	for _, object := range list {
+		ids[object.Id] = uuid.NewString()
+	}
+	for _, object := range list {
+		object.Id = ids[object.Id]
+		object.GameId = gameID.String()
+		if object.TargetId != "" {
+			object.TargetId = ids[object.TargetId]
+		}
+		if err := objects.Create(ctx, q, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Package mapstore reads & writes tiles of maps persisted in Postgres.
+// Every segment is stored as a serialized map.v1.Segment.
+package mapstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func LoadSegment(ctx context.Context, q *db.Queries, mapID uuid.UUID, p grid.Position) (*mapv1.Segment, error) {
+	raw, err := q.GetMapSegment(ctx, db.GetMapSegmentParams{
+		MapID:         mapID,
+		Depth:         int32(p.Depth),
+		SegmentRow:    int32(p.Row),
+		SegmentColumn: int32(p.Column),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	segment, err := DecodeSegment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decoding segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	return segment, nil
+}
+
+func SaveSegment(ctx context.Context, q *db.Queries, mapID uuid.UUID, p grid.Position, segment *mapv1.Segment) error {
+	raw, err := EncodeSegment(segment)
+	if err != nil {
+		return fmt.Errorf("encoding segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	err = q.UpsertMapSegment(ctx, db.UpsertMapSegmentParams{
+		MapID:         mapID,
+		Depth:         int32(p.Depth),
+		SegmentRow:    int32(p.Row),
+		SegmentColumn: int32(p.Column),
+		Data:          raw,
+	})
+	if err != nil {
+		return fmt.Errorf("saving segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	return nil
+}
+
+// EncodeSegment serializes segment the way it's stored.
+func EncodeSegment(segment *mapv1.Segment) ([]byte, error) {
+	return proto.Marshal(segment)
+}
+
+// DecodeSegment deserializes a stored segment.
+func DecodeSegment(raw []byte) (*mapv1.Segment, error) {
+	segment := &mapv1.Segment{}
+	if err := proto.Unmarshal(raw, segment); err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// Terrains resolves terrain definitions, e.g. content.Registry.
+type Terrains interface {
+	Terrain(id string) *mapv1.Terrain
+	Terrains() []*mapv1.Terrain
+}
+
+// Map lazily loads segments of a stored map as tiles are requested, so it's suitable
+// for pathfinding across large maps. It's bound to a transaction and not safe for concurrent use.
+type Map struct {
+	terrains Terrains
+	layout   grid.Layout
+	load     func(p grid.Position) (*mapv1.Segment, error)
+	segments map[grid.Position]*mapv1.Segment
+	err      error
+}
+
+// Open opens a stored map, seeing changes to it made by its owner.
+func Open(ctx context.Context, q *db.Queries, m *db.Map, terrains Terrains) *Map {
+	return open(conv.MapLayout(m), terrains, func(p grid.Position) (*mapv1.Segment, error) {
+		return LoadSegment(ctx, q, m.ID, p)
+	})
+}
+
+func open(layout grid.Layout, terrains Terrains, load func(p grid.Position) (*mapv1.Segment, error)) *Map {
+	return &Map{
+		terrains: terrains,
+		layout:   layout,
+		load:     load,
+		segments: map[grid.Position]*mapv1.Segment{},
+	}
+}
+
+func (m *Map) Terrain(id string) *mapv1.Terrain {
+	return m.terrains.Terrain(id)
+}
+
+func (m *Map) Terrains() []*mapv1.Terrain {
+	return m.terrains.Terrains()
+}
+
+func (m *Map) Layout() grid.Layout {
+	return m.layout
+}
+
+// Tile returns tile at given position, nil if there is none or it failed to load, see Err.
+func (m *Map) Tile(h hex.Axial) *mapv1.Tile {
+	c, ok := h.Coordinate()
+	if !ok {
+		return nil
+	}
+	segment := m.containing(c)
+	if segment == nil {
+		return nil
+	}
+
+	i, ok := grid.Index(segment, c)
+	if !ok {
+		return nil
+	}
+	return segment.Tiles[i]
+}
+
+// containing returns segment the coordinate lies in, nil if there is none or it failed to load.
+func (m *Map) containing(c *mapv1.Tile_Coordinate) *mapv1.Segment {
+	if !m.layout.Contains(c) {
+		return nil
+	}
+	row, column := m.layout.Locate(c)
+	segment, err := m.Segment(grid.Position{Depth: c.Depth, Row: row, Column: column})
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	return segment
+}
+
+// Connected returns the map with tiles linked by its connectors, for pathfinding across levels.
+// Maps with a single level are returned as is, so searching them stays guided by a heuristic.
+func (m *Map) Connected() pathfinding.Map {
+	if m.layout.Levels() == 1 {
+		return m
+	}
+	return &connected{Map: m}
+}
+
+type connected struct {
+	*Map
+}
+
+func (c *connected) Connections(h hex.Axial) []hex.Axial {
+	coordinate, ok := h.Coordinate()
+	if !ok {
+		return nil
+	}
+	var result []hex.Axial
+	for _, exit := range grid.Exits(c.containing(coordinate), coordinate) {
+		result = append(result, hex.FromCoordinate(exit))
+	}
+	return result
+}
+
+// Segment returns segment at given position, loading it once.
+func (m *Map) Segment(p grid.Position) (*mapv1.Segment, error) {
+	if segment, ok := m.segments[p]; ok {
+		return segment, nil
+	}
+	segment, err := m.load(p)
+	if err != nil {
+		return nil, err
+	}
+	m.segments[p] = segment
+	return segment, nil
+}
+
+// Err returns the last error of loading segments.
+func (m *Map) Err() error {
+	return m.err
+}
+
+// CopyToGame copies segments & features of the map a game is played on, see OpenGame.
+// Slots map player slots to accounts, they receive towns placed for their slot.
+func CopyToGame(ctx context.Context, q *db.Queries, gameID, mapID uuid.UUID, slots map[uint32]uuid.UUID) error {
+	if err := q.CopyGameMapSegments(ctx, db.CopyGameMapSegmentsParams{GameID: gameID, MapID: mapID}); err != nil {
+		return fmt.Errorf("copying map segments: %w", err)
+	}
+	if err := copyTowns(ctx, q, gameID, mapID, slots); err != nil {
+		return err
+	}
+	if err := copySites(ctx, q, gameID, mapID); err != nil {
+		return err
+	}
+	return copyObjects(ctx, q, gameID, mapID)
+}
+
+// OpenGame opens map a started game is played on. Its segments are read from the copy made
+// by CopyToGame, so owner editing the map doesn't affect the game. Layout of maps never changes.
+func OpenGame(ctx context.Context, q *db.Queries, gameID uuid.UUID, terrains Terrains) (*Map, error) {
+	g, err := q.GetGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("getting game: %w", err)
+	}
+	m, err := q.GetMap(ctx, db.GetMapParams{ID: g.MapID, OwnerID: g.HostID})
+	if err != nil {
+		return nil, fmt.Errorf("getting map: %w", err)
+	}
+	return open(conv.MapLayout(&m), terrains, func(p grid.Position) (*mapv1.Segment, error) {
+		return loadGameSegment(ctx, q, gameID, p)
+	}), nil
+}
+
+func loadGameSegment(ctx context.Context, q *db.Queries, gameID uuid.UUID, p grid.Position) (*mapv1.Segment, error) {
+	raw, err := q.GetGameMapSegment(ctx, db.GetGameMapSegmentParams{
+		GameID:        gameID,
+		Depth:         int32(p.Depth),
+		SegmentRow:    int32(p.Row),
+		SegmentColumn: int32(p.Column),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	segment, err := DecodeSegment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decoding segment %d:%d: %w", p.Row, p.Column, err)
+	}
+	return segment, nil
+}
@@ -0,0 +1,106 @@
+package mapstore
+
+import (
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapgen"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var stairs = &mapv1.Connector{
+	From: &mapv1.Tile_Coordinate{Row: 2, Column: 3},
+	To:   &mapv1.Tile_Coordinate{Row: 9, Column: 6, Depth: 1},
+	Kind: mapv1.Connector_KIND_STAIRWAY,
+}
+
+// segments generates every segment of a layout like CreateMap does, linking levels by stairs.
+func segments(t *testing.T, layout grid.Layout) map[grid.Position]*mapv1.Segment {
+	t.Helper()
+	generator, err := mapgen.New(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := map[grid.Position]*mapv1.Segment{}
+	for _, p := range layout.Positions() {
+		result[p] = layout.Segment(p, generator.Tile)
+	}
+	for _, end := range []*mapv1.Tile_Coordinate{stairs.From, stairs.To} {
+		row, column := layout.Locate(end)
+		grid.Connect(result[grid.Position{Depth: end.Depth, Row: row, Column: column}], stairs)
+	}
+	return result
+}
+
+func TestSegmentRoundTrip(t *testing.T) {
+	layout := conv.MapLayout(&db.Map{TotalRows: 10, TotalColumns: 7, RowsPerSegment: 4, ColumnsPerSegment: 3, Depths: 2})
+	for p, segment := range segments(t, layout) {
+		raw, err := EncodeSegment(segment)
+		if err != nil {
+			t.Fatalf("encoding segment %v: %s", p, err)
+		}
+		decoded, err := DecodeSegment(raw)
+		if err != nil {
+			t.Fatalf("decoding segment %v: %s", p, err)
+		}
+		if !proto.Equal(segment, decoded) {
+			t.Errorf("segment %v changed after round trip", p)
+		}
+	}
+
+	if _, err := DecodeSegment([]byte("not a segment")); err == nil {
+		t.Error("expected error decoding garbage")
+	}
+}
+
+func TestMap(t *testing.T) {
+	layout := conv.MapLayout(&db.Map{TotalRows: 10, TotalColumns: 7, RowsPerSegment: 4, ColumnsPerSegment: 3, Depths: 2})
+	stored := segments(t, layout)
+	loads := map[grid.Position]int{}
+	m := open(layout, nil, func(p grid.Position) (*mapv1.Segment, error) {
+		loads[p]++
+		return DecodeSegment(mustEncode(t, stored[p]))
+	})
+
+	for _, c := range []*mapv1.Tile_Coordinate{
+		{Row: 0, Column: 0},
+		{Row: 9, Column: 6},
+		{Row: 5, Column: 4, Depth: 1},
+		{Row: 5, Column: 5, Depth: 1},
+	} {
+		tile := m.Tile(hex.FromCoordinate(c))
+		if !proto.Equal(tile.GetCoordinate(), c) {
+			t.Errorf("expected tile %v, got %v", c, tile.GetCoordinate())
+		}
+	}
+	if tile := m.Tile(hex.FromOffset(10, 0, 0)); tile != nil {
+		t.Errorf("expected no tile outside of the map, got %v", tile)
+	}
+	for p, n := range loads {
+		if n != 1 {
+			t.Errorf("segment %v loaded %d times", p, n)
+		}
+	}
+
+	exits := m.Connected().(pathfinding.ConnectedMap).Connections(hex.FromCoordinate(stairs.To))
+	if len(exits) != 1 || exits[0] != hex.FromCoordinate(stairs.From) {
+		t.Errorf("expected stairs to lead to %v, got %v", stairs.From, exits)
+	}
+	if err := m.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func mustEncode(t *testing.T, segment *mapv1.Segment) []byte {
+	t.Helper()
+	raw, err := EncodeSegment(segment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
@@ -0,0 +1,255 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/combat"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/hex"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+)
+
+// Names of core handlers.
+const (
+	HandlerTreasure   = "treasure"
+	HandlerShrine     = "shrine"
+	HandlerDwelling   = "dwelling"
+	HandlerTeleporter = "teleporter"
+	HandlerGuard      = "guard"
+)
+
+// MaxBattleActions limits battles against guards, undecided ones end with the hero retreating.
+const MaxBattleActions = 1000
+
+// Visit is a hero entering an entrance of an object.
+// Handlers change the hero & the object in place and return the rest as an outcome.
+type Visit struct {
+	Hero     *heroesv1.Hero
+	Object   *objectsv1.Object
+	Kind     *objectsv1.Object_Kind
+	Day      uint32
+	Content  Content
+	Terrain  *mapv1.Terrain      // under the hero, battlefield of guards
+	Treasury *economyv1.Treasury // of the owner of the hero, read only
+	Objects  []*objectsv1.Object // all objects of the game, e.g. targets of teleporters
+	Occupied map[hex.Axial]bool  // tiles other heroes stand on
+}
+
+// Outcome of a visit applied by the caller.
+type Outcome struct {
+	Reason   string           // of resource changes, see economy reasons
+	Deltas   map[string]int64 // resources gained or spent by the owner of the hero
+	Removed  bool             // object is gone from the map, e.g. a looted treasure
+	Defeated bool             // hero lost a battle & is gone
+}
+
+// Handler runs interaction of a hero with an object.
+type Handler func(v *Visit) (*Outcome, error)
+
+// Registry maps handler names used by object kinds to their implementations.
+type Registry struct {
+	handlers map[string]Handler
+}
+
+func NewRegistry() *Registry {
+	return &Registry{handlers: map[string]Handler{}}
+}
+
+// Default returns a registry with handlers of core object kinds.
+func Default() *Registry {
+	r := NewRegistry()
+	r.Register(HandlerTreasure, Treasure)
+	r.Register(HandlerShrine, Shrine)
+	r.Register(HandlerDwelling, Dwelling)
+	r.Register(HandlerTeleporter, Teleporter)
+	r.Register(HandlerGuard, Guard)
+	return r
+}
+
+// Register adds a handler, replacing one registered under the same name.
+func (r *Registry) Register(name string, h Handler) {
+	r.handlers[name] = h
+}
+
+// Names lists registered handlers in lexical order.
+func (r *Registry) Names() []string {
+	return slices.Sorted(maps.Keys(r.handlers))
+}
+
+// Check reports object kinds referring to handlers that aren't registered.
+func (r *Registry) Check(kinds []*objectsv1.Object_Kind) error {
+	for _, k := range kinds {
+		if r.handlers[k.GetHandler()] == nil {
+			return fmt.Errorf("object %q: %w %q", k.GetId(), ErrUnknownHandler, k.GetHandler())
+		}
+	}
+	return nil
+}
+
+// Visit runs handler of the object kind & records the hero among visitors.
+func (r *Registry) Visit(v *Visit) (*Outcome, error) {
+	h := r.handlers[v.Kind.GetHandler()]
+	if h == nil {
+		return nil, fmt.Errorf("%w %q", ErrUnknownHandler, v.Kind.GetHandler())
+	}
+	outcome, err := h(v)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(v.Object.VisitedBy, v.Hero.Id) {
+		v.Object.VisitedBy = append(v.Object.VisitedBy, v.Hero.Id)
+	}
+	return outcome, nil
+}
+
+// Treasure grants its resources once & disappears.
+func Treasure(v *Visit) (*Outcome, error) {
+	deltas := make(map[string]int64, len(v.Kind.GetResources()))
+	for id, amount := range v.Kind.GetResources() {
+		deltas[id] = int64(amount)
+	}
+	return &Outcome{Reason: economy.ReasonPickup, Deltas: deltas, Removed: true}, nil
+}
+
+// Shrine teaches its spells to heroes who don't know them yet.
+func Shrine(v *Visit) (*Outcome, error) {
+	for _, id := range v.Kind.GetSpellIds() {
+		if !slices.Contains(v.Hero.Spells, id) {
+			v.Hero.Spells = append(v.Hero.Spells, id)
+		}
+	}
+	return &Outcome{}, nil
+}
+
+// Dwelling is flagged by the visiting player, available creatures join the hero as long as
+// the player can afford them & the army has room for them.
+func Dwelling(v *Visit) (*Outcome, error) {
+	v.Object.OwnerId = v.Hero.OwnerId
+
+	count := min(v.Object.Available, Affordable(v.Treasury, v.Kind.GetCost()))
+	if count == 0 {
+		return &Outcome{}, nil
+	}
+	army, err := heroes.Reinforce(v.Hero.Army, v.Kind.GetCreatureId(), count)
+	if errors.Is(err, heroes.ErrArmyFull) || errors.Is(err, heroes.ErrStackFull) {
+		return &Outcome{}, nil // only flagged
+	} else if err != nil {
+		return nil, err
+	}
+	v.Hero.Army = army
+	v.Object.Available -= count
+	return &Outcome{Reason: economy.ReasonRecruit, Deltas: economy.Cost(v.Kind.GetCost(), count)}, nil
+}
+
+// Affordable returns how many times the cost can be paid, unlimited if there's no cost.
+func Affordable(t *economyv1.Treasury, cost map[string]uint32) uint32 {
+	n := ^uint32(0)
+	for id, amount := range cost {
+		if amount > 0 {
+			n = uint32(min(uint64(n), t.GetAmounts()[id]/uint64(amount)))
+		}
+	}
+	return n
+}
+
+// Teleporter moves the hero to a free entrance of the linked object, if there is one.
+func Teleporter(v *Visit) (*Outcome, error) {
+	var target *objectsv1.Object
+	for _, o := range v.Objects {
+		if o.Id == v.Object.TargetId && o.Id != v.Object.Id {
+			target = o
+		}
+	}
+	if target == nil {
+		return &Outcome{}, nil
+	}
+	for _, h := range Entrances(target, v.Content.Object(target.KindId)) {
+		if v.Occupied[h] {
+			continue
+		}
+		if c, ok := h.Coordinate(); ok {
+			v.Hero.Position = c
+			break
+		}
+	}
+	return &Outcome{}, nil
+}
+
+// Guard fights the hero with its army: beaten guards disappear, a beaten hero is gone.
+// Survivors of both sides stay wounded if the battle is undecided.
+func Guard(v *Visit) (*Outcome, error) {
+	if len(v.Object.Army) == 0 {
+		return &Outcome{Removed: true}, nil
+	}
+	if len(v.Hero.Army) == 0 {
+		return &Outcome{Defeated: true}, nil
+	}
+
+	var stacks []combat.Stack
+	for _, side := range []struct {
+		side combat.Side
+		army []*heroesv1.Hero_Stack
+	}{
+		{side: combat.Attacker, army: v.Hero.Army},
+		{side: combat.Defender, army: v.Object.Army},
+	} {
+		for i, s := range side.army {
+			kind := v.Content.Creature(s.CreatureId)
+			if kind == nil {
+				return nil, fmt.Errorf("unknown creature %q", s.CreatureId)
+			}
+			stacks = append(stacks, combat.Stack{ID: stackID(side.side, i), Side: side.side, Kind: kind, Count: s.Count})
+		}
+	}
+	b, err := combat.New(seed(v), v.Terrain, stacks)
+	if err != nil {
+		return nil, fmt.Errorf("starting battle: %w", err)
+	}
+	for range MaxBattleActions {
+		if _, over := b.Winner(); over {
+			break
+		}
+		if _, err := b.Act(combat.Auto(b)); err != nil {
+			return nil, fmt.Errorf("fighting guards: %w", err)
+		}
+	}
+
+	v.Hero.Army = survivors(b, combat.Attacker, v.Hero.Army)
+	v.Object.Army = survivors(b, combat.Defender, v.Object.Army)
+	winner, over := b.Winner()
+	return &Outcome{
+		Removed:  over && winner == combat.Attacker,
+		Defeated: over && winner == combat.Defender,
+	}, nil
+}
+
+func stackID(side combat.Side, i int) string {
+	return fmt.Sprintf("%s/%d", side, i)
+}
+
+// survivors returns stacks of an army with creatures left after the battle.
+func survivors(b *combat.Battle, side combat.Side, army []*heroesv1.Hero_Stack) []*heroesv1.Hero_Stack {
+	var result []*heroesv1.Hero_Stack
+	for i, s := range army {
+		if u := b.Unit(stackID(side, i)); u != nil && u.Alive() {
+			s.Count = u.Count
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// seed makes battles against guards reproducible: the same hero attacking on the same day fights the same battle.
+func seed(v *Visit) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s/%d", v.Object.Id, v.Hero.Id, v.Day)
+	return int64(h.Sum64())
+}
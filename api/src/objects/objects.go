@@ -0,0 +1,140 @@
+// Package objects places interactive objects on the adventure map, e.g. treasure chests,
+// shrines, dwellings, teleporters & guards.
+//
+// An object covers tiles of its footprint, which heroes can't walk through. Some of them
+// are entrances: a hero ending its path on an entrance visits the object, running
+// a handler registered for the object kind, see Registry. Object kinds come from content
+// packs, handlers are implemented in Go & shared by any number of kinds.
+package objects
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrUnknownKind    = errors.New("unknown object kind")
+	ErrUnknownHandler = errors.New("unknown handler")
+	ErrOverlap        = errors.New("objects overlap")
+)
+
+// Kinds provides object kinds by id, e.g. content.Registry.
+type Kinds interface {
+	Object(id string) *objectsv1.Object_Kind
+}
+
+// Content provides definitions handlers depend on, e.g. content.Registry.
+type Content interface {
+	Kinds
+	Creature(id string) *creaturesv1.Creature_Kind
+}
+
+// New returns an object of given kind anchored at the position, dwellings start with their weekly growth.
+func New(id, gameID string, position *mapv1.Tile_Coordinate, kind *objectsv1.Object_Kind) *objectsv1.Object {
+	o := &objectsv1.Object{
+		Id:        id,
+		GameId:    gameID,
+		KindId:    kind.GetId(),
+		Position:  position,
+		Available: kind.GetWeeklyGrowth(),
+	}
+	for _, stack := range kind.GetGuards() {
+		o.Army = append(o.Army, proto.Clone(stack).(*heroesv1.Hero_Stack))
+	}
+	return o
+}
+
+// Footprint returns tiles covered by an object, including its entrances.
+func Footprint(o *objectsv1.Object, kind *objectsv1.Object_Kind) []hex.Axial {
+	return place(o, kind.GetFootprint())
+}
+
+// Entrances returns tiles heroes visit an object from.
+func Entrances(o *objectsv1.Object, kind *objectsv1.Object_Kind) []hex.Axial {
+	return place(o, kind.GetEntrances())
+}
+
+func place(o *objectsv1.Object, offsets []*objectsv1.Object_Offset) []hex.Axial {
+	anchor := hex.FromCoordinate(o.GetPosition())
+	if len(offsets) == 0 {
+		return []hex.Axial{anchor}
+	}
+	result := make([]hex.Axial, 0, len(offsets))
+	for _, offset := range offsets {
+		result = append(result, anchor.Add(hex.Axial{Q: int(offset.GetQ()), R: int(offset.GetR())}))
+	}
+	return result
+}
+
+// Layer indexes tiles covered by objects of a game.
+type Layer struct {
+	covered   map[hex.Axial]*objectsv1.Object
+	entrances map[hex.Axial]*objectsv1.Object
+}
+
+// NewLayer indexes objects, they must not overlap each other.
+func NewLayer(list []*objectsv1.Object, kinds Kinds) (*Layer, error) {
+	l := &Layer{
+		covered:   map[hex.Axial]*objectsv1.Object{},
+		entrances: map[hex.Axial]*objectsv1.Object{},
+	}
+	for _, o := range list {
+		if err := l.Add(o, kinds.Object(o.KindId)); err != nil {
+			return nil, fmt.Errorf("object %q: %w", o.Id, err)
+		}
+	}
+	return l, nil
+}
+
+// Add places an object on the layer unless it covers tiles of another one.
+func (l *Layer) Add(o *objectsv1.Object, kind *objectsv1.Object_Kind) error {
+	if kind == nil {
+		return fmt.Errorf("%w %q", ErrUnknownKind, o.KindId)
+	}
+	footprint := Footprint(o, kind)
+	for _, h := range footprint {
+		if other := l.covered[h]; other != nil {
+			return fmt.Errorf("%w: %q covers %v", ErrOverlap, other.Id, h)
+		}
+	}
+	for _, h := range footprint {
+		l.covered[h] = o
+	}
+	for _, h := range Entrances(o, kind) {
+		l.entrances[h] = o
+	}
+	return nil
+}
+
+// At returns object covering the tile, nil if there is none.
+func (l *Layer) At(h hex.Axial) *objectsv1.Object {
+	return l.covered[h]
+}
+
+// Entrance returns object visited from the tile, nil if there is none.
+func (l *Layer) Entrance(h hex.Axial) *objectsv1.Object {
+	return l.entrances[h]
+}
+
+// Block hides tiles covered by objects from pathfinding, except given open ones:
+// heroes may step off an entrance they stand on & onto the entrance they head to,
+// but never walk through an object.
+func (l *Layer) Block(m pathfinding.Map, open ...hex.Axial) pathfinding.Map {
+	set := make(map[hex.Axial]bool, len(open))
+	for _, h := range open {
+		if l.entrances[h] != nil {
+			set[h] = true
+		}
+	}
+	return pathfinding.Filter(m, func(h hex.Axial) bool {
+		return l.covered[h] != nil && !set[h]
+	})
+}
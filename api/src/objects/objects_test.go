@@ -0,0 +1,222 @@
+package objects
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+)
+
+var (
+	grass = &mapv1.Terrain{Id: "grass", MovementPenalty: 100, PassableWith: []mapv1.Terrain_MovementType{mapv1.Terrain_MOVEMENT_TYPE_WALKING}}
+
+	walker = &creaturesv1.Creature_Kind{
+		MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+	}
+	swordsman = &creaturesv1.Creature_Kind{
+		Id:            "swordsman",
+		MovementTypes: walker.MovementTypes,
+		Stats:         &creaturesv1.Creature_Stats{Attack: 10, Defence: 12, MinDamage: 6, MaxDamage: 9, Health: 35, Speed: 5},
+	}
+	rat = &creaturesv1.Creature_Kind{
+		Id:            "rat",
+		MovementTypes: walker.MovementTypes,
+		Stats:         &creaturesv1.Creature_Stats{Attack: 1, Defence: 1, MinDamage: 1, MaxDamage: 1, Health: 2, Speed: 3},
+	}
+)
+
+type content map[string]*objectsv1.Object_Kind
+
+func (c content) Object(id string) *objectsv1.Object_Kind {
+	return c[id]
+}
+
+func (c content) Creature(id string) *creaturesv1.Creature_Kind {
+	return map[string]*creaturesv1.Creature_Kind{"swordsman": swordsman, "rat": rat}[id]
+}
+
+var kinds = content{
+	"chest":  {Id: "chest", Handler: HandlerTreasure, Resources: map[string]uint32{"gold": 1000}},
+	"shrine": {Id: "shrine", Handler: HandlerShrine, SpellIds: []string{"haste"}},
+	"nest": {
+		Id:           "nest",
+		Handler:      HandlerDwelling,
+		CreatureId:   "griffin",
+		WeeklyGrowth: 4,
+		Cost:         map[string]uint32{"gold": 200},
+	},
+	"gate":  {Id: "gate", Handler: HandlerTeleporter},
+	"rats":  {Id: "rats", Handler: HandlerGuard, Guards: []*heroesv1.Hero_Stack{{CreatureId: "rat", Count: 5}}},
+	"horde": {Id: "horde", Handler: HandlerGuard, Guards: []*heroesv1.Hero_Stack{{CreatureId: "swordsman", Count: 50}}},
+	// a wall three tiles wide, entered from the middle
+	"wall": {
+		Id:        "wall",
+		Handler:   HandlerShrine,
+		Footprint: []*objectsv1.Object_Offset{{Q: -1}, {}, {Q: 1}},
+		Entrances: []*objectsv1.Object_Offset{{}},
+	},
+}
+
+func at(row, column int) *mapv1.Tile_Coordinate {
+	return &mapv1.Tile_Coordinate{Row: uint32(row), Column: uint32(column)}
+}
+
+func object(id, kind string, position *mapv1.Tile_Coordinate) *objectsv1.Object {
+	return New(id, "game", position, kinds[kind])
+}
+
+// field returns a map of grass tiles of given size.
+func field(rows, columns int) *pathfinding.Grid {
+	var tiles []*mapv1.Tile
+	for row := range rows {
+		for column := range columns {
+			tiles = append(tiles, &mapv1.Tile{Coordinate: at(row, column), TerrainId: grass.Id})
+		}
+	}
+	return pathfinding.NewGrid([]*mapv1.Terrain{grass}, tiles...)
+}
+
+func TestLayer(t *testing.T) {
+	wall := object("w", "wall", at(2, 2))
+	layer, err := NewLayer([]*objectsv1.Object{wall}, kinds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, column := range []int{1, 2, 3} {
+		if layer.At(hex.FromCoordinate(at(2, column))) != wall {
+			t.Errorf("expected wall to cover column %d", column)
+		}
+	}
+	if layer.Entrance(hex.FromCoordinate(at(2, 1))) != nil || layer.Entrance(hex.FromCoordinate(at(2, 2))) != wall {
+		t.Fatal("expected the middle of the wall to be its only entrance")
+	}
+	if err := layer.Add(object("c", "chest", at(2, 3)), kinds["chest"]); !errors.Is(err, ErrOverlap) {
+		t.Fatalf("expected overlapping objects to be rejected, got %v", err)
+	}
+}
+
+func TestBlock(t *testing.T) {
+	m := field(5, 5)
+	layer, err := NewLayer([]*objectsv1.Object{object("w", "wall", at(2, 2))}, kinds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, entrance := hex.FromCoordinate(at(1, 2)), hex.FromCoordinate(at(2, 2))
+	below := hex.FromCoordinate(at(3, 2))
+
+	// heroes walk around the wall instead of through its entrance
+	path, err := pathfinding.Find(context.Background(), layer.Block(m, start, below), walker, start, below)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slices.Contains(path.Steps, entrance) {
+		t.Fatalf("expected path to avoid the entrance, got %v", path.Steps)
+	}
+	if len(path.Steps) <= 3 {
+		t.Fatalf("expected a detour, got %v", path.Steps)
+	}
+
+	// entrances are only entered as the goal
+	path, err = pathfinding.Find(context.Background(), layer.Block(m, start, entrance), walker, start, entrance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path.Steps) != 2 {
+		t.Fatalf("expected a single step onto the entrance, got %v", path.Steps)
+	}
+	side := hex.FromCoordinate(at(2, 1))
+	if _, err := pathfinding.Find(context.Background(), layer.Block(m, start, side), walker, start, side); !errors.Is(err, pathfinding.ErrInvalidGoal) {
+		t.Fatalf("expected tile of the footprint to be unreachable, got %v", err)
+	}
+}
+
+func visit(t *testing.T, hero *heroesv1.Hero, o *objectsv1.Object, treasury *economyv1.Treasury, list ...*objectsv1.Object) *Outcome {
+	t.Helper()
+	outcome, err := Default().Visit(&Visit{
+		Hero:     hero,
+		Object:   o,
+		Kind:     kinds[o.KindId],
+		Day:      1,
+		Content:  kinds,
+		Terrain:  grass,
+		Treasury: treasury,
+		Objects:  list,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return outcome
+}
+
+func TestVisit(t *testing.T) {
+	hero := &heroesv1.Hero{Id: "h", OwnerId: "a", Position: at(0, 0), Army: []*heroesv1.Hero_Stack{{CreatureId: "swordsman", Count: 10}}}
+
+	chest := object("c", "chest", at(0, 0))
+	if outcome := visit(t, hero, chest, nil); outcome.Deltas["gold"] != 1000 || !outcome.Removed || outcome.Reason != economy.ReasonPickup {
+		t.Fatalf("expected chest to grant gold once, got %+v", outcome)
+	}
+
+	visit(t, hero, object("s", "shrine", at(0, 0)), nil)
+	visit(t, hero, object("s", "shrine", at(0, 0)), nil)
+	if !slices.Equal(hero.Spells, []string{"haste"}) {
+		t.Fatalf("expected shrine to teach haste once, got %v", hero.Spells)
+	}
+
+	nest := object("n", "nest", at(0, 0))
+	outcome := visit(t, hero, nest, &economyv1.Treasury{Amounts: map[string]uint64{"gold": 700}})
+	if nest.OwnerId != "a" || nest.Available != 1 || outcome.Deltas["gold"] != -600 {
+		t.Fatalf("expected 3 affordable griffins to join, got %v & %+v", nest, outcome)
+	}
+	if len(hero.Army) != 2 || hero.Army[1].Count != 3 {
+		t.Fatalf("expected griffins in the army, got %v", hero.Army)
+	}
+	if !slices.Equal(nest.VisitedBy, []string{"h"}) {
+		t.Fatalf("expected visitor to be recorded, got %v", nest.VisitedBy)
+	}
+
+	from, to := object("g1", "gate", at(0, 0)), object("g2", "gate", at(4, 4))
+	from.TargetId = to.Id
+	visit(t, hero, from, nil, from, to)
+	if hex.FromCoordinate(hero.Position) != hex.FromCoordinate(at(4, 4)) {
+		t.Fatalf("expected teleporter to move hero, got %v", hero.Position)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	hero := &heroesv1.Hero{Id: "h", Army: []*heroesv1.Hero_Stack{{CreatureId: "swordsman", Count: 10}}}
+	rats := object("r", "rats", at(0, 0))
+	if outcome := visit(t, hero, rats, nil); !outcome.Removed || outcome.Defeated {
+		t.Fatalf("expected rats to be beaten, got %+v", outcome)
+	}
+	if len(hero.Army) != 1 || hero.Army[0].Count == 0 {
+		t.Fatalf("expected swordsmen to survive, got %v", hero.Army)
+	}
+
+	horde := object("h", "horde", at(0, 0))
+	if outcome := visit(t, hero, horde, nil); outcome.Removed || !outcome.Defeated {
+		t.Fatalf("expected hero to be beaten, got %+v", outcome)
+	}
+	if len(hero.Army) != 0 || len(horde.Army) != 1 {
+		t.Fatalf("expected only guards to survive, got %v & %v", hero.Army, horde.Army)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	r := Default()
+	if err := r.Check([]*objectsv1.Object_Kind{{Id: "x", Handler: "quest"}}); !errors.Is(err, ErrUnknownHandler) {
+		t.Fatalf("expected unknown handler to be reported, got %v", err)
+	}
+	r.Register("quest", func(v *Visit) (*Outcome, error) { return &Outcome{}, nil })
+	if err := r.Check([]*objectsv1.Object_Kind{{Id: "x", Handler: "quest"}}); err != nil {
+		t.Fatal(err)
+	}
+}
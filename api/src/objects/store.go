@@ -0,0 +1,81 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// List returns objects of a game in order of placement.
+func List(ctx context.Context, q *db.Queries, gameID uuid.UUID) ([]*objectsv1.Object, error) {
+	rows, err := q.ListMapObjects(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("listing objects: %w", err)
+	}
+	list := make([]*objectsv1.Object, 0, len(rows))
+	for _, row := range rows {
+		o := &objectsv1.Object{}
+		if err := proto.Unmarshal(row.Data, o); err != nil {
+			return nil, fmt.Errorf("decoding object %q: %w", row.ID, err)
+		}
+		list = append(list, o)
+	}
+	return list, nil
+}
+
+func Create(ctx context.Context, q *db.Queries, o *objectsv1.Object) error {
+	raw, err := proto.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("encoding object: %w", err)
+	}
+	err = q.CreateMapObject(ctx, db.CreateMapObjectParams{
+		ID:     uuid.MustParse(o.Id),
+		GameID: uuid.MustParse(o.GameId),
+		Data:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("creating object: %w", err)
+	}
+	return nil
+}
+
+func Save(ctx context.Context, q *db.Queries, o *objectsv1.Object) error {
+	raw, err := proto.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("encoding object: %w", err)
+	}
+	if err := q.UpdateMapObject(ctx, db.UpdateMapObjectParams{ID: uuid.MustParse(o.Id), Data: raw}); err != nil {
+		return fmt.Errorf("saving object %q: %w", o.Id, err)
+	}
+	return nil
+}
+
+func Delete(ctx context.Context, q *db.Queries, o *objectsv1.Object) error {
+	if err := q.DeleteMapObject(ctx, uuid.MustParse(o.Id)); err != nil {
+		return fmt.Errorf("removing object %q: %w", o.Id, err)
+	}
+	return nil
+}
+
+// NewWeek adds weekly growth of dwellings to creatures available in them.
+func NewWeek(ctx context.Context, q *db.Queries, gameID uuid.UUID, kinds Kinds) error {
+	list, err := List(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+	for _, o := range list {
+		growth := kinds.Object(o.KindId).GetWeeklyGrowth()
+		if growth == 0 {
+			continue
+		}
+		o.Available += growth
+		if err := Save(ctx, q, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
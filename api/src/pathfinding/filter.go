@@ -0,0 +1,37 @@
+package pathfinding
+
+import (
+	"github.com/openhexes/openhexes/api/src/hex"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Filter hides tiles from the search, e.g. ones occupied by heroes.
+// Connections of a ConnectedMap are kept, leading onto hidden tiles is prevented by hiding them.
+func Filter(m Map, hidden func(h hex.Axial) bool) Map {
+	f := &filtered{Map: m, hidden: hidden}
+	if cm, ok := m.(ConnectedMap); ok {
+		return &connectedFiltered{filtered: f, connections: cm}
+	}
+	return f
+}
+
+type filtered struct {
+	Map
+	hidden func(h hex.Axial) bool
+}
+
+func (f *filtered) Tile(h hex.Axial) *mapv1.Tile {
+	if f.hidden(h) {
+		return nil
+	}
+	return f.Map.Tile(h)
+}
+
+type connectedFiltered struct {
+	*filtered
+	connections ConnectedMap
+}
+
+func (f *connectedFiltered) Connections(h hex.Axial) []hex.Axial {
+	return f.connections.Connections(h)
+}
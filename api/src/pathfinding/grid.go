@@ -0,0 +1,40 @@
+package pathfinding
+
+import (
+	"github.com/openhexes/openhexes/api/src/hex"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// Grid is an in-memory Map.
+type Grid struct {
+	tiles    map[hex.Axial]*mapv1.Tile
+	terrains map[string]*mapv1.Terrain
+	list     []*mapv1.Terrain
+}
+
+func NewGrid(terrains []*mapv1.Terrain, tiles ...*mapv1.Tile) *Grid {
+	g := &Grid{
+		tiles:    make(map[hex.Axial]*mapv1.Tile, len(tiles)),
+		terrains: make(map[string]*mapv1.Terrain, len(terrains)),
+		list:     terrains,
+	}
+	for _, t := range terrains {
+		g.terrains[t.GetId()] = t
+	}
+	for _, t := range tiles {
+		g.tiles[hex.FromCoordinate(t.GetCoordinate())] = t
+	}
+	return g
+}
+
+func (g *Grid) Tile(h hex.Axial) *mapv1.Tile {
+	return g.tiles[h]
+}
+
+func (g *Grid) Terrain(id string) *mapv1.Terrain {
+	return g.terrains[id]
+}
+
+func (g *Grid) Terrains() []*mapv1.Terrain {
+	return g.list
+}
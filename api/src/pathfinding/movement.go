@@ -0,0 +1,59 @@
+package pathfinding
+
+import (
+	"slices"
+
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+const (
+	// DefaultPenalty is a cost of entering a tile of regular terrain.
+	DefaultPenalty = uint32(100)
+	// PortalCost is a cost of portaling to another level.
+	PortalCost = DefaultPenalty
+)
+
+// terrainMovementType maps creature movement types onto terrain ones,
+// both enums share numbering.
+func terrainMovementType(t creaturesv1.Creature_MovementType) mapv1.Terrain_MovementType {
+	return mapv1.Terrain_MovementType(t)
+}
+
+func Penalty(terrain *mapv1.Terrain) uint32 {
+	if terrain.GetMovementPenalty() == 0 {
+		return DefaultPenalty
+	}
+	return terrain.GetMovementPenalty()
+}
+
+// Passable reports whether terrain lets creature through with given movement type.
+func Passable(terrain *mapv1.Terrain, movement creaturesv1.Creature_MovementType) bool {
+	if terrain == nil {
+		return false
+	}
+	return slices.Contains(terrain.GetPassableWith(), terrainMovementType(movement))
+}
+
+// StepCost returns cost of entering terrain using the cheapest of given movement types.
+// Flying creatures ignore terrain penalties. Portaling is only used to change levels.
+// Returns false if terrain is impassable for all of them.
+func StepCost(terrain *mapv1.Terrain, movement []creaturesv1.Creature_MovementType) (uint32, bool) {
+	var (
+		cost uint32
+		ok   bool
+	)
+	for _, m := range movement {
+		if m == creaturesv1.Creature_MOVEMENT_TYPE_PORTALING || !Passable(terrain, m) {
+			continue
+		}
+		c := Penalty(terrain)
+		if m == creaturesv1.Creature_MOVEMENT_TYPE_FLYING {
+			c = min(c, DefaultPenalty)
+		}
+		if !ok || c < cost {
+			cost, ok = c, true
+		}
+	}
+	return cost, ok
+}
@@ -0,0 +1,263 @@
+// Package pathfinding finds cheapest routes across the map with A* search,
+// honoring terrain movement penalties and passability for creature movement types.
+package pathfinding
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+var (
+	ErrNoPath       = errors.New("no path")
+	ErrInvalidStart = errors.New("start tile is not accessible")
+	ErrInvalidGoal  = errors.New("goal tile is not accessible")
+	ErrLimit        = errors.New("search limit exceeded")
+)
+
+const (
+	// DefaultLimit caps tiles expanded by a single search.
+	DefaultLimit = 1 << 18
+	// checkEvery is how many tiles are expanded between checks of the context.
+	checkEvery = 1024
+)
+
+// Map provides tiles & terrain definitions to search across.
+type Map interface {
+	// Tile returns tile at given position, nil if there is none.
+	Tile(h hex.Axial) *mapv1.Tile
+	// Terrain returns terrain definition by id, nil if unknown.
+	Terrain(id string) *mapv1.Terrain
+	// Terrains lists all terrain definitions tiles may refer to.
+	Terrains() []*mapv1.Terrain
+}
+
+// ConnectedMap additionally links tiles across levels, e.g. with stairways.
+// Connections may lead anywhere, so searching such maps is not guided by a heuristic.
+type ConnectedMap interface {
+	Map
+	Connections(h hex.Axial) []hex.Axial
+}
+
+type Path struct {
+	Steps []hex.Axial // including start & goal
+	Costs []uint32    // cost of the edge entering every step, zero for start
+	Cost  uint32      // total cost of entering every step except start
+}
+
+type Finder struct {
+	m        Map
+	movement []creaturesv1.Creature_MovementType
+	portals  bool
+	minStep  uint32
+	limit    int
+}
+
+type Option func(*Finder)
+
+// WithLimit caps tiles expanded by a single search, ErrLimit is returned beyond it.
+func WithLimit(limit int) Option {
+	return func(f *Finder) {
+		f.limit = limit
+	}
+}
+
+func NewFinder(m Map, kind *creaturesv1.Creature_Kind, opts ...Option) *Finder {
+	f := &Finder{
+		m:        m,
+		movement: kind.GetMovementTypes(),
+		portals:  slices.Contains(kind.GetMovementTypes(), creaturesv1.Creature_MOVEMENT_TYPE_PORTALING),
+		limit:    DefaultLimit,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	if _, ok := m.(ConnectedMap); ok {
+		return f
+	}
+
+	first := true
+	for _, t := range m.Terrains() {
+		if cost, ok := StepCost(t, f.movement); ok && (first || cost < f.minStep) {
+			f.minStep, first = cost, false
+		}
+	}
+	return f
+}
+
+// Find returns the cheapest path from start to goal.
+func Find(ctx context.Context, m Map, kind *creaturesv1.Creature_Kind, start, goal hex.Axial, opts ...Option) (*Path, error) {
+	return NewFinder(m, kind, opts...).Find(ctx, start, goal)
+}
+
+// Cost returns cost of stepping onto a tile, false if it can't be entered.
+func (f *Finder) Cost(h hex.Axial) (uint32, bool) {
+	tile := f.m.Tile(h)
+	if tile == nil {
+		return 0, false
+	}
+	return StepCost(f.m.Terrain(tile.GetTerrainId()), f.movement)
+}
+
+// Find returns the cheapest path from start to goal, giving up once the context is done.
+func (f *Finder) Find(ctx context.Context, start, goal hex.Axial) (*Path, error) {
+	if f.m.Tile(start) == nil {
+		return nil, ErrInvalidStart
+	}
+	if _, ok := f.Cost(goal); !ok {
+		return nil, ErrInvalidGoal
+	}
+
+	open := &queue{}
+	costs := map[hex.Axial]uint32{start: 0}
+	parents := map[hex.Axial]hex.Axial{}
+	closed := map[hex.Axial]bool{}
+
+	heap.Push(open, &node{h: start, priority: f.estimate(start, goal)})
+	for open.Len() > 0 {
+		current := heap.Pop(open).(*node).h
+		if current == goal {
+			return f.path(parents, costs, start, goal), nil
+		}
+		if closed[current] {
+			continue
+		}
+		closed[current] = true
+		if len(closed) > f.limit {
+			return nil, ErrLimit
+		}
+		if len(closed)%checkEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, next := range f.edges(current) {
+			if closed[next.h] {
+				continue
+			}
+			cost := costs[current] + next.cost
+			if known, ok := costs[next.h]; ok && known <= cost {
+				continue
+			}
+			costs[next.h] = cost
+			parents[next.h] = current
+			heap.Push(open, &node{h: next.h, priority: cost + f.estimate(next.h, goal)})
+		}
+	}
+	return nil, ErrNoPath
+}
+
+type edge struct {
+	h    hex.Axial
+	cost uint32
+}
+
+func (f *Finder) edges(h hex.Axial) []edge {
+	result := make([]edge, 0, 8)
+	for _, n := range h.Neighbors() {
+		if cost, ok := f.Cost(n); ok {
+			result = append(result, edge{h: n, cost: cost})
+		}
+	}
+
+	if f.portals {
+		for _, depth := range adjacentDepths(h.Depth) {
+			target := hex.Axial{Q: h.Q, R: h.R, Depth: depth}
+			tile := f.m.Tile(target)
+			if tile == nil {
+				continue
+			}
+			if Passable(f.m.Terrain(tile.GetTerrainId()), creaturesv1.Creature_MOVEMENT_TYPE_PORTALING) {
+				result = append(result, edge{h: target, cost: PortalCost})
+			}
+		}
+	}
+
+	if cm, ok := f.m.(ConnectedMap); ok {
+		for _, c := range cm.Connections(h) {
+			if cost, ok := f.Cost(c); ok {
+				result = append(result, edge{h: c, cost: cost})
+			}
+		}
+	}
+	return result
+}
+
+// estimate never overestimates remaining cost: every planar step costs at least
+// as much as the cheapest terrain and portaling keeps row & column.
+func (f *Finder) estimate(h, goal hex.Axial) uint32 {
+	if f.minStep == 0 {
+		return 0
+	}
+	planar := hex.Distance(h, hex.Axial{Q: goal.Q, R: goal.R, Depth: h.Depth})
+	return uint32(planar) * f.minStep
+}
+
+func adjacentDepths(depth uint32) []uint32 {
+	if depth == 0 {
+		return []uint32{1}
+	}
+	return []uint32{depth - 1, depth + 1}
+}
+
+func (f *Finder) path(parents map[hex.Axial]hex.Axial, costs map[hex.Axial]uint32, start, goal hex.Axial) *Path {
+	steps := []hex.Axial{goal}
+	for h := goal; h != start; {
+		h = parents[h]
+		steps = append(steps, h)
+	}
+	slices.Reverse(steps)
+
+	// totals only grow along the path, so edges taken are their differences
+	edges := make([]uint32, len(steps))
+	for i := 1; i < len(steps); i++ {
+		edges[i] = costs[steps[i]] - costs[steps[i-1]]
+	}
+	return &Path{Steps: steps, Costs: edges, Cost: costs[goal]}
+}
+
+type node struct {
+	h        hex.Axial
+	priority uint32
+	index    int
+}
+
+// queue is a min-heap of nodes, ties are broken in insertion order to keep results stable.
+type queue struct {
+	nodes   []*node
+	counter int
+}
+
+func (q *queue) Len() int {
+	return len(q.nodes)
+}
+
+func (q *queue) Less(i, j int) bool {
+	if q.nodes[i].priority != q.nodes[j].priority {
+		return q.nodes[i].priority < q.nodes[j].priority
+	}
+	return q.nodes[i].index < q.nodes[j].index
+}
+
+func (q *queue) Swap(i, j int) {
+	q.nodes[i], q.nodes[j] = q.nodes[j], q.nodes[i]
+}
+
+func (q *queue) Push(x any) {
+	n := x.(*node)
+	n.index = q.counter
+	q.counter++
+	q.nodes = append(q.nodes, n)
+}
+
+func (q *queue) Pop() any {
+	n := q.nodes[len(q.nodes)-1]
+	q.nodes = q.nodes[:len(q.nodes)-1]
+	return n
+}
@@ -0,0 +1,440 @@
+package pathfinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/hex"
+	creaturesv1 "github.com/openhexes/proto/creatures/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+var (
+	walking = &creaturesv1.Creature_Kind{
+		Id:            "walker",
+		MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_WALKING},
+	}
+	swimming = &creaturesv1.Creature_Kind{
+		Id:            "swimmer",
+		MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_SWIMMING},
+	}
+	flying = &creaturesv1.Creature_Kind{
+		Id: "flyer",
+		MovementTypes: []creaturesv1.Creature_MovementType{
+			creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+			creaturesv1.Creature_MOVEMENT_TYPE_FLYING,
+		},
+	}
+	portaling = &creaturesv1.Creature_Kind{
+		Id: "portaler",
+		MovementTypes: []creaturesv1.Creature_MovementType{
+			creaturesv1.Creature_MOVEMENT_TYPE_WALKING,
+			creaturesv1.Creature_MOVEMENT_TYPE_PORTALING,
+		},
+	}
+
+	landAndAir = []mapv1.Terrain_MovementType{
+		mapv1.Terrain_MOVEMENT_TYPE_WALKING,
+		mapv1.Terrain_MOVEMENT_TYPE_FLYING,
+		mapv1.Terrain_MOVEMENT_TYPE_PORTALING,
+	}
+	terrains = []*mapv1.Terrain{
+		{Id: "g", MovementPenalty: 100, PassableWith: landAndAir},
+		{Id: "s", MovementPenalty: 300, PassableWith: landAndAir},
+		{Id: "r", MovementPenalty: 50, PassableWith: landAndAir},
+		{Id: "w", MovementPenalty: 100, PassableWith: []mapv1.Terrain_MovementType{
+			mapv1.Terrain_MOVEMENT_TYPE_SWIMMING,
+			mapv1.Terrain_MOVEMENT_TYPE_FLYING,
+		}},
+		{Id: "x"}, // impassable
+	}
+)
+
+// grid builds a map out of rows of terrain ids, one character per tile.
+func grid(levels ...[]string) *Grid {
+	var tiles []*mapv1.Tile
+	for depth, rows := range levels {
+		for row, line := range rows {
+			for column, id := range line {
+				tiles = append(tiles, &mapv1.Tile{
+					Coordinate: &mapv1.Tile_Coordinate{Row: uint32(row), Column: uint32(column), Depth: uint32(depth)},
+					TerrainId:  string(id),
+				})
+			}
+		}
+	}
+	return NewGrid(terrains, tiles...)
+}
+
+func at(row, column int) hex.Axial {
+	return hex.FromOffset(row, column, 0)
+}
+
+func TestStepCost(t *testing.T) {
+	tests := []struct {
+		name    string
+		terrain string
+		kind    *creaturesv1.Creature_Kind
+		cost    uint32
+		ok      bool
+	}{
+		{"walking on grass", "g", walking, 100, true},
+		{"walking in swamp", "s", walking, 300, true},
+		{"walking on road", "r", walking, 50, true},
+		{"walking on water", "w", walking, 0, false},
+		{"swimming in water", "w", swimming, 100, true},
+		{"swimming on grass", "g", swimming, 0, false},
+		{"flying over swamp", "s", flying, 100, true},
+		{"flying over road", "r", flying, 50, true},
+		{"flying over water", "w", flying, 100, true},
+		{"portaling on grass", "g", &creaturesv1.Creature_Kind{
+			MovementTypes: []creaturesv1.Creature_MovementType{creaturesv1.Creature_MOVEMENT_TYPE_PORTALING},
+		}, 0, false},
+		{"walking through wall", "x", flying, 0, false},
+	}
+
+	g := grid()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, ok := StepCost(g.Terrain(tt.terrain), tt.kind.GetMovementTypes())
+			if cost != tt.cost || ok != tt.ok {
+				t.Errorf("got (%d, %v), want (%d, %v)", cost, ok, tt.cost, tt.ok)
+			}
+		})
+	}
+
+	if _, ok := StepCost(nil, flying.GetMovementTypes()); ok {
+		t.Error("unknown terrain must be impassable")
+	}
+	if got := Penalty(&mapv1.Terrain{}); got != DefaultPenalty {
+		t.Errorf("unset penalty: got %d", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     *Grid
+		kind  *creaturesv1.Creature_Kind
+		start hex.Axial
+		goal  hex.Axial
+		cost  uint32
+		err   error
+	}{
+		{
+			name:  "same tile",
+			m:     grid([]string{"ggg"}),
+			kind:  walking,
+			start: at(0, 1),
+			goal:  at(0, 1),
+			cost:  0,
+		},
+		{
+			name:  "straight line",
+			m:     grid([]string{"ggggg"}),
+			kind:  walking,
+			start: at(0, 0),
+			goal:  at(0, 4),
+			cost:  400,
+		},
+		{
+			name: "around swamp",
+			m: grid([]string{
+				"ggggg",
+				"gsssg",
+				"ggggg",
+			}),
+			kind:  walking,
+			start: at(1, 0),
+			goal:  at(1, 4),
+			cost:  500,
+		},
+		{
+			name: "through swamp when flying",
+			m: grid([]string{
+				"ggggg",
+				"gsssg",
+				"ggggg",
+			}),
+			kind:  flying,
+			start: at(1, 0),
+			goal:  at(1, 4),
+			cost:  400,
+		},
+		{
+			name: "prefers roads",
+			m: grid([]string{
+				"grrrg",
+				"ggggg",
+			}),
+			kind:  walking,
+			start: at(1, 0),
+			goal:  at(1, 4),
+			cost:  350,
+		},
+		{
+			name: "walled off",
+			m: grid([]string{
+				"ggxgg",
+				"gxxgg",
+				"ggxgg",
+			}),
+			kind:  walking,
+			start: at(1, 0),
+			goal:  at(1, 4),
+			err:   ErrNoPath,
+		},
+		{
+			name: "water blocks walkers",
+			m: grid([]string{
+				"gwg",
+				"wwg",
+				"gwg",
+			}),
+			kind:  walking,
+			start: at(0, 0),
+			goal:  at(2, 0),
+			err:   ErrNoPath,
+		},
+		{
+			name: "water carries swimmers",
+			m: grid([]string{
+				"wwg",
+				"wgg",
+				"wwg",
+			}),
+			kind:  swimming,
+			start: at(0, 0),
+			goal:  at(2, 0),
+			cost:  200,
+		},
+		{
+			name:  "goal is impassable",
+			m:     grid([]string{"ggx"}),
+			kind:  walking,
+			start: at(0, 0),
+			goal:  at(0, 2),
+			err:   ErrInvalidGoal,
+		},
+		{
+			name:  "goal is outside of map",
+			m:     grid([]string{"ggg"}),
+			kind:  walking,
+			start: at(0, 0),
+			goal:  at(5, 5),
+			err:   ErrInvalidGoal,
+		},
+		{
+			name:  "start is outside of map",
+			m:     grid([]string{"ggg"}),
+			kind:  walking,
+			start: at(-1, 0),
+			goal:  at(0, 2),
+			err:   ErrInvalidStart,
+		},
+		{
+			name: "portal under a wall",
+			m: grid(
+				[]string{"gxg"},
+				[]string{"ggg"},
+			),
+			kind:  portaling,
+			start: at(0, 0),
+			goal:  at(0, 2),
+			cost:  PortalCost*2 + 200,
+		},
+		{
+			name: "no portaling for walkers",
+			m: grid(
+				[]string{"gxg"},
+				[]string{"ggg"},
+			),
+			kind:  walking,
+			start: at(0, 0),
+			goal:  at(0, 2),
+			err:   ErrNoPath,
+		},
+		{
+			name: "to another level",
+			m: grid(
+				[]string{"ggg"},
+				[]string{"ggg"},
+			),
+			kind:  portaling,
+			start: at(0, 0),
+			goal:  hex.FromOffset(0, 2, 1),
+			cost:  PortalCost + 200,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := Find(context.Background(), tt.m, tt.kind, tt.start, tt.goal)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got error %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if path.Cost != tt.cost {
+				t.Errorf("got cost %d, want %d: %v", path.Cost, tt.cost, path.Steps)
+			}
+			assertValid(t, tt.m, tt.kind, path, tt.start, tt.goal)
+		})
+	}
+}
+
+type connected struct {
+	*Grid
+	links map[hex.Axial][]hex.Axial
+}
+
+func (c *connected) Connections(h hex.Axial) []hex.Axial {
+	return c.links[h]
+}
+
+func TestFindConnected(t *testing.T) {
+	west := [2]hex.Axial{at(0, 0), hex.FromOffset(0, 0, 1)}
+	east := [2]hex.Axial{at(0, 4), hex.FromOffset(0, 4, 1)}
+
+	m := &connected{
+		Grid: grid(
+			[]string{
+				"gxggg",
+				"gxggg",
+				"gxggg",
+			},
+			[]string{"ggggg"},
+		),
+		links: map[hex.Axial][]hex.Axial{
+			west[0]: {west[1]},
+			west[1]: {west[0]},
+			east[0]: {east[1]},
+			east[1]: {east[0]},
+		},
+	}
+
+	path, err := Find(context.Background(), m, walking, at(2, 0), at(2, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// up to stairs, down the stairs, along lower level, up the stairs, down to goal
+	if want := uint32(200 + 100 + 400 + 100 + 200); path.Cost != want {
+		t.Errorf("got cost %d, want %d: %v", path.Cost, want, path.Steps)
+	}
+	assertValid(t, m, walking, path, at(2, 0), at(2, 4))
+
+	if _, err := Find(context.Background(), m.Grid, walking, at(2, 0), at(2, 4)); !errors.Is(err, ErrNoPath) {
+		t.Errorf("got %v without stairs", err)
+	}
+	// filtering keeps the stairs, hiding one end of them closes the way
+	if _, err := Find(context.Background(), Filter(m, func(h hex.Axial) bool { return false }), walking, at(2, 0), at(2, 4)); err != nil {
+		t.Errorf("got %v after filtering", err)
+	}
+	blocked := Filter(m, func(h hex.Axial) bool { return h == east[1] })
+	if _, err := Find(context.Background(), blocked, walking, at(2, 0), at(2, 4)); !errors.Is(err, ErrNoPath) {
+		t.Errorf("got %v with stairs blocked", err)
+	}
+}
+
+func TestFindIsOptimal(t *testing.T) {
+	rows := []string{
+		"grsgwgrsgg",
+		"gsgrgwgsrg",
+		"rgsgggsggr",
+		"ggrsxsgrgg",
+		"sgggxgggsg",
+		"grgsxrgsgr",
+		"ggrgggsggg",
+	}
+	m := grid(rows)
+
+	// exhaustive Dijkstra without heuristic for reference
+	reference := func(kind *creaturesv1.Creature_Kind, start, goal hex.Axial) uint32 {
+		f := NewFinder(m, kind)
+		f.minStep = 0
+		path, err := f.Find(context.Background(), start, goal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path.Cost
+	}
+
+	for _, kind := range []*creaturesv1.Creature_Kind{walking, flying} {
+		for row := range rows {
+			for column := range len(rows[row]) {
+				goal := at(row, column)
+				if _, ok := NewFinder(m, kind).Cost(goal); !ok {
+					continue
+				}
+				path, err := Find(context.Background(), m, kind, at(0, 0), goal)
+				if err != nil {
+					t.Fatalf("%s -> (%d, %d): %v", kind.Id, row, column, err)
+				}
+				assertValid(t, m, kind, path, at(0, 0), goal)
+				if want := reference(kind, at(0, 0), goal); path.Cost != want {
+					t.Errorf("%s -> (%d, %d): got %d, want %d", kind.Id, row, column, path.Cost, want)
+				}
+			}
+		}
+	}
+}
+
+func assertValid(t *testing.T, m Map, kind *creaturesv1.Creature_Kind, path *Path, start, goal hex.Axial) {
+	t.Helper()
+
+	if path.Steps[0] != start || path.Steps[len(path.Steps)-1] != goal {
+		t.Fatalf("wrong endpoints: %v", path.Steps)
+	}
+	f := NewFinder(m, kind)
+	var total uint32
+	if len(path.Costs) != len(path.Steps) || path.Costs[0] != 0 {
+		t.Fatalf("costs don't match steps: %v", path.Costs)
+	}
+	for i := 1; i < len(path.Steps); i++ {
+		var found bool
+		for _, e := range f.edges(path.Steps[i-1]) {
+			if e.h == path.Steps[i] && e.cost == path.Costs[i] {
+				total += e.cost
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("no edge %v -> %v costing %d", path.Steps[i-1], path.Steps[i], path.Costs[i])
+		}
+	}
+	if total != path.Cost {
+		t.Errorf("steps add up to %d, path claims %d", total, path.Cost)
+	}
+}
+
+// endless is a map of grass spreading in every direction, searches across it only end with the goal.
+type endless struct {
+	*Grid
+}
+
+func (e endless) Tile(h hex.Axial) *mapv1.Tile {
+	return &mapv1.Tile{TerrainId: "g"}
+}
+
+func TestFindLimits(t *testing.T) {
+	m := endless{Grid: grid()}
+	// a wall around the goal only gets discovered by exhausting the map
+	walled := Filter(m, func(h hex.Axial) bool { return hex.Distance(h, at(0, 0)) == 1 })
+
+	if _, err := Find(context.Background(), walled, walking, at(50, 50), at(0, 0), WithLimit(5000)); !errors.Is(err, ErrLimit) {
+		t.Errorf("expected limit to be exceeded, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Find(ctx, walled, walking, at(50, 50), at(0, 0)); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected search to be canceled, got %v", err)
+	}
+
+	if _, err := Find(context.Background(), m, walking, at(20, 20), at(0, 0), WithLimit(5000)); err != nil {
+		t.Errorf("expected path within limit, got %v", err)
+	}
+}
@@ -13,10 +13,27 @@ import (
 	"connectrpc.com/otelconnect"
 	"github.com/openhexes/openhexes/api/src/auth"
 	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/objects"
+	contentsvc "github.com/openhexes/openhexes/api/src/services/content"
+	economysvc "github.com/openhexes/openhexes/api/src/services/economy"
 	"github.com/openhexes/openhexes/api/src/services/game"
+	herosvc "github.com/openhexes/openhexes/api/src/services/heroes"
 	"github.com/openhexes/openhexes/api/src/services/iam"
+	"github.com/openhexes/openhexes/api/src/services/lobby"
+	"github.com/openhexes/openhexes/api/src/services/maps"
+	objectsvc "github.com/openhexes/openhexes/api/src/services/objects"
+	townsvc "github.com/openhexes/openhexes/api/src/services/towns"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/proto/content/v1/contentv1connect"
+	"github.com/openhexes/proto/economy/v1/economyv1connect"
 	"github.com/openhexes/proto/game/v1/gamev1connect"
+	"github.com/openhexes/proto/heroes/v1/heroesv1connect"
 	"github.com/openhexes/proto/iam/v1/iamv1connect"
+	"github.com/openhexes/proto/lobby/v1/lobbyv1connect"
+	"github.com/openhexes/proto/map/v1/mapv1connect"
+	"github.com/openhexes/proto/objects/v1/objectsv1connect"
+	"github.com/openhexes/proto/towns/v1/townsv1connect"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
@@ -28,9 +45,10 @@ type Server struct {
 
 	cfg      *config.Config
 	listener net.Listener
+	game     *game.Service
 }
 
-func New(cfg *config.Config, auth *auth.Controller) (*Server, error) {
+func New(cfg *config.Config, auth *auth.Controller, authorizer *auth.Authorizer) (*Server, error) {
 	mux := http.NewServeMux()
 
 	otel, err := otelconnect.NewInterceptor()
@@ -38,16 +56,52 @@ func New(cfg *config.Config, auth *auth.Controller) (*Server, error) {
 		return nil, fmt.Errorf("initializing OpenTelemetry interceptor: %w", err)
 	}
 
+	registry, err := content.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("loading content: %w", err)
+	}
+	handlers := objects.Default()
+	if err := handlers.Check(registry.Objects()); err != nil {
+		return nil, fmt.Errorf("checking object handlers: %w", err)
+	}
+
 	interceptors := connect.WithInterceptors(
 		otel,
 		auth,
+		authorizer,
+		content.NewInterceptor(registry),
 		NewLoggingInterceptor(cfg),
 	)
 
-	path, handler := iamv1connect.NewIAMServiceHandler(iam.New(cfg, auth), interceptors)
+	path, handler := iamv1connect.NewIAMServiceHandler(iam.New(cfg, auth, authorizer.Policy()), interceptors)
+	mux.Handle(path, handler)
+
+	lobbySvc := lobby.New(cfg, auth, registry)
+	hub := session.NewHub(session.WithAuthorizer(lobbySvc.AuthorizePlayer))
+
+	gameSvc := game.New(cfg, auth, registry, hub)
+	path, handler = gamev1connect.NewGameServiceHandler(gameSvc, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = contentv1connect.NewContentServiceHandler(contentsvc.New(cfg, auth, registry), interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = mapv1connect.NewMapServiceHandler(maps.New(cfg, auth, registry), interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = lobbyv1connect.NewLobbyServiceHandler(lobbySvc, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = heroesv1connect.NewHeroServiceHandler(herosvc.New(cfg, auth, registry, handlers, hub), interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = townsv1connect.NewTownServiceHandler(townsvc.New(cfg, auth, registry, hub), interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = economyv1connect.NewEconomyServiceHandler(economysvc.New(cfg, auth, registry, hub), interceptors)
 	mux.Handle(path, handler)
 
-	path, handler = gamev1connect.NewGameServiceHandler(game.New(cfg, auth), interceptors)
+	path, handler = objectsv1connect.NewObjectServiceHandler(objectsvc.New(cfg, auth, registry, hub), interceptors)
 	mux.Handle(path, handler)
 
 	mux.Handle("/ping", &Ponger{})
@@ -59,7 +113,8 @@ func New(cfg *config.Config, auth *auth.Controller) (*Server, error) {
 	mux.Handle("/", ui)
 
 	return &Server{
-		cfg: cfg,
+		cfg:  cfg,
+		game: gameSvc,
 		Server: &http.Server{
 			Addr:    cfg.Server.Address,
 			Handler: cfg.AddCORS(otelhttp.NewHandler(h2c.NewHandler(mux, &http2.Server{}), "/")),
@@ -100,6 +155,7 @@ func (s *Server) Run(ctx context.Context) (err error) {
 	go func() {
 		srvErr <- s.Server.Serve(s.listener)
 	}()
+	go s.game.ExpireTurns(ctx)
 
 	// Wait for interruption.
 	select {
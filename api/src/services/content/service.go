@@ -0,0 +1,41 @@
+package content
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	contentv1 "github.com/openhexes/proto/content/v1"
+	"github.com/openhexes/proto/content/v1/contentv1connect"
+)
+
+type Service struct {
+	contentv1connect.UnimplementedContentServiceHandler
+
+	cfg      *config.Config
+	auth     *auth.Controller
+	registry *content.Registry
+}
+
+func New(cfg *config.Config, auth *auth.Controller, registry *content.Registry) *Service {
+	return &Service{
+		cfg:      cfg,
+		auth:     auth,
+		registry: registry,
+	}
+}
+
+func (svc *Service) GetContent(ctx context.Context, request *connect.Request[contentv1.GetContentRequest]) (*connect.Response[contentv1.GetContentResponse], error) {
+	return connect.NewResponse(&contentv1.GetContentResponse{
+		Terrains:  svc.registry.Terrains(),
+		Creatures: svc.registry.Creatures(),
+		Spells:    svc.registry.Spells(),
+		Towns:     svc.registry.Towns(),
+		Resources: svc.registry.Resources(),
+		Objects:   svc.registry.Objects(),
+		Packs:     svc.registry.Packs(),
+		Checksum:  svc.registry.Checksum(),
+	}), nil
+}
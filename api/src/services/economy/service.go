@@ -0,0 +1,514 @@
+package economy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	"github.com/openhexes/proto/economy/v1/economyv1connect"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type Service struct {
+	economyv1connect.UnimplementedEconomyServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+	hub     *session.Hub
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry, hub *session.Hub) *Service {
+	svc := &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+		hub:     hub,
+	}
+	hub.Redact("site_updated", svc.redact)
+	return svc
+}
+
+const (
+	defaultTransactionsLimit = 100
+	maxTransactionsLimit     = 1000
+)
+
+func (svc *Service) GetTreasury(ctx context.Context, request *connect.Request[economyv1.GetTreasuryRequest]) (*connect.Response[economyv1.GetTreasuryResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &economyv1.GetTreasuryResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if err := svc.checkPlayer(ctx, q, gameID, account); err != nil {
+			return err
+		}
+		if response.Treasury, err = economy.Load(ctx, q, gameID, account.ID); err != nil {
+			return err
+		}
+		sites, err := economy.Sites(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		list, err := towns.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		response.Income = economy.Total(economy.Incomes(account.ID.String(), sites, list, svc.content))
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// ListSites returns sites on tiles explored by the caller, owners of maps may list sites placed on them.
+func (svc *Service) ListSites(ctx context.Context, request *connect.Request[economyv1.ListSitesRequest]) (*connect.Response[economyv1.ListSitesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	if request.Msg.MapId != "" {
+		return svc.listMapSites(ctx, account, request.Msg.MapId)
+	}
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &economyv1.ListSitesResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if err := svc.checkPlayer(ctx, q, gameID, account); err != nil {
+			return err
+		}
+		sites, err := economy.Sites(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+		if err != nil {
+			return err
+		}
+		explored, err := visibility.Load(ctx, q, gameID, account.ID, m.Layout())
+		if err != nil {
+			return err
+		}
+		for _, s := range sites {
+			if explored.Has(hex.FromCoordinate(s.Position)) {
+				response.Sites = append(response.Sites, s)
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// redact withholds site updates from players who haven't explored the site, like ListSites.
+func (svc *Service) redact(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+	id, err := parseID("game", gameID)
+	if err != nil {
+		return nil, err
+	}
+	position := hex.FromCoordinate(event.GetSiteUpdated().GetSite().GetPosition())
+
+	views := map[string]*gamev1.Event{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+		if err != nil {
+			return err
+		}
+		for _, accountID := range accountIDs {
+			account, err := uuid.Parse(accountID)
+			if err != nil {
+				continue
+			}
+			explored, err := visibility.Load(ctx, q, id, account, m.Layout())
+			if err != nil {
+				return err
+			}
+			if explored.Has(position) {
+				views[accountID] = event
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
+func (svc *Service) listMapSites(ctx context.Context, account *db.Account, mapID string) (*connect.Response[economyv1.ListSitesResponse], error) {
+	id, err := parseID("map", mapID)
+	if err != nil {
+		return nil, err
+	}
+	response := &economyv1.ListSitesResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetMap(ctx, db.GetMapParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		response.Sites, err = mapstore.Sites(ctx, q, id)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// PlaceSite sets up a mine or pickup on a map of the caller, it's copied into every game started on the map.
+func (svc *Service) PlaceSite(ctx context.Context, request *connect.Request[economyv1.PlaceSiteRequest]) (*connect.Response[economyv1.PlaceSiteResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	mapID, err := parseID("map", request.Msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	switch {
+	case msg.Kind != economyv1.Site_KIND_MINE && msg.Kind != economyv1.Site_KIND_PICKUP:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid kind %s", msg.Kind))
+	case svc.content.Resource(msg.ResourceId) == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown resource %q", msg.ResourceId))
+	case msg.Amount == 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount must be positive"))
+	case msg.Position == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("position is required"))
+	}
+
+	site := &economyv1.Site{
+		Id:         uuid.NewString(),
+		Kind:       msg.Kind,
+		ResourceId: msg.ResourceId,
+		Amount:     msg.Amount,
+		Position:   msg.Position,
+	}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		stored, err := q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: mapID, OwnerID: account.ID})
+		if err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		sites, err := mapstore.Sites(ctx, q, mapID)
+		if err != nil {
+			return err
+		}
+		for _, other := range sites {
+			if proto.Equal(other.Position, site.Position) {
+				return connect.NewError(connect.CodeFailedPrecondition, errors.New("tile is occupied by another site"))
+			}
+		}
+
+		m := mapstore.Open(ctx, q, &stored, svc.content)
+		_, ok := pathfinding.NewFinder(m, heroes.Walker).Cost(hex.FromCoordinate(site.Position))
+		if err := m.Err(); err != nil {
+			return err
+		}
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("heroes can't reach this tile"))
+		}
+
+		if err := mapstore.CreateSite(ctx, q, mapID, site); err != nil {
+			return err
+		}
+		if _, err := q.TouchMap(ctx, mapID); err != nil {
+			return fmt.Errorf("updating map: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&economyv1.PlaceSiteResponse{Site: site}), nil
+}
+
+func (svc *Service) ListTransactions(ctx context.Context, request *connect.Request[economyv1.ListTransactionsRequest]) (*connect.Response[economyv1.ListTransactionsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	limit := request.Msg.Limit
+	if limit == 0 {
+		limit = defaultTransactionsLimit
+	}
+	limit = min(limit, maxTransactionsLimit)
+
+	response := &economyv1.ListTransactionsResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if err := svc.checkPlayer(ctx, q, gameID, account); err != nil {
+			return err
+		}
+		response.Transactions, err = economy.Transactions(ctx, q, gameID, account.ID, request.Msg.BeforeId, int32(limit))
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) Exchange(ctx context.Context, request *connect.Request[economyv1.ExchangeRequest]) (*connect.Response[economyv1.ExchangeResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	give, take := svc.content.Resource(msg.GiveResourceId), svc.content.Resource(msg.TakeResourceId)
+	switch {
+	case give == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown resource %q", msg.GiveResourceId))
+	case take == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown resource %q", msg.TakeResourceId))
+	case msg.Amount == 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount must be positive"))
+	case msg.Amount > economy.MaxAmount:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("amount must be at most %d", uint64(economy.MaxAmount)))
+	}
+
+	response := &economyv1.ExchangeResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, true)
+		if err != nil {
+			return err
+		}
+		if err := turns.CanAct(state, account.ID.String()); err != nil {
+			return err
+		}
+		list, err := towns.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+
+		received, err := economy.Exchange(give, take, msg.Amount, economy.Markets(account.ID.String(), list, svc.content))
+		if errors.Is(err, economy.ErrOverflow) {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		} else if err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if received == 0 {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("amount is worth less than a unit"))
+		}
+		deltas := map[string]int64{give.Id: -int64(msg.Amount), take.Id: int64(received)}
+		response.Treasury, err = transact(ctx, q, gameID, account.ID, state.Day, economy.ReasonExchange, uuid.NewString(), deltas)
+		response.Received = received
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) ProposeTrade(ctx context.Context, request *connect.Request[economyv1.ProposeTradeRequest]) (*connect.Response[economyv1.ProposeTradeResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	if msg.ToId == account.ID.String() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("can't trade with yourself"))
+	}
+	if len(msg.Give) == 0 && len(msg.Take) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("trade is empty"))
+	}
+	for _, id := range slices.Sorted(maps.Keys(msg.Give)) {
+		if svc.content.Resource(id) == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown resource %q", id))
+		}
+	}
+	for _, id := range slices.Sorted(maps.Keys(msg.Take)) {
+		if svc.content.Resource(id) == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown resource %q", id))
+		}
+	}
+	spend, err := economy.Spend(msg.Give)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, err := economy.Gain(msg.Take); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	trade := &economyv1.Trade{
+		Id:     uuid.NewString(),
+		GameId: gameID.String(),
+		FromId: account.ID.String(),
+		ToId:   msg.ToId,
+		Give:   msg.Give,
+		Take:   msg.Take,
+		State:  economyv1.Trade_STATE_PENDING,
+	}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, true)
+		if err != nil {
+			return err
+		}
+		if err := turns.CanAct(state, account.ID.String()); err != nil {
+			return err
+		}
+		if !turns.IsPlayer(state, trade.ToId) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%q isn't a player of the game", trade.ToId))
+		}
+		treasury, err := economy.Load(ctx, q, gameID, account.ID)
+		if err != nil {
+			return err
+		}
+		// checked again once accepted, resources aren't reserved meanwhile
+		if _, err := economy.Apply(treasury, spend); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		trade.Day = state.Day
+		return economy.CreateTrade(ctx, q, trade)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&economyv1.ProposeTradeResponse{Trade: trade}), nil
+}
+
+// RespondToTrade lets the recipient accept or decline a pending trade, the proposer may cancel it.
+func (svc *Service) RespondToTrade(ctx context.Context, request *connect.Request[economyv1.RespondToTradeRequest]) (*connect.Response[economyv1.RespondToTradeResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var trade *economyv1.Trade
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, true)
+		if err != nil {
+			return err
+		}
+		list, err := economy.Trades(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		for _, t := range list {
+			if t.Id == request.Msg.TradeId {
+				trade = t
+			}
+		}
+		if trade == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("trade %q not found", request.Msg.TradeId))
+		}
+		if trade.State != economyv1.Trade_STATE_PENDING {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("trade is %s", trade.State))
+		}
+
+		switch account.ID.String() {
+		case trade.ToId:
+			if !request.Msg.Accept {
+				trade.State = economyv1.Trade_STATE_DECLINED
+				break
+			}
+			if err := economy.Settle(ctx, q, trade, state.Day); errors.Is(err, economy.ErrInsufficient) {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			} else if errors.Is(err, economy.ErrOverflow) {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			} else if err != nil {
+				return err
+			}
+			trade.State = economyv1.Trade_STATE_ACCEPTED
+		case trade.FromId:
+			if request.Msg.Accept {
+				return connect.NewError(connect.CodePermissionDenied, errors.New("only the recipient may accept a trade"))
+			}
+			trade.State = economyv1.Trade_STATE_CANCELLED
+		default:
+			return connect.NewError(connect.CodePermissionDenied, errors.New("trade belongs to other players"))
+		}
+		return economy.SaveTrade(ctx, q, trade)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&economyv1.RespondToTradeResponse{Trade: trade}), nil
+}
+
+func (svc *Service) ListTrades(ctx context.Context, request *connect.Request[economyv1.ListTradesRequest]) (*connect.Response[economyv1.ListTradesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &economyv1.ListTradesResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if err := svc.checkPlayer(ctx, q, gameID, account); err != nil {
+			return err
+		}
+		list, err := economy.Trades(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		for _, t := range list {
+			involved := t.FromId == account.ID.String() || t.ToId == account.ID.String()
+			if involved && t.State == economyv1.Trade_STATE_PENDING {
+				response.Trades = append(response.Trades, t)
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) checkPlayer(ctx context.Context, q *db.Queries, gameID uuid.UUID, account *db.Account) error {
+	state, err := turns.Load(ctx, q, gameID, false)
+	if err != nil {
+		return err
+	}
+	if !turns.IsPlayer(state, account.ID.String()) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", gameID))
+	}
+	return nil
+}
+
+func transact(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, day uint32, reason, reference string, deltas map[string]int64) (*economyv1.Treasury, error) {
+	t, err := economy.Transact(ctx, q, gameID, accountID, day, reason, reference, deltas)
+	switch {
+	case errors.Is(err, economy.ErrInsufficient):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, economy.ErrOverflow):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return t, err
+}
+
+func parseID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id %q: %w", kind, id, err))
+	}
+	return parsed, nil
+}
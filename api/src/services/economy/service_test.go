@@ -0,0 +1,168 @@
+package economy
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+const (
+	gold = "core/resource/gold"
+	wood = "core/resource/wood"
+)
+
+func TestExchange(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content, session.NewHub())
+
+	var received uint64
+	exchange := func(account *db.Account) func() error {
+		return func() error {
+			response, err := svc.Exchange(lobbytest.As(account), connect.NewRequest(&economyv1.ExchangeRequest{
+				GameId:         g.ID.String(),
+				GiveResourceId: gold,
+				TakeResourceId: wood,
+				Amount:         1000,
+			}))
+			if err == nil {
+				received = response.Msg.Received
+			}
+			return err
+		}
+	}
+	treasury := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.GetTreasury(lobbytest.As(account), connect.NewRequest(&economyv1.GetTreasuryRequest{GameId: g.ID.String()}))
+			return err
+		}
+	}
+	sites := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.ListSites(lobbytest.As(account), connect.NewRequest(&economyv1.ListSitesRequest{GameId: g.ID.String()}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo gets treasury", Call: treasury(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "bravo lists sites", Call: sites(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "bravo exchanges", Call: exchange(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "host exchanges without a marketplace", Call: exchange(g.Host), Code: connect.CodeFailedPrecondition},
+	})
+
+	for _, account := range []*db.Account{g.Host, g.Alfa} {
+		town := g.Town(t, account)
+		town.Buildings = append(town.Buildings, "core/building/marketplace")
+		g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+			return towns.Save(ctx, q, town)
+		})
+	}
+	before := g.Treasury(t, g.Host)
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "alfa exchanges out of turn", Call: exchange(g.Alfa), Code: connect.CodeFailedPrecondition},
+		{Name: "host exchanges", Call: exchange(g.Host)},
+	})
+	if received == 0 {
+		t.Fatal("expected some wood for the gold")
+	}
+	lobbytest.CheckDeltas(t, before, g.Treasury(t, g.Host), map[string]int64{gold: -1000, wood: int64(received)})
+}
+
+func TestTrade(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content, session.NewHub())
+	host, alfa := g.Treasury(t, g.Host), g.Treasury(t, g.Alfa)
+
+	var trade *economyv1.Trade
+	propose := func(account, to *db.Account) func() error {
+		return func() error {
+			response, err := svc.ProposeTrade(lobbytest.As(account), connect.NewRequest(&economyv1.ProposeTradeRequest{
+				GameId: g.ID.String(),
+				ToId:   to.ID.String(),
+				Give:   map[string]uint64{gold: 100},
+				Take:   map[string]uint64{wood: 1},
+			}))
+			if err == nil {
+				trade = response.Msg.Trade
+			}
+			return err
+		}
+	}
+	respond := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.RespondToTrade(lobbytest.As(account), connect.NewRequest(&economyv1.RespondToTradeRequest{
+				GameId:  g.ID.String(),
+				TradeId: trade.GetId(),
+				Accept:  true,
+			}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo proposes", Call: propose(g.Bravo, g.Host), Code: connect.CodePermissionDenied},
+		{Name: "alfa proposes out of turn", Call: propose(g.Alfa, g.Host), Code: connect.CodeFailedPrecondition},
+		{Name: "host proposes to bravo", Call: propose(g.Host, g.Bravo), Code: connect.CodeInvalidArgument},
+		{Name: "host proposes", Call: propose(g.Host, g.Alfa)},
+		{Name: "host accepts", Call: respond(g.Host), Code: connect.CodePermissionDenied},
+		{Name: "bravo accepts", Call: respond(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "alfa accepts", Call: respond(g.Alfa)},
+		{Name: "alfa accepts again", Call: respond(g.Alfa), Code: connect.CodeFailedPrecondition},
+	})
+
+	lobbytest.CheckDeltas(t, host, g.Treasury(t, g.Host), map[string]int64{gold: -100, wood: 1})
+	lobbytest.CheckDeltas(t, alfa, g.Treasury(t, g.Alfa), map[string]int64{gold: 100, wood: -1})
+}
+
+func TestRedact(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	New(g.Config, nil, g.Content, hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+
+	// the host explored tiles around their town once the game started
+	hub.Publish(context.Background(), g.ID.String(), &gamev1.Event{
+		Kind: &gamev1.Event_SiteUpdated_{SiteUpdated: &gamev1.Event_SiteUpdated{Site: &economyv1.Site{
+			GameId:     g.ID.String(),
+			Kind:       economyv1.Site_KIND_PICKUP,
+			ResourceId: gold,
+			Amount:     500,
+			Position:   &mapv1.Tile_Coordinate{Row: 2, Column: 2},
+		}}},
+	})
+	if n := len(lobbytest.Received(host, "site_updated")); n != 1 {
+		t.Errorf("expected the host to be told about the explored site, got %d events", n)
+	}
+	if n := len(lobbytest.Received(alfa, "site_updated")); n != 0 {
+		t.Errorf("expected alfa not to learn about the unexplored site, got %d events", n)
+	}
+}
+
+func TestPlaceSite(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content, session.NewHub())
+
+	place := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.PlaceSite(lobbytest.As(account), connect.NewRequest(&economyv1.PlaceSiteRequest{
+				MapId:      g.MapID.String(),
+				Kind:       economyv1.Site_KIND_MINE,
+				ResourceId: wood,
+				Amount:     2,
+				Position:   &mapv1.Tile_Coordinate{Row: 4, Column: 4},
+			}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "alfa places a site on map of the host", Call: place(g.Alfa), Code: connect.CodeNotFound},
+		{Name: "host places a site", Call: place(g.Host)},
+		{Name: "host places a site on another one", Call: place(g.Host), Code: connect.CodeFailedPrecondition},
+	})
+}
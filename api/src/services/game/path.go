@@ -0,0 +1,88 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapgen"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// sampleMap lazily generates tiles of a sample grid. Sample grids have no connectors,
+// only portaling creatures move across levels.
+type sampleMap struct {
+	*mapgen.Generator
+	totalRows    uint32
+	totalColumns uint32
+	depths       uint32
+}
+
+func (m *sampleMap) Tile(h hex.Axial) *mapv1.Tile {
+	if h.Depth >= m.depths || !h.InGrid(m.totalRows, m.totalColumns) {
+		return nil
+	}
+	c, _ := h.Coordinate()
+	return m.Generator.Tile(c)
+}
+
+func (svc *Service) FindPath(ctx context.Context, request *connect.Request[gamev1.FindPathRequest]) (*connect.Response[gamev1.FindPathResponse], error) {
+	if request.Msg.TotalRows == uint32(0) {
+		request.Msg.TotalRows = defaultTotalRows
+	}
+	if request.Msg.TotalColumns == uint32(0) {
+		request.Msg.TotalColumns = defaultTotalColumns
+	}
+	if request.Msg.Seed == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("seed is required"))
+	}
+	if request.Msg.Start == nil || request.Msg.Goal == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("start & goal are required"))
+	}
+
+	generator, err := svc.generator(request.Msg.Seed)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("initializing map generator: %w", err))
+	}
+	m := &sampleMap{
+		Generator:    generator,
+		totalRows:    request.Msg.TotalRows,
+		totalColumns: request.Msg.TotalColumns,
+		depths:       min(max(request.Msg.Depths, 1), maxDepths),
+	}
+
+	path, err := pathfinding.Find(
+		ctx,
+		m,
+		request.Msg.Kind,
+		hex.FromCoordinate(request.Msg.Start),
+		hex.FromCoordinate(request.Msg.Goal),
+	)
+	if errors.Is(err, pathfinding.ErrInvalidStart) || errors.Is(err, pathfinding.ErrInvalidGoal) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if errors.Is(err, pathfinding.ErrNoPath) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, pathfinding.ErrLimit) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("goal is too far: %w", err))
+	}
+	if errors.Is(err, context.Canceled) {
+		return nil, connect.NewError(connect.CodeCanceled, err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("finding path: %w", err))
+	}
+
+	return connect.NewResponse(&gamev1.FindPathResponse{
+		Path:      hex.Coordinates(path.Steps),
+		TotalCost: path.Cost,
+	}), nil
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/session"
+	gamev1 "github.com/openhexes/proto/game/v1"
+)
+
+// Play binds the stream to a game session: commands coming from the client are dispatched
+// in order, events of the game are sent back as they happen.
+func (svc *Service) Play(ctx context.Context, stream *connect.BidiStream[gamev1.PlayRequest, gamev1.PlayResponse]) error {
+	account := auth.AccountFromContext(ctx)
+
+	request, err := stream.Receive()
+	if errors.Is(err, io.EOF) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	s, err := svc.hub.Join(ctx, request.GameId, account)
+	if err != nil {
+		return err
+	}
+	defer s.Leave()
+
+	received := make(chan error, 1)
+	go func(request *gamev1.PlayRequest) {
+		for {
+			if request.Command != nil {
+				svc.hub.Dispatch(ctx, s, request.Command)
+			}
+			next, err := stream.Receive()
+			if err != nil {
+				received <- err
+				return
+			}
+			request = next
+		}
+	}(request)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case err := <-received:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case event, ok := <-s.Events():
+			if !ok {
+				return closed(ctx, s)
+			}
+
+			// send whatever is queued at once
+			response := &gamev1.PlayResponse{Events: []*gamev1.Event{event}}
+			for len(s.Events()) > 0 {
+				if event, ok := <-s.Events(); ok {
+					response.Events = append(response.Events, event)
+				}
+			}
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// closed explains why events of the session stopped coming: streams of ended sessions &
+// deactivated accounts are cancelled with errors of their own, see auth.Controller.EndStreams.
+func closed(ctx context.Context, s *session.Session) error {
+	err := s.Err()
+	if errors.Is(err, session.ErrTooSlow) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if cause := context.Cause(ctx); cause != nil {
+		return cause
+	}
+	if err != nil {
+		return err
+	}
+	return connect.NewError(connect.CodeAborted, errors.New("session closed"))
+}
@@ -0,0 +1,38 @@
+package game
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/session"
+	gamev1 "github.com/openhexes/proto/game/v1"
+)
+
+func TestClosed(t *testing.T) {
+	h := session.NewHub(session.WithBuffer(1))
+	s, err := h.Join(context.Background(), "game", &db.Account{ID: uuid.New()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Leave()
+
+	for _, cause := range []error{auth.ErrSessionEnded, auth.ErrDeactivated} {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(cause)
+		if err := closed(ctx, s); connect.CodeOf(err) != connect.CodeOf(cause) {
+			t.Fatalf("expected %v, got %v", cause, err)
+		}
+	}
+
+	// joined event fills the buffer
+	h.Publish(context.Background(), "game", &gamev1.Event{})
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(auth.ErrSessionEnded)
+	if err := closed(ctx, s); connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Fatalf("expected slow session to be exhausted, got %v", err)
+	}
+}
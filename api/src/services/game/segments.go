@@ -0,0 +1,123 @@
+package game
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/grid"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// segmentStream is served by StreamSegments, GetSegments serves a single request with it.
+type segmentStream interface {
+	Receive() (*gamev1.StreamSegmentsRequest, error)
+	Send(*gamev1.StreamSegmentsResponse) error
+}
+
+type singleRequest struct {
+	request *gamev1.StreamSegmentsRequest
+	stream  *connect.ServerStream[gamev1.StreamSegmentsResponse]
+}
+
+func (s *singleRequest) Receive() (*gamev1.StreamSegmentsRequest, error) {
+	if s.request == nil {
+		return nil, io.EOF
+	}
+	request := s.request
+	s.request = nil
+	return request, nil
+}
+
+func (s *singleRequest) Send(response *gamev1.StreamSegmentsResponse) error {
+	return s.stream.Send(response)
+}
+
+// StreamSegments sends segments of a sample grid as they come into client's viewport.
+// Unlike GetSampleGrid, tiles are only generated for segments client is about to render.
+// If game id is given, the game map is streamed instead, hidden by fog of war.
+func (svc *Service) StreamSegments(ctx context.Context, stream *connect.BidiStream[gamev1.StreamSegmentsRequest, gamev1.StreamSegmentsResponse]) error {
+	return svc.streamSegments(ctx, stream)
+}
+
+// GetSegments sends segments intersecting a single viewport, see StreamSegments.
+func (svc *Service) GetSegments(ctx context.Context, request *connect.Request[gamev1.StreamSegmentsRequest], stream *connect.ServerStream[gamev1.StreamSegmentsResponse]) error {
+	return svc.streamSegments(ctx, &singleRequest{request: request.Msg, stream: stream})
+}
+
+func (svc *Service) streamSegments(ctx context.Context, stream segmentStream) error {
+	request, err := stream.Receive()
+	if errors.Is(err, io.EOF) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if request.GameId != "" {
+		return svc.streamGame(ctx, stream, request)
+	}
+
+	layout := grid.Layout{
+		TotalRows:         cmp.Or(request.TotalRows, defaultTotalRows),
+		TotalColumns:      cmp.Or(request.TotalColumns, defaultTotalColumns),
+		RowsPerSegment:    cmp.Or(request.MaxRowsPerSegment, defaultMaxRowsPerSegment),
+		ColumnsPerSegment: cmp.Or(request.MaxColumnsPerSegment, defaultMaxColumnsPerSegment),
+		Depths:            request.Depths,
+	}
+	if err := checkLayout(layout); err != nil {
+		return err
+	}
+	seed := request.Seed
+	if seed == 0 {
+		seed = rand.Int64()
+	}
+
+	generator, err := svc.generator(seed)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("initializing map generator: %w", err))
+	}
+
+	err = stream.Send(&gamev1.StreamSegmentsResponse{
+		Grid: &mapv1.Grid{
+			TotalRows:    layout.TotalRows,
+			TotalColumns: layout.TotalColumns,
+			Depths:       layout.Levels(),
+		},
+		Seed: seed,
+	})
+	if err != nil {
+		return err
+	}
+
+	tracker := grid.NewTracker(layout)
+	for {
+		if request.Viewport != nil {
+			if err := checkViewport(request.Viewport, request.Margin); err != nil {
+				return err
+			}
+			positions := tracker.Update(request.Viewport, request.Margin)
+			if len(positions) > 0 {
+				response := &gamev1.StreamSegmentsResponse{
+					Segments: make([]*mapv1.Segment, 0, len(positions)),
+				}
+				for _, p := range positions {
+					response.Segments = append(response.Segments, layout.Segment(p, generator.Tile))
+				}
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+
+		request, err = stream.Receive()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -3,13 +3,18 @@ package game
 import (
 	"context"
 	"fmt"
+	"math/rand/v2"
 	"slices"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/openhexes/openhexes/api/src/auth"
 	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/mapgen"
 	"github.com/openhexes/openhexes/api/src/server/progress"
+	"github.com/openhexes/openhexes/api/src/session"
 	gamev1 "github.com/openhexes/proto/game/v1"
 	"github.com/openhexes/proto/game/v1/gamev1connect"
 	mapv1 "github.com/openhexes/proto/map/v1"
@@ -20,25 +25,72 @@ import (
 type Service struct {
 	gamev1connect.UnimplementedGameServiceHandler
 
-	cfg  *config.Config
-	auth *auth.Controller
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+	hub     *session.Hub
 }
 
-func New(cfg *config.Config, auth *auth.Controller) *Service {
-	return &Service{
-		cfg:  cfg,
-		auth: auth,
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry, hub *session.Hub) *Service {
+	svc := &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+		hub:     hub,
 	}
+	hub.Handle("end_turn", svc.endTurn)
+	return svc
 }
 
-func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[gamev1.GetSampleGridRequest], stream *connect.ServerStream[gamev1.GetSampleGridResponse]) error {
-	const (
-		defaultTotalRows            = uint32(64)
-		defaultTotalColumns         = uint32(64)
-		defaultMaxRowsPerSegment    = uint32(15)
-		defaultMaxColumnsPerSegment = uint32(15)
-	)
+const (
+	defaultTotalRows            = uint32(64)
+	defaultTotalColumns         = uint32(64)
+	defaultMaxRowsPerSegment    = uint32(15)
+	defaultMaxColumnsPerSegment = uint32(15)
+
+	// sample grids are generated on every request, so their size is capped
+	maxDepths       = uint32(8)
+	maxTotalRows    = uint32(1024)
+	maxTotalColumns = uint32(1024)
+	maxSegmentSize  = uint32(64)
+)
+
+// checkLayout validates layout of a sample grid requested by a client.
+func checkLayout(l grid.Layout) error {
+	if err := l.Validate(); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if l.Depths > maxDepths {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("grid can't have more than %d levels", maxDepths))
+	}
+	if l.TotalRows > maxTotalRows || l.TotalColumns > maxTotalColumns {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("grid can't be larger than %dx%d, got %dx%d", maxTotalRows, maxTotalColumns, l.TotalRows, l.TotalColumns),
+		)
+	}
+	if l.RowsPerSegment > maxSegmentSize || l.ColumnsPerSegment > maxSegmentSize {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("segments can't be larger than %dx%d, got %dx%d", maxSegmentSize, maxSegmentSize, l.RowsPerSegment, l.ColumnsPerSegment),
+		)
+	}
+	return nil
+}
+
+// checkViewport limits segments a single request may ask for.
+func checkViewport(v *mapv1.Segment_Bounds, margin int32) error {
+	if err := grid.CheckViewport(v, margin); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
 
+func (svc *Service) generator(seed int64) (*mapgen.Generator, error) {
+	return mapgen.New(seed, mapgen.WithTerrains(svc.content.Terrains()...))
+}
+
+func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[gamev1.GetSampleGridRequest], stream *connect.ServerStream[gamev1.GetSampleGridResponse]) error {
 	if request.Msg.TotalRows == uint32(0) {
 		request.Msg.TotalRows = defaultTotalRows
 	}
@@ -51,6 +103,24 @@ func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[
 	if request.Msg.MaxColumnsPerSegment == uint32(0) {
 		request.Msg.MaxColumnsPerSegment = defaultMaxColumnsPerSegment
 	}
+	if request.Msg.Seed == 0 {
+		request.Msg.Seed = rand.Int64()
+	}
+	layout := grid.Layout{
+		TotalRows:         request.Msg.TotalRows,
+		TotalColumns:      request.Msg.TotalColumns,
+		RowsPerSegment:    request.Msg.MaxRowsPerSegment,
+		ColumnsPerSegment: request.Msg.MaxColumnsPerSegment,
+		Depths:            request.Msg.Depths,
+	}
+	if err := checkLayout(layout); err != nil {
+		return err
+	}
+
+	generator, err := svc.generator(request.Msg.Seed)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("initializing map generator: %w", err))
+	}
 
 	stageGrid := &progressv1.Stage{
 		State: progressv1.Stage_STATE_RUNNING,
@@ -72,50 +142,23 @@ func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[
 	defer reporter.Close()
 	reporter.Update()
 
-	// prepare segment containers
+	// prepare segment containers, segment rows of every level follow the ones of the level above
 	start := time.Now()
-	segmentsLength := request.Msg.TotalRows / request.Msg.MaxRowsPerSegment * request.Msg.TotalColumns / request.Msg.MaxColumnsPerSegment
-	segments := make([]*mapv1.Segment, 0, segmentsLength)
-
-	for rowStart := uint32(0); rowStart < request.Msg.TotalRows; rowStart += request.Msg.MaxRowsPerSegment {
-		for columnStart := uint32(0); columnStart < request.Msg.TotalColumns; columnStart += request.Msg.MaxColumnsPerSegment {
-			rowEnd := rowStart + request.Msg.MaxRowsPerSegment
-			columnEnd := columnStart + request.Msg.MaxColumnsPerSegment
-			segments = append(segments, &mapv1.Segment{
-				Tiles: make([]*mapv1.Tile, 0, request.Msg.MaxRowsPerSegment*request.Msg.MaxColumnsPerSegment),
-				Bounds: &mapv1.Segment_Bounds{
-					MinRow:    int32(rowStart),
-					MaxRow:    int32(rowEnd),
-					MinColumn: int32(columnStart),
-					MaxColumn: int32(columnEnd),
-				},
+	segmentRows := make([]*mapv1.Segment_Row, 0, layout.Levels()*layout.SegmentRows())
+	for _, p := range layout.Positions() {
+		if p.Column == 0 {
+			segmentRows = append(segmentRows, &mapv1.Segment_Row{
+				Segments: make([]*mapv1.Segment, 0, layout.SegmentColumns()),
 			})
 		}
+		bounds := layout.Bounds(p)
+		row := segmentRows[len(segmentRows)-1]
+		row.Segments = append(row.Segments, &mapv1.Segment{
+			Tiles:  make([]*mapv1.Tile, 0, (bounds.MaxRow-bounds.MinRow)*(bounds.MaxColumn-bounds.MinColumn)),
+			Bounds: bounds,
+		})
 	}
 
-	// arrange segments in a grid
-	gridRowLength := request.Msg.TotalRows / request.Msg.MaxRowsPerSegment
-	segmentsPerRow := request.Msg.TotalColumns / request.Msg.MaxColumnsPerSegment
-	segmentRows := make([]*mapv1.Segment_Row, 0, request.Msg.TotalRows/request.Msg.MaxRowsPerSegment)
-	gridRow := make([]*mapv1.Segment, 0, segmentsPerRow)
-	var rowStart *int32
-
-	for _, segment := range segments {
-		if rowStart == nil {
-			rowStart = &segment.Bounds.MinRow
-			gridRow = make([]*mapv1.Segment, 0, gridRowLength)
-			gridRow = append(gridRow, segment)
-		} else if *rowStart != segment.Bounds.MinRow {
-			segmentRows = append(segmentRows, &mapv1.Segment_Row{Segments: gridRow})
-			gridRow = make([]*mapv1.Segment, 0, gridRowLength)
-			gridRow = append(gridRow, segment)
-			rowStart = &segment.Bounds.MinRow
-		} else {
-			gridRow = append(gridRow, segment)
-		}
-	}
-	segmentRows = append(segmentRows, &mapv1.Segment_Row{Segments: gridRow})
-
 	stageGrid.Duration = durationpb.New(time.Since(start))
 	stageGrid.State = progressv1.Stage_STATE_DONE
 	stageTiles.State = progressv1.Stage_STATE_RUNNING
@@ -123,29 +166,30 @@ func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[
 
 	// generate tiles & put them into respective segments
 	start = time.Now()
-	totalTiles := request.Msg.TotalRows * request.Msg.TotalColumns
+	totalTiles := layout.Levels() * request.Msg.TotalRows * request.Msg.TotalColumns
 	var processedTileCount int
 
-	for row := range request.Msg.TotalRows {
-		segRowIdx := row / request.Msg.MaxRowsPerSegment
-		segRow := segmentRows[segRowIdx]
+	for depth := range layout.Levels() {
+		for row := range request.Msg.TotalRows {
+			segRowIdx := depth*layout.SegmentRows() + row/request.Msg.MaxRowsPerSegment
+			segRow := segmentRows[segRowIdx]
 
-		for column := range request.Msg.TotalColumns {
-			segColIdx := column / request.Msg.MaxColumnsPerSegment
-			segment := segRow.Segments[segColIdx]
+			for column := range request.Msg.TotalColumns {
+				segColIdx := column / request.Msg.MaxColumnsPerSegment
+				segment := segRow.Segments[segColIdx]
 
-			tile := &mapv1.Tile{
-				Coordinate: &mapv1.Tile_Coordinate{
+				tile := generator.Tile(&mapv1.Tile_Coordinate{
 					Row:    uint32(row),
 					Column: uint32(column),
-				},
-			}
-			segment.Tiles = append(segment.Tiles, tile)
-
-			processedTileCount++
-			if processedTileCount%10_000 == 0 {
-				stageTiles.Subtitle = fmt.Sprintf("%d / %d", processedTileCount, totalTiles)
-				reporter.Update(float64(processedTileCount) / float64(totalTiles))
+					Depth:  depth,
+				})
+				segment.Tiles = append(segment.Tiles, tile)
+
+				processedTileCount++
+				if processedTileCount%10_000 == 0 {
+					stageTiles.Subtitle = fmt.Sprintf("%d / %d", processedTileCount, totalTiles)
+					reporter.Update(float64(processedTileCount) / float64(totalTiles))
+				}
 			}
 		}
 	}
@@ -159,7 +203,9 @@ func (svc *Service) GetSampleGrid(ctx context.Context, request *connect.Request[
 		Grid: &mapv1.Grid{
 			TotalRows:    request.Msg.TotalRows,
 			TotalColumns: request.Msg.TotalColumns,
+			Depths:       layout.Levels(),
 		},
+		Seed: generator.Seed(),
 	}
 	if err := stream.Send(response); err != nil {
 		return err
@@ -186,7 +232,8 @@ func BoundsInclude(b *mapv1.Segment_Bounds, t *mapv1.Tile, modifier int32) bool
 	row := int32(c.GetRow())
 	column := int32(c.GetColumn())
 
-	return row >= (b.GetMinRow()-modifier) &&
+	return c.GetDepth() == b.GetDepth() &&
+		row >= (b.GetMinRow()-modifier) &&
 		row < (b.GetMaxRow()+modifier) &&
 		column >= (b.GetMinColumn()-modifier) &&
 		column < (b.GetMaxColumn()+modifier)
@@ -0,0 +1,171 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	// how often games are checked for turns that have run out of time
+	expiryInterval = time.Second
+	// games expired at once, the rest is handled on the next check
+	expiryBatch = int32(100)
+	// games which failed to expire are retried after a while, so they don't hold up the rest
+	expiryRetry = time.Minute
+)
+
+func (svc *Service) GetTurnState(ctx context.Context, request *connect.Request[gamev1.GetTurnStateRequest]) (*connect.Response[gamev1.GetTurnStateResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseGameID(request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var state *gamev1.TurnState
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		state, err = turns.Load(ctx, q, id, false)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	if !turns.IsPlayer(state, account.ID.String()) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", id))
+	}
+	return connect.NewResponse(&gamev1.GetTurnStateResponse{State: state}), nil
+}
+
+func (svc *Service) endTurn(ctx context.Context, s *session.Session, command *gamev1.Command) ([]*gamev1.Event, error) {
+	id, err := parseGameID(s.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*gamev1.Event
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, id, true)
+		if err != nil {
+			return err
+		}
+		if events, err = turns.EndTurn(state, s.Account.ID.String(), time.Now()); err != nil {
+			return err
+		}
+		return svc.advance(ctx, q, id, state, events)
+	})
+	return events, err
+}
+
+// ExpireTurns ends turns that have run out of time until ctx is done.
+func (svc *Service) ExpireTurns(ctx context.Context) {
+	log := config.GetLogger(ctx)
+	ticker := time.NewTicker(expiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := svc.expireTurns(ctx); err != nil && ctx.Err() == nil {
+			log.Error("failed to expire turns", zap.Error(err))
+		}
+	}
+}
+
+// expireTurns ends expired turns of a batch of games, games that fail are postponed
+// so the rest keeps going.
+func (svc *Service) expireTurns(ctx context.Context) error {
+	log := config.GetLogger(ctx)
+	var ids []uuid.UUID
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		ids, err = turns.ListExpired(ctx, q, expiryBatch)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := svc.expireTurn(ctx, id); err != nil && ctx.Err() == nil {
+			log.Error("failed to expire turn", zap.String("game.id", id.String()), zap.Error(err))
+			err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+				return turns.Postpone(ctx, q, id, time.Now().Add(expiryRetry))
+			})
+			if err != nil {
+				log.Error("failed to postpone expiry", zap.String("game.id", id.String()), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// expireTurn ends the turn of a game if it's still expired.
+func (svc *Service) expireTurn(ctx context.Context, id uuid.UUID) error {
+	// expiring is serialized with commands of the game, so its events are published in order
+	return svc.hub.Execute(ctx, id.String(), func() ([]*gamev1.Event, error) {
+		var events []*gamev1.Event
+		err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			// the turn may have been ended by its player in the meantime
+			state, err := turns.Load(ctx, q, id, true)
+			if err != nil {
+				return err
+			}
+			if events = turns.Expire(state, time.Now()); len(events) == 0 {
+				return nil
+			}
+			return svc.advance(ctx, q, id, state, events)
+		})
+		return events, err
+	})
+}
+
+// advance applies turn transition events to the game and persists its turn state.
+func (svc *Service) advance(ctx context.Context, q *db.Queries, id uuid.UUID, state *gamev1.TurnState, events []*gamev1.Event) error {
+	for _, e := range events {
+		if started := e.GetTurnStarted(); started.GetNewDay() {
+			if err := heroes.NewDay(ctx, q, id); err != nil {
+				return err
+			}
+			if err := towns.NewDay(ctx, q, id, svc.content, started.GetNewWeek()); err != nil {
+				return err
+			}
+			if err := economy.NewDay(ctx, q, id, state.Day, svc.content); err != nil {
+				return err
+			}
+			if started.GetNewWeek() {
+				if err := objects.NewWeek(ctx, q, id, svc.content); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return turns.Save(ctx, q, id, state)
+}
+
+func parseGameID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid game id %q: %w", id, err))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/turns"
+	gamev1 "github.com/openhexes/proto/game/v1"
+)
+
+// current returns index of the player acting in the game.
+func current(t *testing.T, svc *Service, g *lobbytest.Game) uint32 {
+	t.Helper()
+	response, err := svc.GetTurnState(lobbytest.As(g.Host), connect.NewRequest(&gamev1.GetTurnStateRequest{GameId: g.ID.String()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.Msg.State.Current
+}
+
+func TestEndTurn(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+
+	_, err := svc.GetTurnState(lobbytest.As(g.Bravo), connect.NewRequest(&gamev1.GetTurnStateRequest{GameId: g.ID.String()}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected bravo not to see turns of the game, got %v", err)
+	}
+
+	endTurn := &gamev1.Command{Id: "end", Kind: &gamev1.Command_EndTurn_{EndTurn: &gamev1.Command_EndTurn{}}}
+	hub.Dispatch(context.Background(), alfa, endTurn)
+	rejected := lobbytest.Received(alfa, "rejected")
+	if len(rejected) != 1 || rejected[0].GetRejected().GetCode() != connect.CodeFailedPrecondition.String() {
+		t.Fatalf("expected alfa to be rejected out of turn, got %v", rejected)
+	}
+	if n := len(lobbytest.Received(host, "rejected")); n != 0 {
+		t.Errorf("expected rejection to reach only its author, got %d events", n)
+	}
+	if i := current(t, svc, g); i != 0 {
+		t.Fatalf("expected the host to keep acting, got player %d", i)
+	}
+
+	hub.Dispatch(context.Background(), host, endTurn)
+	if n := len(lobbytest.Received(host, "rejected")); n != 0 {
+		t.Fatalf("expected the host to end their turn, got %d rejections", n)
+	}
+	if i := current(t, svc, g); i != 1 {
+		t.Fatalf("expected alfa to act next, got player %d", i)
+	}
+	for name, s := range map[string]*session.Session{"host": host, "alfa": alfa} {
+		if n := len(lobbytest.Received(s, "turn_started")); n != 1 {
+			t.Errorf("expected %s to be told about the turn, got %d events", name, n)
+		}
+	}
+}
+
+func TestExpireTurns(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, hub)
+	host := g.Join(t, hub, g.Host)
+
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, g.ID, true)
+		if err != nil {
+			return err
+		}
+		turns.Limit(state, time.Minute, time.Now().Add(-time.Hour))
+		return turns.Save(ctx, q, g.ID, state)
+	})
+	if err := svc.expireTurns(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if i := current(t, svc, g); i != 1 {
+		t.Fatalf("expected turn of the host to run out, got player %d acting", i)
+	}
+	if n := len(lobbytest.Received(host, "turn_started")); n != 1 {
+		t.Errorf("expected the host to be told about the turn, got %d events", n)
+	}
+}
@@ -0,0 +1,128 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// streamGame sends segments of the game map as seen by the player. Segments sent before
+// are sent again once their visibility changes, clients are expected to repeat their viewport
+// after heroes move to learn about newly revealed tiles.
+func (svc *Service) streamGame(ctx context.Context, stream segmentStream, request *gamev1.StreamSegmentsRequest) error {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseGameID(request.GameId)
+	if err != nil {
+		return err
+	}
+
+	var layout grid.Layout
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, id, false)
+		if err != nil {
+			return err
+		}
+		if !turns.IsPlayer(state, account.ID.String()) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", id))
+		}
+		m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+		if err != nil {
+			return err
+		}
+		layout = m.Layout()
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&gamev1.StreamSegmentsResponse{
+		Grid: &mapv1.Grid{
+			TotalRows:    layout.TotalRows,
+			TotalColumns: layout.TotalColumns,
+			Depths:       layout.Levels(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	sent := map[grid.Position]uint64{} // fingerprints of visibility
+	for {
+		if request.Viewport != nil {
+			if err := checkViewport(request.Viewport, request.Margin); err != nil {
+				return err
+			}
+			positions := layout.Intersecting(request.Viewport, request.Margin)
+			response := &gamev1.StreamSegmentsResponse{}
+			err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+				m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+				if err != nil {
+					return err
+				}
+				explored, err := visibility.Load(ctx, q, id, account.ID, layout)
+				if err != nil {
+					return err
+				}
+				list, err := heroes.List(ctx, q, id)
+				if err != nil {
+					return err
+				}
+				townList, err := towns.List(ctx, q, id)
+				if err != nil {
+					return err
+				}
+				sources := append(visibility.Heroes(list, account.ID.String()), visibility.Towns(townList, account.ID.String())...)
+				visible := visibility.Visible(m, sources...)
+				if err := m.Err(); err != nil {
+					return err
+				}
+
+				for _, p := range positions {
+					segment, err := m.Segment(p)
+					if err != nil {
+						return err
+					}
+					masked := visibility.Mask(segment, explored, visible)
+					fingerprint := visibility.Fingerprint(masked)
+					if previous, ok := sent[p]; ok && previous == fingerprint {
+						continue
+					}
+					sent[p] = fingerprint
+					response.Segments = append(response.Segments, masked)
+				}
+				return nil
+			}, config.WithAccessMode(pgx.ReadOnly))
+			if err != nil {
+				return err
+			}
+			if len(response.Segments) > 0 {
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+
+		request, err = stream.Receive()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,553 @@
+package heroes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/effects"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/magic"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	"github.com/openhexes/proto/heroes/v1/heroesv1connect"
+	magicv1 "github.com/openhexes/proto/magic/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Service struct {
+	heroesv1connect.UnimplementedHeroServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+	engine  *effects.Engine
+	objects *objects.Registry
+	hub     *session.Hub
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry, handlers *objects.Registry, hub *session.Hub) *Service {
+	svc := &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+		engine:  effects.New(),
+		objects: handlers,
+		hub:     hub,
+	}
+	hub.Handle("move_hero", svc.moveHero)
+	for _, kind := range []protoreflect.Name{"hero_recruited", "hero_moved", "spell_cast"} {
+		hub.Redact(kind, svc.redact)
+	}
+	return svc
+}
+
+const (
+	maxHeroesPerPlayer = 8
+	maxNameLength      = 64
+)
+
+func (svc *Service) ListHeroes(ctx context.Context, request *connect.Request[heroesv1.ListHeroesRequest]) (*connect.Response[heroesv1.ListHeroesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &heroesv1.ListHeroesResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, false)
+		if err != nil {
+			return err
+		}
+		if !turns.IsPlayer(state, account.ID.String()) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", gameID))
+		}
+		list, err := heroes.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+
+		// heroes of other players are only listed while seen by the caller
+		m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+		if err != nil {
+			return err
+		}
+		townList, err := towns.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		sources := append(visibility.Heroes(list, account.ID.String()), visibility.Towns(townList, account.ID.String())...)
+		visible := visibility.Visible(m, sources...)
+		if err := m.Err(); err != nil {
+			return err
+		}
+		for _, hero := range list {
+			if hero.OwnerId == account.ID.String() || visible[hex.FromCoordinate(hero.Position)] {
+				response.Heroes = append(response.Heroes, hero)
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) RecruitHero(ctx context.Context, request *connect.Request[heroesv1.RecruitHeroRequest]) (*connect.Response[heroesv1.RecruitHeroResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	if msg.Name == "" || len(msg.Name) > maxNameLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1-%d characters long", maxNameLength))
+	}
+	var hero *heroesv1.Hero
+	err = svc.hub.Execute(ctx, gameID.String(), func() ([]*gamev1.Event, error) {
+		err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			state, err := turns.Load(ctx, q, gameID, true)
+			if err != nil {
+				return err
+			}
+			if err := turns.CanAct(state, account.ID.String()); err != nil {
+				return err
+			}
+			town, err := ownedTown(ctx, q, gameID, msg.TownId, account)
+			if err != nil {
+				return err
+			}
+			kind := svc.content.Town(town.KindId)
+			class := kind.GetHeroClass()
+			if class == nil {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("heroes can't be recruited in town %q", town.Id))
+			}
+
+			// heroes appear in the town, starting with the army & spells of its hero class
+			army := make([]*heroesv1.Hero_Stack, 0, len(class.Army))
+			for _, stack := range class.Army {
+				army = append(army, proto.Clone(stack).(*heroesv1.Hero_Stack))
+			}
+			hero = heroes.New(uuid.NewString(), gameID.String(), account.ID.String(), msg.Name, town.Position, army)
+			hero.Spells = towns.Spells(town, kind)
+
+			list, err := heroes.List(ctx, q, gameID)
+			if err != nil {
+				return err
+			}
+			var owned int
+			for _, other := range list {
+				if other.OwnerId == hero.OwnerId {
+					owned++
+				}
+				if proto.Equal(other.Position, hero.Position) {
+					return connect.NewError(connect.CodeFailedPrecondition, errors.New("town is visited by another hero"))
+				}
+			}
+			if owned >= maxHeroesPerPlayer {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("player can't have more than %d heroes", maxHeroesPerPlayer))
+			}
+
+			_, err = economy.Transact(ctx, q, gameID, account.ID, state.Day, economy.ReasonRecruit, hero.Id, economy.Cost(class.Cost, 1))
+			if errors.Is(err, economy.ErrInsufficient) {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			if err != nil {
+				return err
+			}
+
+			m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+			if err != nil {
+				return err
+			}
+			if err := heroes.Create(ctx, q, hero); err != nil {
+				return err
+			}
+			return visibility.Reveal(ctx, q, gameID, account.ID, m, visibility.Heroes([]*heroesv1.Hero{hero}, hero.OwnerId)...)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []*gamev1.Event{{
+			Kind: &gamev1.Event_HeroRecruited_{HeroRecruited: &gamev1.Event_HeroRecruited{Hero: hero}},
+		}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&heroesv1.RecruitHeroResponse{Hero: hero}), nil
+}
+
+// redact withholds events about heroes from players who don't see them, see visibility.Redact.
+func (svc *Service) redact(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+	id, err := parseID("game", gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	views := map[string]*gamev1.Event{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+		if err != nil {
+			return err
+		}
+		heroList, err := heroes.List(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		townList, err := towns.List(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		for _, accountID := range accountIDs {
+			visible := visibility.Visible(m, append(visibility.Heroes(heroList, accountID), visibility.Towns(townList, accountID)...)...)
+			if e := visibility.Redact(event, accountID, visible); e != nil {
+				views[accountID] = e
+			}
+		}
+		return m.Err()
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
+// ownedTown finds a town of the game controlled by the account.
+func ownedTown(ctx context.Context, q *db.Queries, gameID uuid.UUID, townID string, account *db.Account) (*townsv1.Town, error) {
+	list, err := towns.List(ctx, q, gameID)
+	if err != nil {
+		return nil, err
+	}
+	for _, town := range list {
+		if town.Id != townID {
+			continue
+		}
+		if town.OwnerId != account.ID.String() {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("town %q belongs to another player", townID))
+		}
+		return town, nil
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("town %q not found", townID))
+}
+
+func (svc *Service) MoveHero(ctx context.Context, request *connect.Request[heroesv1.MoveHeroRequest]) (*connect.Response[heroesv1.MoveHeroResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var response *heroesv1.MoveHeroResponse
+	err = svc.hub.Execute(ctx, gameID.String(), func() (events []*gamev1.Event, err error) {
+		response, events, err = svc.move(ctx, account, gameID, request.Msg.HeroId, request.Msg.Goal)
+		return events, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) moveHero(ctx context.Context, s *session.Session, command *gamev1.Command) ([]*gamev1.Event, error) {
+	gameID, err := parseID("game", s.GameID)
+	if err != nil {
+		return nil, err
+	}
+	m := command.GetMoveHero()
+	_, events, err := svc.move(ctx, s.Account, gameID, m.GetHeroId(), m.GetGoal())
+	return events, err
+}
+
+// move walks the hero towards the goal, visiting sites along the way & the object whose entrance it ends on.
+// Returns events to publish: the hero moving & the visited object changing.
+func (svc *Service) move(ctx context.Context, account *db.Account, gameID uuid.UUID, heroID string, goal *mapv1.Tile_Coordinate) (*heroesv1.MoveHeroResponse, []*gamev1.Event, error) {
+	if goal == nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("goal is required"))
+	}
+
+	var (
+		response = &heroesv1.MoveHeroResponse{}
+		visit    *gamev1.Event_ObjectUpdated
+	)
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, true)
+		if err != nil {
+			return err
+		}
+		if err := turns.CanAct(state, account.ID.String()); err != nil {
+			return err
+		}
+
+		list, err := heroes.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		var (
+			hero     *heroesv1.Hero
+			occupied []*mapv1.Tile_Coordinate
+		)
+		for _, h := range list {
+			if h.Id == heroID {
+				hero = h
+			} else {
+				occupied = append(occupied, h.Position)
+			}
+		}
+		if hero == nil {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("hero %q not found", heroID))
+		}
+		if hero.OwnerId != account.ID.String() {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("hero %q belongs to another player", heroID))
+		}
+
+		m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+		if err != nil {
+			return err
+		}
+		placed, err := objects.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		layer, err := objects.NewLayer(placed, svc.content)
+		if err != nil {
+			return err
+		}
+
+		// heroes walk around objects, stepping on an entrance only to visit the object,
+		// connectors lead them across levels
+		start, end := hex.FromCoordinate(hero.Position), hex.FromCoordinate(goal)
+		finder := pathfinding.NewFinder(layer.Block(heroes.Block(m.Connected(), occupied...), start, end), heroes.Walker)
+		path, err := finder.Find(ctx, start, end)
+		if err := m.Err(); err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("finding path: %w", err))
+		}
+
+		traveled, err := heroes.Move(hero, path)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("moving hero: %w", err))
+		}
+		if len(traveled) == 1 && len(path.Steps) > 1 {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("not enough movement points"))
+		}
+
+		response.Hero = hero
+		response.Path = hex.Coordinates(traveled)
+		response.Arrived = len(traveled) == len(path.Steps)
+		response.Visited, err = economy.VisitSites(ctx, q, gameID, account.ID, state.Day, traveled[1:])
+		if err != nil {
+			return err
+		}
+		if o := layer.Entrance(end); o != nil && response.Arrived && len(traveled) > 1 {
+			outcome, err := svc.visit(ctx, q, m, state.Day, hero, o, placed, occupied)
+			if err != nil {
+				return err
+			}
+			response.ObjectId = o.Id
+			response.Defeated = outcome.Defeated
+			visit = &gamev1.Event_ObjectUpdated{Object: o, Removed: outcome.Removed}
+		}
+		if response.Defeated {
+			if err := heroes.Delete(ctx, q, hero); err != nil {
+				return err
+			}
+		} else if err := heroes.Save(ctx, q, hero); err != nil {
+			return err
+		}
+
+		// everything seen along the way is explored, not only tiles around the destination
+		sources := make([]visibility.Source, 0, len(traveled)+1)
+		for _, h := range append(traveled, hex.FromCoordinate(hero.Position)) {
+			sources = append(sources, visibility.Source{Position: h, Radius: visibility.HeroRadius})
+		}
+		return visibility.Reveal(ctx, q, gameID, account.ID, m, sources...)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := []*gamev1.Event{moved(response)}
+	if visit != nil {
+		events = append(events, &gamev1.Event{Kind: &gamev1.Event_ObjectUpdated_{ObjectUpdated: visit}})
+	}
+	return response, events, nil
+}
+
+// visit runs interaction of the hero with an object & applies its outcome except for the hero itself.
+func (svc *Service) visit(ctx context.Context, q *db.Queries, m *mapstore.Map, day uint32, hero *heroesv1.Hero, o *objectsv1.Object, list []*objectsv1.Object, occupied []*mapv1.Tile_Coordinate) (*objects.Outcome, error) {
+	kind := svc.content.Object(o.KindId)
+	accountID := uuid.MustParse(hero.OwnerId)
+	gameID := uuid.MustParse(hero.GameId)
+	treasury, err := economy.Load(ctx, q, gameID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	tile := m.Tile(hex.FromCoordinate(hero.Position))
+	if err := m.Err(); err != nil {
+		return nil, err
+	}
+	others := make(map[hex.Axial]bool, len(occupied))
+	for _, c := range occupied {
+		others[hex.FromCoordinate(c)] = true
+	}
+
+	outcome, err := svc.objects.Visit(&objects.Visit{
+		Hero:     hero,
+		Object:   o,
+		Kind:     kind,
+		Day:      day,
+		Content:  svc.content,
+		Terrain:  svc.content.Terrain(tile.GetTerrainId()),
+		Treasury: treasury,
+		Objects:  list,
+		Occupied: others,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("visiting object %q: %w", o.Id, err))
+	}
+	if _, err := economy.Transact(ctx, q, gameID, accountID, day, outcome.Reason, o.Id, outcome.Deltas); err != nil {
+		return nil, err
+	}
+	if outcome.Removed {
+		return outcome, objects.Delete(ctx, q, o)
+	}
+	return outcome, objects.Save(ctx, q, o)
+}
+
+func (svc *Service) CastSpell(ctx context.Context, request *connect.Request[heroesv1.CastSpellRequest]) (*connect.Response[heroesv1.CastSpellResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	spell := svc.content.Spell(request.Msg.SpellId)
+	if spell == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown spell %q", request.Msg.SpellId))
+	}
+
+	response := &heroesv1.CastSpellResponse{}
+	err = svc.hub.Execute(ctx, gameID.String(), func() ([]*gamev1.Event, error) {
+		err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			state, err := turns.Load(ctx, q, gameID, true)
+			if err != nil {
+				return err
+			}
+			if err := turns.CanAct(state, account.ID.String()); err != nil {
+				return err
+			}
+			hero, err := owned(ctx, q, gameID, request.Msg.HeroId, account)
+			if err != nil {
+				return err
+			}
+			if !slices.Contains(hero.Spells, spell.GetId()) {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("hero doesn't know spell %q", spell.GetId()))
+			}
+
+			m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+			if err != nil {
+				return err
+			}
+			tile := m.Tile(hex.FromCoordinate(hero.Position))
+			if err := m.Err(); err != nil {
+				return err
+			}
+			terrain := svc.content.Terrain(tile.GetTerrainId())
+			if terrain == nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("unknown terrain %q under the hero", tile.GetTerrainId()))
+			}
+
+			result, err := magic.Check(svc.engine, terrain, spell, magicv1.Spell_SCOPE_ADVENTURE, hero.Mana)
+			if err != nil {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			if err := magic.Adventure(hero, spell, result.Level); err != nil {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+
+			response.Hero = hero
+			response.Level = result.Level
+			return heroes.Save(ctx, q, hero)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []*gamev1.Event{{
+			Kind: &gamev1.Event_SpellCast_{SpellCast: &gamev1.Event_SpellCast{
+				Hero:    response.Hero,
+				SpellId: spell.GetId(),
+				Level:   response.Level,
+			}},
+		}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// owned finds a hero of the game controlled by the account.
+func owned(ctx context.Context, q *db.Queries, gameID uuid.UUID, heroID string, account *db.Account) (*heroesv1.Hero, error) {
+	list, err := heroes.List(ctx, q, gameID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hero := range list {
+		if hero.Id != heroID {
+			continue
+		}
+		if hero.OwnerId != account.ID.String() {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("hero %q belongs to another player", heroID))
+		}
+		return hero, nil
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("hero %q not found", heroID))
+}
+
+func moved(response *heroesv1.MoveHeroResponse) *gamev1.Event {
+	return &gamev1.Event{
+		Kind: &gamev1.Event_HeroMoved_{HeroMoved: &gamev1.Event_HeroMoved{
+			Hero:     response.Hero,
+			Path:     response.Path,
+			Visited:  response.Visited,
+			ObjectId: response.ObjectId,
+			Defeated: response.Defeated,
+		}},
+	}
+}
+
+func parseID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id %q: %w", kind, id, err))
+	}
+	return parsed, nil
+}
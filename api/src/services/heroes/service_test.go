@@ -0,0 +1,111 @@
+package heroes
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	"github.com/openhexes/openhexes/api/src/session"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRecruitHero(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, objects.Default(), hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+	hostTown, alfaTown := g.Town(t, g.Host), g.Town(t, g.Alfa)
+	before := g.Treasury(t, g.Host)
+
+	recruit := func(account *db.Account, townID string) func() error {
+		return func() error {
+			_, err := svc.RecruitHero(lobbytest.As(account), connect.NewRequest(&heroesv1.RecruitHeroRequest{
+				GameId: g.ID.String(),
+				Name:   "hero",
+				TownId: townID,
+			}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo recruits", Call: recruit(g.Bravo, hostTown.Id), Code: connect.CodePermissionDenied},
+		{Name: "alfa recruits out of turn", Call: recruit(g.Alfa, alfaTown.Id), Code: connect.CodeFailedPrecondition},
+		{Name: "host recruits in town of alfa", Call: recruit(g.Host, alfaTown.Id), Code: connect.CodePermissionDenied},
+		{Name: "host recruits", Call: recruit(g.Host, hostTown.Id)},
+		{Name: "host recruits in visited town", Call: recruit(g.Host, hostTown.Id), Code: connect.CodeFailedPrecondition},
+	})
+
+	cost := g.Content.Town(hostTown.KindId).GetHeroClass().GetCost()
+	lobbytest.CheckDeltas(t, before, g.Treasury(t, g.Host), economy.Cost(cost, 1))
+	if n := len(lobbytest.Received(host, "hero_recruited")); n != 1 {
+		t.Errorf("expected the host to be told about the hero, got %d events", n)
+	}
+	if n := len(lobbytest.Received(alfa, "hero_recruited")); n != 0 {
+		t.Errorf("expected alfa not to see the hero, got %d events", n)
+	}
+}
+
+func TestMoveHero(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, objects.Default(), hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+
+	hostHero := heroes.New(uuid.NewString(), g.ID.String(), g.Host.ID.String(), "host", lobbytest.HostTown, nil)
+	alfaHero := heroes.New(uuid.NewString(), g.ID.String(), g.Alfa.ID.String(), "alfa", lobbytest.AlfaTown, nil)
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		for _, hero := range []*heroesv1.Hero{hostHero, alfaHero} {
+			if err := heroes.Create(ctx, q, hero); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	goal := &mapv1.Tile_Coordinate{Row: 4, Column: 4}
+	var arrived *heroesv1.Hero
+	move := func(account *db.Account, heroID string) func() error {
+		return func() error {
+			response, err := svc.MoveHero(lobbytest.As(account), connect.NewRequest(&heroesv1.MoveHeroRequest{
+				GameId: g.ID.String(),
+				HeroId: heroID,
+				Goal:   goal,
+			}))
+			if err == nil {
+				arrived = response.Msg.Hero
+			}
+			return err
+		}
+	}
+	list := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.ListHeroes(lobbytest.As(account), connect.NewRequest(&heroesv1.ListHeroesRequest{GameId: g.ID.String()}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo lists heroes", Call: list(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "bravo moves", Call: move(g.Bravo, hostHero.Id), Code: connect.CodePermissionDenied},
+		{Name: "alfa moves out of turn", Call: move(g.Alfa, alfaHero.Id), Code: connect.CodeFailedPrecondition},
+		{Name: "host moves hero of alfa", Call: move(g.Host, alfaHero.Id), Code: connect.CodePermissionDenied},
+		{Name: "host moves", Call: move(g.Host, hostHero.Id)},
+	})
+
+	if !proto.Equal(arrived.GetPosition(), goal) {
+		t.Errorf("expected the hero to arrive at %v, got %v", goal, arrived.GetPosition())
+	}
+	if n := len(lobbytest.Received(host, "hero_moved")); n != 1 {
+		t.Errorf("expected the host to be told about the move, got %d events", n)
+	}
+	if n := len(lobbytest.Received(alfa, "hero_moved")); n != 0 {
+		t.Errorf("expected alfa not to see the move, got %d events", n)
+	}
+}
@@ -0,0 +1,54 @@
+package iam
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	v1 "github.com/openhexes/proto/iam/v1"
+)
+
+// cursor points at the last account of a page, it's opaque to clients.
+type cursor struct {
+	Order     v1.ListAccountsRequest_Order `json:"o"`
+	CreatedAt time.Time                    `json:"c,omitzero"`
+	ID        uuid.UUID                    `json:"i,omitzero"`
+	Email     string                       `json:"e,omitempty"`
+}
+
+func newCursor(order v1.ListAccountsRequest_Order, last *db.Account) string {
+	c := cursor{Order: order}
+	if order == v1.ListAccountsRequest_ORDER_EMAIL {
+		c.Email = last.Email
+	} else {
+		c.CreatedAt, c.ID = last.CreatedAt.Time, last.ID
+	}
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func parseCursor(order v1.ListAccountsRequest_Order, value string) (*cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor: %w", err))
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(raw, c); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor: %w", err))
+	}
+	if c.Order != order {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cursor belongs to a different order"))
+	}
+	if order == v1.ListAccountsRequest_ORDER_EMAIL && c.Email == "" || order != v1.ListAccountsRequest_ORDER_EMAIL && c.ID == uuid.Nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cursor is missing position"))
+	}
+	return c, nil
+}
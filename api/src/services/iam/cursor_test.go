@@ -0,0 +1,73 @@
+package iam
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/db"
+	v1 "github.com/openhexes/proto/iam/v1"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	last := &db.Account{
+		ID:        uuid.New(),
+		Email:     "alfa@test.com",
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 10, 17, 12, 30, 0, 123456000, time.UTC), Valid: true},
+	}
+
+	for _, tc := range []struct {
+		order    v1.ListAccountsRequest_Order
+		expected cursor
+	}{
+		{v1.ListAccountsRequest_ORDER_NEWEST_FIRST, cursor{Order: v1.ListAccountsRequest_ORDER_NEWEST_FIRST, CreatedAt: last.CreatedAt.Time, ID: last.ID}},
+		{v1.ListAccountsRequest_ORDER_EMAIL, cursor{Order: v1.ListAccountsRequest_ORDER_EMAIL, Email: last.Email}},
+	} {
+		t.Run(tc.order.String(), func(t *testing.T) {
+			c, err := parseCursor(tc.order, newCursor(tc.order, last))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Order != tc.expected.Order || !c.CreatedAt.Equal(tc.expected.CreatedAt) || c.ID != tc.expected.ID || c.Email != tc.expected.Email {
+				t.Errorf("expected %+v, got %+v", tc.expected, *c)
+			}
+		})
+	}
+
+	if c, err := parseCursor(v1.ListAccountsRequest_ORDER_EMAIL, ""); c != nil || err != nil {
+		t.Errorf("expected first page without cursor, got %v, %v", c, err)
+	}
+}
+
+func TestCursorRejected(t *testing.T) {
+	last := &db.Account{ID: uuid.New(), Email: "alfa@test.com"}
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	newest := newCursor(v1.ListAccountsRequest_ORDER_NEWEST_FIRST, last)
+
+	for _, tc := range []struct {
+		name  string
+		order v1.ListAccountsRequest_Order
+		value string
+	}{
+		{"different order", v1.ListAccountsRequest_ORDER_EMAIL, newest},
+		{"different order reversed", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, newCursor(v1.ListAccountsRequest_ORDER_EMAIL, last)},
+		{"not base64", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, "!" + newest},
+		{"truncated", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, newest[:len(newest)/2]},
+		{"not json", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, encode("cursor")},
+		{"invalid id", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, encode(`{"o":1,"i":"me"}`)},
+		{"missing id", v1.ListAccountsRequest_ORDER_NEWEST_FIRST, encode(`{"o":1}`)},
+		{"missing email", v1.ListAccountsRequest_ORDER_EMAIL, encode(`{"o":2}`)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := parseCursor(tc.order, tc.value)
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("expected invalid argument, got %+v, %v", c, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package iam
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/identity"
+	v1 "github.com/openhexes/proto/iam/v1"
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
+	"go.uber.org/zap"
+)
+
+// bcrypt ignores anything beyond
+const maxPasswordLength = 72
+
+var kinds = map[identity.Kind]v1.IdentityProvider_Kind{
+	identity.KindIDToken:     v1.IdentityProvider_KIND_ID_TOKEN,
+	identity.KindAccessToken: v1.IdentityProvider_KIND_ACCESS_TOKEN,
+	identity.KindPassword:    v1.IdentityProvider_KIND_PASSWORD,
+}
+
+// ListIdentityProviders starts attempts to sign in with providers exchanging codes when a redirect URI is given,
+// they're bound to the caller by the attempt cookie.
+func (svc *Service) ListIdentityProviders(ctx context.Context, request *connect.Request[v1.ListIdentityProvidersRequest]) (*connect.Response[v1.ListIdentityProvidersResponse], error) {
+	secret := auth.NewAttemptSecret()
+	response := connect.NewResponse(&v1.ListIdentityProvidersResponse{})
+	for _, p := range svc.auth.Providers().List() {
+		provider := &v1.IdentityProvider{Name: p.Name(), Kind: kinds[p.Kind()]}
+		if ex, ok := p.(identity.Exchanger); ok {
+			provider.ClientId = ex.ClientID()
+			if request.Msg.RedirectUri != "" {
+				url, err := svc.auth.Authorize(ctx, p.Name(), request.Msg.RedirectUri, secret)
+				if err != nil {
+					return nil, err
+				}
+				provider.AuthorizeUrl = url
+			}
+		}
+		response.Msg.Providers = append(response.Msg.Providers, provider)
+	}
+	if slices.ContainsFunc(response.Msg.Providers, func(p *v1.IdentityProvider) bool { return p.AuthorizeUrl != "" }) {
+		response.Header().Add("Set-Cookie", attemptCookie(secret, time.Now().Add(auth.AttemptTTL)).String())
+	}
+	return response, nil
+}
+
+// ExchangeCode accepts codes of attempts started by the caller only, the attempt cookie is dropped once used.
+func (svc *Service) ExchangeCode(ctx context.Context, request *connect.Request[v1.ExchangeCodeRequest]) (*connect.Response[v1.ExchangeCodeResponse], error) {
+	msg := request.Msg
+	var secret string
+	if cookie, err := (&http.Request{Header: request.Header()}).Cookie(auth.AttemptCookie); err == nil {
+		secret = cookie.Value
+	}
+	credential, err := svc.auth.Exchange(ctx, msg.Provider, msg.Code, msg.RedirectUri, msg.State, secret)
+	if err != nil {
+		return nil, err
+	}
+	response := connect.NewResponse(&v1.ExchangeCodeResponse{Credential: credential})
+	response.Header().Add("Set-Cookie", attemptCookie("", time.Unix(0, 0)).String())
+	return response, nil
+}
+
+func attemptCookie(value string, expires time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     auth.AttemptCookie,
+		Value:    value,
+		Path:     iamv1connect.IAMServiceExchangeCodeProcedure,
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// SignUp creates an account with a password login, it has to be activated before use.
+// Owners configured by email aren't recognized, since nobody verified the email.
+// Taken emails get the same response, so it can't be used to find out who has an account.
+func (svc *Service) SignUp(ctx context.Context, request *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error) {
+	log := config.GetLogger(ctx)
+	msg := request.Msg
+	if err := svc.checkPassword(msg.Password); err != nil {
+		return nil, err
+	}
+	address, err := mail.ParseAddress(msg.Email)
+	if err != nil || address.Name != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid email %q", msg.Email))
+	}
+	email := identity.NormalizeEmail(address.Address)
+	hash, err := identity.HashPassword(svc.cfg.Auth.Password.Hash, msg.Password)
+	if err != nil {
+		return nil, fmt.Errorf("hashing password: %w", err)
+	}
+
+	var account db.Account
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetAccount(ctx, email); err == nil {
+			return nil
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		account, err = q.CreateAccount(ctx, db.CreateAccountParams{
+			Email:       email,
+			DisplayName: cmp.Or(strings.TrimSpace(msg.DisplayName), strings.Split(email, "@")[0]),
+		})
+		if err != nil {
+			return fmt.Errorf("creating account: %w", err)
+		}
+		_, err = q.CreateLinkedIdentity(ctx, db.CreateLinkedIdentityParams{
+			Provider:     identity.PasswordProvider,
+			Subject:      email,
+			AccountID:    account.ID,
+			Email:        email,
+			PasswordHash: pgtype.Text{String: hash, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("linking identity: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if account.ID == uuid.Nil {
+		log.Info("sign up with a taken email ignored")
+	} else {
+		log.Info("account signed up", zap.String("account.id", account.ID.String()))
+	}
+	return connect.NewResponse(&v1.SignUpResponse{}), nil
+}
+
+func (svc *Service) ListIdentities(ctx context.Context, request *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	response := &v1.ListIdentitiesResponse{}
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		list, err := q.ListLinkedIdentities(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("listing identities: %w", err)
+		}
+		for i := range list {
+			response.Identities = append(response.Identities, conv.IdentityToProto(&list[i]))
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// LinkIdentity adds another login to the caller, its email doesn't have to match or be verified.
+func (svc *Service) LinkIdentity(ctx context.Context, request *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	if request.Msg.Provider == identity.PasswordProvider {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("password logins are added with SetPassword"))
+	}
+	i, err := svc.auth.Resolve(ctx, request.Msg.Provider, request.Msg.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	var linked db.LinkedIdentity
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		existing, err := q.GetLinkedIdentity(ctx, db.GetLinkedIdentityParams{Provider: i.Provider, Subject: i.Subject})
+		switch {
+		case err == nil && existing.AccountID == account.ID:
+			return connect.NewError(connect.CodeAlreadyExists, errors.New("identity is already linked"))
+		case err == nil:
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("identity is linked to another account"))
+		case !errors.Is(err, pgx.ErrNoRows):
+			return fmt.Errorf("getting identity: %w", err)
+		}
+		linked, err = q.CreateLinkedIdentity(ctx, db.CreateLinkedIdentityParams{
+			Provider:  i.Provider,
+			Subject:   i.Subject,
+			AccountID: account.ID,
+			Email:     i.Email,
+		})
+		if err != nil {
+			return fmt.Errorf("linking identity: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"identity linked",
+		zap.String("account.id", account.ID.String()),
+		zap.String("provider", i.Provider),
+		zap.String("subject", i.Subject),
+	)
+	return connect.NewResponse(&v1.LinkIdentityResponse{Identity: conv.IdentityToProto(&linked)}), nil
+}
+
+// UnlinkIdentity removes a login of the caller, the last one is kept. Other sessions signed in
+// with the provider end. Logins with the verified email of the account get linked again when used.
+func (svc *Service) UnlinkIdentity(ctx context.Context, request *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	msg := request.Msg
+
+	var ended []uuid.UUID
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		list, err := q.ListLinkedIdentities(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("listing identities: %w", err)
+		}
+		if len(list) <= 1 {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("the last login can't be unlinked"))
+		}
+		deleted, err := q.DeleteLinkedIdentity(ctx, db.DeleteLinkedIdentityParams{
+			Provider:  msg.Provider,
+			Subject:   msg.Subject,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("unlinking identity: %w", err)
+		}
+		if deleted == 0 {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("identity %s/%s not found", msg.Provider, msg.Subject))
+		}
+		ended, err = q.DeleteSessions(ctx, db.DeleteSessionsParams{
+			AccountID: account.ID,
+			KeepID:    pgtype.UUID{Bytes: auth.SessionFromContext(ctx), Valid: true},
+			Provider:  pgtype.Text{String: msg.Provider, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("ending sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.auth.EvictSessions(ended...)
+	log.Info(
+		"identity unlinked",
+		zap.String("account.id", account.ID.String()),
+		zap.String("provider", msg.Provider),
+		zap.String("subject", msg.Subject),
+		zap.Int("sessions.ended", len(ended)),
+	)
+	return connect.NewResponse(&v1.UnlinkIdentityResponse{}), nil
+}
+
+// SetPassword adds a password login to the caller or changes its password,
+// other sessions signed in with a password end.
+func (svc *Service) SetPassword(ctx context.Context, request *connect.Request[v1.SetPasswordRequest]) (*connect.Response[v1.SetPasswordResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	if err := svc.checkPassword(request.Msg.Password); err != nil {
+		return nil, err
+	}
+	hash, err := identity.HashPassword(svc.cfg.Auth.Password.Hash, request.Msg.Password)
+	if err != nil {
+		return nil, fmt.Errorf("hashing password: %w", err)
+	}
+
+	subject := identity.NormalizeEmail(account.Email)
+	var (
+		linked db.LinkedIdentity
+		ended  []uuid.UUID
+	)
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		linked, err = q.UpdateLinkedIdentityPassword(ctx, db.UpdateLinkedIdentityPasswordParams{
+			PasswordHash: pgtype.Text{String: hash, Valid: true},
+			Provider:     identity.PasswordProvider,
+			Subject:      subject,
+			AccountID:    account.ID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			if _, err := q.GetLinkedIdentity(ctx, db.GetLinkedIdentityParams{Provider: identity.PasswordProvider, Subject: subject}); err == nil {
+				return connect.NewError(connect.CodeFailedPrecondition, errors.New("email is used by another password login"))
+			}
+			linked, err = q.CreateLinkedIdentity(ctx, db.CreateLinkedIdentityParams{
+				Provider:     identity.PasswordProvider,
+				Subject:      subject,
+				AccountID:    account.ID,
+				Email:        account.Email,
+				PasswordHash: pgtype.Text{String: hash, Valid: true},
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		// whoever knew the old password is signed out
+		ended, err = q.DeleteSessions(ctx, db.DeleteSessionsParams{
+			AccountID: account.ID,
+			KeepID:    pgtype.UUID{Bytes: auth.SessionFromContext(ctx), Valid: true},
+			Provider:  pgtype.Text{String: identity.PasswordProvider, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("ending sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.auth.EvictSessions(ended...)
+	log.Info("password set", zap.String("account.id", account.ID.String()), zap.Int("sessions.ended", len(ended)))
+	return connect.NewResponse(&v1.SetPasswordResponse{Identity: conv.IdentityToProto(&linked)}), nil
+}
+
+func (svc *Service) checkPassword(password string) error {
+	if svc.auth.Providers().Get(identity.PasswordProvider) == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("password logins are disabled"))
+	}
+	if n := len(password); n < svc.cfg.Auth.Password.MinLength || n > maxPasswordLength {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("password has to be %d to %d bytes long", svc.cfg.Auth.Password.MinLength, maxPasswordLength),
+		)
+	}
+	return nil
+}
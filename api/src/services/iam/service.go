@@ -1,27 +1,40 @@
 package iam
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/openhexes/openhexes/api/src/auth"
 	"github.com/openhexes/openhexes/api/src/config"
 	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
 	v1 "github.com/openhexes/proto/iam/v1"
 	"github.com/openhexes/proto/iam/v1/iamv1connect"
+	"go.uber.org/zap"
 )
 
 type Service struct {
 	iamv1connect.UnimplementedIAMServiceHandler
 
-	cfg  *config.Config
-	auth *auth.Controller
+	cfg    *config.Config
+	auth   *auth.Controller
+	policy *auth.Policy
 }
 
-func New(cfg *config.Config, auth *auth.Controller) *Service {
+func New(cfg *config.Config, auth *auth.Controller, policy *auth.Policy) *Service {
 	return &Service{
-		cfg:  cfg,
-		auth: auth,
+		cfg:    cfg,
+		auth:   auth,
+		policy: policy,
 	}
 }
 
@@ -32,6 +45,287 @@ func (svc *Service) ResolveAccount(ctx context.Context, request *connect.Request
 	}), err
 }
 
+const (
+	defaultAccountsLimit = uint32(100)
+	maxAccountsLimit     = uint32(1000)
+	accountsPerChunk     = 50
+)
+
+// ListAccounts streams a page of accounts with their roles.
 func (svc *Service) ListAccounts(ctx context.Context, request *connect.Request[v1.ListAccountsRequest], stream *connect.ServerStream[v1.ListAccountsResponse]) error {
+	msg := request.Msg
+	order := cmp.Or(msg.Order, v1.ListAccountsRequest_ORDER_NEWEST_FIRST)
+	after, err := parseCursor(order, msg.Cursor)
+	if err != nil {
+		return err
+	}
+	limit := min(cmp.Or(msg.Limit, defaultAccountsLimit), maxAccountsLimit)
+
+	var (
+		accounts []db.Account
+		roles    = map[uuid.UUID][]string{}
+	)
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		// one extra account tells whether there's another page
+		var (
+			active = pgtype.Bool{Bool: msg.GetActive(), Valid: msg.Active != nil}
+			email  = pgtype.Text{String: escapeLike(msg.Email), Valid: msg.Email != ""}
+			role   = pgtype.Text{String: msg.RoleId, Valid: msg.RoleId != ""}
+		)
+		if order == v1.ListAccountsRequest_ORDER_EMAIL {
+			params := db.ListAccountsByEmailParams{Active: active, Email: email, RoleID: role, RowLimit: int32(limit) + 1}
+			if after != nil {
+				params.AfterEmail = pgtype.Text{String: after.Email, Valid: true}
+			}
+			accounts, err = q.ListAccountsByEmail(ctx, params)
+		} else {
+			params := db.ListAccountsParams{Active: active, Email: email, RoleID: role, RowLimit: int32(limit) + 1}
+			if after != nil {
+				params.BeforeCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+				params.BeforeID = pgtype.UUID{Bytes: after.ID, Valid: true}
+			}
+			accounts, err = q.ListAccounts(ctx, params)
+		}
+		if err != nil {
+			return fmt.Errorf("listing accounts: %w", err)
+		}
+
+		for _, a := range accounts[:min(len(accounts), int(limit))] {
+			if roles[a.ID], err = q.ListAccountRoles(ctx, a.ID); err != nil {
+				return fmt.Errorf("listing roles of %q: %w", a.ID, err)
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return err
+	}
+
+	var next string
+	if len(accounts) > int(limit) {
+		accounts = accounts[:limit]
+		next = newCursor(order, &accounts[len(accounts)-1])
+	}
+	list := make([]*v1.Account, 0, len(accounts))
+	for i := range accounts {
+		a := conv.AccountToProto(&accounts[i])
+		a.Roles = roles[accounts[i].ID]
+		list = append(list, a)
+	}
+
+	chunks := slices.Collect(slices.Chunk(list, accountsPerChunk))
+	if len(chunks) == 0 {
+		chunks = [][]*v1.Account{nil}
+	}
+	for i, chunk := range chunks {
+		response := &v1.ListAccountsResponse{Accounts: chunk}
+		if i == len(chunks)-1 {
+			response.NextCursor = next
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// UpdateAccountActivation activates or deactivates accounts holding no roles beyond the ones of the caller.
+// Changes take effect immediately & are recorded along with the account which made them.
+func (svc *Service) UpdateAccountActivation(ctx context.Context, request *connect.Request[v1.UpdateAccountActivationRequest]) (*connect.Response[v1.UpdateAccountActivationResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	if len(request.Msg.IdToActivation) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no accounts to update"))
+	}
+
+	ids := map[bool][]uuid.UUID{}
+	for _, id := range slices.Sorted(maps.Keys(request.Msg.IdToActivation)) {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account id %q: %w", id, err))
+		}
+		active := request.Msg.IdToActivation[id]
+		if parsed == account.ID && !active {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("accounts can't deactivate themselves"))
+		}
+		ids[active] = append(ids[active], parsed)
+	}
+
+	changed := map[uuid.UUID]bool{}
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		for _, list := range ids {
+			for _, id := range list {
+				if _, err := q.GetAccountByID(ctx, id); errors.Is(err, pgx.ErrNoRows) {
+					return connect.NewError(connect.CodeNotFound, fmt.Errorf("account %q not found", id))
+				} else if err != nil {
+					return fmt.Errorf("getting account %q: %w", id, err)
+				}
+				if err := checkOutranked(ctx, q, auth.RolesFromContext(ctx), id); err != nil {
+					return err
+				}
+			}
+		}
+		for active, list := range ids {
+			updated, err := q.UpdateAccountActivation(ctx, db.UpdateAccountActivationParams{Active: active, Ids: list})
+			if err != nil {
+				return fmt.Errorf("updating accounts: %w", err)
+			}
+			for _, id := range updated {
+				err := q.CreateAccountActivation(ctx, db.CreateAccountActivationParams{
+					AccountID: id,
+					Active:    active,
+					ChangedBy: pgtype.UUID{Bytes: account.ID, Valid: true},
+				})
+				if err != nil {
+					return fmt.Errorf("recording activation of %q: %w", id, err)
+				}
+				changed[id] = active
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// cached accounts keep their previous activation until evicted, open streams until ended
+	svc.auth.Evict(slices.Collect(maps.Keys(changed))...)
+	var deactivated []uuid.UUID
+	for id, active := range changed {
+		if !active {
+			deactivated = append(deactivated, id)
+		}
+	}
+	svc.auth.EndStreams(auth.ErrDeactivated, deactivated...)
+	for id, active := range changed {
+		log.Info(
+			"account activation changed",
+			zap.String("account.id", id.String()),
+			zap.Bool("active", active),
+			zap.String("changed_by", account.ID.String()),
+		)
+	}
+	return connect.NewResponse(&v1.UpdateAccountActivationResponse{}), nil
+}
+
+// GrantRole grants a role known to the policy, callers may only grant roles they hold themselves.
+func (svc *Service) GrantRole(ctx context.Context, request *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	accountID, role, err := svc.parseBinding(ctx, request.Msg.AccountId, request.Msg.RoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *v1.Account
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		target, err := q.GetAccountByID(ctx, accountID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("account %q not found", accountID))
+		} else if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		if err := q.GrantRole(ctx, db.GrantRoleParams{AccountID: accountID, RoleID: role}); err != nil {
+			return fmt.Errorf("granting role: %w", err)
+		}
+		result, err = withRoles(ctx, q, &target)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"role granted",
+		zap.String("account.id", accountID.String()),
+		zap.String("role.id", role),
+		zap.String("granted_by", account.ID.String()),
+	)
+	return connect.NewResponse(&v1.GrantRoleResponse{Account: result}), nil
+}
+
+// RevokeRole revokes a role of another account, callers may only revoke roles they hold themselves.
+func (svc *Service) RevokeRole(ctx context.Context, request *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	accountID, role, err := svc.parseBinding(ctx, request.Msg.AccountId, request.Msg.RoleId)
+	if err != nil {
+		return nil, err
+	}
+	if accountID == account.ID {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("accounts can't revoke their own roles"))
+	}
+
+	var result *v1.Account
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		target, err := q.GetAccountByID(ctx, accountID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("account %q not found", accountID))
+		} else if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		revoked, err := q.RevokeRole(ctx, db.RevokeRoleParams{AccountID: accountID, RoleID: role})
+		if err != nil {
+			return fmt.Errorf("revoking role: %w", err)
+		}
+		if revoked == 0 {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("account %q isn't granted role %q", accountID, role))
+		}
+		result, err = withRoles(ctx, q, &target)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(
+		"role revoked",
+		zap.String("account.id", accountID.String()),
+		zap.String("role.id", role),
+		zap.String("revoked_by", account.ID.String()),
+	)
+	return connect.NewResponse(&v1.RevokeRoleResponse{Account: result}), nil
+}
+
+// parseBinding validates ids of a role binding against the policy & roles of the caller.
+func (svc *Service) parseBinding(ctx context.Context, accountID, role string) (uuid.UUID, string, error) {
+	parsed, err := uuid.Parse(accountID)
+	if err != nil {
+		return uuid.Nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid account id %q: %w", accountID, err))
+	}
+	if !svc.policy.Known(role) {
+		return uuid.Nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown role %q", role))
+	}
+	if !slices.Contains(auth.RolesFromContext(ctx), role) {
+		return uuid.Nil, "", connect.NewError(connect.CodePermissionDenied, fmt.Errorf("role %q isn't held by the caller", role))
+	}
+	return parsed, role, nil
+}
+
+// checkOutranked makes sure the account holds no roles beyond the given ones,
+// e.g. moderators can't deactivate owners.
+func checkOutranked(ctx context.Context, q *db.Queries, roles []string, accountID uuid.UUID) error {
+	held, err := q.ListAccountRoles(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("listing roles of %q: %w", accountID, err)
+	}
+	for _, role := range held {
+		if !slices.Contains(roles, role) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("account %q holds role %q", accountID, role))
+		}
+	}
+	return nil
+}
+
+func withRoles(ctx context.Context, q *db.Queries, account *db.Account) (*v1.Account, error) {
+	roles, err := q.ListAccountRoles(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("listing roles of %q: %w", account.ID, err)
+	}
+	result := conv.AccountToProto(account)
+	result.Roles = roles
+	return result, nil
+}
+
+// escapeLike makes wildcards of a like pattern match literally.
+var escapeLike = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace
@@ -0,0 +1,150 @@
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/identity"
+	v1 "github.com/openhexes/proto/iam/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUpdateAccountActivation(t *testing.T) {
+	cfg := config.SetUpTest(t)
+	controller, err := auth.NewController(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := New(cfg, controller, auth.DefaultPolicy())
+
+	ctx := context.Background()
+	accounts := map[string]*db.Account{}
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		for _, role := range []string{auth.RoleModerator, auth.RoleOwner} {
+			if err := q.CreateRole(ctx, role); err != nil {
+				return err
+			}
+		}
+		for name, role := range map[string]string{"moderator": auth.RoleModerator, "owner": auth.RoleOwner, "player": ""} {
+			a, err := q.CreateAccount(ctx, db.CreateAccountParams{
+				Active:      true,
+				Email:       uuid.NewString() + "@test.com",
+				DisplayName: name,
+			})
+			if err != nil {
+				return err
+			}
+			accounts[name] = &a
+			if role != "" {
+				if err := q.GrantRole(ctx, db.GrantRoleParams{AccountID: a.ID, RoleID: role}); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	moderator, player := accounts["moderator"], accounts["player"]
+	ctx = context.WithValue(ctx, auth.ContextKey, moderator)
+	ctx = context.WithValue(ctx, auth.RolesContextKey, []string{auth.RoleModerator})
+	update := func(idToActivation map[string]bool) error {
+		_, err := svc.UpdateAccountActivation(ctx, connect.NewRequest(&v1.UpdateAccountActivationRequest{IdToActivation: idToActivation}))
+		return err
+	}
+
+	for _, tc := range []struct {
+		name           string
+		idToActivation map[string]bool
+		code           connect.Code
+	}{
+		{"no accounts", nil, connect.CodeInvalidArgument},
+		{"invalid id", map[string]bool{"player": false}, connect.CodeInvalidArgument},
+		{"deactivating self", map[string]bool{moderator.ID.String(): false}, connect.CodeFailedPrecondition},
+		{"deactivating outranking account", map[string]bool{accounts["owner"].ID.String(): false}, connect.CodePermissionDenied},
+		{"unknown account", map[string]bool{player.ID.String(): false, uuid.NewString(): false}, connect.CodeNotFound},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := update(tc.idToActivation); connect.CodeOf(err) != tc.code {
+				t.Errorf("expected %v, got %v", tc.code, err)
+			}
+		})
+	}
+
+	// repeating the update records no further change
+	for range 2 {
+		if err := update(map[string]bool{player.ID.String(): false}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		a, err := q.GetAccountByID(ctx, player.ID)
+		if err != nil {
+			return err
+		}
+		if a.Active {
+			t.Error("expected account to be deactivated")
+		}
+
+		var n int
+		err = tx.QueryRow(ctx, "select count(*) from account_activations where account_id = $1 and not active and changed_by = $2", player.ID, moderator.ID).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n != 1 {
+			t.Errorf("expected 1 recorded deactivation, got %d", n)
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSignUp(t *testing.T) {
+	cfg := config.SetUpTest(t)
+	cfg.Auth.Password.Enabled = true
+	controller, err := auth.NewController(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := New(cfg, controller, auth.DefaultPolicy())
+
+	ctx := context.Background()
+	email := uuid.NewString() + "@test.com"
+	signUp := func(password string) *v1.SignUpResponse {
+		t.Helper()
+		response, err := svc.SignUp(ctx, connect.NewRequest(&v1.SignUpRequest{Email: email, Password: password}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return response.Msg
+	}
+
+	// signing up with a taken email looks the same & doesn't touch the account
+	first, second := signUp("first password"), signUp("second password")
+	if !proto.Equal(first, second) {
+		t.Errorf("expected the same response, got %v & %v", first, second)
+	}
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		linked, err := q.GetLinkedIdentity(ctx, db.GetLinkedIdentityParams{Provider: identity.PasswordProvider, Subject: email})
+		if err != nil {
+			return err
+		}
+		if ok, err := identity.CheckPassword(linked.PasswordHash.String, "first password"); err != nil || !ok {
+			t.Errorf("expected the first password to be kept, got %v", err)
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,194 @@
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	v1 "github.com/openhexes/proto/iam/v1"
+	"github.com/openhexes/proto/iam/v1/iamv1connect"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxUserAgentLength = 256
+
+func (svc *Service) SignIn(ctx context.Context, request *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error) {
+	userAgent := request.Header().Get("User-Agent")
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	userAgent = strings.ToValidUTF8(userAgent, "")
+	account, tokens, err := svc.auth.SignIn(ctx, request.Msg.Provider, request.Msg.Credential, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	response := connect.NewResponse(&v1.SignInResponse{
+		Tokens:  tokensToProto(tokens),
+		Account: conv.AccountToProto(account),
+	})
+	if request.Msg.RefreshCookie {
+		setRefreshCookie(response.Header(), response.Msg.Tokens)
+	}
+	return response, nil
+}
+
+func (svc *Service) Refresh(ctx context.Context, request *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	token, fromCookie := request.Msg.RefreshToken, false
+	if token == "" {
+		cookie, err := (&http.Request{Header: request.Header()}).Cookie(auth.RefreshCookie)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("refresh token not set"))
+		}
+		token, fromCookie = cookie.Value, true
+	}
+	tokens, err := svc.auth.Refresh(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	response := connect.NewResponse(&v1.RefreshResponse{Tokens: tokensToProto(tokens)})
+	if fromCookie {
+		setRefreshCookie(response.Header(), response.Msg.Tokens)
+	}
+	return response, nil
+}
+
+// setRefreshCookie moves the refresh token into the cookie, it's kept as is if none was issued.
+func setRefreshCookie(header http.Header, tokens *v1.Tokens) {
+	if tokens.RefreshToken == "" {
+		return
+	}
+	header.Add("Set-Cookie", refreshCookie(tokens.RefreshToken, tokens.RefreshExpiresAt.AsTime()).String())
+	tokens.RefreshToken = ""
+}
+
+// clearRefreshCookie drops the refresh cookie of the browser signing out.
+func clearRefreshCookie(header http.Header) {
+	header.Add("Set-Cookie", refreshCookie("", time.Unix(0, 0)).String())
+}
+
+func refreshCookie(value string, expires time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     auth.RefreshCookie,
+		Value:    value,
+		Path:     iamv1connect.IAMServiceRefreshProcedure,
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	}
+}
+
+func (svc *Service) ListSessions(ctx context.Context, request *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	current := auth.SessionFromContext(ctx)
+	response := &v1.ListSessionsResponse{}
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		list, err := q.ListSessions(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("listing sessions: %w", err)
+		}
+		for i := range list {
+			s := conv.SessionToProto(&list[i])
+			s.Current = list[i].ID == current
+			response.Sessions = append(response.Sessions, s)
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// RevokeSession ends a session of the caller, signing out if it's the current one.
+func (svc *Service) RevokeSession(ctx context.Context, request *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	id := auth.SessionFromContext(ctx)
+	if request.Msg.Id != "" {
+		parsed, err := uuid.Parse(request.Msg.Id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid session id %q: %w", request.Msg.Id, err))
+		}
+		id = parsed
+	}
+
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		deleted, err := q.DeleteSession(ctx, db.DeleteSessionParams{ID: id, AccountID: account.ID})
+		if err != nil {
+			return fmt.Errorf("deleting session: %w", err)
+		}
+		if deleted == 0 {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("session %q not found", id))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.auth.EvictSessions(id)
+	log.Info("session revoked", zap.String("account.id", account.ID.String()), zap.String("session.id", id.String()))
+	response := connect.NewResponse(&v1.RevokeSessionResponse{})
+	if id == auth.SessionFromContext(ctx) {
+		clearRefreshCookie(response.Header())
+	}
+	return response, nil
+}
+
+// RevokeSessions ends all sessions of the caller, optionally except the current one.
+func (svc *Service) RevokeSessions(ctx context.Context, request *connect.Request[v1.RevokeSessionsRequest]) (*connect.Response[v1.RevokeSessionsResponse], error) {
+	log := config.GetLogger(ctx)
+	account := auth.AccountFromContext(ctx)
+	params := db.DeleteSessionsParams{AccountID: account.ID}
+	if request.Msg.KeepCurrent {
+		params.KeepID = pgtype.UUID{Bytes: auth.SessionFromContext(ctx), Valid: true}
+	}
+
+	var ended []uuid.UUID
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		ended, err = q.DeleteSessions(ctx, params)
+		if err != nil {
+			return fmt.Errorf("deleting sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.auth.EvictSessions(ended...)
+	log.Info(
+		"sessions revoked",
+		zap.String("account.id", account.ID.String()),
+		zap.Int("count", len(ended)),
+		zap.Bool("keep_current", request.Msg.KeepCurrent),
+	)
+	response := connect.NewResponse(&v1.RevokeSessionsResponse{Revoked: uint32(len(ended))})
+	if !request.Msg.KeepCurrent {
+		clearRefreshCookie(response.Header())
+	}
+	return response, nil
+}
+
+func tokensToProto(t *auth.Tokens) *v1.Tokens {
+	return &v1.Tokens{
+		SessionId:        t.Session.String(),
+		AccessToken:      t.Access,
+		AccessExpiresAt:  timestamppb.New(t.AccessExpiresAt),
+		RefreshToken:     t.Refresh,
+		RefreshExpiresAt: timestamppb.New(t.RefreshExpiresAt),
+	}
+}
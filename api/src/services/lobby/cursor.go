@@ -0,0 +1,46 @@
+package lobby
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+)
+
+// cursor points at the last game of a page, it's opaque to clients.
+type cursor struct {
+	Mine      bool      `json:"m,omitempty"`
+	CreatedAt time.Time `json:"c,omitzero"`
+	ID        uuid.UUID `json:"i,omitzero"`
+}
+
+func newCursor(mine bool, last *db.Game) string {
+	raw, _ := json.Marshal(cursor{Mine: mine, CreatedAt: last.CreatedAt.Time, ID: last.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func parseCursor(mine bool, value string) (*cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor: %w", err))
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(raw, c); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid cursor: %w", err))
+	}
+	if c.Mine != mine {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cursor belongs to a different list"))
+	}
+	if c.ID == uuid.Nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cursor is missing position"))
+	}
+	return c, nil
+}
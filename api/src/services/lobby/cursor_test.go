@@ -0,0 +1,62 @@
+package lobby
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/db"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	last := &db.Game{
+		ID:        uuid.New(),
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 10, 17, 12, 30, 0, 123456000, time.UTC), Valid: true},
+	}
+
+	for _, mine := range []bool{false, true} {
+		c, err := parseCursor(mine, newCursor(mine, last))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Mine != mine || !c.CreatedAt.Equal(last.CreatedAt.Time) || c.ID != last.ID {
+			t.Errorf("expected position of %v, got %+v", last.ID, *c)
+		}
+	}
+
+	if c, err := parseCursor(true, ""); c != nil || err != nil {
+		t.Errorf("expected first page without cursor, got %v, %v", c, err)
+	}
+}
+
+func TestCursorRejected(t *testing.T) {
+	last := &db.Game{ID: uuid.New()}
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	open := newCursor(false, last)
+
+	for _, tc := range []struct {
+		name  string
+		mine  bool
+		value string
+	}{
+		{"different list", true, open},
+		{"different list reversed", false, newCursor(true, last)},
+		{"not base64", false, "!" + open},
+		{"truncated", false, open[:len(open)/2]},
+		{"not json", false, encode("cursor")},
+		{"invalid id", false, encode(`{"i":"me"}`)},
+		{"missing id", false, encode(`{}`)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := parseCursor(tc.mine, tc.value)
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("expected invalid argument, got %+v, %v", c, err)
+			}
+		})
+	}
+}
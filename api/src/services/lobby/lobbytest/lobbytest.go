@@ -0,0 +1,270 @@
+// Package lobbytest starts games for tests of services playing them.
+package lobbytest
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/services/lobby"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	lobbyv1 "github.com/openhexes/proto/lobby/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// Size is number of rows & columns of maps games are played on, see Layout.
+	Size = 16
+	// Terrain covers the whole map, heroes may walk anywhere.
+	Terrain = "core/terrain/grass"
+	// TownKind of towns players start with.
+	TownKind = "core/town/castle"
+)
+
+// Layout of maps games are played on.
+var Layout = grid.Layout{TotalRows: Size, TotalColumns: Size, RowsPerSegment: Size / 2, ColumnsPerSegment: Size / 2, Depths: 1}
+
+// Positions of towns players start with, they're too far apart to see each other.
+var (
+	HostTown = &mapv1.Tile_Coordinate{Row: 1, Column: 1}
+	AlfaTown = &mapv1.Tile_Coordinate{Row: Size - 2, Column: Size - 2}
+)
+
+// Game is started by Start in a temporary database.
+type Game struct {
+	Config  *config.Config
+	Content *content.Registry
+	Lobby   *lobby.Service
+
+	// Host & Alfa play the game in slots 0 & 1, Bravo doesn't.
+	Host, Alfa, Bravo *db.Account
+	MapID, ID         uuid.UUID
+}
+
+// Start creates accounts & a map of the host with a town for each slot, then starts a game of host & alfa on it.
+// Turns are sequential & unlimited, the host acts first. The test is skipped without Postgres, see config.SetUpTest.
+func Start(t testing.TB) *Game {
+	t.Helper()
+	cfg := config.SetUpTest(t)
+	registry, err := content.Load(content.Builtin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &Game{Config: cfg, Content: registry, Lobby: lobby.New(cfg, nil, registry)}
+
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		for _, account := range []**db.Account{&g.Host, &g.Alfa, &g.Bravo} {
+			a, err := q.CreateAccount(ctx, db.CreateAccountParams{
+				Active:      true,
+				Email:       uuid.NewString() + "@test.com",
+				DisplayName: "player",
+			})
+			if err != nil {
+				return err
+			}
+			*account = &a
+		}
+
+		layout := Layout
+		m, err := q.CreateMap(ctx, db.CreateMapParams{
+			OwnerID:           g.Host.ID,
+			Name:              "map",
+			TotalRows:         int32(layout.TotalRows),
+			TotalColumns:      int32(layout.TotalColumns),
+			RowsPerSegment:    int32(layout.RowsPerSegment),
+			ColumnsPerSegment: int32(layout.ColumnsPerSegment),
+			Depths:            int32(layout.Depths),
+			Seed:              42,
+		})
+		if err != nil {
+			return err
+		}
+		g.MapID = m.ID
+		grass := func(c *mapv1.Tile_Coordinate) *mapv1.Tile {
+			return &mapv1.Tile{Coordinate: c, TerrainId: Terrain, RenderingSpec: &mapv1.Tile_RenderingSpec{}}
+		}
+		for _, p := range layout.Positions() {
+			if err := mapstore.SaveSegment(ctx, q, m.ID, p, layout.Segment(p, grass)); err != nil {
+				return err
+			}
+		}
+
+		for slot, position := range []*mapv1.Tile_Coordinate{HostTown, AlfaTown} {
+			town := towns.New(uuid.NewString(), "", "", "town", position, registry.Town(TownKind))
+			town.Slot = proto.Uint32(uint32(slot))
+			if err := mapstore.CreateTown(ctx, q, m.ID, town); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	created, err := g.Lobby.CreateGame(As(g.Host), connect.NewRequest(&lobbyv1.CreateGameRequest{
+		Name:       "game",
+		MapId:      g.MapID.String(),
+		MaxPlayers: 2,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Msg.Game.Id
+	g.ID = uuid.MustParse(id)
+	if _, err := g.Lobby.JoinGame(As(g.Alfa), connect.NewRequest(&lobbyv1.JoinGameRequest{GameId: id})); err != nil {
+		t.Fatal(err)
+	}
+	for _, account := range []*db.Account{g.Host, g.Alfa} {
+		if _, err := g.Lobby.SetReady(As(account), connect.NewRequest(&lobbyv1.SetReadyRequest{GameId: id, Ready: true})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := g.Lobby.StartGame(As(g.Host), connect.NewRequest(&lobbyv1.StartGameRequest{GameId: id})); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+// Tx runs fn in a transaction of the test database, failing the test on errors.
+func (g *Game) Tx(t testing.TB, fn func(ctx context.Context, q *db.Queries) error) {
+	t.Helper()
+	ctx := context.Background()
+	err := g.Config.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		return fn(ctx, q)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Town returns the town the account started the game with.
+func (g *Game) Town(t testing.TB, account *db.Account) *townsv1.Town {
+	t.Helper()
+	var town *townsv1.Town
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		list, err := towns.List(ctx, q, g.ID)
+		for _, other := range list {
+			if other.OwnerId == account.ID.String() {
+				town = other
+			}
+		}
+		return err
+	})
+	if town == nil {
+		t.Fatalf("account %s has no town", account.ID)
+	}
+	return town
+}
+
+// Treasury returns amounts of resources the account has in the game.
+func (g *Game) Treasury(t testing.TB, account *db.Account) map[string]uint64 {
+	t.Helper()
+	var amounts map[string]uint64
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		treasury, err := economy.Load(ctx, q, g.ID, account.ID)
+		amounts = treasury.GetAmounts()
+		return err
+	})
+	return amounts
+}
+
+// CheckDeltas fails the test unless amounts changed by exactly the deltas, see economy.Cost.
+func CheckDeltas(t testing.TB, before, after map[string]uint64, deltas map[string]int64) {
+	t.Helper()
+	ids := map[string]bool{}
+	for id := range before {
+		ids[id] = true
+	}
+	for id := range after {
+		ids[id] = true
+	}
+	for id := range ids {
+		if delta := int64(after[id]) - int64(before[id]); delta != deltas[id] {
+			t.Errorf("%s: expected change by %d, got %d", id, deltas[id], delta)
+		}
+	}
+}
+
+// Explore adds the tiles to those the account explored in the game.
+func (g *Game) Explore(t testing.TB, account *db.Account, tiles ...*mapv1.Tile_Coordinate) {
+	t.Helper()
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		explored, err := visibility.Load(ctx, q, g.ID, account.ID, Layout)
+		if err != nil {
+			return err
+		}
+		for _, tile := range tiles {
+			explored.Add(hex.FromCoordinate(tile))
+		}
+		return visibility.Save(ctx, q, g.ID, account.ID, explored)
+	})
+}
+
+// Join opens a session of the account in the game, it's left once the test ends.
+func (g *Game) Join(t testing.TB, hub *session.Hub, account *db.Account) *session.Session {
+	t.Helper()
+	s, err := hub.Join(context.Background(), g.ID.String(), account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Leave)
+	return s
+}
+
+// Received drains events delivered to the session so far & returns those of the kind, see session.EventKind.
+func Received(s *session.Session, kind protoreflect.Name) []*gamev1.Event {
+	var events []*gamev1.Event
+	for {
+		select {
+		case event, ok := <-s.Events():
+			if !ok {
+				return events
+			}
+			if session.EventKind(event) == kind {
+				events = append(events, event)
+			}
+		default:
+			return events
+		}
+	}
+}
+
+// As returns context of a call made by the account.
+func As(account *db.Account) context.Context {
+	return context.WithValue(context.Background(), auth.ContextKey, account)
+}
+
+// Step is a call of a player, Code is expected error code, zero if the call has to succeed.
+type Step struct {
+	Name string
+	Call func() error
+	Code connect.Code
+}
+
+// Run makes the calls in order, stopping at the first unexpected outcome.
+func Run(t testing.TB, steps []Step) {
+	t.Helper()
+	for _, s := range steps {
+		err := s.Call()
+		switch {
+		case s.Code == 0 && err != nil:
+			t.Fatalf("%s: %v", s.Name, err)
+		case s.Code != 0 && connect.CodeOf(err) != s.Code:
+			t.Fatalf("%s: expected %v, got %v", s.Name, s.Code, err)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+package lobby
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	lobbyv1 "github.com/openhexes/proto/lobby/v1"
+	"github.com/openhexes/proto/lobby/v1/lobbyv1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+type Service struct {
+	lobbyv1connect.UnimplementedLobbyServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry) *Service {
+	return &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+	}
+}
+
+const (
+	defaultMaxPlayers = uint32(2)
+	maxPlayers        = uint32(8)
+	maxNameLength     = 256
+
+	defaultGamesLimit = uint32(50)
+	maxGamesLimit     = uint32(500)
+)
+
+func (svc *Service) CreateGame(ctx context.Context, request *connect.Request[lobbyv1.CreateGameRequest]) (*connect.Response[lobbyv1.CreateGameResponse], error) {
+	account := auth.AccountFromContext(ctx)
+
+	msg := request.Msg
+	if msg.Name == "" || len(msg.Name) > maxNameLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1-%d characters long", maxNameLength))
+	}
+	if msg.MaxPlayers == 0 {
+		msg.MaxPlayers = defaultMaxPlayers
+	}
+	if msg.MaxPlayers > maxPlayers {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game can't have more than %d players", maxPlayers))
+	}
+	mapID, err := parseID("map", msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Settings == nil {
+		msg.Settings = &lobbyv1.Settings{}
+	}
+	if err := validateSettings(msg.Settings); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	settings, err := proto.Marshal(msg.Settings)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("encoding settings: %w", err))
+	}
+
+	var game *lobbyv1.Game
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetMap(ctx, db.GetMapParams{ID: mapID, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		g, err := q.CreateGame(ctx, db.CreateGameParams{
+			HostID:          account.ID,
+			MapID:           mapID,
+			Name:            msg.Name,
+			State:           lobbyv1.Game_STATE_OPEN.String(),
+			MaxPlayers:      int32(msg.MaxPlayers),
+			Settings:        settings,
+			ContentChecksum: svc.content.Checksum(),
+		})
+		if err != nil {
+			return fmt.Errorf("creating game: %w", err)
+		}
+		err = q.AddGamePlayer(ctx, db.AddGamePlayerParams{GameID: g.ID, Slot: 0, AccountID: account.ID})
+		if err != nil {
+			return fmt.Errorf("adding host: %w", err)
+		}
+
+		game, err = load(ctx, q, g.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lobbyv1.CreateGameResponse{Game: game}), nil
+}
+
+func (svc *Service) GetGame(ctx context.Context, request *connect.Request[lobbyv1.GetGameRequest]) (*connect.Response[lobbyv1.GetGameResponse], error) {
+	id, err := parseID("game", request.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var game *lobbyv1.Game
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		game, err = load(ctx, q, id)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lobbyv1.GetGameResponse{Game: game}), nil
+}
+
+func (svc *Service) ListGames(ctx context.Context, request *connect.Request[lobbyv1.ListGamesRequest]) (*connect.Response[lobbyv1.ListGamesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	msg := request.Msg
+	after, err := parseCursor(msg.Mine, msg.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := min(cmp.Or(msg.Limit, defaultGamesLimit), maxGamesLimit)
+
+	// one extra game tells whether there's another page
+	params := db.ListGamesParams{RowLimit: int32(limit) + 1}
+	if msg.Mine {
+		params.AccountID = pgtype.UUID{Bytes: account.ID, Valid: true}
+	} else {
+		params.State = pgtype.Text{String: lobbyv1.Game_STATE_OPEN.String(), Valid: true}
+	}
+	if after != nil {
+		params.BeforeCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+		params.BeforeID = pgtype.UUID{Bytes: after.ID, Valid: true}
+	}
+
+	response := &lobbyv1.ListGamesResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		games, err := q.ListGames(ctx, params)
+		if err != nil {
+			return fmt.Errorf("listing games: %w", err)
+		}
+		if len(games) > int(limit) {
+			games = games[:limit]
+			response.NextCursor = newCursor(msg.Mine, &games[len(games)-1])
+		}
+		ids := make([]uuid.UUID, 0, len(games))
+		for _, g := range games {
+			ids = append(ids, g.ID)
+		}
+		players, err := q.ListGamePlayers(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("listing players: %w", err)
+		}
+
+		response.Games = make([]*lobbyv1.Game, 0, len(games))
+		for _, g := range games {
+			game, err := conv.GameToProto(&g, players)
+			if err != nil {
+				return err
+			}
+			response.Games = append(response.Games, game)
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) JoinGame(ctx context.Context, request *connect.Request[lobbyv1.JoinGameRequest]) (*connect.Response[lobbyv1.JoinGameResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var game *lobbyv1.Game
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		g, err := lockOpen(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if game, err = load(ctx, q, id); err != nil {
+			return err
+		}
+
+		taken := make(map[uint32]bool, len(game.Players))
+		for _, p := range game.Players {
+			if p.AccountId == account.ID.String() {
+				return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("already joined game %q in slot %d", id, p.Slot))
+			}
+			taken[p.Slot] = true
+		}
+
+		var slot uint32
+		if request.Msg.Slot != nil {
+			slot = request.Msg.GetSlot()
+			if slot >= uint32(g.MaxPlayers) {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("game only has %d slots", g.MaxPlayers))
+			}
+			if taken[slot] {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("slot %d is taken", slot))
+			}
+		} else {
+			for taken[slot] {
+				slot++
+			}
+			if slot >= uint32(g.MaxPlayers) {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("game is full"))
+			}
+		}
+
+		err = q.AddGamePlayer(ctx, db.AddGamePlayerParams{GameID: id, Slot: int32(slot), AccountID: account.ID})
+		if err != nil {
+			return fmt.Errorf("adding player: %w", err)
+		}
+		game, err = load(ctx, q, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lobbyv1.JoinGameResponse{Game: game}), nil
+}
+
+func (svc *Service) LeaveGame(ctx context.Context, request *connect.Request[lobbyv1.LeaveGameRequest]) (*connect.Response[lobbyv1.LeaveGameResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &lobbyv1.LeaveGameResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		g, err := lockOpen(ctx, q, id)
+		if err != nil {
+			return err
+		}
+
+		// host leaving cancels the game
+		if g.HostID == account.ID {
+			if err := q.DeleteGame(ctx, id); err != nil {
+				return fmt.Errorf("deleting game: %w", err)
+			}
+			return nil
+		}
+
+		removed, err := q.RemoveGamePlayer(ctx, db.RemoveGamePlayerParams{GameID: id, AccountID: account.ID})
+		if err != nil {
+			return fmt.Errorf("removing player: %w", err)
+		}
+		if removed == 0 {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("not a player of game %q", id))
+		}
+		response.Game, err = load(ctx, q, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) SetReady(ctx context.Context, request *connect.Request[lobbyv1.SetReadyRequest]) (*connect.Response[lobbyv1.SetReadyResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var game *lobbyv1.Game
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := lockOpen(ctx, q, id); err != nil {
+			return err
+		}
+		updated, err := q.SetGamePlayerReady(ctx, db.SetGamePlayerReadyParams{
+			Ready:     request.Msg.Ready,
+			GameID:    id,
+			AccountID: account.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("updating player: %w", err)
+		}
+		if updated == 0 {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("not a player of game %q", id))
+		}
+		game, err = load(ctx, q, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lobbyv1.SetReadyResponse{Game: game}), nil
+}
+
+func (svc *Service) StartGame(ctx context.Context, request *connect.Request[lobbyv1.StartGameRequest]) (*connect.Response[lobbyv1.StartGameResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var game *lobbyv1.Game
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		g, err := lockOpen(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		if g.HostID != account.ID {
+			return connect.NewError(connect.CodePermissionDenied, errors.New("only host can start the game"))
+		}
+		if g.ContentChecksum != svc.content.Checksum() {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("content changed since the game was created"))
+		}
+
+		if game, err = load(ctx, q, id); err != nil {
+			return err
+		}
+		if len(game.Players) < 2 && game.MaxPlayers > 1 {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("waiting for more players"))
+		}
+		for _, p := range game.Players {
+			if !p.Ready {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("player %q is not ready", p.DisplayName))
+			}
+		}
+
+		_, err = q.StartGame(ctx, db.StartGameParams{State: lobbyv1.Game_STATE_STARTED.String(), ID: id})
+		if err != nil {
+			return fmt.Errorf("starting game: %w", err)
+		}
+		players := make([]turns.Player, 0, len(game.Players))
+		accountIDs := make([]uuid.UUID, 0, len(game.Players))
+		slots := map[uint32]uuid.UUID{}
+		for _, p := range game.Players {
+			players = append(players, turns.Player{AccountID: p.AccountId, Slot: p.Slot})
+			accountIDs = append(accountIDs, uuid.MustParse(p.AccountId))
+			slots[p.Slot] = uuid.MustParse(p.AccountId)
+		}
+		if err := mapstore.CopyToGame(ctx, q, id, g.MapID, slots); err != nil {
+			return err
+		}
+		if err := reveal(ctx, q, id, accountIDs, svc.content); err != nil {
+			return err
+		}
+		state := turns.New(game.Settings.GetTurnMode(), players...)
+		turns.Limit(state, game.Settings.GetTurnDuration().AsDuration(), time.Now())
+		if err := turns.Create(ctx, q, id, state); err != nil {
+			return err
+		}
+		if err := economy.Start(ctx, q, id, accountIDs, svc.content.Resources()); err != nil {
+			return err
+		}
+		game, err = load(ctx, q, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lobbyv1.StartGameResponse{Game: game}), nil
+}
+
+// reveal lets players see around towns they start with.
+func reveal(ctx context.Context, q *db.Queries, gameID uuid.UUID, accountIDs []uuid.UUID, terrains mapstore.Terrains) error {
+	m, err := mapstore.OpenGame(ctx, q, gameID, terrains)
+	if err != nil {
+		return err
+	}
+	list, err := towns.List(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+	for _, accountID := range accountIDs {
+		sources := visibility.Towns(list, accountID.String())
+		if len(sources) == 0 {
+			continue
+		}
+		if err := visibility.Reveal(ctx, q, gameID, accountID, m, sources...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuthorizePlayer only lets players of a game join its session, see session.WithAuthorizer.
+func (svc *Service) AuthorizePlayer(ctx context.Context, gameID string, account *db.Account) error {
+	id, err := parseID("game", gameID)
+	if err != nil {
+		return err
+	}
+	return svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		_, err := q.GetGamePlayer(ctx, db.GetGamePlayerParams{GameID: id, AccountID: account.ID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", id))
+		}
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+}
+
+// lockOpen locks game for the rest of transaction, making sure players can still change.
+func lockOpen(ctx context.Context, q *db.Queries, id uuid.UUID) (*db.Game, error) {
+	g, err := q.GetGameForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting game: %w", err)
+	}
+	if g.State != lobbyv1.Game_STATE_OPEN.String() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("game %q has already started", id))
+	}
+	return &g, nil
+}
+
+func load(ctx context.Context, q *db.Queries, id uuid.UUID) (*lobbyv1.Game, error) {
+	g, err := q.GetGame(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting game: %w", err)
+	}
+	players, err := q.ListGamePlayers(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, fmt.Errorf("listing players: %w", err)
+	}
+	return conv.GameToProto(&g, players)
+}
+
+func validateSettings(s *lobbyv1.Settings) error {
+	if s.TurnDuration != nil {
+		if err := s.TurnDuration.CheckValid(); err != nil {
+			return fmt.Errorf("invalid turn duration: %w", err)
+		}
+		if s.TurnDuration.AsDuration() <= 0 {
+			return errors.New("turn duration must be positive")
+		}
+	}
+	return nil
+}
+
+func parseID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id %q: %w", kind, id, err))
+	}
+	return parsed, nil
+}
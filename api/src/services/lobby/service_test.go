@@ -0,0 +1,468 @@
+package lobby
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapgen"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	economyv1 "github.com/openhexes/proto/economy/v1"
+	lobbyv1 "github.com/openhexes/proto/lobby/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type fixture struct {
+	svc               *Service
+	host, alfa, bravo *db.Account
+	mapID             uuid.UUID
+}
+
+// setUp creates accounts & a map of the host in a temporary database.
+func setUp(t *testing.T) *fixture {
+	t.Helper()
+	cfg := config.SetUpTest(t)
+	registry, err := content.Load(content.Builtin())
+	if err != nil {
+		t.Fatal(err)
+	}
+	generator, err := mapgen.New(42, mapgen.WithTerrains(registry.Terrains()...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := &fixture{svc: New(cfg, nil, registry)}
+	ctx := context.Background()
+	err = cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		for _, account := range []**db.Account{&f.host, &f.alfa, &f.bravo} {
+			a, err := q.CreateAccount(ctx, db.CreateAccountParams{
+				Active:      true,
+				Email:       uuid.NewString() + "@test.com",
+				DisplayName: "player",
+			})
+			if err != nil {
+				return err
+			}
+			*account = &a
+		}
+
+		layout := grid.Layout{TotalRows: 8, TotalColumns: 8, RowsPerSegment: 4, ColumnsPerSegment: 4, Depths: 1}
+		m, err := q.CreateMap(ctx, db.CreateMapParams{
+			OwnerID:           f.host.ID,
+			Name:              "map",
+			TotalRows:         int32(layout.TotalRows),
+			TotalColumns:      int32(layout.TotalColumns),
+			RowsPerSegment:    int32(layout.RowsPerSegment),
+			ColumnsPerSegment: int32(layout.ColumnsPerSegment),
+			Depths:            int32(layout.Depths),
+			Seed:              42,
+		})
+		if err != nil {
+			return err
+		}
+		f.mapID = m.ID
+		for _, p := range layout.Positions() {
+			if err := mapstore.SaveSegment(ctx, q, m.ID, p, layout.Segment(p, generator.Tile)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func as(account *db.Account) context.Context {
+	return context.WithValue(context.Background(), auth.ContextKey, account)
+}
+
+func (f *fixture) create(t *testing.T, maxPlayers uint32) *lobbyv1.Game {
+	t.Helper()
+	response, err := f.svc.CreateGame(as(f.host), connect.NewRequest(&lobbyv1.CreateGameRequest{
+		Name:       "game",
+		MapId:      f.mapID.String(),
+		MaxPlayers: maxPlayers,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response.Msg.Game
+}
+
+// step is a call of a player, code is expected error code, zero if the call has to succeed.
+type step struct {
+	name string
+	call func() error
+	code connect.Code
+}
+
+func run(t *testing.T, steps []step) {
+	t.Helper()
+	for _, s := range steps {
+		err := s.call()
+		switch {
+		case s.code == 0 && err != nil:
+			t.Fatalf("%s: %v", s.name, err)
+		case s.code != 0 && connect.CodeOf(err) != s.code:
+			t.Fatalf("%s: expected %v, got %v", s.name, s.code, err)
+		}
+	}
+}
+
+func TestCreateGame(t *testing.T) {
+	f := setUp(t)
+
+	for _, tc := range []struct {
+		name    string
+		account *db.Account
+		request *lobbyv1.CreateGameRequest
+		code    connect.Code
+	}{
+		{"no name", f.host, &lobbyv1.CreateGameRequest{MapId: f.mapID.String()}, connect.CodeInvalidArgument},
+		{"too many players", f.host, &lobbyv1.CreateGameRequest{Name: "game", MapId: f.mapID.String(), MaxPlayers: maxPlayers + 1}, connect.CodeInvalidArgument},
+		{"invalid map id", f.host, &lobbyv1.CreateGameRequest{Name: "game", MapId: "map"}, connect.CodeInvalidArgument},
+		{"map of another account", f.alfa, &lobbyv1.CreateGameRequest{Name: "game", MapId: f.mapID.String()}, connect.CodeNotFound},
+		{
+			"negative turn duration",
+			f.host,
+			&lobbyv1.CreateGameRequest{Name: "game", MapId: f.mapID.String(), Settings: &lobbyv1.Settings{TurnDuration: durationpb.New(-1)}},
+			connect.CodeInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.svc.CreateGame(as(tc.account), connect.NewRequest(tc.request))
+			if connect.CodeOf(err) != tc.code {
+				t.Errorf("expected %v, got %v", tc.code, err)
+			}
+		})
+	}
+
+	game := f.create(t, 0)
+	if game.State != lobbyv1.Game_STATE_OPEN || game.MaxPlayers != defaultMaxPlayers {
+		t.Errorf("unexpected game: %v", game)
+	}
+	if len(game.Players) != 1 || game.Players[0].AccountId != f.host.ID.String() || game.Players[0].Slot != 0 {
+		t.Errorf("expected host in the first slot, got %v", game.Players)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	f := setUp(t)
+	game := f.create(t, 2)
+
+	join := func(account *db.Account, slot *uint32) func() error {
+		return func() error {
+			_, err := f.svc.JoinGame(as(account), connect.NewRequest(&lobbyv1.JoinGameRequest{GameId: game.Id, Slot: slot}))
+			return err
+		}
+	}
+	leave := func(account *db.Account) func() error {
+		return func() error {
+			_, err := f.svc.LeaveGame(as(account), connect.NewRequest(&lobbyv1.LeaveGameRequest{GameId: game.Id}))
+			return err
+		}
+	}
+	ready := func(account *db.Account) func() error {
+		return func() error {
+			_, err := f.svc.SetReady(as(account), connect.NewRequest(&lobbyv1.SetReadyRequest{GameId: game.Id, Ready: true}))
+			return err
+		}
+	}
+	start := func(account *db.Account) func() error {
+		return func() error {
+			_, err := f.svc.StartGame(as(account), connect.NewRequest(&lobbyv1.StartGameRequest{GameId: game.Id}))
+			return err
+		}
+	}
+	slot := func(s uint32) *uint32 { return &s }
+
+	run(t, []step{
+		{"host starts alone", start(f.host), connect.CodeFailedPrecondition},
+		{"joining slot out of range", join(f.alfa, slot(2)), connect.CodeInvalidArgument},
+		{"joining taken slot", join(f.alfa, slot(0)), connect.CodeFailedPrecondition},
+		{"host joins again", join(f.host, nil), connect.CodeAlreadyExists},
+		{"alfa joins", join(f.alfa, nil), 0},
+		{"bravo joins full game", join(f.bravo, nil), connect.CodeFailedPrecondition},
+		{"bravo gets ready", ready(f.bravo), connect.CodeFailedPrecondition},
+		{"bravo leaves", leave(f.bravo), connect.CodeFailedPrecondition},
+		{"alfa starts", start(f.alfa), connect.CodePermissionDenied},
+		{"host starts before players are ready", start(f.host), connect.CodeFailedPrecondition},
+		{"host gets ready", ready(f.host), 0},
+		{"alfa leaves", leave(f.alfa), 0},
+		{"bravo joins", join(f.bravo, slot(1)), 0},
+		{"host starts before bravo is ready", start(f.host), connect.CodeFailedPrecondition},
+		{"bravo gets ready", ready(f.bravo), 0},
+		{"bravo starts", start(f.bravo), connect.CodePermissionDenied},
+		{"host starts", start(f.host), 0},
+		{"host starts again", start(f.host), connect.CodeFailedPrecondition},
+		{"alfa joins started game", join(f.alfa, nil), connect.CodeFailedPrecondition},
+		{"bravo leaves started game", leave(f.bravo), connect.CodeFailedPrecondition},
+		{"bravo gets unready", ready(f.bravo), connect.CodeFailedPrecondition},
+	})
+
+	response, err := f.svc.GetGame(as(f.alfa), connect.NewRequest(&lobbyv1.GetGameRequest{Id: game.Id}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	game = response.Msg.Game
+	if game.State != lobbyv1.Game_STATE_STARTED || game.StartedAt == nil {
+		t.Errorf("expected started game, got %v", game)
+	}
+	if len(game.Players) != 2 || game.Players[1].AccountId != f.bravo.ID.String() {
+		t.Errorf("unexpected players: %v", game.Players)
+	}
+
+	for _, tc := range []struct {
+		account *db.Account
+		code    connect.Code
+	}{
+		{f.host, 0},
+		{f.bravo, 0},
+		{f.alfa, connect.CodePermissionDenied},
+	} {
+		err := f.svc.AuthorizePlayer(context.Background(), game.Id, tc.account)
+		if (tc.code == 0) != (err == nil) || tc.code != 0 && connect.CodeOf(err) != tc.code {
+			t.Errorf("authorizing %s: expected %v, got %v", tc.account.ID, tc.code, err)
+		}
+	}
+}
+
+func TestHostLeaving(t *testing.T) {
+	f := setUp(t)
+	game := f.create(t, 2)
+
+	if _, err := f.svc.JoinGame(as(f.alfa), connect.NewRequest(&lobbyv1.JoinGameRequest{GameId: game.Id})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.svc.LeaveGame(as(f.host), connect.NewRequest(&lobbyv1.LeaveGameRequest{GameId: game.Id})); err != nil {
+		t.Fatal(err)
+	}
+	_, err := f.svc.GetGame(as(f.alfa), connect.NewRequest(&lobbyv1.GetGameRequest{Id: game.Id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("expected game to be cancelled, got %v", err)
+	}
+}
+
+func TestStartedGameMap(t *testing.T) {
+	f := setUp(t)
+	game := f.create(t, 1)
+	ctx := as(f.host)
+
+	if _, err := f.svc.SetReady(ctx, connect.NewRequest(&lobbyv1.SetReadyRequest{GameId: game.Id, Ready: true})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.svc.StartGame(ctx, connect.NewRequest(&lobbyv1.StartGameRequest{GameId: game.Id})); err != nil {
+		t.Fatal(err)
+	}
+
+	gameID := uuid.MustParse(game.Id)
+	c := &mapv1.Tile_Coordinate{Row: 1, Column: 1}
+	err := f.svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		// host edits the map after the game started
+		p := grid.Position{}
+		segment, err := mapstore.LoadSegment(ctx, q, f.mapID, p)
+		if err != nil {
+			return err
+		}
+		i, _ := grid.Index(segment, c)
+		segment.Tiles[i].TerrainId = "edited"
+		if err := mapstore.SaveSegment(ctx, q, f.mapID, p, segment); err != nil {
+			return err
+		}
+
+		m, err := mapstore.OpenGame(ctx, q, gameID, f.svc.content)
+		if err != nil {
+			return err
+		}
+		if tile := m.Tile(hex.FromCoordinate(c)); tile == nil || tile.TerrainId == "edited" {
+			t.Errorf("editing the map changed the game, got tile %v", tile)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = f.svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		_, err := q.DeleteMap(ctx, db.DeleteMapParams{ID: f.mapID, OwnerID: f.host.ID})
+		return err
+	})
+	if err == nil {
+		t.Error("deleted map of a game")
+	}
+}
+
+func TestListGames(t *testing.T) {
+	f := setUp(t)
+	var created []string
+	for range 3 {
+		created = append(created, f.create(t, 0).Id)
+	}
+
+	var listed []string
+	cursor := ""
+	for page := 0; ; page++ {
+		response, err := f.svc.ListGames(as(f.host), connect.NewRequest(&lobbyv1.ListGamesRequest{Mine: true, Limit: 2, Cursor: cursor}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Msg.Games) > 2 {
+			t.Fatalf("expected at most 2 games per page, got %d", len(response.Msg.Games))
+		}
+		for _, g := range response.Msg.Games {
+			listed = append(listed, g.Id)
+		}
+		if cursor = response.Msg.NextCursor; cursor == "" {
+			break
+		}
+		if page > 1 {
+			t.Fatal("expected 2 pages")
+		}
+	}
+	if len(listed) != 3 || listed[0] != created[2] || listed[2] != created[0] {
+		t.Errorf("expected newest games first, got %v of %v", listed, created)
+	}
+
+	response, err := f.svc.ListGames(as(f.host), connect.NewRequest(&lobbyv1.ListGamesRequest{Mine: true, Limit: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.svc.ListGames(as(f.host), connect.NewRequest(&lobbyv1.ListGamesRequest{Cursor: response.Msg.NextCursor}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("expected cursor of another list to be rejected, got %v", err)
+	}
+}
+
+func TestMapFeatures(t *testing.T) {
+	f := setUp(t)
+	game := f.create(t, 2)
+	slot := func(s uint32) *uint32 { return &s }
+
+	ctx := context.Background()
+	kind := f.svc.content.Towns()[0]
+	placed := []*townsv1.Town{
+		towns.New(uuid.NewString(), "", "", "host", &mapv1.Tile_Coordinate{Row: 1, Column: 1}, kind),
+		towns.New(uuid.NewString(), "", "", "empty slot", &mapv1.Tile_Coordinate{Row: 6, Column: 6}, kind),
+		towns.New(uuid.NewString(), "", "", "neutral", &mapv1.Tile_Coordinate{Row: 6, Column: 1}, kind),
+	}
+	placed[0].Slot, placed[1].Slot = slot(0), slot(5)
+	site := &economyv1.Site{
+		Id:         uuid.NewString(),
+		Kind:       economyv1.Site_KIND_MINE,
+		ResourceId: f.svc.content.Resources()[0].Id,
+		Amount:     1,
+		Position:   &mapv1.Tile_Coordinate{Row: 2, Column: 5},
+	}
+	monolith := f.svc.content.Object("core/object/monolith")
+	gates := []*objectsv1.Object{
+		objects.New(uuid.NewString(), "", &mapv1.Tile_Coordinate{Row: 3, Column: 3}, monolith),
+		objects.New(uuid.NewString(), "", &mapv1.Tile_Coordinate{Row: 4, Column: 4}, monolith),
+	}
+	gates[0].TargetId, gates[1].TargetId = gates[1].Id, gates[0].Id
+	err := f.svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		for _, town := range placed {
+			if err := mapstore.CreateTown(ctx, q, f.mapID, town); err != nil {
+				return err
+			}
+		}
+		for _, gate := range gates {
+			if err := mapstore.CreateObject(ctx, q, f.mapID, gate); err != nil {
+				return err
+			}
+		}
+		return mapstore.CreateSite(ctx, q, f.mapID, site)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, account := range []*db.Account{f.host, f.alfa} {
+		if account != f.host {
+			if _, err := f.svc.JoinGame(as(account), connect.NewRequest(&lobbyv1.JoinGameRequest{GameId: game.Id})); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := f.svc.SetReady(as(account), connect.NewRequest(&lobbyv1.SetReadyRequest{GameId: game.Id, Ready: true})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := f.svc.StartGame(as(f.host), connect.NewRequest(&lobbyv1.StartGameRequest{GameId: game.Id})); err != nil {
+		t.Fatal(err)
+	}
+
+	gameID := uuid.MustParse(game.Id)
+	err = f.svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		list, err := towns.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		owners := map[string]string{}
+		for _, town := range list {
+			if town.GameId != game.Id || town.Slot != nil || slices.ContainsFunc(placed, func(p *townsv1.Town) bool { return p.Id == town.Id }) {
+				t.Errorf("expected a copy of the town in the game, got %v", town)
+			}
+			owners[town.Name] = town.OwnerId
+		}
+		expected := map[string]string{"host": f.host.ID.String(), "empty slot": "", "neutral": ""}
+		if !maps.Equal(owners, expected) {
+			t.Errorf("expected owners %v, got %v", expected, owners)
+		}
+
+		sites, err := economy.Sites(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		if len(sites) != 1 || sites[0].Id == site.Id || sites[0].GameId != game.Id || !proto.Equal(sites[0].Position, site.Position) {
+			t.Errorf("expected a copy of the site in the game, got %v", sites)
+		}
+
+		copied, err := objects.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+		if len(copied) != 2 || copied[0].TargetId != copied[1].Id || copied[1].TargetId != copied[0].Id {
+			t.Errorf("expected copies of gates leading to each other, got %v", copied)
+		}
+		for _, o := range copied {
+			if o.GameId != game.Id || slices.ContainsFunc(gates, func(g *objectsv1.Object) bool { return g.Id == o.Id }) {
+				t.Errorf("expected a copy of the object in the game, got %v", o)
+			}
+		}
+
+		m, err := mapstore.OpenGame(ctx, q, gameID, f.svc.content)
+		if err != nil {
+			return err
+		}
+		explored, err := visibility.Load(ctx, q, gameID, f.host.ID, m.Layout())
+		if err != nil {
+			return err
+		}
+		if !explored.Has(hex.FromCoordinate(placed[0].Position)) {
+			t.Error("expected host to see around their town")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
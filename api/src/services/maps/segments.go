@@ -0,0 +1,192 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+// StreamSegments sends segments of a stored map as they come into client's viewport,
+// so large maps don't need to be transferred in full before the first tile renders.
+// Segments modified by the owner are sent again while they're within viewport.
+func (svc *Service) StreamSegments(ctx context.Context, stream *connect.BidiStream[mapv1.StreamSegmentsRequest, mapv1.StreamSegmentsResponse]) error {
+	account := auth.AccountFromContext(ctx)
+
+	request, err := stream.Receive()
+	if errors.Is(err, io.EOF) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	id, err := parseID(request.MapId)
+	if err != nil {
+		return err
+	}
+
+	var m db.Map
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if m, err = q.GetMap(ctx, db.GetMapParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&mapv1.StreamSegmentsResponse{Map: conv.MapToProto(&m)}); err != nil {
+		return err
+	}
+
+	changes := svc.watchers.watch(id)
+	defer svc.watchers.unwatch(id, changes)
+
+	received := make(chan *mapv1.StreamSegmentsRequest)
+	failed := make(chan error, 1)
+	go func() {
+		for {
+			request, err := stream.Receive()
+			if err != nil {
+				failed <- err
+				return
+			}
+			select {
+			case received <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	tracker := grid.NewTracker(conv.MapLayout(&m))
+	var viewport *mapv1.Segment_Bounds
+	var margin int32
+	for {
+		if request != nil && request.Viewport != nil {
+			if err := grid.CheckViewport(request.Viewport, request.Margin); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			viewport, margin = request.Viewport, request.Margin
+		}
+		if viewport != nil {
+			positions := tracker.Update(viewport, margin)
+			if len(positions) > 0 {
+				response := &mapv1.StreamSegmentsResponse{
+					Segments: make([]*mapv1.Segment, 0, len(positions)),
+				}
+				err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+					for _, p := range positions {
+						segment, err := mapstore.LoadSegment(ctx, q, id, p)
+						if err != nil {
+							return err
+						}
+						response.Segments = append(response.Segments, segment)
+					}
+					return nil
+				}, config.WithAccessMode(pgx.ReadOnly))
+				if err != nil {
+					return err
+				}
+				if err := stream.Send(response); err != nil {
+					return err
+				}
+			}
+		}
+
+		request = nil
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case err := <-failed:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case request = <-received:
+		case <-changes.signal:
+			// modified segments are sent again if they're still within viewport
+			for _, p := range changes.take() {
+				tracker.Forget(p)
+			}
+		}
+	}
+}
+
+// watchers notifies open streams of stored maps about segments modified by their owners.
+type watchers struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]map[*changes]bool // map id -> open streams
+}
+
+// changes collects modified segments until the stream gets to them.
+type changes struct {
+	mu        sync.Mutex
+	positions map[grid.Position]bool
+	signal    chan struct{}
+}
+
+func (w *watchers) watch(mapID uuid.UUID) *changes {
+	c := &changes{
+		positions: map[grid.Position]bool{},
+		signal:    make(chan struct{}, 1),
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.streams == nil {
+		w.streams = map[uuid.UUID]map[*changes]bool{}
+	}
+	if w.streams[mapID] == nil {
+		w.streams[mapID] = map[*changes]bool{}
+	}
+	w.streams[mapID][c] = true
+	return c
+}
+
+func (w *watchers) unwatch(mapID uuid.UUID, c *changes) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.streams[mapID], c)
+	if len(w.streams[mapID]) == 0 {
+		delete(w.streams, mapID)
+	}
+}
+
+// notify marks segments of the map modified for every open stream, it never blocks.
+func (w *watchers) notify(mapID uuid.UUID, positions ...grid.Position) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for c := range w.streams[mapID] {
+		c.mu.Lock()
+		for _, p := range positions {
+			c.positions[p] = true
+		}
+		c.mu.Unlock()
+		select {
+		case c.signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (c *changes) take() []grid.Position {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	positions := make([]grid.Position, 0, len(c.positions))
+	for p := range c.positions {
+		positions = append(positions, p)
+	}
+	clear(c.positions)
+	return positions
+}
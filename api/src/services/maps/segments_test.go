@@ -0,0 +1,37 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/grid"
+)
+
+func TestWatchers(t *testing.T) {
+	var w watchers
+	id, other := uuid.New(), uuid.New()
+	c := w.watch(id)
+
+	w.notify(other, grid.Position{Row: 1})
+	select {
+	case <-c.signal:
+		t.Fatal("notified about another map")
+	default:
+	}
+
+	w.notify(id, grid.Position{Row: 1}, grid.Position{Column: 1})
+	w.notify(id, grid.Position{Row: 1})
+	<-c.signal
+	if positions := c.take(); len(positions) != 2 {
+		t.Fatalf("expected modified segments once, got %v", positions)
+	}
+	if positions := c.take(); len(positions) != 0 {
+		t.Fatalf("expected nothing after take, got %v", positions)
+	}
+
+	w.unwatch(id, c)
+	w.notify(id, grid.Position{Row: 1})
+	if len(w.streams) != 0 || len(c.signal) != 0 {
+		t.Fatal("expected closed stream to be forgotten")
+	}
+}
@@ -0,0 +1,447 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/conv"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/mapgen"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	"github.com/openhexes/proto/map/v1/mapv1connect"
+)
+
+type Service struct {
+	mapv1connect.UnimplementedMapServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+
+	watchers watchers
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry) *Service {
+	return &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+	}
+}
+
+const (
+	defaultTotalRows            = uint32(64)
+	defaultTotalColumns         = uint32(64)
+	defaultMaxRowsPerSegment    = uint32(15)
+	defaultMaxColumnsPerSegment = uint32(15)
+
+	maxMapSize     = uint32(1024)
+	maxSegmentSize = uint32(64)
+	maxDepths      = uint32(8)
+	maxNameLength  = 256
+	maxConnectors  = 1024
+)
+
+func (svc *Service) CreateMap(ctx context.Context, request *connect.Request[mapv1.CreateMapRequest]) (*connect.Response[mapv1.CreateMapResponse], error) {
+	account := auth.AccountFromContext(ctx)
+
+	msg := request.Msg
+	if msg.Name == "" || len(msg.Name) > maxNameLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1-%d characters long", maxNameLength))
+	}
+	if msg.TotalRows == 0 {
+		msg.TotalRows = defaultTotalRows
+	}
+	if msg.TotalColumns == 0 {
+		msg.TotalColumns = defaultTotalColumns
+	}
+	if msg.MaxRowsPerSegment == 0 {
+		msg.MaxRowsPerSegment = defaultMaxRowsPerSegment
+	}
+	if msg.MaxColumnsPerSegment == 0 {
+		msg.MaxColumnsPerSegment = defaultMaxColumnsPerSegment
+	}
+	if msg.Seed == 0 {
+		msg.Seed = rand.Int64()
+	}
+	if msg.Depths == 0 {
+		msg.Depths = 1
+	}
+	if msg.TotalRows > maxMapSize || msg.TotalColumns > maxMapSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("map can't be larger than %dx%d", maxMapSize, maxMapSize))
+	}
+	if msg.MaxRowsPerSegment > maxSegmentSize || msg.MaxColumnsPerSegment > maxSegmentSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("segment can't be larger than %dx%d", maxSegmentSize, maxSegmentSize))
+	}
+	if msg.Depths > maxDepths {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("map can't have more than %d levels", maxDepths))
+	}
+
+	layout := grid.Layout{
+		TotalRows:         msg.TotalRows,
+		TotalColumns:      msg.TotalColumns,
+		RowsPerSegment:    msg.MaxRowsPerSegment,
+		ColumnsPerSegment: msg.MaxColumnsPerSegment,
+		Depths:            msg.Depths,
+	}
+	generator, err := mapgen.New(msg.Seed, mapgen.WithTerrains(svc.content.Terrains()...))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("initializing map generator: %w", err))
+	}
+
+	var m db.Map
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		m, err = q.CreateMap(ctx, db.CreateMapParams{
+			OwnerID:           account.ID,
+			Name:              msg.Name,
+			TotalRows:         int32(layout.TotalRows),
+			TotalColumns:      int32(layout.TotalColumns),
+			RowsPerSegment:    int32(layout.RowsPerSegment),
+			ColumnsPerSegment: int32(layout.ColumnsPerSegment),
+			Depths:            int32(layout.Depths),
+			Seed:              msg.Seed,
+		})
+		if err != nil {
+			return fmt.Errorf("creating map: %w", err)
+		}
+
+		for _, p := range layout.Positions() {
+			if err := mapstore.SaveSegment(ctx, q, m.ID, p, layout.Segment(p, generator.Tile)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mapv1.CreateMapResponse{
+		Map: conv.MapToProto(&m),
+	}), nil
+}
+
+func (svc *Service) GetMap(ctx context.Context, request *connect.Request[mapv1.GetMapRequest], stream *connect.ServerStream[mapv1.GetMapResponse]) error {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID(request.Msg.Id)
+	if err != nil {
+		return err
+	}
+
+	var (
+		m        db.Map
+		segments []db.MapSegment
+	)
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if m, err = q.GetMap(ctx, db.GetMapParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		if segments, err = q.ListMapSegments(ctx, id); err != nil {
+			return fmt.Errorf("listing segments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// arrange segments in a grid, segments come ordered by position,
+	// segment rows of every level follow the ones of the level above
+	layout := conv.MapLayout(&m)
+	segmentRows := make([]*mapv1.Segment_Row, layout.Levels()*layout.SegmentRows())
+	for i := range segmentRows {
+		segmentRows[i] = &mapv1.Segment_Row{
+			Segments: make([]*mapv1.Segment, 0, layout.SegmentColumns()),
+		}
+	}
+	for _, s := range segments {
+		if uint32(s.Depth) >= layout.Levels() || uint32(s.SegmentRow) >= layout.SegmentRows() {
+			continue
+		}
+		segment, err := mapstore.DecodeSegment(s.Data)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("decoding segment %d:%d: %w", s.SegmentRow, s.SegmentColumn, err))
+		}
+		row := segmentRows[uint32(s.Depth)*layout.SegmentRows()+uint32(s.SegmentRow)]
+		row.Segments = append(row.Segments, segment)
+	}
+
+	response := &mapv1.GetMapResponse{
+		Map: conv.MapToProto(&m),
+		Grid: &mapv1.Grid{
+			TotalRows:    layout.TotalRows,
+			TotalColumns: layout.TotalColumns,
+			Depths:       layout.Levels(),
+		},
+	}
+	if err := stream.Send(response); err != nil {
+		return err
+	}
+
+	const segmentRowsPerChunk = 10
+	for rows := range slices.Chunk(segmentRows, segmentRowsPerChunk) {
+		response := &mapv1.GetMapResponse{
+			Grid: &mapv1.Grid{
+				SegmentRows: rows,
+			},
+		}
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (svc *Service) ListMaps(ctx context.Context, request *connect.Request[mapv1.ListMapsRequest]) (*connect.Response[mapv1.ListMapsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+
+	var maps []db.Map
+	err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) (err error) {
+		if maps, err = q.ListMaps(ctx, account.ID); err != nil {
+			return fmt.Errorf("listing maps: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &mapv1.ListMapsResponse{
+		Maps: make([]*mapv1.Map, 0, len(maps)),
+	}
+	for _, m := range maps {
+		response.Maps = append(response.Maps, conv.MapToProto(&m))
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) UpdateTiles(ctx context.Context, request *connect.Request[mapv1.UpdateTilesRequest]) (*connect.Response[mapv1.UpdateTilesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID(request.Msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Msg.Tiles) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no tiles to update"))
+	}
+	for _, tile := range request.Msg.Tiles {
+		if tile.GetCoordinate() == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tile without coordinate"))
+		}
+		if svc.content.Terrain(tile.GetTerrainId()) == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown terrain %q", tile.GetTerrainId()))
+		}
+	}
+
+	var m db.Map
+	var modified []grid.Position
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if m, err = q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		// group tiles by segment, so every affected segment is rewritten once
+		layout := conv.MapLayout(&m)
+		var order []grid.Position
+		updates := map[grid.Position][]*mapv1.Tile{}
+		for _, tile := range request.Msg.Tiles {
+			c := tile.GetCoordinate()
+			if !layout.Contains(c) {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tile %d:%d:%d is outside of the map", c.GetRow(), c.GetColumn(), c.GetDepth()))
+			}
+			row, column := layout.Locate(c)
+			p := grid.Position{Depth: c.GetDepth(), Row: row, Column: column}
+			if _, ok := updates[p]; !ok {
+				order = append(order, p)
+			}
+			updates[p] = append(updates[p], tile)
+		}
+
+		for _, p := range order {
+			segment, err := mapstore.LoadSegment(ctx, q, id, p)
+			if err != nil {
+				return err
+			}
+
+			for _, tile := range updates[p] {
+				i, ok := grid.Index(segment, tile.Coordinate)
+				if !ok {
+					return fmt.Errorf("segment %d:%d is missing tile %d:%d", p.Row, p.Column, tile.Coordinate.Row, tile.Coordinate.Column)
+				}
+				segment.Tiles[i] = tile
+			}
+			if err := mapstore.SaveSegment(ctx, q, id, p, segment); err != nil {
+				return err
+			}
+		}
+
+		if m, err = q.TouchMap(ctx, id); err != nil {
+			return fmt.Errorf("updating map: %w", err)
+		}
+		modified = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc.watchers.notify(id, modified...)
+
+	return connect.NewResponse(&mapv1.UpdateTilesResponse{
+		Map: conv.MapToProto(&m),
+	}), nil
+}
+
+// UpdateConnectors links levels of a map, every connector is stored within segments of both of its ends.
+func (svc *Service) UpdateConnectors(ctx context.Context, request *connect.Request[mapv1.UpdateConnectorsRequest]) (*connect.Response[mapv1.UpdateConnectorsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID(request.Msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("no connectors to update"))
+	}
+	if len(msg.Add) > maxConnectors {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("can't add more than %d connectors at once", maxConnectors))
+	}
+	for _, c := range slices.Concat(msg.Add, msg.Remove) {
+		if c.GetFrom() == nil || c.GetTo() == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("connector without ends"))
+		}
+		if c.From.Depth == c.To.Depth {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("connector must link different levels"))
+		}
+	}
+	for _, c := range msg.Add {
+		if c.Kind == mapv1.Connector_KIND_UNSPECIFIED {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("connector kind is required"))
+		}
+	}
+
+	var m db.Map
+	var modified []grid.Position
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if m, err = q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		// load every affected segment once
+		layout := conv.MapLayout(&m)
+		var order []grid.Position
+		segments := map[grid.Position]*mapv1.Segment{}
+		load := func(c *mapv1.Tile_Coordinate) (*mapv1.Segment, error) {
+			if !layout.Contains(c) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tile %d:%d:%d is outside of the map", c.GetRow(), c.GetColumn(), c.GetDepth()))
+			}
+			row, column := layout.Locate(c)
+			p := grid.Position{Depth: c.GetDepth(), Row: row, Column: column}
+			if segment, ok := segments[p]; ok {
+				return segment, nil
+			}
+			segment, err := mapstore.LoadSegment(ctx, q, id, p)
+			if err != nil {
+				return nil, err
+			}
+			order = append(order, p)
+			segments[p] = segment
+			return segment, nil
+		}
+
+		for _, c := range msg.Remove {
+			var removed bool
+			for _, end := range []*mapv1.Tile_Coordinate{c.From, c.To} {
+				segment, err := load(end)
+				if err != nil {
+					return err
+				}
+				removed = grid.Disconnect(segment, c) || removed
+			}
+			if !removed {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("connector %v not found", c))
+			}
+		}
+		for _, c := range msg.Add {
+			for _, end := range []*mapv1.Tile_Coordinate{c.From, c.To} {
+				segment, err := load(end)
+				if err != nil {
+					return err
+				}
+				grid.Connect(segment, c)
+			}
+		}
+
+		for _, p := range order {
+			if err := mapstore.SaveSegment(ctx, q, id, p, segments[p]); err != nil {
+				return err
+			}
+		}
+		if m, err = q.TouchMap(ctx, id); err != nil {
+			return fmt.Errorf("updating map: %w", err)
+		}
+		modified = order
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	svc.watchers.notify(id, modified...)
+
+	return connect.NewResponse(&mapv1.UpdateConnectorsResponse{
+		Map: conv.MapToProto(&m),
+	}), nil
+}
+
+func (svc *Service) DeleteMap(ctx context.Context, request *connect.Request[mapv1.DeleteMapRequest]) (*connect.Response[mapv1.DeleteMapResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	id, err := parseID(request.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		// games keep referencing their map, deleting it would delete them too
+		games, err := q.CountMapGames(ctx, id)
+		if err != nil {
+			return fmt.Errorf("counting games: %w", err)
+		}
+		if games > 0 {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("map %q is used by %d games", id, games))
+		}
+
+		deleted, err := q.DeleteMap(ctx, db.DeleteMapParams{ID: id, OwnerID: account.ID})
+		if err != nil {
+			return fmt.Errorf("deleting map: %w", err)
+		}
+		if deleted == 0 {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("map %q not found", id))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mapv1.DeleteMapResponse{}), nil
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid map id %q: %w", id, err))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,91 @@
+package maps
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+func TestService(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content)
+
+	var created *mapv1.Map
+	create := func(account *db.Account, request *mapv1.CreateMapRequest) func() error {
+		return func() error {
+			response, err := svc.CreateMap(lobbytest.As(account), connect.NewRequest(request))
+			if err == nil {
+				created = response.Msg.Map
+			}
+			return err
+		}
+	}
+	updateTiles := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.UpdateTiles(lobbytest.As(account), connect.NewRequest(&mapv1.UpdateTilesRequest{
+				MapId: created.GetId(),
+				Tiles: []*mapv1.Tile{{Coordinate: &mapv1.Tile_Coordinate{Row: 1, Column: 1}, TerrainId: lobbytest.Terrain}},
+			}))
+			return err
+		}
+	}
+	updateConnectors := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.UpdateConnectors(lobbytest.As(account), connect.NewRequest(&mapv1.UpdateConnectorsRequest{
+				MapId: created.GetId(),
+				Add: []*mapv1.Connector{{
+					Kind: mapv1.Connector_KIND_STAIRWAY,
+					From: &mapv1.Tile_Coordinate{Row: 2, Column: 2},
+					To:   &mapv1.Tile_Coordinate{Row: 2, Column: 2, Depth: 1},
+				}},
+			}))
+			return err
+		}
+	}
+	remove := func(account *db.Account, id func() string) func() error {
+		return func() error {
+			_, err := svc.DeleteMap(lobbytest.As(account), connect.NewRequest(&mapv1.DeleteMapRequest{Id: id()}))
+			return err
+		}
+	}
+	createdID, gameMapID := func() string { return created.GetId() }, func() string { return g.MapID.String() }
+
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "alfa creates a map without a name", Call: create(g.Alfa, &mapv1.CreateMapRequest{}), Code: connect.CodeInvalidArgument},
+		{Name: "alfa creates a huge map", Call: create(g.Alfa, &mapv1.CreateMapRequest{Name: "map", TotalRows: maxMapSize + 1}), Code: connect.CodeInvalidArgument},
+		{Name: "alfa creates huge segments", Call: create(g.Alfa, &mapv1.CreateMapRequest{Name: "map", MaxRowsPerSegment: maxSegmentSize + 1}), Code: connect.CodeInvalidArgument},
+		{Name: "alfa creates a deep map", Call: create(g.Alfa, &mapv1.CreateMapRequest{Name: "map", Depths: maxDepths + 1}), Code: connect.CodeInvalidArgument},
+		{Name: "alfa creates a map", Call: create(g.Alfa, &mapv1.CreateMapRequest{
+			Name:                 "map",
+			TotalRows:            8,
+			TotalColumns:         8,
+			MaxRowsPerSegment:    4,
+			MaxColumnsPerSegment: 4,
+			Depths:               2,
+		})},
+		{Name: "host updates tiles of the map", Call: updateTiles(g.Host), Code: connect.CodeNotFound},
+		{Name: "alfa updates tiles", Call: updateTiles(g.Alfa)},
+		{Name: "host updates connectors of the map", Call: updateConnectors(g.Host), Code: connect.CodeNotFound},
+		{Name: "alfa updates connectors", Call: updateConnectors(g.Alfa)},
+		{Name: "host deletes the map", Call: remove(g.Host, createdID), Code: connect.CodeNotFound},
+		{Name: "host deletes map of the game", Call: remove(g.Host, gameMapID), Code: connect.CodeFailedPrecondition},
+	})
+
+	for account, expected := range map[*db.Account]string{g.Host: g.MapID.String(), g.Alfa: created.GetId()} {
+		response, err := svc.ListMaps(lobbytest.As(account), connect.NewRequest(&mapv1.ListMapsRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list := response.Msg.Maps; len(list) != 1 || list[0].Id != expected {
+			t.Errorf("expected only map %s of the account, got %v", expected, list)
+		}
+	}
+
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "alfa deletes the map", Call: remove(g.Alfa, createdID)},
+		{Name: "alfa deletes the map again", Call: remove(g.Alfa, createdID), Code: connect.CodeNotFound},
+	})
+}
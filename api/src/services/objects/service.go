@@ -0,0 +1,263 @@
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+	"github.com/openhexes/proto/objects/v1/objectsv1connect"
+)
+
+type Service struct {
+	objectsv1connect.UnimplementedObjectServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+	hub     *session.Hub
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry, hub *session.Hub) *Service {
+	svc := &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+		hub:     hub,
+	}
+	hub.Redact("object_updated", svc.redact)
+	return svc
+}
+
+// ListObjects returns objects with any tile of their footprint explored by the caller.
+// Owners of maps may list objects placed on them.
+func (svc *Service) ListObjects(ctx context.Context, request *connect.Request[objectsv1.ListObjectsRequest]) (*connect.Response[objectsv1.ListObjectsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	if request.Msg.MapId != "" {
+		return svc.listMapObjects(ctx, account, request.Msg.MapId)
+	}
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &objectsv1.ListObjectsResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, false)
+		if err != nil {
+			return err
+		}
+		if !turns.IsPlayer(state, account.ID.String()) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", gameID))
+		}
+		list, err := objects.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+
+		m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+		if err != nil {
+			return err
+		}
+		explored, err := visibility.Load(ctx, q, gameID, account.ID, m.Layout())
+		if err != nil {
+			return err
+		}
+		for _, o := range list {
+			for _, h := range objects.Footprint(o, svc.content.Object(o.KindId)) {
+				if explored.Has(h) {
+					response.Objects = append(response.Objects, o)
+					break
+				}
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// redact withholds object updates from players who have neither explored nor currently see
+// any tile of the footprint, like ListObjects.
+func (svc *Service) redact(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+	id, err := parseID("game", gameID)
+	if err != nil {
+		return nil, err
+	}
+	o := event.GetObjectUpdated().GetObject()
+	footprint := objects.Footprint(o, svc.content.Object(o.GetKindId()))
+
+	views := map[string]*gamev1.Event{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+		if err != nil {
+			return err
+		}
+		heroList, err := heroes.List(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		townList, err := towns.List(ctx, q, id)
+		if err != nil {
+			return err
+		}
+		for _, accountID := range accountIDs {
+			account, err := uuid.Parse(accountID)
+			if err != nil {
+				continue
+			}
+			explored, err := visibility.Load(ctx, q, id, account, m.Layout())
+			if err != nil {
+				return err
+			}
+			visible := visibility.Visible(m, append(visibility.Heroes(heroList, accountID), visibility.Towns(townList, accountID)...)...)
+			for _, h := range footprint {
+				if explored.Has(h) || visible[h] {
+					views[accountID] = event
+					break
+				}
+			}
+		}
+		return m.Err()
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
+func (svc *Service) listMapObjects(ctx context.Context, account *db.Account, mapID string) (*connect.Response[objectsv1.ListObjectsResponse], error) {
+	id, err := parseID("map", mapID)
+	if err != nil {
+		return nil, err
+	}
+	response := &objectsv1.ListObjectsResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetMap(ctx, db.GetMapParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		response.Objects, err = mapstore.Objects(ctx, q, id)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// PlaceObject sets up an object on a map of the caller, it's copied into every game started on the map.
+func (svc *Service) PlaceObject(ctx context.Context, request *connect.Request[objectsv1.PlaceObjectRequest]) (*connect.Response[objectsv1.PlaceObjectResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	mapID, err := parseID("map", request.Msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	kind := svc.content.Object(msg.KindId)
+	switch {
+	case kind == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown object kind %q", msg.KindId))
+	case msg.Position == nil:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("position is required"))
+	}
+
+	object := objects.New(uuid.NewString(), "", msg.Position, kind)
+	object.TargetId = msg.TargetId
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		stored, err := q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: mapID, OwnerID: account.ID})
+		if err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		list, err := mapstore.Objects(ctx, q, mapID)
+		if err != nil {
+			return err
+		}
+		layer, err := objects.NewLayer(list, svc.content)
+		if err != nil {
+			return err
+		}
+		if err := layer.Add(object, kind); errors.Is(err, objects.ErrOverlap) {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		} else if err != nil {
+			return err
+		}
+		if object.TargetId != "" && !linked(list, object.TargetId) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target object %q not found", object.TargetId))
+		}
+
+		m := mapstore.Open(ctx, q, &stored, svc.content)
+		if err := checkPlacement(m, object, kind); err != nil {
+			return err
+		}
+
+		if err := mapstore.CreateObject(ctx, q, mapID, object); err != nil {
+			return err
+		}
+		if _, err := q.TouchMap(ctx, mapID); err != nil {
+			return fmt.Errorf("updating map: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&objectsv1.PlaceObjectResponse{Object: object}), nil
+}
+
+// checkPlacement makes sure the footprint lies within the map & heroes can reach every entrance.
+func checkPlacement(m *mapstore.Map, o *objectsv1.Object, kind *objectsv1.Object_Kind) error {
+	for _, h := range objects.Footprint(o, kind) {
+		if m.Tile(h) == nil {
+			if err := m.Err(); err != nil {
+				return err
+			}
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("footprint leaves the map at %v", h))
+		}
+	}
+	finder := pathfinding.NewFinder(m, heroes.Walker)
+	for _, h := range objects.Entrances(o, kind) {
+		if _, ok := finder.Cost(h); !ok {
+			if err := m.Err(); err != nil {
+				return err
+			}
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("heroes can't reach entrance at %v", h))
+		}
+	}
+	return m.Err()
+}
+
+func linked(list []*objectsv1.Object, id string) bool {
+	for _, o := range list {
+		if o.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+func parseID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id %q: %w", kind, id, err))
+	}
+	return parsed, nil
+}
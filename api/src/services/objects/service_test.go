@@ -0,0 +1,103 @@
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/objects"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	"github.com/openhexes/openhexes/api/src/session"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	objectsv1 "github.com/openhexes/proto/objects/v1"
+)
+
+const monolith = "core/object/monolith"
+
+func TestPlaceObject(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content, session.NewHub())
+
+	var placed []*objectsv1.Object
+	place := func(account *db.Account, position *mapv1.Tile_Coordinate, targetID string) func() error {
+		return func() error {
+			response, err := svc.PlaceObject(lobbytest.As(account), connect.NewRequest(&objectsv1.PlaceObjectRequest{
+				MapId:    g.MapID.String(),
+				KindId:   monolith,
+				Position: position,
+				TargetId: targetID,
+			}))
+			if err == nil {
+				placed = append(placed, response.Msg.Object)
+			}
+			return err
+		}
+	}
+	list := func(account *db.Account, request *objectsv1.ListObjectsRequest) func() error {
+		return func() error {
+			_, err := svc.ListObjects(lobbytest.As(account), connect.NewRequest(request))
+			return err
+		}
+	}
+	a, b := &mapv1.Tile_Coordinate{Row: 4, Column: 4}, &mapv1.Tile_Coordinate{Row: 10, Column: 10}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo lists objects of the game", Call: list(g.Bravo, &objectsv1.ListObjectsRequest{GameId: g.ID.String()}), Code: connect.CodePermissionDenied},
+		{Name: "alfa lists objects of the map", Call: list(g.Alfa, &objectsv1.ListObjectsRequest{MapId: g.MapID.String()}), Code: connect.CodeNotFound},
+		{Name: "alfa places an object on map of the host", Call: place(g.Alfa, a, ""), Code: connect.CodeNotFound},
+		{Name: "host places an object linked to nothing", Call: place(g.Host, a, uuid.NewString()), Code: connect.CodeInvalidArgument},
+		{Name: "host places an object off the map", Call: place(g.Host, &mapv1.Tile_Coordinate{Row: lobbytest.Size, Column: 0}, ""), Code: connect.CodeInvalidArgument},
+		{Name: "host places an object", Call: place(g.Host, a, "")},
+		{Name: "host places an object on another one", Call: place(g.Host, a, ""), Code: connect.CodeFailedPrecondition},
+	})
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "host places a linked object", Call: place(g.Host, b, placed[0].Id)},
+	})
+
+	response, err := svc.ListObjects(lobbytest.As(g.Host), connect.NewRequest(&objectsv1.ListObjectsRequest{MapId: g.MapID.String()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(response.Msg.Objects); n != len(placed) {
+		t.Errorf("expected %d objects on the map, got %d", len(placed), n)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	New(g.Config, nil, g.Content, hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+
+	// alfa sees the middle of the map through a hero without having explored it
+	hero := heroes.New(uuid.NewString(), g.ID.String(), g.Alfa.ID.String(), "alfa", &mapv1.Tile_Coordinate{Row: 9, Column: 10}, nil)
+	g.Tx(t, func(ctx context.Context, q *db.Queries) error {
+		return heroes.Create(ctx, q, hero)
+	})
+
+	publish := func(position *mapv1.Tile_Coordinate) {
+		o := objects.New(uuid.NewString(), g.ID.String(), position, g.Content.Object(monolith))
+		hub.Publish(context.Background(), g.ID.String(), &gamev1.Event{
+			Kind: &gamev1.Event_ObjectUpdated_{ObjectUpdated: &gamev1.Event_ObjectUpdated{Object: o}},
+		})
+	}
+	for _, tc := range []struct {
+		name       string
+		position   *mapv1.Tile_Coordinate
+		host, alfa int
+	}{
+		{name: "explored by the host", position: &mapv1.Tile_Coordinate{Row: 2, Column: 2}, host: 1},
+		{name: "seen by hero of alfa", position: &mapv1.Tile_Coordinate{Row: 9, Column: 9}, alfa: 1},
+	} {
+		publish(tc.position)
+		if n := len(lobbytest.Received(host, "object_updated")); n != tc.host {
+			t.Errorf("%s: expected %d events for the host, got %d", tc.name, tc.host, n)
+		}
+		if n := len(lobbytest.Received(alfa, "object_updated")); n != tc.alfa {
+			t.Errorf("%s: expected %d events for alfa, got %d", tc.name, tc.alfa, n)
+		}
+	}
+}
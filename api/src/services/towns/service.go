@@ -0,0 +1,408 @@
+package towns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/auth"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/content"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/heroes"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	"github.com/openhexes/openhexes/api/src/turns"
+	"github.com/openhexes/openhexes/api/src/visibility"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"github.com/openhexes/proto/towns/v1/townsv1connect"
+	"google.golang.org/protobuf/proto"
+)
+
+type Service struct {
+	townsv1connect.UnimplementedTownServiceHandler
+
+	cfg     *config.Config
+	auth    *auth.Controller
+	content *content.Registry
+	hub     *session.Hub
+}
+
+func New(cfg *config.Config, auth *auth.Controller, content *content.Registry, hub *session.Hub) *Service {
+	svc := &Service{
+		cfg:     cfg,
+		auth:    auth,
+		content: content,
+		hub:     hub,
+	}
+	hub.Redact("town_updated", svc.redact)
+	return svc
+}
+
+const maxNameLength = 64
+
+// ListTowns returns towns of the caller and towns of others on explored tiles,
+// garrisons & creatures available in the latter are hidden. Owners of maps may list towns placed on them.
+func (svc *Service) ListTowns(ctx context.Context, request *connect.Request[townsv1.ListTownsRequest]) (*connect.Response[townsv1.ListTownsResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	if request.Msg.MapId != "" {
+		return svc.listMapTowns(ctx, account, request.Msg.MapId)
+	}
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &townsv1.ListTownsResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		state, err := turns.Load(ctx, q, gameID, false)
+		if err != nil {
+			return err
+		}
+		if !turns.IsPlayer(state, account.ID.String()) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not a player of game %q", gameID))
+		}
+		list, err := towns.List(ctx, q, gameID)
+		if err != nil {
+			return err
+		}
+
+		m, err := mapstore.OpenGame(ctx, q, gameID, svc.content)
+		if err != nil {
+			return err
+		}
+		explored, err := visibility.Load(ctx, q, gameID, account.ID, m.Layout())
+		if err != nil {
+			return err
+		}
+		for _, town := range list {
+			switch {
+			case town.OwnerId == account.ID.String():
+				response.Towns = append(response.Towns, town)
+			case explored.Has(hex.FromCoordinate(town.Position)):
+				response.Towns = append(response.Towns, towns.Public(town))
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) listMapTowns(ctx context.Context, account *db.Account, mapID string) (*connect.Response[townsv1.ListTownsResponse], error) {
+	id, err := parseID("map", mapID)
+	if err != nil {
+		return nil, err
+	}
+	response := &townsv1.ListTownsResponse{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		if _, err := q.GetMap(ctx, db.GetMapParams{ID: id, OwnerID: account.ID}); err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+		response.Towns, err = mapstore.Towns(ctx, q, id)
+		return err
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// PlaceTown sets up a town on a map of the caller, it's copied into every game started on the map.
+func (svc *Service) PlaceTown(ctx context.Context, request *connect.Request[townsv1.PlaceTownRequest]) (*connect.Response[townsv1.PlaceTownResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	mapID, err := parseID("map", request.Msg.MapId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	if msg.Name == "" || len(msg.Name) > maxNameLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name must be 1-%d characters long", maxNameLength))
+	}
+	if msg.Position == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("position is required"))
+	}
+	kind := svc.content.Town(msg.KindId)
+	if kind == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown town kind %q", msg.KindId))
+	}
+
+	town := towns.New(uuid.NewString(), "", "", msg.Name, msg.Position, kind)
+	town.Slot = msg.Slot
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		stored, err := q.GetMapForUpdate(ctx, db.GetMapForUpdateParams{ID: mapID, OwnerID: account.ID})
+		if err != nil {
+			return fmt.Errorf("getting map: %w", err)
+		}
+
+		list, err := mapstore.Towns(ctx, q, mapID)
+		if err != nil {
+			return err
+		}
+		for _, other := range list {
+			if proto.Equal(other.Position, town.Position) {
+				return connect.NewError(connect.CodeFailedPrecondition, errors.New("tile is occupied by another town"))
+			}
+		}
+
+		m := mapstore.Open(ctx, q, &stored, svc.content)
+		_, ok := pathfinding.NewFinder(m, heroes.Walker).Cost(hex.FromCoordinate(town.Position))
+		if err := m.Err(); err != nil {
+			return err
+		}
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("town can't stand on this tile"))
+		}
+
+		if err := mapstore.CreateTown(ctx, q, mapID, town); err != nil {
+			return err
+		}
+		if _, err := q.TouchMap(ctx, mapID); err != nil {
+			return fmt.Errorf("updating map: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&townsv1.PlaceTownResponse{Town: town}), nil
+}
+
+func (svc *Service) Build(ctx context.Context, request *connect.Request[townsv1.BuildRequest]) (*connect.Response[townsv1.BuildResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var town *townsv1.Town
+	err = svc.hub.Execute(ctx, gameID.String(), func() ([]*gamev1.Event, error) {
+		err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			state, err := turns.Load(ctx, q, gameID, true)
+			if err != nil {
+				return err
+			}
+			if err := turns.CanAct(state, account.ID.String()); err != nil {
+				return err
+			}
+			if town, err = owned(ctx, q, gameID, request.Msg.TownId, account); err != nil {
+				return err
+			}
+			kind, err := svc.kind(town)
+			if err != nil {
+				return err
+			}
+
+			b, err := towns.Build(town, kind, request.Msg.BuildingId)
+			if errors.Is(err, towns.ErrUnknownBuilding) {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			} else if err != nil {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			if err := charge(ctx, q, gameID, account, state.Day, economy.ReasonBuild, town.Id, economy.Cost(b.GetCost(), 1)); err != nil {
+				return err
+			}
+			return towns.Save(ctx, q, town)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []*gamev1.Event{updated(town)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&townsv1.BuildResponse{Town: town}), nil
+}
+
+// RecruitCreatures moves creatures available in a town into its garrison or army of a visiting hero.
+func (svc *Service) RecruitCreatures(ctx context.Context, request *connect.Request[townsv1.RecruitCreaturesRequest]) (*connect.Response[townsv1.RecruitCreaturesResponse], error) {
+	account := auth.AccountFromContext(ctx)
+	gameID, err := parseID("game", request.Msg.GameId)
+	if err != nil {
+		return nil, err
+	}
+	msg := request.Msg
+	if msg.Count == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("count must be positive"))
+	}
+
+	response := &townsv1.RecruitCreaturesResponse{}
+	err = svc.hub.Execute(ctx, gameID.String(), func() ([]*gamev1.Event, error) {
+		err := svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+			state, err := turns.Load(ctx, q, gameID, true)
+			if err != nil {
+				return err
+			}
+			if err := turns.CanAct(state, account.ID.String()); err != nil {
+				return err
+			}
+			town, err := owned(ctx, q, gameID, msg.TownId, account)
+			if err != nil {
+				return err
+			}
+			kind, err := svc.kind(town)
+			if err != nil {
+				return err
+			}
+
+			var hero *heroesv1.Hero
+			if msg.HeroId != "" {
+				if hero, err = visiting(ctx, q, town, msg.HeroId); err != nil {
+					return err
+				}
+			}
+
+			d, err := towns.Recruit(town, kind, msg.CreatureId, msg.Count)
+			if err != nil {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			if err := charge(ctx, q, gameID, account, state.Day, economy.ReasonRecruit, town.Id, economy.Cost(d.GetCost(), msg.Count)); err != nil {
+				return err
+			}
+			if hero != nil {
+				hero.Army, err = heroes.Reinforce(hero.Army, msg.CreatureId, msg.Count)
+			} else {
+				town.Garrison, err = heroes.Reinforce(town.Garrison, msg.CreatureId, msg.Count)
+			}
+			if err != nil {
+				return connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+
+			if hero != nil {
+				if err := heroes.Save(ctx, q, hero); err != nil {
+					return err
+				}
+			}
+			response.Town, response.Hero = town, hero
+			return towns.Save(ctx, q, town)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []*gamev1.Event{updated(response.Town)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (svc *Service) kind(town *townsv1.Town) (*townsv1.Town_Kind, error) {
+	kind := svc.content.Town(town.KindId)
+	if kind == nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("town %q: unknown kind %q", town.Id, town.KindId))
+	}
+	return kind, nil
+}
+
+// charge pays for construction or recruitment out of player's treasury.
+func charge(ctx context.Context, q *db.Queries, gameID uuid.UUID, account *db.Account, day uint32, reason, reference string, deltas map[string]int64) error {
+	_, err := economy.Transact(ctx, q, gameID, account.ID, day, reason, reference, deltas)
+	if errors.Is(err, economy.ErrInsufficient) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
+
+// owned finds a town of the game controlled by the account.
+func owned(ctx context.Context, q *db.Queries, gameID uuid.UUID, townID string, account *db.Account) (*townsv1.Town, error) {
+	list, err := towns.List(ctx, q, gameID)
+	if err != nil {
+		return nil, err
+	}
+	for _, town := range list {
+		if town.Id != townID {
+			continue
+		}
+		if town.OwnerId != account.ID.String() {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("town %q belongs to another player", townID))
+		}
+		return town, nil
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("town %q not found", townID))
+}
+
+// visiting finds a hero standing in the town, recruits may only join heroes of the town owner.
+func visiting(ctx context.Context, q *db.Queries, town *townsv1.Town, heroID string) (*heroesv1.Hero, error) {
+	list, err := heroes.List(ctx, q, uuid.MustParse(town.GameId))
+	if err != nil {
+		return nil, err
+	}
+	for _, hero := range list {
+		if hero.Id != heroID {
+			continue
+		}
+		if !towns.Visiting(town, hero) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("hero %q isn't visiting town %q", heroID, town.Id))
+		}
+		return hero, nil
+	}
+	return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("hero %q not found", heroID))
+}
+
+// redact sends updated towns to their owners as is, other players only learn about towns
+// on tiles they explored & without garrisons, same as in ListTowns.
+func (svc *Service) redact(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+	id, err := parseID("game", gameID)
+	if err != nil {
+		return nil, err
+	}
+	town := event.GetTownUpdated().GetTown()
+	public := updated(towns.Public(town))
+
+	views := map[string]*gamev1.Event{}
+	err = svc.cfg.Postgres.Tx(ctx, func(tx pgx.Tx, q *db.Queries) error {
+		m, err := mapstore.OpenGame(ctx, q, id, svc.content)
+		if err != nil {
+			return err
+		}
+		for _, accountID := range accountIDs {
+			if accountID == town.GetOwnerId() {
+				views[accountID] = event
+				continue
+			}
+			account, err := uuid.Parse(accountID)
+			if err != nil {
+				continue
+			}
+			explored, err := visibility.Load(ctx, q, id, account, m.Layout())
+			if err != nil {
+				return err
+			}
+			if explored.Has(hex.FromCoordinate(town.GetPosition())) {
+				views[accountID] = public
+			}
+		}
+		return nil
+	}, config.WithAccessMode(pgx.ReadOnly))
+	if err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
+func updated(town *townsv1.Town) *gamev1.Event {
+	return &gamev1.Event{
+		Kind: &gamev1.Event_TownUpdated_{TownUpdated: &gamev1.Event_TownUpdated{Town: town}},
+	}
+}
+
+func parseID(kind, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s id %q: %w", kind, id, err))
+	}
+	return parsed, nil
+}
@@ -0,0 +1,128 @@
+package towns
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/economy"
+	"github.com/openhexes/openhexes/api/src/services/lobby/lobbytest"
+	"github.com/openhexes/openhexes/api/src/session"
+	"github.com/openhexes/openhexes/api/src/towns"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+)
+
+const (
+	marketplace = "core/building/marketplace"
+	peasant     = "core/creature/peasant"
+)
+
+func TestBuild(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+	hostTown, alfaTown := g.Town(t, g.Host), g.Town(t, g.Alfa)
+	before := g.Treasury(t, g.Host)
+
+	build := func(account *db.Account, townID, buildingID string) func() error {
+		return func() error {
+			_, err := svc.Build(lobbytest.As(account), connect.NewRequest(&townsv1.BuildRequest{
+				GameId:     g.ID.String(),
+				TownId:     townID,
+				BuildingId: buildingID,
+			}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo builds", Call: build(g.Bravo, hostTown.Id, marketplace), Code: connect.CodePermissionDenied},
+		{Name: "alfa builds out of turn", Call: build(g.Alfa, alfaTown.Id, marketplace), Code: connect.CodeFailedPrecondition},
+		{Name: "host builds in town of alfa", Call: build(g.Host, alfaTown.Id, marketplace), Code: connect.CodePermissionDenied},
+		{Name: "host builds unknown building", Call: build(g.Host, hostTown.Id, "core/building/unknown"), Code: connect.CodeInvalidArgument},
+		{Name: "host builds", Call: build(g.Host, hostTown.Id, marketplace)},
+		{Name: "host builds again", Call: build(g.Host, hostTown.Id, marketplace), Code: connect.CodeFailedPrecondition},
+	})
+
+	kind := g.Content.Town(hostTown.KindId)
+	lobbytest.CheckDeltas(t, before, g.Treasury(t, g.Host), economy.Cost(towns.Building(kind, marketplace).GetCost(), 1))
+	if n := len(lobbytest.Received(host, "town_updated")); n != 1 {
+		t.Errorf("expected the host to be told about the building, got %d events", n)
+	}
+	if n := len(lobbytest.Received(alfa, "town_updated")); n != 0 {
+		t.Errorf("expected alfa not to learn about the unexplored town, got %d events", n)
+	}
+}
+
+func TestRecruitCreatures(t *testing.T) {
+	g := lobbytest.Start(t)
+	hub := session.NewHub()
+	svc := New(g.Config, nil, g.Content, hub)
+	host, alfa := g.Join(t, hub, g.Host), g.Join(t, hub, g.Alfa)
+	hostTown, alfaTown := g.Town(t, g.Host), g.Town(t, g.Alfa)
+	g.Explore(t, g.Alfa, lobbytest.HostTown)
+	before := g.Treasury(t, g.Host)
+
+	recruit := func(account *db.Account, townID string, count uint32) func() error {
+		return func() error {
+			_, err := svc.RecruitCreatures(lobbytest.As(account), connect.NewRequest(&townsv1.RecruitCreaturesRequest{
+				GameId:     g.ID.String(),
+				TownId:     townID,
+				CreatureId: peasant,
+				Count:      count,
+			}))
+			return err
+		}
+	}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo recruits", Call: recruit(g.Bravo, hostTown.Id, 2), Code: connect.CodePermissionDenied},
+		{Name: "alfa recruits out of turn", Call: recruit(g.Alfa, alfaTown.Id, 2), Code: connect.CodeFailedPrecondition},
+		{Name: "host recruits in town of alfa", Call: recruit(g.Host, alfaTown.Id, 2), Code: connect.CodePermissionDenied},
+		{Name: "host recruits nobody", Call: recruit(g.Host, hostTown.Id, 0), Code: connect.CodeInvalidArgument},
+		{Name: "host recruits", Call: recruit(g.Host, hostTown.Id, 2)},
+	})
+
+	kind := g.Content.Town(hostTown.KindId)
+	cost := towns.Building(kind, "core/building/hovel").GetDwelling().GetCost()
+	lobbytest.CheckDeltas(t, before, g.Treasury(t, g.Host), economy.Cost(cost, 2))
+
+	events := lobbytest.Received(host, "town_updated")
+	if len(events) != 1 || len(events[0].GetTownUpdated().GetTown().GetGarrison()) == 0 {
+		t.Errorf("expected the host to be told about the garrison, got %v", events)
+	}
+	events = lobbytest.Received(alfa, "town_updated")
+	if len(events) != 1 || events[0].GetTownUpdated().GetTown().GetGarrison() != nil {
+		t.Errorf("expected alfa to learn about the explored town without its garrison, got %v", events)
+	}
+}
+
+func TestPlaceTown(t *testing.T) {
+	g := lobbytest.Start(t)
+	svc := New(g.Config, nil, g.Content, session.NewHub())
+
+	place := func(account *db.Account, position *mapv1.Tile_Coordinate) func() error {
+		return func() error {
+			_, err := svc.PlaceTown(lobbytest.As(account), connect.NewRequest(&townsv1.PlaceTownRequest{
+				MapId:    g.MapID.String(),
+				KindId:   lobbytest.TownKind,
+				Name:     "town",
+				Position: position,
+			}))
+			return err
+		}
+	}
+	list := func(account *db.Account) func() error {
+		return func() error {
+			_, err := svc.ListTowns(lobbytest.As(account), connect.NewRequest(&townsv1.ListTownsRequest{GameId: g.ID.String()}))
+			return err
+		}
+	}
+	center := &mapv1.Tile_Coordinate{Row: lobbytest.Size / 2, Column: lobbytest.Size / 2}
+	lobbytest.Run(t, []lobbytest.Step{
+		{Name: "bravo lists towns of the game", Call: list(g.Bravo), Code: connect.CodePermissionDenied},
+		{Name: "alfa places a town on map of the host", Call: place(g.Alfa, center), Code: connect.CodeNotFound},
+		{Name: "host places a town on another one", Call: place(g.Host, lobbytest.HostTown), Code: connect.CodeFailedPrecondition},
+		{Name: "host places a town", Call: place(g.Host, center)},
+	})
+}
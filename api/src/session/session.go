@@ -0,0 +1,388 @@
+// Package session connects players of a game: commands they send are dispatched to
+// registered handlers, resulting events are sequenced and broadcast to everyone in the game.
+// Events revealing what other players can't see are tailored to each of them by registered redactors.
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/config"
+	"github.com/openhexes/openhexes/api/src/db"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrTooSlow closes sessions which don't keep up with events of their game.
+var ErrTooSlow = errors.New("session can't keep up with game events")
+
+// Handler executes a command on behalf of session's account and returns resulting events.
+// Returned connect errors are reported to the author of the command with their code.
+type Handler func(ctx context.Context, s *Session, command *gamev1.Command) ([]*gamev1.Event, error)
+
+// Redactor tailors an event to what each of the accounts may know about the game.
+// Accounts missing from the result don't receive the event.
+type Redactor func(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error)
+
+// Authorizer decides whether account may join a game.
+type Authorizer func(ctx context.Context, gameID string, account *db.Account) error
+
+type Hub struct {
+	mu        sync.Mutex
+	rooms     map[string]*room
+	handlers  map[protoreflect.Name]Handler
+	redactors map[protoreflect.Name]Redactor
+
+	authorize Authorizer
+	buffer    int
+	now       func() time.Time
+}
+
+type Option func(*Hub)
+
+// WithAuthorizer restricts joining games, anyone may join any game by default.
+func WithAuthorizer(a Authorizer) Option {
+	return func(h *Hub) {
+		h.authorize = a
+	}
+}
+
+// WithBuffer sets number of events kept for a session before it's considered too slow.
+func WithBuffer(size int) Option {
+	return func(h *Hub) {
+		h.buffer = size
+	}
+}
+
+func NewHub(opts ...Option) *Hub {
+	h := &Hub{
+		rooms:     map[string]*room{},
+		handlers:  map[protoreflect.Name]Handler{},
+		redactors: map[protoreflect.Name]Redactor{},
+		authorize: func(context.Context, string, *db.Account) error { return nil },
+		buffer:    256,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Kind returns name of the command kind field, e.g. "end_turn", empty if not set.
+func Kind(command *gamev1.Command) protoreflect.Name {
+	return kind(command.ProtoReflect())
+}
+
+// EventKind returns name of the event kind field, e.g. "hero_moved", empty if not set.
+func EventKind(event *gamev1.Event) protoreflect.Name {
+	return kind(event.ProtoReflect())
+}
+
+func kind(m protoreflect.Message) protoreflect.Name {
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("kind"))
+	if field == nil {
+		return ""
+	}
+	return field.Name()
+}
+
+// Handle registers handler of a command kind, see Kind.
+func (h *Hub) Handle(kind protoreflect.Name, handler Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[kind] = handler
+}
+
+// Redact registers redactor of an event kind, see EventKind.
+// Events without one are delivered to everyone as is.
+func (h *Hub) Redact(kind protoreflect.Name, redactor Redactor) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.redactors[kind] = redactor
+}
+
+// room keeps sessions of a single game. Events of a game are published under room lock,
+// so every session observes them in the same order.
+type room struct {
+	mu       sync.Mutex
+	sequence uint64
+	sessions map[*Session]bool
+
+	commands sync.Mutex // commands of a game are executed one at a time
+}
+
+type Session struct {
+	ID      string
+	GameID  string
+	Account *db.Account
+
+	hub    *Hub
+	events chan *gamev1.Event
+
+	mu     sync.Mutex
+	closed bool
+	err    error
+}
+
+// Events delivers events of the game, closed once session is over.
+func (s *Session) Events() <-chan *gamev1.Event {
+	return s.events
+}
+
+// Err explains why session was closed by the hub, nil if it's open or left voluntarily.
+func (s *Session) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Join opens a session in a game, it must be closed with Leave.
+func (h *Hub) Join(ctx context.Context, gameID string, account *db.Account) (*Session, error) {
+	if gameID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("game id is required"))
+	}
+	if err := h.authorize(ctx, gameID, account); err != nil {
+		return nil, err
+	}
+
+	s := &Session{
+		ID:      uuid.NewString(),
+		GameID:  gameID,
+		Account: account,
+		hub:     h,
+		events:  make(chan *gamev1.Event, h.buffer),
+	}
+
+	h.mu.Lock()
+	r := h.roomLocked(gameID)
+	r.mu.Lock()
+	r.sessions[s] = true
+	r.mu.Unlock()
+	h.mu.Unlock()
+
+	h.Publish(ctx, gameID, &gamev1.Event{
+		Kind: &gamev1.Event_Joined_{Joined: &gamev1.Event_Joined{AccountId: account.ID.String()}},
+	})
+	return s, nil
+}
+
+// roomLocked returns room of the game, creating it if there is none. Hub must be locked.
+func (h *Hub) roomLocked(gameID string) *room {
+	r, ok := h.rooms[gameID]
+	if !ok {
+		r = &room{sessions: map[*Session]bool{}}
+		h.rooms[gameID] = r
+	}
+	return r
+}
+
+// Leave closes the session and lets other players know.
+func (s *Session) Leave() {
+	h := s.hub
+	h.mu.Lock()
+	r := h.rooms[s.GameID]
+	empty := false
+	if r != nil {
+		r.mu.Lock()
+		delete(r.sessions, s)
+		empty = len(r.sessions) == 0
+		r.mu.Unlock()
+		if empty {
+			delete(h.rooms, s.GameID)
+		}
+	}
+	h.mu.Unlock()
+
+	s.close(nil)
+	if !empty {
+		h.Publish(context.Background(), s.GameID, &gamev1.Event{
+			Kind: &gamev1.Event_Left_{Left: &gamev1.Event_Left{AccountId: s.Account.ID.String()}},
+		})
+	}
+}
+
+func (s *Session) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeLocked(err)
+}
+
+func (s *Session) closeLocked(err error) {
+	if !s.closed {
+		s.closed = true
+		s.err = err
+		close(s.events)
+	}
+}
+
+// Publish sequences events and delivers them to every session of the game, tailored by redactors.
+// Sessions which can't accept events are closed with ErrTooSlow.
+func (h *Hub) Publish(ctx context.Context, gameID string, events ...*gamev1.Event) {
+	h.mu.Lock()
+	r := h.rooms[gameID]
+	redactors := make([]Redactor, len(events))
+	for i, event := range events {
+		redactors[i] = h.redactors[EventKind(event)]
+	}
+	h.mu.Unlock()
+	if r == nil {
+		return
+	}
+
+	// redactors may query the database, so they run before the room is locked
+	views := make([]map[string]*gamev1.Event, len(events))
+	accountIDs := r.accountIDs()
+	for i, event := range events {
+		if redactors[i] == nil {
+			continue
+		}
+		view, err := redactors[i](ctx, gameID, accountIDs, event)
+		if err != nil {
+			// withheld from everyone rather than leaked
+			config.GetLogger(ctx).Error("failed to redact event", zap.String("game", gameID), zap.Error(err))
+			view = map[string]*gamev1.Event{}
+		}
+		views[i] = view
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, event := range events {
+		r.sequence++
+		event.Sequence = r.sequence
+		if event.Time == nil {
+			event.Time = timestamppb.New(h.now())
+		}
+		for _, e := range views[i] {
+			e.Sequence, e.Time, e.CommandId = event.Sequence, event.Time, event.CommandId
+		}
+		for s := range r.sessions {
+			if views[i] == nil {
+				s.deliver(event)
+			} else if e := views[i][s.Account.ID.String()]; e != nil {
+				s.deliver(e)
+			}
+		}
+	}
+}
+
+// accountIDs returns accounts with sessions in the room.
+func (r *room) accountIDs() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for s := range r.sessions {
+		if id := s.Account.ID.String(); !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (s *Session) deliver(event *gamev1.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.events <- event:
+	default:
+		s.closeLocked(ErrTooSlow)
+	}
+}
+
+// Dispatch executes command with a registered handler and publishes resulting events.
+// Failures are only reported to the session that sent the command.
+func (h *Hub) Dispatch(ctx context.Context, s *Session, command *gamev1.Command) {
+	kind := Kind(command)
+
+	h.mu.Lock()
+	handler, ok := h.handlers[kind]
+	r := h.rooms[s.GameID]
+	h.mu.Unlock()
+	if r == nil {
+		return // session is over
+	}
+
+	// events are published before the next command runs, so their order matches execution
+	r.commands.Lock()
+	defer r.commands.Unlock()
+
+	var (
+		events []*gamev1.Event
+		err    error
+	)
+	if !ok {
+		err = connect.NewError(connect.CodeUnimplemented, fmt.Errorf("unsupported command: %q", kind))
+	} else {
+		events, err = handler(ctx, s, command)
+	}
+
+	if err != nil {
+		s.deliver(&gamev1.Event{
+			Time:      timestamppb.New(h.now()),
+			CommandId: command.GetId(),
+			Kind:      &gamev1.Event_Rejected_{Rejected: rejection(err)},
+		})
+		return
+	}
+	for _, event := range events {
+		event.CommandId = command.GetId()
+	}
+	h.Publish(ctx, s.GameID, events...)
+}
+
+// Execute runs fn one at a time with commands of the game, see Dispatch, and publishes resulting events.
+// It's meant for changes of a game coming from elsewhere, e.g. unary calls or turns running out of time,
+// so their events are published in the order changes were made.
+func (h *Hub) Execute(ctx context.Context, gameID string, fn func() ([]*gamev1.Event, error)) error {
+	// the room is kept while fn runs, so sessions joining meanwhile wait for its events
+	h.mu.Lock()
+	r := h.roomLocked(gameID)
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if len(r.sessions) == 0 && h.rooms[gameID] == r {
+			delete(h.rooms, gameID)
+		}
+	}()
+
+	r.commands.Lock()
+	defer r.commands.Unlock()
+
+	events, err := fn()
+	if err != nil {
+		return err
+	}
+	h.Publish(ctx, gameID, events...)
+	return nil
+}
+
+// rejection reports connect errors as is, other errors are internal and not exposed to clients.
+func rejection(err error) *gamev1.Event_Rejected {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return &gamev1.Event_Rejected{
+			Code:    connectErr.Code().String(),
+			Message: connectErr.Message(),
+		}
+	}
+	return &gamev1.Event_Rejected{
+		Code:    connect.CodeInternal.String(),
+		Message: "internal error",
+	}
+}
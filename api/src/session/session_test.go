@@ -0,0 +1,259 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+)
+
+func account() *db.Account {
+	return &db.Account{ID: uuid.New()}
+}
+
+func next(t *testing.T, s *Session) *gamev1.Event {
+	t.Helper()
+	select {
+	case event, ok := <-s.Events():
+		if !ok {
+			t.Fatalf("session is closed: %v", s.Err())
+		}
+		return event
+	default:
+		t.Fatal("no events")
+		return nil
+	}
+}
+
+func endTurn(id string) *gamev1.Command {
+	return &gamev1.Command{Id: id, Kind: &gamev1.Command_EndTurn_{EndTurn: &gamev1.Command_EndTurn{}}}
+}
+
+func TestKind(t *testing.T) {
+	if kind := Kind(endTurn("")); kind != "end_turn" {
+		t.Fatalf("unexpected kind: %q", kind)
+	}
+	if kind := Kind(&gamev1.Command{}); kind != "" {
+		t.Fatalf("unexpected kind: %q", kind)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	ctx := context.Background()
+	h := NewHub()
+	h.Handle("end_turn", func(ctx context.Context, s *Session, command *gamev1.Command) ([]*gamev1.Event, error) {
+		return []*gamev1.Event{{}}, nil
+	})
+
+	alfa, err := h.Join(ctx, "game", account())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bravo, err := h.Join(ctx, "game", account())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := h.Join(ctx, "other", account())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event := next(t, alfa); event.GetJoined().GetAccountId() != alfa.Account.ID.String() || event.Sequence != 1 {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	if event := next(t, alfa); event.GetJoined().GetAccountId() != bravo.Account.ID.String() || event.Sequence != 2 {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	next(t, bravo)
+	next(t, other)
+
+	h.Dispatch(ctx, bravo, endTurn("c1"))
+	for _, s := range []*Session{alfa, bravo} {
+		if event := next(t, s); event.CommandId != "c1" || event.Sequence != 3 {
+			t.Fatalf("unexpected event: %v", event)
+		}
+	}
+	if len(other.Events()) != 0 {
+		t.Fatal("event leaked into another game")
+	}
+
+	bravo.Leave()
+	if _, ok := <-bravo.Events(); ok {
+		t.Fatal("expected events to be closed after leaving")
+	}
+	if event := next(t, alfa); event.GetLeft().GetAccountId() != bravo.Account.ID.String() {
+		t.Fatalf("unexpected event: %v", event)
+	}
+}
+
+func TestRejected(t *testing.T) {
+	ctx := context.Background()
+	h := NewHub()
+	h.Handle("end_turn", func(ctx context.Context, s *Session, command *gamev1.Command) ([]*gamev1.Event, error) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("not your turn"))
+	})
+
+	alfa, _ := h.Join(ctx, "game", account())
+	bravo, _ := h.Join(ctx, "game", account())
+	next(t, alfa)
+	next(t, alfa)
+	next(t, bravo)
+
+	h.Dispatch(ctx, alfa, endTurn("c1"))
+	event := next(t, alfa)
+	if event.GetRejected().GetCode() != "failed_precondition" || event.GetRejected().GetMessage() != "not your turn" || event.Sequence != 0 {
+		t.Fatalf("unexpected event: %v", event)
+	}
+	if len(bravo.Events()) != 0 {
+		t.Fatal("rejection leaked to another session")
+	}
+
+	h.Dispatch(ctx, alfa, &gamev1.Command{Id: "c2", Kind: &gamev1.Command_MoveHero_{MoveHero: &gamev1.Command_MoveHero{}}})
+	if event := next(t, alfa); event.GetRejected().GetCode() != "unimplemented" {
+		t.Fatalf("unexpected event: %v", event)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	ctx := context.Background()
+	h := NewHub()
+	// moves are only seen by owners of heroes, as if opponents were too far
+	h.Redact("hero_moved", func(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+		owner := event.GetHeroMoved().GetHero().GetOwnerId()
+		if !slices.Contains(accountIDs, owner) {
+			return nil, nil
+		}
+		return map[string]*gamev1.Event{owner: event}, nil
+	})
+	h.Redact("spell_cast", func(ctx context.Context, gameID string, accountIDs []string, event *gamev1.Event) (map[string]*gamev1.Event, error) {
+		return nil, errors.New("broken")
+	})
+
+	alfa, _ := h.Join(ctx, "game", account())
+	bravo, _ := h.Join(ctx, "game", account())
+	next(t, alfa)
+	next(t, alfa)
+	next(t, bravo)
+
+	moved := &gamev1.Event{Kind: &gamev1.Event_HeroMoved_{HeroMoved: &gamev1.Event_HeroMoved{
+		Hero: &heroesv1.Hero{OwnerId: alfa.Account.ID.String()},
+	}}}
+	cast := &gamev1.Event{Kind: &gamev1.Event_SpellCast_{SpellCast: &gamev1.Event_SpellCast{}}}
+	h.Publish(ctx, "game", moved, cast, &gamev1.Event{})
+
+	if event := next(t, alfa); event.GetHeroMoved() == nil || event.Sequence != 3 {
+		t.Fatalf("expected owner to see the move, got %v", event)
+	}
+	if event := next(t, alfa); event.Sequence != 5 {
+		t.Fatalf("expected event failed to redact to be withheld, got %v", event)
+	}
+	if event := next(t, bravo); event.GetHeroMoved() != nil || event.Sequence != 5 {
+		t.Fatalf("expected hidden move to be withheld from the opponent, got %v", event)
+	}
+}
+
+func TestAuthorizer(t *testing.T) {
+	denied := connect.NewError(connect.CodePermissionDenied, errors.New("not a player"))
+	h := NewHub(WithAuthorizer(func(ctx context.Context, gameID string, account *db.Account) error {
+		if gameID != "mine" {
+			return denied
+		}
+		return nil
+	}))
+
+	if _, err := h.Join(context.Background(), "theirs", account()); !errors.Is(err, denied) {
+		t.Fatalf("expected denial, got %v", err)
+	}
+	if _, err := h.Join(context.Background(), "mine", account()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Join(context.Background(), "", account()); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestTooSlow(t *testing.T) {
+	h := NewHub(WithBuffer(2))
+	slow, _ := h.Join(context.Background(), "game", account())
+	h.Publish(context.Background(), "game", &gamev1.Event{}, &gamev1.Event{})
+
+	for range 2 {
+		<-slow.Events()
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Fatal("expected session to be closed")
+	}
+	if !errors.Is(slow.Err(), ErrTooSlow) {
+		t.Fatalf("unexpected error: %v", slow.Err())
+	}
+	slow.Leave()
+}
+
+func TestExecute(t *testing.T) {
+	ctx := context.Background()
+	h := NewHub()
+	started, release := make(chan struct{}), make(chan struct{})
+	h.Handle("end_turn", func(ctx context.Context, s *Session, command *gamev1.Command) ([]*gamev1.Event, error) {
+		close(started)
+		<-release
+		return []*gamev1.Event{{}}, nil
+	})
+
+	alfa, _ := h.Join(ctx, "game", account())
+	next(t, alfa)
+
+	dispatched := make(chan struct{})
+	go func() {
+		h.Dispatch(ctx, alfa, endTurn("c1"))
+		close(dispatched)
+	}()
+	<-started
+
+	executed := make(chan error)
+	ran := false
+	go func() {
+		executed <- h.Execute(ctx, "game", func() ([]*gamev1.Event, error) {
+			ran = true
+			return []*gamev1.Event{{}}, nil
+		})
+	}()
+	select {
+	case <-executed:
+		t.Fatal("executed while a command of the game was running")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	<-dispatched
+	if err := <-executed; err != nil || !ran {
+		t.Fatalf("expected to execute, got %v", err)
+	}
+	if event := next(t, alfa); event.CommandId != "c1" || event.Sequence != 2 {
+		t.Fatalf("expected event of the command first, got %v", event)
+	}
+	if event := next(t, alfa); event.CommandId != "" || event.Sequence != 3 {
+		t.Fatalf("expected executed event next, got %v", event)
+	}
+
+	failed := errors.New("failed")
+	if err := h.Execute(ctx, "game", func() ([]*gamev1.Event, error) { return []*gamev1.Event{{}}, failed }); !errors.Is(err, failed) {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if len(alfa.Events()) != 0 {
+		t.Fatal("events of failed execution were published")
+	}
+
+	if err := h.Execute(ctx, "empty", func() ([]*gamev1.Event, error) { return nil, nil }); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := h.rooms["empty"]; ok {
+		t.Fatal("room of a game without sessions was kept")
+	}
+}
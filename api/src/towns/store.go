@@ -0,0 +1,77 @@
+package towns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/openhexes/openhexes/api/src/db"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// List returns towns of a game in order of placement.
+func List(ctx context.Context, q *db.Queries, gameID uuid.UUID) ([]*townsv1.Town, error) {
+	rows, err := q.ListTowns(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("listing towns: %w", err)
+	}
+	list := make([]*townsv1.Town, 0, len(rows))
+	for _, row := range rows {
+		town := &townsv1.Town{}
+		if err := proto.Unmarshal(row.Data, town); err != nil {
+			return nil, fmt.Errorf("decoding town %q: %w", row.ID, err)
+		}
+		list = append(list, town)
+	}
+	return list, nil
+}
+
+func Create(ctx context.Context, q *db.Queries, town *townsv1.Town) error {
+	raw, err := proto.Marshal(town)
+	if err != nil {
+		return fmt.Errorf("encoding town: %w", err)
+	}
+	err = q.CreateTown(ctx, db.CreateTownParams{
+		ID:     uuid.MustParse(town.Id),
+		GameID: uuid.MustParse(town.GameId),
+		Data:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("creating town: %w", err)
+	}
+	return nil
+}
+
+func Save(ctx context.Context, q *db.Queries, town *townsv1.Town) error {
+	raw, err := proto.Marshal(town)
+	if err != nil {
+		return fmt.Errorf("encoding town: %w", err)
+	}
+	if err := q.UpdateTown(ctx, db.UpdateTownParams{ID: uuid.MustParse(town.Id), Data: raw}); err != nil {
+		return fmt.Errorf("saving town %q: %w", town.Id, err)
+	}
+	return nil
+}
+
+// NewDay allows every town of a game to build again, dwellings grow at the start of a week.
+func NewDay(ctx context.Context, q *db.Queries, gameID uuid.UUID, kinds Kinds, newWeek bool) error {
+	list, err := List(ctx, q, gameID)
+	if err != nil {
+		return err
+	}
+	for _, town := range list {
+		StartDay(town)
+		if newWeek {
+			kind := kinds.Town(town.KindId)
+			if kind == nil {
+				return fmt.Errorf("town %q: unknown kind %q", town.Id, town.KindId)
+			}
+			StartWeek(town, kind)
+		}
+		if err := Save(ctx, q, town); err != nil {
+			return err
+		}
+	}
+	return nil
+}
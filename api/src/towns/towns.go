@@ -0,0 +1,180 @@
+// Package towns grows towns of the adventure map: construction of buildings & recruitment of creatures.
+//
+// Town kinds come from content packs: each lists its buildings, their prerequisites & costs,
+// and dwellings which let creatures be recruited, so factions are added without code changes.
+// Dwellings produce their weekly growth of creatures when built & at the start of every week.
+package towns
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrUnknownBuilding = errors.New("unknown building")
+	ErrBuilt           = errors.New("already built")
+	ErrBuiltToday      = errors.New("town has already built today")
+	ErrRequirements    = errors.New("requirements not met")
+	ErrNotAvailable    = errors.New("not enough creatures available")
+)
+
+// Kinds provides town kinds by id, e.g. content.Registry.
+type Kinds interface {
+	Town(id string) *townsv1.Town_Kind
+}
+
+// New returns a town of given kind with its initial buildings.
+func New(id, gameID, ownerID, name string, position *mapv1.Tile_Coordinate, kind *townsv1.Town_Kind) *townsv1.Town {
+	town := &townsv1.Town{
+		Id:       id,
+		GameId:   gameID,
+		OwnerId:  ownerID,
+		KindId:   kind.GetId(),
+		Name:     name,
+		Position: position,
+	}
+	for _, id := range kind.GetInitialBuildings() {
+		if b := Building(kind, id); b != nil && !slices.Contains(town.Buildings, id) {
+			add(town, b)
+		}
+	}
+	return town
+}
+
+// Building returns building of the town kind by id, nil if unknown.
+func Building(kind *townsv1.Town_Kind, id string) *townsv1.Town_Building {
+	for _, b := range kind.GetBuildings() {
+		if b.GetId() == id {
+			return b
+		}
+	}
+	return nil
+}
+
+// Missing returns prerequisites of the building the town lacks.
+func Missing(town *townsv1.Town, b *townsv1.Town_Building) []string {
+	var missing []string
+	for _, id := range b.GetRequires() {
+		if !slices.Contains(town.Buildings, id) {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// Build constructs a building in the town, returning it so its cost can be charged.
+// A single building may be built per day.
+func Build(town *townsv1.Town, kind *townsv1.Town_Kind, buildingID string) (*townsv1.Town_Building, error) {
+	b := Building(kind, buildingID)
+	switch {
+	case b == nil:
+		return nil, fmt.Errorf("%w %q", ErrUnknownBuilding, buildingID)
+	case slices.Contains(town.Buildings, buildingID):
+		return nil, fmt.Errorf("%q: %w", buildingID, ErrBuilt)
+	case town.BuiltToday:
+		return nil, ErrBuiltToday
+	}
+	if missing := Missing(town, b); len(missing) > 0 {
+		return nil, fmt.Errorf("%q: %w, missing %q", buildingID, ErrRequirements, missing)
+	}
+
+	add(town, b)
+	town.BuiltToday = true
+	return b, nil
+}
+
+func add(town *townsv1.Town, b *townsv1.Town_Building) {
+	town.Buildings = append(town.Buildings, b.GetId())
+	if d := b.GetDwelling(); d != nil {
+		available(town, d.GetCreatureId()).Count += d.GetWeeklyGrowth()
+	}
+}
+
+func available(town *townsv1.Town, creatureID string) *townsv1.Town_Available {
+	for _, a := range town.Available {
+		if a.CreatureId == creatureID {
+			return a
+		}
+	}
+	a := &townsv1.Town_Available{CreatureId: creatureID}
+	town.Available = append(town.Available, a)
+	return a
+}
+
+// Dwelling returns a built dwelling of the creature, nil if there's none.
+func Dwelling(town *townsv1.Town, kind *townsv1.Town_Kind, creatureID string) *townsv1.Town_Dwelling {
+	for _, id := range town.Buildings {
+		if d := Building(kind, id).GetDwelling(); d != nil && d.GetCreatureId() == creatureID {
+			return d
+		}
+	}
+	return nil
+}
+
+// Recruit takes creatures available in the town, returning their dwelling so the cost can be charged.
+// Recruited creatures are expected to join a garrison or a visiting hero, see heroes.Reinforce.
+func Recruit(town *townsv1.Town, kind *townsv1.Town_Kind, creatureID string, count uint32) (*townsv1.Town_Dwelling, error) {
+	d := Dwelling(town, kind, creatureID)
+	if d == nil {
+		return nil, fmt.Errorf("no dwelling of %q", creatureID)
+	}
+	if count == 0 {
+		return nil, errors.New("count must be positive")
+	}
+	a := available(town, creatureID)
+	if a.Count < count {
+		return nil, fmt.Errorf("%w: %d of %q", ErrNotAvailable, a.Count, creatureID)
+	}
+	a.Count -= count
+	return d, nil
+}
+
+// Spells returns ids of spells a hero recruited in the town knows:
+// those of the hero class followed by those taught by built buildings.
+func Spells(town *townsv1.Town, kind *townsv1.Town_Kind) []string {
+	var spells []string
+	learn := func(ids []string) {
+		for _, id := range ids {
+			if !slices.Contains(spells, id) {
+				spells = append(spells, id)
+			}
+		}
+	}
+	learn(kind.GetHeroClass().GetSpells())
+	for _, id := range town.Buildings {
+		learn(Building(kind, id).GetSpells())
+	}
+	return spells
+}
+
+// Public returns a copy of the town as seen by other players, without its garrison & available creatures.
+func Public(town *townsv1.Town) *townsv1.Town {
+	public := proto.Clone(town).(*townsv1.Town)
+	public.Garrison, public.Available = nil, nil
+	return public
+}
+
+// Visiting reports whether the hero stands in the town & may be joined by recruits.
+func Visiting(town *townsv1.Town, hero *heroesv1.Hero) bool {
+	return hero.GetOwnerId() == town.GetOwnerId() && proto.Equal(hero.GetPosition(), town.GetPosition())
+}
+
+// StartDay allows building again.
+func StartDay(town *townsv1.Town) {
+	town.BuiltToday = false
+}
+
+// StartWeek adds weekly growth of built dwellings to available creatures.
+func StartWeek(town *townsv1.Town, kind *townsv1.Town_Kind) {
+	for _, id := range town.Buildings {
+		if d := Building(kind, id).GetDwelling(); d != nil {
+			available(town, d.GetCreatureId()).Count += d.GetWeeklyGrowth()
+		}
+	}
+}
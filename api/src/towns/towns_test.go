@@ -0,0 +1,122 @@
+package towns
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+)
+
+var castle = &townsv1.Town_Kind{
+	Id:               "castle",
+	InitialBuildings: []string{"hall", "hovel"},
+	Buildings: []*townsv1.Town_Building{
+		{Id: "hall"},
+		{Id: "hovel", Requires: []string{"hall"}, Dwelling: &townsv1.Town_Dwelling{CreatureId: "peasant", WeeklyGrowth: 20}},
+		{Id: "tower", Requires: []string{"hovel", "barracks"}, Dwelling: &townsv1.Town_Dwelling{CreatureId: "griffin", WeeklyGrowth: 7}},
+		{Id: "barracks", Requires: []string{"hall"}},
+	},
+}
+
+func TestBuild(t *testing.T) {
+	town := New("t", "g", "a", "Town", &mapv1.Tile_Coordinate{}, castle)
+	if len(town.Buildings) != 2 || town.Available[0].Count != 20 {
+		t.Fatalf("expected initial buildings with their growth, got %v", town)
+	}
+
+	if _, err := Build(town, castle, "tower"); !errors.Is(err, ErrRequirements) {
+		t.Fatalf("expected missing barracks to prevent building, got %v", err)
+	}
+	if _, err := Build(town, castle, "barracks"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Build(town, castle, "tower"); !errors.Is(err, ErrBuiltToday) {
+		t.Fatalf("expected a single building per day, got %v", err)
+	}
+
+	StartDay(town)
+	if _, err := Build(town, castle, "barracks"); !errors.Is(err, ErrBuilt) {
+		t.Fatalf("expected building to be built once, got %v", err)
+	}
+	if _, err := Build(town, castle, "castle"); !errors.Is(err, ErrUnknownBuilding) {
+		t.Fatalf("expected unknown building to be rejected, got %v", err)
+	}
+	if b, err := Build(town, castle, "tower"); err != nil || b.GetDwelling().GetCreatureId() != "griffin" {
+		t.Fatalf("expected dwelling to be built, got %v, %v", b, err)
+	}
+	if a := available(town, "griffin"); a.Count != 7 {
+		t.Fatalf("expected first week of growth, got %d", a.Count)
+	}
+}
+
+func TestRecruit(t *testing.T) {
+	town := New("t", "g", "a", "Town", &mapv1.Tile_Coordinate{}, castle)
+
+	if _, err := Recruit(town, castle, "griffin", 1); err == nil {
+		t.Fatal("expected creatures without dwelling to be unavailable")
+	}
+	if _, err := Recruit(town, castle, "peasant", 21); !errors.Is(err, ErrNotAvailable) {
+		t.Fatalf("expected recruits to be limited by growth, got %v", err)
+	}
+	if _, err := Recruit(town, castle, "peasant", 15); err != nil {
+		t.Fatal(err)
+	}
+
+	StartWeek(town, castle)
+	if a := available(town, "peasant"); a.Count != 25 {
+		t.Fatalf("expected creatures left unrecruited to accumulate, got %d", a.Count)
+	}
+}
+
+func TestSpells(t *testing.T) {
+	kind := &townsv1.Town_Kind{
+		Id:               "tower",
+		InitialBuildings: []string{"hall"},
+		Buildings: []*townsv1.Town_Building{
+			{Id: "hall"},
+			{Id: "guild", Requires: []string{"hall"}, Spells: []string{"haste", "fireball"}},
+		},
+		HeroClass: &townsv1.Town_HeroClass{Spells: []string{"haste"}},
+	}
+	town := New("t", "g", "a", "Town", &mapv1.Tile_Coordinate{}, kind)
+	if got := Spells(town, kind); !slices.Equal(got, []string{"haste"}) {
+		t.Fatalf("expected spells of the hero class, got %v", got)
+	}
+	if _, err := Build(town, kind, "guild"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Spells(town, kind); !slices.Equal(got, []string{"haste", "fireball"}) {
+		t.Fatalf("expected spells of the mage guild to be added once, got %v", got)
+	}
+}
+
+func TestPublic(t *testing.T) {
+	town := New("t", "g", "a", "Town", &mapv1.Tile_Coordinate{}, castle)
+	town.Garrison = []*heroesv1.Hero_Stack{{CreatureId: "peasant", Count: 5}}
+	public := Public(town)
+	if public.Garrison != nil || public.Available != nil || len(public.Buildings) != 2 {
+		t.Fatalf("expected garrison & available creatures to be hidden, got %v", public)
+	}
+	if len(town.Garrison) != 1 || len(town.Available) != 1 {
+		t.Fatalf("expected the town to stay intact, got %v", town)
+	}
+}
+
+func TestVisiting(t *testing.T) {
+	town := New("t", "g", "a", "Town", &mapv1.Tile_Coordinate{Row: 1, Column: 2}, castle)
+	for _, tt := range []struct {
+		hero *heroesv1.Hero
+		want bool
+	}{
+		{hero: &heroesv1.Hero{OwnerId: "a", Position: &mapv1.Tile_Coordinate{Row: 1, Column: 2}}, want: true},
+		{hero: &heroesv1.Hero{OwnerId: "a", Position: &mapv1.Tile_Coordinate{Row: 1, Column: 2, Depth: 1}}},
+		{hero: &heroesv1.Hero{OwnerId: "b", Position: &mapv1.Tile_Coordinate{Row: 1, Column: 2}}},
+	} {
+		if got := Visiting(town, tt.hero); got != tt.want {
+			t.Errorf("hero %v: expected %t, got %t", tt.hero, tt.want, got)
+		}
+	}
+}
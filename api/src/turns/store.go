@@ -0,0 +1,84 @@
+package turns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/openhexes/openhexes/api/src/db"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Create persists state of a game that has just started.
+func Create(ctx context.Context, q *db.Queries, gameID uuid.UUID, state *gamev1.TurnState) error {
+	raw, err := proto.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding turn state: %w", err)
+	}
+	err = q.CreateGameState(ctx, db.CreateGameStateParams{GameID: gameID, Day: int32(state.Day), Turn: raw, Deadline: deadline(state)})
+	if err != nil {
+		return fmt.Errorf("creating game state: %w", err)
+	}
+	return nil
+}
+
+// Load reads state of a game. With forUpdate, the state is locked until the transaction ends,
+// so commands of a game are processed one at a time.
+func Load(ctx context.Context, q *db.Queries, gameID uuid.UUID, forUpdate bool) (*gamev1.TurnState, error) {
+	get := q.GetGameState
+	if forUpdate {
+		get = q.GetGameStateForUpdate
+	}
+	row, err := get(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("getting game state: %w", err)
+	}
+	state := &gamev1.TurnState{}
+	if err := proto.Unmarshal(row.Turn, state); err != nil {
+		return nil, fmt.Errorf("decoding turn state: %w", err)
+	}
+	return state, nil
+}
+
+// Save persists a turn transition.
+func Save(ctx context.Context, q *db.Queries, gameID uuid.UUID, state *gamev1.TurnState) error {
+	raw, err := proto.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encoding turn state: %w", err)
+	}
+	err = q.UpdateGameState(ctx, db.UpdateGameStateParams{GameID: gameID, Day: int32(state.Day), Turn: raw, Deadline: deadline(state)})
+	if err != nil {
+		return fmt.Errorf("saving game state: %w", err)
+	}
+	return nil
+}
+
+// ListExpired lists up to limit games whose current turn has run out of time.
+func ListExpired(ctx context.Context, q *db.Queries, limit int32) ([]uuid.UUID, error) {
+	ids, err := q.ListExpiredGameStates(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("listing expired game states: %w", err)
+	}
+	return ids, nil
+}
+
+// Postpone delays the next expiry check of a game, e.g. after expiring its turn failed.
+// Deadline of the turn itself stays the same.
+func Postpone(ctx context.Context, q *db.Queries, gameID uuid.UUID, until time.Time) error {
+	err := q.PostponeGameState(ctx, db.PostponeGameStateParams{GameID: gameID, Deadline: pgtype.Timestamptz{Time: until, Valid: true}})
+	if err != nil {
+		return fmt.Errorf("postponing game state: %w", err)
+	}
+	return nil
+}
+
+// deadline is stored alongside the state, so expired games are found without decoding every state.
+func deadline(state *gamev1.TurnState) pgtype.Timestamptz {
+	if state.Deadline == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: state.Deadline.AsTime(), Valid: true}
+}
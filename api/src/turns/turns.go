@@ -0,0 +1,199 @@
+// Package turns decides who may act in a game and advances the in-game calendar.
+//
+// In sequential mode players act one after another in slot order, a new day starts
+// once the last player ends their turn. In simultaneous mode everyone acts at once and
+// a new day starts when all players are done.
+//
+// With limited turn duration, a turn that is not ended in time is ended by [Expire]:
+// in sequential mode for the acting player, in simultaneous mode for everyone still acting.
+package turns
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DaysPerWeek   = 7
+	WeeksPerMonth = 4
+)
+
+// DateOf converts number of days since start of the game (starting from 1) to calendar date.
+func DateOf(day uint32) *gamev1.Date {
+	if day == 0 {
+		day = 1
+	}
+	d := day - 1
+	return &gamev1.Date{
+		Month: d/(DaysPerWeek*WeeksPerMonth) + 1,
+		Week:  d/DaysPerWeek%WeeksPerMonth + 1,
+		Day:   d%DaysPerWeek + 1,
+	}
+}
+
+// Player takes part in a game.
+type Player struct {
+	AccountID string
+	Slot      uint32
+}
+
+// New returns state of the first day, players act in given order.
+func New(mode gamev1.TurnMode, players ...Player) *gamev1.TurnState {
+	if mode == gamev1.TurnMode_TURN_MODE_UNSPECIFIED {
+		mode = gamev1.TurnMode_TURN_MODE_SEQUENTIAL
+	}
+	state := &gamev1.TurnState{
+		Mode: mode,
+		Day:  1,
+		Date: DateOf(1),
+	}
+	for _, p := range players {
+		state.Players = append(state.Players, &gamev1.TurnState_Player{AccountId: p.AccountID, Slot: p.Slot})
+	}
+	return state
+}
+
+// Limit caps every turn to given duration, starting with the current one at now.
+// Non-positive duration makes turns unlimited.
+func Limit(state *gamev1.TurnState, duration time.Duration, now time.Time) {
+	state.TurnDuration = nil
+	if duration > 0 {
+		state.TurnDuration = durationpb.New(duration)
+	}
+	restart(state, now)
+}
+
+func restart(state *gamev1.TurnState, now time.Time) {
+	state.Deadline = nil
+	if state.TurnDuration != nil {
+		state.Deadline = timestamppb.New(now.Add(state.TurnDuration.AsDuration()))
+	}
+}
+
+func player(state *gamev1.TurnState, accountID string) (int, *gamev1.TurnState_Player) {
+	for i, p := range state.GetPlayers() {
+		if p.AccountId == accountID {
+			return i, p
+		}
+	}
+	return -1, nil
+}
+
+// IsPlayer reports whether account takes part in the game.
+func IsPlayer(state *gamev1.TurnState, accountID string) bool {
+	_, p := player(state, accountID)
+	return p != nil
+}
+
+// CanAct checks whether player may issue commands right now.
+func CanAct(state *gamev1.TurnState, accountID string) error {
+	i, p := player(state, accountID)
+	if p == nil {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("not a player of the game"))
+	}
+	if state.Mode == gamev1.TurnMode_TURN_MODE_SIMULTANEOUS {
+		if p.Done {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.New("turn is already ended, waiting for other players"))
+		}
+		return nil
+	}
+	if uint32(i) != state.Current {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("not your turn"))
+	}
+	return nil
+}
+
+// EndTurn finishes player's turn at now, advancing state in place, and returns resulting events.
+func EndTurn(state *gamev1.TurnState, accountID string, now time.Time) ([]*gamev1.Event, error) {
+	if err := CanAct(state, accountID); err != nil {
+		return nil, err
+	}
+	if len(state.Players) == 0 {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("game has no players"))
+	}
+	_, p := player(state, accountID)
+
+	switch state.Mode {
+	case gamev1.TurnMode_TURN_MODE_SIMULTANEOUS:
+		p.Done = true
+		for _, other := range state.Players {
+			if !other.Done {
+				return []*gamev1.Event{{
+					Kind: &gamev1.Event_PlayerDone_{PlayerDone: &gamev1.Event_PlayerDone{AccountId: accountID}},
+				}}, nil
+			}
+		}
+		return []*gamev1.Event{nextDay(state, now, false)}, nil
+
+	default:
+		p.Done = true
+		return []*gamev1.Event{next(state, now, false)}, nil
+	}
+}
+
+// Expired reports whether current turn has run out of time at now.
+func Expired(state *gamev1.TurnState, now time.Time) bool {
+	return state.Deadline != nil && !now.Before(state.Deadline.AsTime())
+}
+
+// Expire ends turns that have run out of time at now, advancing state in place,
+// and returns resulting events. Nothing happens if the deadline is not reached yet.
+func Expire(state *gamev1.TurnState, now time.Time) []*gamev1.Event {
+	if !Expired(state, now) || len(state.Players) == 0 {
+		return nil
+	}
+	switch state.Mode {
+	case gamev1.TurnMode_TURN_MODE_SIMULTANEOUS:
+		for _, p := range state.Players {
+			p.Done = true
+		}
+		return []*gamev1.Event{nextDay(state, now, true)}
+
+	default:
+		if state.Current < uint32(len(state.Players)) {
+			state.Players[state.Current].Done = true
+		}
+		return []*gamev1.Event{next(state, now, true)}
+	}
+}
+
+// next passes the turn to the following player in sequential mode.
+func next(state *gamev1.TurnState, now time.Time, timedOut bool) *gamev1.Event {
+	state.Current++
+	if state.Current < uint32(len(state.Players)) {
+		restart(state, now)
+		return turnStarted(state, false, false, false, timedOut)
+	}
+	return nextDay(state, now, timedOut)
+}
+
+func nextDay(state *gamev1.TurnState, now time.Time, timedOut bool) *gamev1.Event {
+	previous := state.Date
+	state.Day++
+	state.Date = DateOf(state.Day)
+	state.Current = 0
+	for _, p := range state.Players {
+		p.Done = false
+	}
+	restart(state, now)
+	return turnStarted(state, true, state.Date.Week != previous.GetWeek(), state.Date.Month != previous.GetMonth(), timedOut)
+}
+
+func turnStarted(state *gamev1.TurnState, day, week, month, timedOut bool) *gamev1.Event {
+	return &gamev1.Event{
+		Kind: &gamev1.Event_TurnStarted_{TurnStarted: &gamev1.Event_TurnStarted{
+			State:    proto.Clone(state).(*gamev1.TurnState),
+			NewDay:   day,
+			NewWeek:  week,
+			NewMonth: month,
+			TimedOut: timedOut,
+		}},
+	}
+}
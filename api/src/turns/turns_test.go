@@ -0,0 +1,152 @@
+package turns
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDateOf(t *testing.T) {
+	for day, expected := range map[uint32]*gamev1.Date{
+		1:  {Month: 1, Week: 1, Day: 1},
+		7:  {Month: 1, Week: 1, Day: 7},
+		8:  {Month: 1, Week: 2, Day: 1},
+		28: {Month: 1, Week: 4, Day: 7},
+		29: {Month: 2, Week: 1, Day: 1},
+		57: {Month: 3, Week: 1, Day: 1},
+	} {
+		if date := DateOf(day); !proto.Equal(date, expected) {
+			t.Errorf("day %d: expected %v, got %v", day, expected, date)
+		}
+	}
+}
+
+func TestSequential(t *testing.T) {
+	state := New(gamev1.TurnMode_TURN_MODE_UNSPECIFIED, Player{AccountID: "a"}, Player{AccountID: "b", Slot: 1})
+	if state.Mode != gamev1.TurnMode_TURN_MODE_SEQUENTIAL {
+		t.Fatalf("expected sequential mode by default, got %v", state.Mode)
+	}
+
+	if err := CanAct(state, "b"); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected out-of-turn rejection, got %v", err)
+	}
+	if err := CanAct(state, "c"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected stranger rejection, got %v", err)
+	}
+	if _, err := EndTurn(state, "b", time.Time{}); err == nil {
+		t.Fatal("expected out-of-turn end to be rejected")
+	}
+
+	events, err := EndTurn(state, "a", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := events[0].GetTurnStarted()
+	if started.NewDay || started.State.Current != 1 || state.Day != 1 {
+		t.Fatalf("expected second player to act on the same day, got %v", events)
+	}
+	if err := CanAct(state, "a"); err == nil {
+		t.Fatal("expected first player to wait")
+	}
+
+	events, err = EndTurn(state, "b", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	started = events[0].GetTurnStarted()
+	if !started.NewDay || started.NewWeek || state.Day != 2 || state.Current != 0 {
+		t.Fatalf("expected new day, got %v", events)
+	}
+	if err := CanAct(state, "a"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSimultaneous(t *testing.T) {
+	state := New(gamev1.TurnMode_TURN_MODE_SIMULTANEOUS, Player{AccountID: "a"}, Player{AccountID: "b", Slot: 1})
+	state.Day = DaysPerWeek
+	state.Date = DateOf(state.Day)
+
+	if err := CanAct(state, "b"); err != nil {
+		t.Fatal(err)
+	}
+	events, err := EndTurn(state, "b", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events[0].GetPlayerDone().GetAccountId() != "b" {
+		t.Fatalf("expected player to wait for others, got %v", events)
+	}
+	if _, err := EndTurn(state, "b", time.Time{}); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected repeated end to be rejected, got %v", err)
+	}
+
+	events, err = EndTurn(state, "a", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	started := events[0].GetTurnStarted()
+	if !started.NewDay || !started.NewWeek || started.NewMonth {
+		t.Fatalf("expected new week, got %v", events)
+	}
+	for _, p := range state.Players {
+		if p.Done {
+			t.Fatalf("expected player %q to act again", p.AccountId)
+		}
+	}
+}
+
+func TestExpire(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	state := New(gamev1.TurnMode_TURN_MODE_SEQUENTIAL, Player{AccountID: "a"}, Player{AccountID: "b", Slot: 1})
+	if events := Expire(state, start.Add(time.Hour)); events != nil {
+		t.Fatalf("expected unlimited turn not to expire, got %v", events)
+	}
+
+	Limit(state, time.Minute, start)
+	if events := Expire(state, start.Add(time.Minute-time.Second)); events != nil {
+		t.Fatalf("expected turn not to expire before deadline, got %v", events)
+	}
+	events := Expire(state, start.Add(time.Minute))
+	started := events[0].GetTurnStarted()
+	if !started.TimedOut || started.NewDay || state.Current != 1 {
+		t.Fatalf("expected second player to act after timeout, got %v", events)
+	}
+	if deadline := state.Deadline.AsTime(); !deadline.Equal(start.Add(2 * time.Minute)) {
+		t.Fatalf("expected deadline to restart with the turn, got %v", deadline)
+	}
+
+	events, err := EndTurn(state, "b", start.Add(90*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !events[0].GetTurnStarted().NewDay || events[0].GetTurnStarted().TimedOut {
+		t.Fatalf("expected new day, got %v", events)
+	}
+	if deadline := state.Deadline.AsTime(); !deadline.Equal(start.Add(150 * time.Second)) {
+		t.Fatalf("expected deadline to restart with the day, got %v", deadline)
+	}
+
+	state = New(gamev1.TurnMode_TURN_MODE_SIMULTANEOUS, Player{AccountID: "a"}, Player{AccountID: "b", Slot: 1})
+	Limit(state, time.Minute, start)
+	if _, err := EndTurn(state, "a", start); err != nil {
+		t.Fatal(err)
+	}
+	if !state.Deadline.AsTime().Equal(start.Add(time.Minute)) {
+		t.Fatalf("expected deadline to be shared by the day, got %v", state.Deadline.AsTime())
+	}
+	events = Expire(state, start.Add(time.Hour))
+	started = events[0].GetTurnStarted()
+	if !started.TimedOut || !started.NewDay || state.Day != 2 {
+		t.Fatalf("expected new day after timeout, got %v", events)
+	}
+	for _, p := range state.Players {
+		if p.Done {
+			t.Fatalf("expected player %q to act again", p.AccountId)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package visibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/openhexes/openhexes/api/src/db"
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/mapstore"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Load returns tiles explored by a player, nothing is explored until the first Save.
+func Load(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, layout grid.Layout) (*Explored, error) {
+	raw, err := q.GetGameVisibility(ctx, db.GetGameVisibilityParams{GameID: gameID, AccountID: accountID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return NewExplored(layout), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting visibility: %w", err)
+	}
+	v := &gamev1.Visibility{}
+	if err := proto.Unmarshal(raw, v); err != nil {
+		return nil, fmt.Errorf("decoding visibility: %w", err)
+	}
+	return FromProto(layout, v), nil
+}
+
+func Save(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, explored *Explored) error {
+	raw, err := proto.Marshal(explored.Proto())
+	if err != nil {
+		return fmt.Errorf("encoding visibility: %w", err)
+	}
+	err = q.UpsertGameVisibility(ctx, db.UpsertGameVisibilityParams{GameID: gameID, AccountID: accountID, Explored: raw})
+	if err != nil {
+		return fmt.Errorf("saving visibility: %w", err)
+	}
+	return nil
+}
+
+// Reveal marks tiles seen from given sources as explored by the player.
+func Reveal(ctx context.Context, q *db.Queries, gameID, accountID uuid.UUID, m *mapstore.Map, sources ...Source) error {
+	explored, err := Load(ctx, q, gameID, accountID, m.Layout())
+	if err != nil {
+		return err
+	}
+	changed := false
+	for h := range Visible(m, sources...) {
+		changed = explored.Add(h) || changed
+	}
+	if err := m.Err(); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	return Save(ctx, q, gameID, accountID, explored)
+}
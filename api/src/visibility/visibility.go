@@ -0,0 +1,248 @@
+// Package visibility computes which tiles players see, hiding the rest of the map behind fog of war.
+//
+// Tiles are visible within scouting radius of player's heroes & towns, as long as the line of sight
+// isn't obstructed: looking across a tile spends its terrain sight cost out of the radius.
+// Once seen, tiles stay explored: their terrain is known, but not what happens on them.
+package visibility
+
+import (
+	"hash/fnv"
+	"maps"
+	"slices"
+
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+	townsv1 "github.com/openhexes/proto/towns/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HeroRadius is scouting radius of heroes.
+	HeroRadius = 5
+	// TownRadius is scouting radius of towns.
+	TownRadius = 6
+)
+
+// Source reveals tiles around it, e.g. a hero.
+type Source struct {
+	Position hex.Axial
+	Radius   int
+}
+
+// Heroes returns sources of heroes owned by the player.
+func Heroes(heroes []*heroesv1.Hero, ownerID string) []Source {
+	var sources []Source
+	for _, h := range heroes {
+		if h.OwnerId == ownerID {
+			sources = append(sources, Source{Position: hex.FromCoordinate(h.Position), Radius: HeroRadius})
+		}
+	}
+	return sources
+}
+
+// Towns returns sources of towns owned by the player.
+func Towns(towns []*townsv1.Town, ownerID string) []Source {
+	var sources []Source
+	for _, t := range towns {
+		if t.OwnerId == ownerID {
+			sources = append(sources, Source{Position: hex.FromCoordinate(t.Position), Radius: TownRadius})
+		}
+	}
+	return sources
+}
+
+// Map provides tiles & their terrains, e.g. mapstore.Map.
+type Map interface {
+	Tile(h hex.Axial) *mapv1.Tile
+	Terrain(id string) *mapv1.Terrain
+}
+
+// Visible returns tiles currently seen from given sources.
+func Visible(m Map, sources ...Source) map[hex.Axial]bool {
+	visible := map[hex.Axial]bool{}
+	for _, s := range sources {
+		if m.Tile(s.Position) == nil {
+			continue
+		}
+		visible[s.Position] = true
+		for _, h := range hex.Range(s.Position, s.Radius) {
+			if visible[h] || m.Tile(h) == nil {
+				continue
+			}
+			if cost(m, hex.Line(s.Position, h)) <= s.Radius {
+				visible[h] = true
+			}
+		}
+	}
+	return visible
+}
+
+// cost returns scouting range spent to see the last tile of a line starting at the source:
+// sight costs of tiles in between plus one for the tile itself.
+func cost(m Map, line []hex.Axial) int {
+	total := 1
+	for _, h := range line[1 : len(line)-1] {
+		t := m.Tile(h)
+		if t == nil {
+			return hex.Unreachable
+		}
+		total += max(int(m.Terrain(t.GetTerrainId()).GetSightCost()), 1)
+	}
+	return total
+}
+
+// Explored remembers tiles seen by a player so far.
+type Explored struct {
+	layout grid.Layout
+	levels map[uint32][]byte
+}
+
+func NewExplored(layout grid.Layout) *Explored {
+	return &Explored{layout: layout, levels: map[uint32][]byte{}}
+}
+
+// FromProto restores tiles explored by a player, levels of unexpected size are dropped.
+func FromProto(layout grid.Layout, v *gamev1.Visibility) *Explored {
+	e := NewExplored(layout)
+	for _, level := range v.GetLevels() {
+		if len(level.Explored) == e.size() {
+			e.levels[level.Depth] = level.Explored
+		}
+	}
+	return e
+}
+
+func (e *Explored) Proto() *gamev1.Visibility {
+	v := &gamev1.Visibility{}
+	for _, depth := range slices.Sorted(maps.Keys(e.levels)) {
+		v.Levels = append(v.Levels, &gamev1.Visibility_Level{Depth: depth, Explored: e.levels[depth]})
+	}
+	return v
+}
+
+func (e *Explored) size() int {
+	return int((e.layout.TotalRows*e.layout.TotalColumns + 7) / 8)
+}
+
+func (e *Explored) index(h hex.Axial) (int, bool) {
+	c, ok := h.Coordinate()
+	if !ok || !e.layout.Contains(c) {
+		return 0, false
+	}
+	return int(c.Row*e.layout.TotalColumns + c.Column), true
+}
+
+func (e *Explored) Has(h hex.Axial) bool {
+	i, ok := e.index(h)
+	bits := e.levels[h.Depth]
+	return ok && bits != nil && bits[i/8]&(1<<(i%8)) != 0
+}
+
+// Add marks tile as explored, reporting whether it wasn't before.
+func (e *Explored) Add(h hex.Axial) bool {
+	i, ok := e.index(h)
+	if !ok || e.Has(h) {
+		return false
+	}
+	bits, ok := e.levels[h.Depth]
+	if !ok {
+		bits = make([]byte, e.size())
+		e.levels[h.Depth] = bits
+	}
+	bits[i/8] |= 1 << (i % 8)
+	return true
+}
+
+// Mask returns a copy of the segment as seen by a player: hidden tiles only keep their coordinate,
+// so clients can still address tiles by their index. Connectors are kept once their end within
+// the segment is explored.
+func Mask(segment *mapv1.Segment, explored *Explored, visible map[hex.Axial]bool) *mapv1.Segment {
+	masked := &mapv1.Segment{Bounds: segment.Bounds, Tiles: make([]*mapv1.Tile, 0, len(segment.Tiles))}
+	for _, t := range segment.Tiles {
+		h := hex.FromCoordinate(t.Coordinate)
+		switch {
+		case visible[h]:
+			t = proto.Clone(t).(*mapv1.Tile)
+			t.Visibility = mapv1.Tile_VISIBILITY_VISIBLE
+		case explored.Has(h):
+			t = proto.Clone(t).(*mapv1.Tile)
+			t.Visibility = mapv1.Tile_VISIBILITY_EXPLORED
+		default:
+			t = &mapv1.Tile{Coordinate: t.Coordinate, Visibility: mapv1.Tile_VISIBILITY_HIDDEN}
+		}
+		masked.Tiles = append(masked.Tiles, t)
+	}
+	for _, c := range segment.Connectors {
+		for _, end := range []*mapv1.Tile_Coordinate{c.From, c.To} {
+			h := hex.FromCoordinate(end)
+			if _, ok := grid.Index(segment, end); ok && (visible[h] || explored.Has(h)) {
+				masked.Connectors = append(masked.Connectors, c)
+				break
+			}
+		}
+	}
+	return masked
+}
+
+// Fingerprint summarizes visibility of tiles in a masked segment, it changes once any tile is revealed.
+func Fingerprint(segment *mapv1.Segment) uint64 {
+	h := fnv.New64a()
+	for _, t := range segment.Tiles {
+		h.Write([]byte{byte(t.Visibility)})
+	}
+	return h.Sum64()
+}
+
+// Redact returns an event about a hero as seen by the account, nil if the account doesn't see it at all.
+// Owners see their heroes as is, other players only see what happens on visible tiles:
+// a hero leaving sight is reported without its whereabouts & army.
+func Redact(event *gamev1.Event, accountID string, visible map[hex.Axial]bool) *gamev1.Event {
+	seen := func(hero *heroesv1.Hero) bool {
+		return hero.GetOwnerId() == accountID || visible[hex.FromCoordinate(hero.GetPosition())]
+	}
+	switch kind := event.Kind.(type) {
+	case *gamev1.Event_HeroRecruited_:
+		if seen(kind.HeroRecruited.GetHero()) {
+			return event
+		}
+	case *gamev1.Event_SpellCast_:
+		if seen(kind.SpellCast.GetHero()) {
+			return event
+		}
+	case *gamev1.Event_HeroMoved_:
+		moved := kind.HeroMoved
+		if moved.GetHero().GetOwnerId() == accountID {
+			return event
+		}
+		redacted := &gamev1.Event_HeroMoved{Defeated: moved.Defeated}
+		for _, c := range moved.Path {
+			if visible[hex.FromCoordinate(c)] {
+				redacted.Path = append(redacted.Path, c)
+			}
+		}
+		if len(redacted.Path) == 0 {
+			return nil
+		}
+		for _, s := range moved.Visited {
+			if visible[hex.FromCoordinate(s.GetPosition())] {
+				redacted.Visited = append(redacted.Visited, s)
+			}
+		}
+		if seen(moved.GetHero()) {
+			redacted.Hero, redacted.ObjectId = moved.Hero, moved.ObjectId
+		} else {
+			h := moved.GetHero()
+			redacted.Hero = &heroesv1.Hero{Id: h.GetId(), GameId: h.GetGameId(), OwnerId: h.GetOwnerId(), Name: h.GetName()}
+		}
+		return &gamev1.Event{
+			CommandId: event.CommandId,
+			Kind:      &gamev1.Event_HeroMoved_{HeroMoved: redacted},
+		}
+	default:
+		return event
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package visibility
+
+import (
+	"testing"
+
+	"github.com/openhexes/openhexes/api/src/grid"
+	"github.com/openhexes/openhexes/api/src/hex"
+	"github.com/openhexes/openhexes/api/src/pathfinding"
+	gamev1 "github.com/openhexes/proto/game/v1"
+	heroesv1 "github.com/openhexes/proto/heroes/v1"
+	mapv1 "github.com/openhexes/proto/map/v1"
+)
+
+var terrains = []*mapv1.Terrain{
+	{Id: "g"},
+	{Id: "f", SightCost: 3},
+}
+
+// row builds a single row map out of terrain ids, one character per tile.
+func row(ids string) *pathfinding.Grid {
+	var tiles []*mapv1.Tile
+	for column, id := range ids {
+		tiles = append(tiles, &mapv1.Tile{
+			Coordinate: &mapv1.Tile_Coordinate{Column: uint32(column)},
+			TerrainId:  string(id),
+		})
+	}
+	return pathfinding.NewGrid(terrains, tiles...)
+}
+
+func TestVisible(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiles   string
+		radius  int
+		visible string // one character per tile, 'x' for visible
+	}{
+		{name: "open field", tiles: "gggggggg", radius: 3, visible: "xxxx...."},
+		{name: "obstructed", tiles: "ggfggggg", radius: 4, visible: "xxx....."},
+		{name: "obstruction itself is seen", tiles: "gfgggggg", radius: 2, visible: "xx......"},
+		{name: "no radius", tiles: "gggg", radius: 0, visible: "x..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible := Visible(row(tt.tiles), Source{Position: hex.FromOffset(0, 0, 0), Radius: tt.radius})
+			got := make([]byte, len(tt.tiles))
+			for column := range got {
+				got[column] = '.'
+				if visible[hex.FromOffset(0, column, 0)] {
+					got[column] = 'x'
+				}
+			}
+			if string(got) != tt.visible {
+				t.Fatalf("expected %q, got %q", tt.visible, got)
+			}
+		})
+	}
+}
+
+func TestExplored(t *testing.T) {
+	layout := grid.Layout{TotalRows: 3, TotalColumns: 5, RowsPerSegment: 3, ColumnsPerSegment: 5, Depths: 2}
+	explored := NewExplored(layout)
+
+	h := hex.FromOffset(2, 4, 0)
+	if explored.Has(h) || !explored.Add(h) || explored.Add(h) || !explored.Has(h) {
+		t.Fatal("expected tile to be explored exactly once")
+	}
+	if explored.Add(hex.FromOffset(3, 0, 0)) || explored.Add(hex.FromOffset(0, 0, 2)) {
+		t.Fatal("expected tile outside of the map to be ignored")
+	}
+	if explored.Add(hex.FromOffset(0, 0, 1)); explored.Has(hex.FromOffset(0, 0, 0)) {
+		t.Fatal("expected levels to be explored independently")
+	}
+
+	restored := FromProto(layout, explored.Proto())
+	for _, h := range []hex.Axial{hex.FromOffset(2, 4, 0), hex.FromOffset(0, 0, 1)} {
+		if !restored.Has(h) {
+			t.Fatalf("expected %v to stay explored", h)
+		}
+	}
+	if restored := FromProto(grid.Layout{TotalRows: 30, TotalColumns: 50}, explored.Proto()); restored.Has(h) {
+		t.Fatal("expected visibility of a different map to be dropped")
+	}
+}
+
+func TestMask(t *testing.T) {
+	segment := &mapv1.Segment{
+		Bounds: &mapv1.Segment_Bounds{MaxRow: 1, MaxColumn: 3},
+		Tiles: []*mapv1.Tile{
+			{Coordinate: &mapv1.Tile_Coordinate{Column: 0}, TerrainId: "g"},
+			{Coordinate: &mapv1.Tile_Coordinate{Column: 1}, TerrainId: "f"},
+			{Coordinate: &mapv1.Tile_Coordinate{Column: 2}, TerrainId: "g"},
+		},
+	}
+	explored := NewExplored(grid.Layout{TotalRows: 1, TotalColumns: 3, RowsPerSegment: 1, ColumnsPerSegment: 3})
+	explored.Add(hex.FromOffset(0, 0, 0))
+	explored.Add(hex.FromOffset(0, 1, 0))
+
+	masked := Mask(segment, explored, map[hex.Axial]bool{hex.FromOffset(0, 0, 0): true})
+	for i, expected := range []mapv1.Tile_Visibility{
+		mapv1.Tile_VISIBILITY_VISIBLE,
+		mapv1.Tile_VISIBILITY_EXPLORED,
+		mapv1.Tile_VISIBILITY_HIDDEN,
+	} {
+		if masked.Tiles[i].Visibility != expected {
+			t.Errorf("tile #%d: expected %v, got %v", i, expected, masked.Tiles[i].Visibility)
+		}
+	}
+	if masked.Tiles[2].TerrainId != "" || masked.Tiles[1].TerrainId != "f" {
+		t.Fatalf("expected only hidden terrain to be masked, got %v", masked.Tiles)
+	}
+	if segment.Tiles[0].Visibility != mapv1.Tile_VISIBILITY_UNSPECIFIED {
+		t.Fatal("expected original segment to stay intact")
+	}
+
+	segment.Connectors = []*mapv1.Connector{
+		{From: &mapv1.Tile_Coordinate{Column: 1}, To: &mapv1.Tile_Coordinate{Column: 1, Depth: 1}},
+		{From: &mapv1.Tile_Coordinate{Column: 2, Depth: 1}, To: &mapv1.Tile_Coordinate{Column: 2}},
+	}
+	if masked := Mask(segment, explored, nil); len(masked.Connectors) != 1 || masked.Connectors[0] != segment.Connectors[0] {
+		t.Fatalf("expected only connector on an explored tile to be kept, got %v", masked.Connectors)
+	}
+	segment.Connectors = nil
+
+	before := Fingerprint(masked)
+	explored.Add(hex.FromOffset(0, 2, 0))
+	if Fingerprint(Mask(segment, explored, nil)) == before {
+		t.Fatal("expected fingerprint to change once a tile is revealed")
+	}
+}
+
+func TestRedact(t *testing.T) {
+	at := func(column uint32) *mapv1.Tile_Coordinate { return &mapv1.Tile_Coordinate{Column: column} }
+	visible := map[hex.Axial]bool{hex.FromOffset(0, 0, 0): true, hex.FromOffset(0, 1, 0): true}
+	move := func(path ...uint32) *gamev1.Event {
+		moved := &gamev1.Event_HeroMoved{
+			Hero:     &heroesv1.Hero{Id: "h", OwnerId: "a", Position: at(path[len(path)-1]), Army: []*heroesv1.Hero_Stack{{Count: 1}}},
+			ObjectId: "o",
+		}
+		for _, column := range path {
+			moved.Path = append(moved.Path, at(column))
+		}
+		return &gamev1.Event{Kind: &gamev1.Event_HeroMoved_{HeroMoved: moved}}
+	}
+
+	if e := move(3, 4); Redact(e, "a", visible) != e {
+		t.Fatal("expected owner to see own move as is")
+	}
+	if e := Redact(move(3, 4), "b", visible); e != nil {
+		t.Fatalf("expected hidden move to be withheld, got %v", e)
+	}
+	moved := Redact(move(0, 1), "b", visible).GetHeroMoved()
+	if len(moved.Path) != 2 || moved.Hero.Army == nil || moved.ObjectId != "o" {
+		t.Fatalf("expected visible move as is, got %v", moved)
+	}
+	moved = Redact(move(1, 2, 3), "b", visible).GetHeroMoved()
+	if len(moved.Path) != 1 || moved.Hero.Position != nil || moved.Hero.Army != nil || moved.ObjectId != "" {
+		t.Fatalf("expected hero leaving sight to be redacted, got %v", moved)
+	}
+
+	recruited := &gamev1.Event{Kind: &gamev1.Event_HeroRecruited_{HeroRecruited: &gamev1.Event_HeroRecruited{
+		Hero: &heroesv1.Hero{OwnerId: "a", Position: at(5)},
+	}}}
+	if Redact(recruited, "b", visible) != nil || Redact(recruited, "a", visible) != recruited {
+		t.Fatal("expected hidden recruitment to be seen by its owner only")
+	}
+}
@@ -172,19 +172,129 @@ func (x *Creature_AttributeModification) GetNegateNegativeEffects() bool {
 	return false
 }
 
+// Stats are base values of a single creature, before terrain effects & hero bonuses.
+type Creature_Stats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attack        int32                  `protobuf:"varint,1,opt,name=attack,proto3" json:"attack,omitempty"`
+	Defence       int32                  `protobuf:"varint,2,opt,name=defence,proto3" json:"defence,omitempty"`
+	MinDamage     uint32                 `protobuf:"varint,3,opt,name=min_damage,json=minDamage,proto3" json:"min_damage,omitempty"`
+	MaxDamage     uint32                 `protobuf:"varint,4,opt,name=max_damage,json=maxDamage,proto3" json:"max_damage,omitempty"`
+	Health        uint32                 `protobuf:"varint,5,opt,name=health,proto3" json:"health,omitempty"`
+	Speed         int32                  `protobuf:"varint,6,opt,name=speed,proto3" json:"speed,omitempty"` // hexes per turn on the battlefield
+	Shots         uint32                 `protobuf:"varint,7,opt,name=shots,proto3" json:"shots,omitempty"` // ranged attacks per battle, zero for melee-only creatures
+	Morale        int32                  `protobuf:"varint,8,opt,name=morale,proto3" json:"morale,omitempty"`
+	Luck          int32                  `protobuf:"varint,9,opt,name=luck,proto3" json:"luck,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Creature_Stats) Reset() {
+	*x = Creature_Stats{}
+	mi := &file_creatures_v1_creature_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Creature_Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Creature_Stats) ProtoMessage() {}
+
+func (x *Creature_Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_creatures_v1_creature_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Creature_Stats.ProtoReflect.Descriptor instead.
+func (*Creature_Stats) Descriptor() ([]byte, []int) {
+	return file_creatures_v1_creature_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Creature_Stats) GetAttack() int32 {
+	if x != nil {
+		return x.Attack
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetDefence() int32 {
+	if x != nil {
+		return x.Defence
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetMinDamage() uint32 {
+	if x != nil {
+		return x.MinDamage
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetMaxDamage() uint32 {
+	if x != nil {
+		return x.MaxDamage
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetHealth() uint32 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetShots() uint32 {
+	if x != nil {
+		return x.Shots
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetMorale() int32 {
+	if x != nil {
+		return x.Morale
+	}
+	return 0
+}
+
+func (x *Creature_Stats) GetLuck() int32 {
+	if x != nil {
+		return x.Luck
+	}
+	return 0
+}
+
 type Creature_Kind struct {
 	state          protoimpl.MessageState  `protogen:"open.v1"`
 	Id             string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Tags           []string                `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 	MovementTypes  []Creature_MovementType `protobuf:"varint,3,rep,packed,name=movement_types,json=movementTypes,proto3,enum=creatures.v1.Creature_MovementType" json:"movement_types,omitempty"`
 	NativeTerrains []string                `protobuf:"bytes,4,rep,name=native_terrains,json=nativeTerrains,proto3" json:"native_terrains,omitempty"`
+	Stats          *Creature_Stats         `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Creature_Kind) Reset() {
 	*x = Creature_Kind{}
-	mi := &file_creatures_v1_creature_proto_msgTypes[2]
+	mi := &file_creatures_v1_creature_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -196,7 +306,7 @@ func (x *Creature_Kind) String() string {
 func (*Creature_Kind) ProtoMessage() {}
 
 func (x *Creature_Kind) ProtoReflect() protoreflect.Message {
-	mi := &file_creatures_v1_creature_proto_msgTypes[2]
+	mi := &file_creatures_v1_creature_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -209,7 +319,7 @@ func (x *Creature_Kind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Creature_Kind.ProtoReflect.Descriptor instead.
 func (*Creature_Kind) Descriptor() ([]byte, []int) {
-	return file_creatures_v1_creature_proto_rawDescGZIP(), []int{0, 1}
+	return file_creatures_v1_creature_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *Creature_Kind) GetId() string {
@@ -240,6 +350,13 @@ func (x *Creature_Kind) GetNativeTerrains() []string {
 	return nil
 }
 
+func (x *Creature_Kind) GetStats() *Creature_Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 type Creature_Kind_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
@@ -253,7 +370,7 @@ type Creature_Kind_Filter struct {
 
 func (x *Creature_Kind_Filter) Reset() {
 	*x = Creature_Kind_Filter{}
-	mi := &file_creatures_v1_creature_proto_msgTypes[3]
+	mi := &file_creatures_v1_creature_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +382,7 @@ func (x *Creature_Kind_Filter) String() string {
 func (*Creature_Kind_Filter) ProtoMessage() {}
 
 func (x *Creature_Kind_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_creatures_v1_creature_proto_msgTypes[3]
+	mi := &file_creatures_v1_creature_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +395,7 @@ func (x *Creature_Kind_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Creature_Kind_Filter.ProtoReflect.Descriptor instead.
 func (*Creature_Kind_Filter) Descriptor() ([]byte, []int) {
-	return file_creatures_v1_creature_proto_rawDescGZIP(), []int{0, 1, 0}
+	return file_creatures_v1_creature_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
 func (x *Creature_Kind_Filter) GetAll() bool {
@@ -320,17 +437,30 @@ var File_creatures_v1_creature_proto protoreflect.FileDescriptor
 
 const file_creatures_v1_creature_proto_rawDesc = "" +
 	"\n" +
-	"\x1bcreatures/v1/creature.proto\x12\fcreatures.v1\"\x8f\x05\n" +
+	"\x1bcreatures/v1/creature.proto\x12\fcreatures.v1\"\xad\a\n" +
 	"\bCreature\x1a\x9d\x01\n" +
 	"\x15AttributeModification\x12\x14\n" +
 	"\x05delta\x18\x01 \x01(\x05R\x05delta\x126\n" +
 	"\x17negate_positive_effects\x18\x02 \x01(\bR\x15negatePositiveEffects\x126\n" +
-	"\x17negate_negative_effects\x18\x03 \x01(\bR\x15negateNegativeEffects\x1a\xc4\x02\n" +
+	"\x17negate_negative_effects\x18\x03 \x01(\bR\x15negateNegativeEffects\x1a\xe7\x01\n" +
+	"\x05Stats\x12\x16\n" +
+	"\x06attack\x18\x01 \x01(\x05R\x06attack\x12\x18\n" +
+	"\adefence\x18\x02 \x01(\x05R\adefence\x12\x1d\n" +
+	"\n" +
+	"min_damage\x18\x03 \x01(\rR\tminDamage\x12\x1d\n" +
+	"\n" +
+	"max_damage\x18\x04 \x01(\rR\tmaxDamage\x12\x16\n" +
+	"\x06health\x18\x05 \x01(\rR\x06health\x12\x14\n" +
+	"\x05speed\x18\x06 \x01(\x05R\x05speed\x12\x14\n" +
+	"\x05shots\x18\a \x01(\rR\x05shots\x12\x16\n" +
+	"\x06morale\x18\b \x01(\x05R\x06morale\x12\x12\n" +
+	"\x04luck\x18\t \x01(\x05R\x04luck\x1a\xf8\x02\n" +
 	"\x04Kind\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12J\n" +
 	"\x0emovement_types\x18\x03 \x03(\x0e2#.creatures.v1.Creature.MovementTypeR\rmovementTypes\x12'\n" +
-	"\x0fnative_terrains\x18\x04 \x03(\tR\x0enativeTerrains\x1a\xa2\x01\n" +
+	"\x0fnative_terrains\x18\x04 \x03(\tR\x0enativeTerrains\x122\n" +
+	"\x05stats\x18\x05 \x01(\v2\x1c.creatures.v1.Creature.StatsR\x05stats\x1a\xa2\x01\n" +
 	"\x06Filter\x12\x10\n" +
 	"\x03all\x18\x01 \x01(\bR\x03all\x12\x1f\n" +
 	"\vinclude_ids\x18\x02 \x03(\tR\n" +
@@ -360,21 +490,23 @@ func file_creatures_v1_creature_proto_rawDescGZIP() []byte {
 }
 
 var file_creatures_v1_creature_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_creatures_v1_creature_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_creatures_v1_creature_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_creatures_v1_creature_proto_goTypes = []any{
 	(Creature_MovementType)(0),             // 0: creatures.v1.Creature.MovementType
 	(*Creature)(nil),                       // 1: creatures.v1.Creature
 	(*Creature_AttributeModification)(nil), // 2: creatures.v1.Creature.AttributeModification
-	(*Creature_Kind)(nil),                  // 3: creatures.v1.Creature.Kind
-	(*Creature_Kind_Filter)(nil),           // 4: creatures.v1.Creature.Kind.Filter
+	(*Creature_Stats)(nil),                 // 3: creatures.v1.Creature.Stats
+	(*Creature_Kind)(nil),                  // 4: creatures.v1.Creature.Kind
+	(*Creature_Kind_Filter)(nil),           // 5: creatures.v1.Creature.Kind.Filter
 }
 var file_creatures_v1_creature_proto_depIdxs = []int32{
 	0, // 0: creatures.v1.Creature.Kind.movement_types:type_name -> creatures.v1.Creature.MovementType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: creatures.v1.Creature.Kind.stats:type_name -> creatures.v1.Creature.Stats
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_creatures_v1_creature_proto_init() }
@@ -388,7 +520,7 @@ func file_creatures_v1_creature_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_creatures_v1_creature_proto_rawDesc), len(file_creatures_v1_creature_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,10 +7,17 @@
 package gamev1
 
 import (
+	v12 "github.com/openhexes/proto/creatures/v1"
+	v14 "github.com/openhexes/proto/economy/v1"
+	v13 "github.com/openhexes/proto/heroes/v1"
 	v1 "github.com/openhexes/proto/map/v1"
+	v16 "github.com/openhexes/proto/objects/v1"
 	v11 "github.com/openhexes/proto/progress/v1"
+	v15 "github.com/openhexes/proto/towns/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,12 +30,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TurnMode int32
+
+const (
+	TurnMode_TURN_MODE_UNSPECIFIED  TurnMode = 0 // sequential
+	TurnMode_TURN_MODE_SEQUENTIAL   TurnMode = 1 // players act one after another
+	TurnMode_TURN_MODE_SIMULTANEOUS TurnMode = 2 // players act at the same time, day ends once everyone is done
+)
+
+// Enum value maps for TurnMode.
+var (
+	TurnMode_name = map[int32]string{
+		0: "TURN_MODE_UNSPECIFIED",
+		1: "TURN_MODE_SEQUENTIAL",
+		2: "TURN_MODE_SIMULTANEOUS",
+	}
+	TurnMode_value = map[string]int32{
+		"TURN_MODE_UNSPECIFIED":  0,
+		"TURN_MODE_SEQUENTIAL":   1,
+		"TURN_MODE_SIMULTANEOUS": 2,
+	}
+)
+
+func (x TurnMode) Enum() *TurnMode {
+	p := new(TurnMode)
+	*p = x
+	return p
+}
+
+func (x TurnMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TurnMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_v1_game_proto_enumTypes[0].Descriptor()
+}
+
+func (TurnMode) Type() protoreflect.EnumType {
+	return &file_game_v1_game_proto_enumTypes[0]
+}
+
+func (x TurnMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TurnMode.Descriptor instead.
+func (TurnMode) EnumDescriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{0}
+}
+
 type GetSampleGridRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
-	TotalRows            uint32                 `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	TotalColumns         uint32                 `protobuf:"varint,2,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
-	MaxRowsPerSegment    uint32                 `protobuf:"varint,3,opt,name=max_rows_per_segment,json=maxRowsPerSegment,proto3" json:"max_rows_per_segment,omitempty"`
-	MaxColumnsPerSegment uint32                 `protobuf:"varint,4,opt,name=max_columns_per_segment,json=maxColumnsPerSegment,proto3" json:"max_columns_per_segment,omitempty"`
+	TotalRows            uint32                 `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`                                      // up to 1024
+	TotalColumns         uint32                 `protobuf:"varint,2,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`                             // up to 1024
+	MaxRowsPerSegment    uint32                 `protobuf:"varint,3,opt,name=max_rows_per_segment,json=maxRowsPerSegment,proto3" json:"max_rows_per_segment,omitempty"`          // up to 64
+	MaxColumnsPerSegment uint32                 `protobuf:"varint,4,opt,name=max_columns_per_segment,json=maxColumnsPerSegment,proto3" json:"max_columns_per_segment,omitempty"` // up to 64
+	Seed                 int64                  `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`                                                                 // same seed always produces the same map, random if omitted
+	Depths               uint32                 `protobuf:"varint,6,opt,name=depths,proto3" json:"depths,omitempty"`                                                             // number of levels, single level if omitted
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -91,10 +149,25 @@ func (x *GetSampleGridRequest) GetMaxColumnsPerSegment() uint32 {
 	return 0
 }
 
+func (x *GetSampleGridRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GetSampleGridRequest) GetDepths() uint32 {
+	if x != nil {
+		return x.Depths
+	}
+	return 0
+}
+
 type GetSampleGridResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Grid          *v1.Grid               `protobuf:"bytes,1,opt,name=grid,proto3" json:"grid,omitempty"` // may be partial, containing a subset of segment rows
 	Progress      *v11.Progress          `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	Seed          int64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"` // seed the grid was generated with
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -143,72 +216,2146 @@ func (x *GetSampleGridResponse) GetProgress() *v11.Progress {
 	return nil
 }
 
-var File_game_v1_game_proto protoreflect.FileDescriptor
+func (x *GetSampleGridResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
 
-const file_game_v1_game_proto_rawDesc = "" +
-	"\n" +
-	"\x12game/v1/game.proto\x12\agame.v1\x1a\x11map/v1/tile.proto\x1a\x1aprogress/v1/progress.proto\"\xc2\x01\n" +
-	"\x14GetSampleGridRequest\x12\x1d\n" +
-	"\n" +
-	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12#\n" +
-	"\rtotal_columns\x18\x02 \x01(\rR\ftotalColumns\x12/\n" +
-	"\x14max_rows_per_segment\x18\x03 \x01(\rR\x11maxRowsPerSegment\x125\n" +
-	"\x17max_columns_per_segment\x18\x04 \x01(\rR\x14maxColumnsPerSegment\"l\n" +
-	"\x15GetSampleGridResponse\x12 \n" +
-	"\x04grid\x18\x01 \x01(\v2\f.map.v1.GridR\x04grid\x121\n" +
-	"\bprogress\x18\x02 \x01(\v2\x15.progress.v1.ProgressR\bprogress2_\n" +
-	"\vGameService\x12P\n" +
-	"\rGetSampleGrid\x12\x1d.game.v1.GetSampleGridRequest\x1a\x1e.game.v1.GetSampleGridResponse0\x01B\x80\x01\n" +
-	"\vcom.game.v1B\tGameProtoP\x01Z)github.com/openhexes/proto/game/v1;gamev1\xa2\x02\x03GXX\xaa\x02\aGame.V1\xca\x02\aGame\\V1\xe2\x02\x13Game\\V1\\GPBMetadata\xea\x02\bGame::V1b\x06proto3"
+type StreamSegmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sample grid to stream, see GetSampleGridRequest; only read from the first message
+	TotalRows            uint32 `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TotalColumns         uint32 `protobuf:"varint,2,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
+	MaxRowsPerSegment    uint32 `protobuf:"varint,3,opt,name=max_rows_per_segment,json=maxRowsPerSegment,proto3" json:"max_rows_per_segment,omitempty"`
+	MaxColumnsPerSegment uint32 `protobuf:"varint,4,opt,name=max_columns_per_segment,json=maxColumnsPerSegment,proto3" json:"max_columns_per_segment,omitempty"`
+	Seed                 int64  `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	// tiles visible to the client on a single level, max values are exclusive;
+	// extended by margin it may span up to 256 rows & columns
+	Viewport *v1.Segment_Bounds `protobuf:"bytes,6,opt,name=viewport,proto3" json:"viewport,omitempty"`
+	Margin   int32              `protobuf:"varint,7,opt,name=margin,proto3" json:"margin,omitempty"` // tiles around the viewport to prefetch
+	// stream map of the game as seen by the player instead of a sample grid, tiles hidden by fog of war are masked;
+	// only read from the first message
+	GameId        string `protobuf:"bytes,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Depths        uint32 `protobuf:"varint,9,opt,name=depths,proto3" json:"depths,omitempty"` // sample grid only, see GetSampleGridRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var (
-	file_game_v1_game_proto_rawDescOnce sync.Once
-	file_game_v1_game_proto_rawDescData []byte
-)
+func (x *StreamSegmentsRequest) Reset() {
+	*x = StreamSegmentsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func file_game_v1_game_proto_rawDescGZIP() []byte {
-	file_game_v1_game_proto_rawDescOnce.Do(func() {
-		file_game_v1_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)))
-	})
-	return file_game_v1_game_proto_rawDescData
+func (x *StreamSegmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_game_v1_game_proto_goTypes = []any{
-	(*GetSampleGridRequest)(nil),  // 0: game.v1.GetSampleGridRequest
-	(*GetSampleGridResponse)(nil), // 1: game.v1.GetSampleGridResponse
-	(*v1.Grid)(nil),               // 2: map.v1.Grid
-	(*v11.Progress)(nil),          // 3: progress.v1.Progress
+func (*StreamSegmentsRequest) ProtoMessage() {}
+
+func (x *StreamSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_game_v1_game_proto_depIdxs = []int32{
-	2, // 0: game.v1.GetSampleGridResponse.grid:type_name -> map.v1.Grid
-	3, // 1: game.v1.GetSampleGridResponse.progress:type_name -> progress.v1.Progress
-	0, // 2: game.v1.GameService.GetSampleGrid:input_type -> game.v1.GetSampleGridRequest
-	1, // 3: game.v1.GameService.GetSampleGrid:output_type -> game.v1.GetSampleGridResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use StreamSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{2}
 }
 
-func init() { file_game_v1_game_proto_init() }
-func file_game_v1_game_proto_init() {
-	if File_game_v1_game_proto != nil {
-		return
+func (x *StreamSegmentsRequest) GetTotalRows() uint32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetTotalColumns() uint32 {
+	if x != nil {
+		return x.TotalColumns
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetMaxRowsPerSegment() uint32 {
+	if x != nil {
+		return x.MaxRowsPerSegment
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetMaxColumnsPerSegment() uint32 {
+	if x != nil {
+		return x.MaxColumnsPerSegment
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetViewport() *v1.Segment_Bounds {
+	if x != nil {
+		return x.Viewport
+	}
+	return nil
+}
+
+func (x *StreamSegmentsRequest) GetMargin() int32 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+func (x *StreamSegmentsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StreamSegmentsRequest) GetDepths() uint32 {
+	if x != nil {
+		return x.Depths
+	}
+	return 0
+}
+
+type StreamSegmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grid          *v1.Grid               `protobuf:"bytes,1,opt,name=grid,proto3" json:"grid,omitempty"`         // only set in the first message, without segments
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`        // only set in the first message
+	Segments      []*v1.Segment          `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"` // segments the client hasn't received yet or whose visibility changed, closest to viewport first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSegmentsResponse) Reset() {
+	*x = StreamSegmentsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSegmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSegmentsResponse) ProtoMessage() {}
+
+func (x *StreamSegmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*StreamSegmentsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamSegmentsResponse) GetGrid() *v1.Grid {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+func (x *StreamSegmentsResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *StreamSegmentsResponse) GetSegments() []*v1.Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// Visibility is stored per player, it remembers tiles explored so far.
+type Visibility struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Levels        []*Visibility_Level    `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Visibility) Reset() {
+	*x = Visibility{}
+	mi := &file_game_v1_game_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Visibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visibility) ProtoMessage() {}
+
+func (x *Visibility) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visibility.ProtoReflect.Descriptor instead.
+func (*Visibility) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Visibility) GetLevels() []*Visibility_Level {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type FindPathRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sample grid to search across, see GetSampleGridRequest
+	TotalRows     uint32              `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	TotalColumns  uint32              `protobuf:"varint,2,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
+	Seed          int64               `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Start         *v1.Tile_Coordinate `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	Goal          *v1.Tile_Coordinate `protobuf:"bytes,5,opt,name=goal,proto3" json:"goal,omitempty"`
+	Kind          *v12.Creature_Kind  `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Depths        uint32              `protobuf:"varint,7,opt,name=depths,proto3" json:"depths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPathRequest) Reset() {
+	*x = FindPathRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathRequest) ProtoMessage() {}
+
+func (x *FindPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathRequest.ProtoReflect.Descriptor instead.
+func (*FindPathRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindPathRequest) GetTotalRows() uint32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetTotalColumns() uint32 {
+	if x != nil {
+		return x.TotalColumns
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetStart() *v1.Tile_Coordinate {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *FindPathRequest) GetGoal() *v1.Tile_Coordinate {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *FindPathRequest) GetKind() *v12.Creature_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *FindPathRequest) GetDepths() uint32 {
+	if x != nil {
+		return x.Depths
+	}
+	return 0
+}
+
+type FindPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          []*v1.Tile_Coordinate  `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"` // including start & goal
+	TotalCost     uint32                 `protobuf:"varint,2,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPathResponse) Reset() {
+	*x = FindPathResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathResponse) ProtoMessage() {}
+
+func (x *FindPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathResponse.ProtoReflect.Descriptor instead.
+func (*FindPathResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindPathResponse) GetPath() []*v1.Tile_Coordinate {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *FindPathResponse) GetTotalCost() uint32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+type Date struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         uint32                 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"` // starting from 1
+	Week          uint32                 `protobuf:"varint,2,opt,name=week,proto3" json:"week,omitempty"`   // of month, 1-4
+	Day           uint32                 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`     // of week, 1-7
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Date) Reset() {
+	*x = Date{}
+	mi := &file_game_v1_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Date) ProtoMessage() {}
+
+func (x *Date) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Date.ProtoReflect.Descriptor instead.
+func (*Date) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Date) GetMonth() uint32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *Date) GetWeek() uint32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *Date) GetDay() uint32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+type TurnState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          TurnMode               `protobuf:"varint,1,opt,name=mode,proto3,enum=game.v1.TurnMode" json:"mode,omitempty"`
+	Day           uint32                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"` // days since start of the game, starting from 1
+	Date          *Date                  `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Players       []*TurnState_Player    `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`                               // in turn order
+	Current       uint32                 `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`                              // index of the acting player, sequential mode only
+	TurnDuration  *durationpb.Duration   `protobuf:"bytes,6,opt,name=turn_duration,json=turnDuration,proto3" json:"turn_duration,omitempty"` // unlimited if omitted
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`                             // current turn ends automatically at this time, unset if unlimited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnState) Reset() {
+	*x = TurnState{}
+	mi := &file_game_v1_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnState) ProtoMessage() {}
+
+func (x *TurnState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnState.ProtoReflect.Descriptor instead.
+func (*TurnState) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TurnState) GetMode() TurnMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TurnMode_TURN_MODE_UNSPECIFIED
+}
+
+func (x *TurnState) GetDay() uint32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *TurnState) GetDate() *Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *TurnState) GetPlayers() []*TurnState_Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TurnState) GetCurrent() uint32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *TurnState) GetTurnDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TurnDuration
+	}
+	return nil
+}
+
+func (x *TurnState) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type GetTurnStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTurnStateRequest) Reset() {
+	*x = GetTurnStateRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTurnStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTurnStateRequest) ProtoMessage() {}
+
+func (x *GetTurnStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTurnStateRequest.ProtoReflect.Descriptor instead.
+func (*GetTurnStateRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTurnStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetTurnStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *TurnState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTurnStateResponse) Reset() {
+	*x = GetTurnStateResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTurnStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTurnStateResponse) ProtoMessage() {}
+
+func (x *GetTurnStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTurnStateResponse.ProtoReflect.Descriptor instead.
+func (*GetTurnStateResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTurnStateResponse) GetState() *TurnState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type Command struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // chosen by the client, referenced by resulting events
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Command_MoveHero_
+	//	*Command_EndTurn_
+	Kind          isCommand_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Command) Reset() {
+	*x = Command{}
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Command) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command) ProtoMessage() {}
+
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Command) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Command) GetKind() isCommand_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Command) GetMoveHero() *Command_MoveHero {
+	if x != nil {
+		if x, ok := x.Kind.(*Command_MoveHero_); ok {
+			return x.MoveHero
+		}
+	}
+	return nil
+}
+
+func (x *Command) GetEndTurn() *Command_EndTurn {
+	if x != nil {
+		if x, ok := x.Kind.(*Command_EndTurn_); ok {
+			return x.EndTurn
+		}
+	}
+	return nil
+}
+
+type isCommand_Kind interface {
+	isCommand_Kind()
+}
+
+type Command_MoveHero_ struct {
+	MoveHero *Command_MoveHero `protobuf:"bytes,2,opt,name=move_hero,json=moveHero,proto3,oneof"`
+}
+
+type Command_EndTurn_ struct {
+	EndTurn *Command_EndTurn `protobuf:"bytes,3,opt,name=end_turn,json=endTurn,proto3,oneof"`
+}
+
+func (*Command_MoveHero_) isCommand_Kind() {}
+
+func (*Command_EndTurn_) isCommand_Kind() {}
+
+type Event struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Sequence  uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // increments within a game, gaps are events withheld from the recipient, zero for rejections only sent to their author
+	Time      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	CommandId string                 `protobuf:"bytes,3,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"` // command that caused the event, if any
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Event_Joined_
+	//	*Event_Left_
+	//	*Event_Rejected_
+	//	*Event_TurnStarted_
+	//	*Event_PlayerDone_
+	//	*Event_HeroRecruited_
+	//	*Event_HeroMoved_
+	//	*Event_SpellCast_
+	//	*Event_TownUpdated_
+	//	*Event_SiteUpdated_
+	//	*Event_ObjectUpdated_
+	Kind          isEvent_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Event) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *Event) GetKind() isEvent_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Event) GetJoined() *Event_Joined {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_Joined_); ok {
+			return x.Joined
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetLeft() *Event_Left {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_Left_); ok {
+			return x.Left
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetRejected() *Event_Rejected {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_Rejected_); ok {
+			return x.Rejected
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetTurnStarted() *Event_TurnStarted {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_TurnStarted_); ok {
+			return x.TurnStarted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPlayerDone() *Event_PlayerDone {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_PlayerDone_); ok {
+			return x.PlayerDone
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHeroRecruited() *Event_HeroRecruited {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_HeroRecruited_); ok {
+			return x.HeroRecruited
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetHeroMoved() *Event_HeroMoved {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_HeroMoved_); ok {
+			return x.HeroMoved
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSpellCast() *Event_SpellCast {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_SpellCast_); ok {
+			return x.SpellCast
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetTownUpdated() *Event_TownUpdated {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_TownUpdated_); ok {
+			return x.TownUpdated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSiteUpdated() *Event_SiteUpdated {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_SiteUpdated_); ok {
+			return x.SiteUpdated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetObjectUpdated() *Event_ObjectUpdated {
+	if x != nil {
+		if x, ok := x.Kind.(*Event_ObjectUpdated_); ok {
+			return x.ObjectUpdated
+		}
+	}
+	return nil
+}
+
+type isEvent_Kind interface {
+	isEvent_Kind()
+}
+
+type Event_Joined_ struct {
+	Joined *Event_Joined `protobuf:"bytes,4,opt,name=joined,proto3,oneof"`
+}
+
+type Event_Left_ struct {
+	Left *Event_Left `protobuf:"bytes,5,opt,name=left,proto3,oneof"`
+}
+
+type Event_Rejected_ struct {
+	Rejected *Event_Rejected `protobuf:"bytes,6,opt,name=rejected,proto3,oneof"`
+}
+
+type Event_TurnStarted_ struct {
+	TurnStarted *Event_TurnStarted `protobuf:"bytes,7,opt,name=turn_started,json=turnStarted,proto3,oneof"`
+}
+
+type Event_PlayerDone_ struct {
+	PlayerDone *Event_PlayerDone `protobuf:"bytes,8,opt,name=player_done,json=playerDone,proto3,oneof"`
+}
+
+type Event_HeroRecruited_ struct {
+	HeroRecruited *Event_HeroRecruited `protobuf:"bytes,9,opt,name=hero_recruited,json=heroRecruited,proto3,oneof"`
+}
+
+type Event_HeroMoved_ struct {
+	HeroMoved *Event_HeroMoved `protobuf:"bytes,10,opt,name=hero_moved,json=heroMoved,proto3,oneof"`
+}
+
+type Event_SpellCast_ struct {
+	SpellCast *Event_SpellCast `protobuf:"bytes,11,opt,name=spell_cast,json=spellCast,proto3,oneof"`
+}
+
+type Event_TownUpdated_ struct {
+	TownUpdated *Event_TownUpdated `protobuf:"bytes,12,opt,name=town_updated,json=townUpdated,proto3,oneof"`
+}
+
+type Event_SiteUpdated_ struct {
+	SiteUpdated *Event_SiteUpdated `protobuf:"bytes,13,opt,name=site_updated,json=siteUpdated,proto3,oneof"`
+}
+
+type Event_ObjectUpdated_ struct {
+	ObjectUpdated *Event_ObjectUpdated `protobuf:"bytes,14,opt,name=object_updated,json=objectUpdated,proto3,oneof"`
+}
+
+func (*Event_Joined_) isEvent_Kind() {}
+
+func (*Event_Left_) isEvent_Kind() {}
+
+func (*Event_Rejected_) isEvent_Kind() {}
+
+func (*Event_TurnStarted_) isEvent_Kind() {}
+
+func (*Event_PlayerDone_) isEvent_Kind() {}
+
+func (*Event_HeroRecruited_) isEvent_Kind() {}
+
+func (*Event_HeroMoved_) isEvent_Kind() {}
+
+func (*Event_SpellCast_) isEvent_Kind() {}
+
+func (*Event_TownUpdated_) isEvent_Kind() {}
+
+func (*Event_SiteUpdated_) isEvent_Kind() {}
+
+func (*Event_ObjectUpdated_) isEvent_Kind() {}
+
+type PlayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // only read from the first message
+	Command       *Command               `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayRequest) Reset() {
+	*x = PlayRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayRequest) ProtoMessage() {}
+
+func (x *PlayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayRequest.ProtoReflect.Descriptor instead.
+func (*PlayRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PlayRequest) GetCommand() *Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type PlayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayResponse) Reset() {
+	*x = PlayResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayResponse) ProtoMessage() {}
+
+func (x *PlayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayResponse.ProtoReflect.Descriptor instead.
+func (*PlayResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlayResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type Visibility_Level struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Depth         uint32                 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	Explored      []byte                 `protobuf:"bytes,2,opt,name=explored,proto3" json:"explored,omitempty"` // bitset of tiles in row-major order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Visibility_Level) Reset() {
+	*x = Visibility_Level{}
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Visibility_Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visibility_Level) ProtoMessage() {}
+
+func (x *Visibility_Level) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visibility_Level.ProtoReflect.Descriptor instead.
+func (*Visibility_Level) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Visibility_Level) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Visibility_Level) GetExplored() []byte {
+	if x != nil {
+		return x.Explored
+	}
+	return nil
+}
+
+type TurnState_Player struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Slot          uint32                 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"` // ended their turn today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnState_Player) Reset() {
+	*x = TurnState_Player{}
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnState_Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnState_Player) ProtoMessage() {}
+
+func (x *TurnState_Player) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnState_Player.ProtoReflect.Descriptor instead.
+func (*TurnState_Player) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *TurnState_Player) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TurnState_Player) GetSlot() uint32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *TurnState_Player) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type Command_MoveHero struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeroId        string                 `protobuf:"bytes,1,opt,name=hero_id,json=heroId,proto3" json:"hero_id,omitempty"`
+	Goal          *v1.Tile_Coordinate    `protobuf:"bytes,2,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Command_MoveHero) Reset() {
+	*x = Command_MoveHero{}
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Command_MoveHero) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command_MoveHero) ProtoMessage() {}
+
+func (x *Command_MoveHero) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command_MoveHero.ProtoReflect.Descriptor instead.
+func (*Command_MoveHero) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Command_MoveHero) GetHeroId() string {
+	if x != nil {
+		return x.HeroId
+	}
+	return ""
+}
+
+func (x *Command_MoveHero) GetGoal() *v1.Tile_Coordinate {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type Command_EndTurn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Command_EndTurn) Reset() {
+	*x = Command_EndTurn{}
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Command_EndTurn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Command_EndTurn) ProtoMessage() {}
+
+func (x *Command_EndTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Command_EndTurn.ProtoReflect.Descriptor instead.
+func (*Command_EndTurn) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{11, 1}
+}
+
+type Event_Joined struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Joined) Reset() {
+	*x = Event_Joined{}
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Joined) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Joined) ProtoMessage() {}
+
+func (x *Event_Joined) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Joined.ProtoReflect.Descriptor instead.
+func (*Event_Joined) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *Event_Joined) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type Event_Left struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Left) Reset() {
+	*x = Event_Left{}
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Left) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Left) ProtoMessage() {}
+
+func (x *Event_Left) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Left.ProtoReflect.Descriptor instead.
+func (*Event_Left) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *Event_Left) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type Event_TurnStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *TurnState             `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	NewDay        bool                   `protobuf:"varint,2,opt,name=new_day,json=newDay,proto3" json:"new_day,omitempty"`
+	NewWeek       bool                   `protobuf:"varint,3,opt,name=new_week,json=newWeek,proto3" json:"new_week,omitempty"`
+	NewMonth      bool                   `protobuf:"varint,4,opt,name=new_month,json=newMonth,proto3" json:"new_month,omitempty"`
+	TimedOut      bool                   `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"` // previous turn ended because its time ran out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_TurnStarted) Reset() {
+	*x = Event_TurnStarted{}
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_TurnStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_TurnStarted) ProtoMessage() {}
+
+func (x *Event_TurnStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_TurnStarted.ProtoReflect.Descriptor instead.
+func (*Event_TurnStarted) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *Event_TurnStarted) GetState() *TurnState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *Event_TurnStarted) GetNewDay() bool {
+	if x != nil {
+		return x.NewDay
+	}
+	return false
+}
+
+func (x *Event_TurnStarted) GetNewWeek() bool {
+	if x != nil {
+		return x.NewWeek
+	}
+	return false
+}
+
+func (x *Event_TurnStarted) GetNewMonth() bool {
+	if x != nil {
+		return x.NewMonth
+	}
+	return false
+}
+
+func (x *Event_TurnStarted) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+type Event_PlayerDone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // waiting for other players, simultaneous mode only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_PlayerDone) Reset() {
+	*x = Event_PlayerDone{}
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_PlayerDone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_PlayerDone) ProtoMessage() {}
+
+func (x *Event_PlayerDone) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_PlayerDone.ProtoReflect.Descriptor instead.
+func (*Event_PlayerDone) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 3}
+}
+
+func (x *Event_PlayerDone) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type Event_HeroRecruited struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hero          *v13.Hero              `protobuf:"bytes,1,opt,name=hero,proto3" json:"hero,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_HeroRecruited) Reset() {
+	*x = Event_HeroRecruited{}
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_HeroRecruited) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_HeroRecruited) ProtoMessage() {}
+
+func (x *Event_HeroRecruited) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_HeroRecruited.ProtoReflect.Descriptor instead.
+func (*Event_HeroRecruited) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 4}
+}
+
+func (x *Event_HeroRecruited) GetHero() *v13.Hero {
+	if x != nil {
+		return x.Hero
+	}
+	return nil
+}
+
+type Event_HeroMoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hero          *v13.Hero              `protobuf:"bytes,1,opt,name=hero,proto3" json:"hero,omitempty"`
+	Path          []*v1.Tile_Coordinate  `protobuf:"bytes,2,rep,name=path,proto3" json:"path,omitempty"` // including start
+	Visited       []*v14.Site            `protobuf:"bytes,3,rep,name=visited,proto3" json:"visited,omitempty"`
+	ObjectId      string                 `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"` // object visited at the end of the path, see ObjectUpdated
+	Defeated      bool                   `protobuf:"varint,5,opt,name=defeated,proto3" json:"defeated,omitempty"`                // hero lost a battle & is gone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_HeroMoved) Reset() {
+	*x = Event_HeroMoved{}
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_HeroMoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_HeroMoved) ProtoMessage() {}
+
+func (x *Event_HeroMoved) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_HeroMoved.ProtoReflect.Descriptor instead.
+func (*Event_HeroMoved) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 5}
+}
+
+func (x *Event_HeroMoved) GetHero() *v13.Hero {
+	if x != nil {
+		return x.Hero
+	}
+	return nil
+}
+
+func (x *Event_HeroMoved) GetPath() []*v1.Tile_Coordinate {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Event_HeroMoved) GetVisited() []*v14.Site {
+	if x != nil {
+		return x.Visited
+	}
+	return nil
+}
+
+func (x *Event_HeroMoved) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *Event_HeroMoved) GetDefeated() bool {
+	if x != nil {
+		return x.Defeated
+	}
+	return false
+}
+
+type Event_SpellCast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hero          *v13.Hero              `protobuf:"bytes,1,opt,name=hero,proto3" json:"hero,omitempty"`
+	SpellId       string                 `protobuf:"bytes,2,opt,name=spell_id,json=spellId,proto3" json:"spell_id,omitempty"`
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_SpellCast) Reset() {
+	*x = Event_SpellCast{}
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_SpellCast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_SpellCast) ProtoMessage() {}
+
+func (x *Event_SpellCast) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_SpellCast.ProtoReflect.Descriptor instead.
+func (*Event_SpellCast) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 6}
+}
+
+func (x *Event_SpellCast) GetHero() *v13.Hero {
+	if x != nil {
+		return x.Hero
+	}
+	return nil
+}
+
+func (x *Event_SpellCast) GetSpellId() string {
+	if x != nil {
+		return x.SpellId
+	}
+	return ""
+}
+
+func (x *Event_SpellCast) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type Event_TownUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Town          *v15.Town              `protobuf:"bytes,1,opt,name=town,proto3" json:"town,omitempty"` // placed, built or recruited in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_TownUpdated) Reset() {
+	*x = Event_TownUpdated{}
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_TownUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_TownUpdated) ProtoMessage() {}
+
+func (x *Event_TownUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_TownUpdated.ProtoReflect.Descriptor instead.
+func (*Event_TownUpdated) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 7}
+}
+
+func (x *Event_TownUpdated) GetTown() *v15.Town {
+	if x != nil {
+		return x.Town
+	}
+	return nil
+}
+
+type Event_SiteUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Site          *v14.Site              `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_SiteUpdated) Reset() {
+	*x = Event_SiteUpdated{}
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_SiteUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_SiteUpdated) ProtoMessage() {}
+
+func (x *Event_SiteUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_SiteUpdated.ProtoReflect.Descriptor instead.
+func (*Event_SiteUpdated) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 8}
+}
+
+func (x *Event_SiteUpdated) GetSite() *v14.Site {
+	if x != nil {
+		return x.Site
+	}
+	return nil
+}
+
+type Event_ObjectUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *v16.Object            `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`    // placed or visited
+	Removed       bool                   `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"` // e.g. a looted treasure or defeated guards
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_ObjectUpdated) Reset() {
+	*x = Event_ObjectUpdated{}
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_ObjectUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_ObjectUpdated) ProtoMessage() {}
+
+func (x *Event_ObjectUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_ObjectUpdated.ProtoReflect.Descriptor instead.
+func (*Event_ObjectUpdated) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 9}
+}
+
+func (x *Event_ObjectUpdated) GetObject() *v16.Object {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *Event_ObjectUpdated) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type Event_Rejected struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // connect error code, e.g. "invalid_argument"
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event_Rejected) Reset() {
+	*x = Event_Rejected{}
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event_Rejected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event_Rejected) ProtoMessage() {}
+
+func (x *Event_Rejected) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event_Rejected.ProtoReflect.Descriptor instead.
+func (*Event_Rejected) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12, 10}
+}
+
+func (x *Event_Rejected) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Event_Rejected) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_game_v1_game_proto protoreflect.FileDescriptor
+
+const file_game_v1_game_proto_rawDesc = "" +
+	"\n" +
+	"\x12game/v1/game.proto\x12\agame.v1\x1a\x1bcreatures/v1/creature.proto\x1a\x18economy/v1/economy.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14heroes/v1/hero.proto\x1a\x11map/v1/tile.proto\x1a\x17objects/v1/object.proto\x1a\x1aprogress/v1/progress.proto\x1a\x13towns/v1/town.proto\"\xee\x01\n" +
+	"\x14GetSampleGridRequest\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12#\n" +
+	"\rtotal_columns\x18\x02 \x01(\rR\ftotalColumns\x12/\n" +
+	"\x14max_rows_per_segment\x18\x03 \x01(\rR\x11maxRowsPerSegment\x125\n" +
+	"\x17max_columns_per_segment\x18\x04 \x01(\rR\x14maxColumnsPerSegment\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x03R\x04seed\x12\x16\n" +
+	"\x06depths\x18\x06 \x01(\rR\x06depths\"\x80\x01\n" +
+	"\x15GetSampleGridResponse\x12 \n" +
+	"\x04grid\x18\x01 \x01(\v2\f.map.v1.GridR\x04grid\x121\n" +
+	"\bprogress\x18\x02 \x01(\v2\x15.progress.v1.ProgressR\bprogress\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\"\xd4\x02\n" +
+	"\x15StreamSegmentsRequest\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12#\n" +
+	"\rtotal_columns\x18\x02 \x01(\rR\ftotalColumns\x12/\n" +
+	"\x14max_rows_per_segment\x18\x03 \x01(\rR\x11maxRowsPerSegment\x125\n" +
+	"\x17max_columns_per_segment\x18\x04 \x01(\rR\x14maxColumnsPerSegment\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x03R\x04seed\x122\n" +
+	"\bviewport\x18\x06 \x01(\v2\x16.map.v1.Segment.BoundsR\bviewport\x12\x16\n" +
+	"\x06margin\x18\a \x01(\x05R\x06margin\x12\x17\n" +
+	"\agame_id\x18\b \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06depths\x18\t \x01(\rR\x06depths\"{\n" +
+	"\x16StreamSegmentsResponse\x12 \n" +
+	"\x04grid\x18\x01 \x01(\v2\f.map.v1.GridR\x04grid\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12+\n" +
+	"\bsegments\x18\x03 \x03(\v2\x0f.map.v1.SegmentR\bsegments\"z\n" +
+	"\n" +
+	"Visibility\x121\n" +
+	"\x06levels\x18\x01 \x03(\v2\x19.game.v1.Visibility.LevelR\x06levels\x1a9\n" +
+	"\x05Level\x12\x14\n" +
+	"\x05depth\x18\x01 \x01(\rR\x05depth\x12\x1a\n" +
+	"\bexplored\x18\x02 \x01(\fR\bexplored\"\x8e\x02\n" +
+	"\x0fFindPathRequest\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12#\n" +
+	"\rtotal_columns\x18\x02 \x01(\rR\ftotalColumns\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\x12-\n" +
+	"\x05start\x18\x04 \x01(\v2\x17.map.v1.Tile.CoordinateR\x05start\x12+\n" +
+	"\x04goal\x18\x05 \x01(\v2\x17.map.v1.Tile.CoordinateR\x04goal\x12/\n" +
+	"\x04kind\x18\x06 \x01(\v2\x1b.creatures.v1.Creature.KindR\x04kind\x12\x16\n" +
+	"\x06depths\x18\a \x01(\rR\x06depths\"^\n" +
+	"\x10FindPathResponse\x12+\n" +
+	"\x04path\x18\x01 \x03(\v2\x17.map.v1.Tile.CoordinateR\x04path\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x02 \x01(\rR\ttotalCost\"B\n" +
+	"\x04Date\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\rR\x05month\x12\x12\n" +
+	"\x04week\x18\x02 \x01(\rR\x04week\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\rR\x03day\"\xff\x02\n" +
+	"\tTurnState\x12%\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x11.game.v1.TurnModeR\x04mode\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\rR\x03day\x12!\n" +
+	"\x04date\x18\x03 \x01(\v2\r.game.v1.DateR\x04date\x123\n" +
+	"\aplayers\x18\x04 \x03(\v2\x19.game.v1.TurnState.PlayerR\aplayers\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\rR\acurrent\x12>\n" +
+	"\rturn_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\fturnDuration\x126\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x1aO\n" +
+	"\x06Player\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\rR\x04slot\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\".\n" +
+	"\x13GetTurnStateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"@\n" +
+	"\x14GetTurnStateResponse\x12(\n" +
+	"\x05state\x18\x01 \x01(\v2\x12.game.v1.TurnStateR\x05state\"\xef\x01\n" +
+	"\aCommand\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\tmove_hero\x18\x02 \x01(\v2\x19.game.v1.Command.MoveHeroH\x00R\bmoveHero\x125\n" +
+	"\bend_turn\x18\x03 \x01(\v2\x18.game.v1.Command.EndTurnH\x00R\aendTurn\x1aP\n" +
+	"\bMoveHero\x12\x17\n" +
+	"\ahero_id\x18\x01 \x01(\tR\x06heroId\x12+\n" +
+	"\x04goal\x18\x02 \x01(\v2\x17.map.v1.Tile.CoordinateR\x04goal\x1a\t\n" +
+	"\aEndTurnB\x06\n" +
+	"\x04kind\"\x8e\r\n" +
+	"\x05Event\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x03 \x01(\tR\tcommandId\x12/\n" +
+	"\x06joined\x18\x04 \x01(\v2\x15.game.v1.Event.JoinedH\x00R\x06joined\x12)\n" +
+	"\x04left\x18\x05 \x01(\v2\x13.game.v1.Event.LeftH\x00R\x04left\x125\n" +
+	"\brejected\x18\x06 \x01(\v2\x17.game.v1.Event.RejectedH\x00R\brejected\x12?\n" +
+	"\fturn_started\x18\a \x01(\v2\x1a.game.v1.Event.TurnStartedH\x00R\vturnStarted\x12<\n" +
+	"\vplayer_done\x18\b \x01(\v2\x19.game.v1.Event.PlayerDoneH\x00R\n" +
+	"playerDone\x12E\n" +
+	"\x0ehero_recruited\x18\t \x01(\v2\x1c.game.v1.Event.HeroRecruitedH\x00R\rheroRecruited\x129\n" +
+	"\n" +
+	"hero_moved\x18\n" +
+	" \x01(\v2\x18.game.v1.Event.HeroMovedH\x00R\theroMoved\x129\n" +
+	"\n" +
+	"spell_cast\x18\v \x01(\v2\x18.game.v1.Event.SpellCastH\x00R\tspellCast\x12?\n" +
+	"\ftown_updated\x18\f \x01(\v2\x1a.game.v1.Event.TownUpdatedH\x00R\vtownUpdated\x12?\n" +
+	"\fsite_updated\x18\r \x01(\v2\x1a.game.v1.Event.SiteUpdatedH\x00R\vsiteUpdated\x12E\n" +
+	"\x0eobject_updated\x18\x0e \x01(\v2\x1c.game.v1.Event.ObjectUpdatedH\x00R\robjectUpdated\x1a'\n" +
+	"\x06Joined\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x1a%\n" +
+	"\x04Left\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x1a\xa5\x01\n" +
+	"\vTurnStarted\x12(\n" +
+	"\x05state\x18\x01 \x01(\v2\x12.game.v1.TurnStateR\x05state\x12\x17\n" +
+	"\anew_day\x18\x02 \x01(\bR\x06newDay\x12\x19\n" +
+	"\bnew_week\x18\x03 \x01(\bR\anewWeek\x12\x1b\n" +
+	"\tnew_month\x18\x04 \x01(\bR\bnewMonth\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\bR\btimedOut\x1a+\n" +
+	"\n" +
+	"PlayerDone\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x1a4\n" +
+	"\rHeroRecruited\x12#\n" +
+	"\x04hero\x18\x01 \x01(\v2\x0f.heroes.v1.HeroR\x04hero\x1a\xc2\x01\n" +
+	"\tHeroMoved\x12#\n" +
+	"\x04hero\x18\x01 \x01(\v2\x0f.heroes.v1.HeroR\x04hero\x12+\n" +
+	"\x04path\x18\x02 \x03(\v2\x17.map.v1.Tile.CoordinateR\x04path\x12*\n" +
+	"\avisited\x18\x03 \x03(\v2\x10.economy.v1.SiteR\avisited\x12\x1b\n" +
+	"\tobject_id\x18\x04 \x01(\tR\bobjectId\x12\x1a\n" +
+	"\bdefeated\x18\x05 \x01(\bR\bdefeated\x1aa\n" +
+	"\tSpellCast\x12#\n" +
+	"\x04hero\x18\x01 \x01(\v2\x0f.heroes.v1.HeroR\x04hero\x12\x19\n" +
+	"\bspell_id\x18\x02 \x01(\tR\aspellId\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x1a1\n" +
+	"\vTownUpdated\x12\"\n" +
+	"\x04town\x18\x01 \x01(\v2\x0e.towns.v1.TownR\x04town\x1a3\n" +
+	"\vSiteUpdated\x12$\n" +
+	"\x04site\x18\x01 \x01(\v2\x10.economy.v1.SiteR\x04site\x1aU\n" +
+	"\rObjectUpdated\x12*\n" +
+	"\x06object\x18\x01 \x01(\v2\x12.objects.v1.ObjectR\x06object\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\bR\aremoved\x1a8\n" +
+	"\bRejected\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessageB\x06\n" +
+	"\x04kind\"R\n" +
+	"\vPlayRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12*\n" +
+	"\acommand\x18\x02 \x01(\v2\x10.game.v1.CommandR\acommand\"6\n" +
+	"\fPlayResponse\x12&\n" +
+	"\x06events\x18\x01 \x03(\v2\x0e.game.v1.EventR\x06events*[\n" +
+	"\bTurnMode\x12\x19\n" +
+	"\x15TURN_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TURN_MODE_SEQUENTIAL\x10\x01\x12\x1a\n" +
+	"\x16TURN_MODE_SIMULTANEOUS\x10\x022\xcf\x03\n" +
+	"\vGameService\x12P\n" +
+	"\rGetSampleGrid\x12\x1d.game.v1.GetSampleGridRequest\x1a\x1e.game.v1.GetSampleGridResponse0\x01\x12U\n" +
+	"\x0eStreamSegments\x12\x1e.game.v1.StreamSegmentsRequest\x1a\x1f.game.v1.StreamSegmentsResponse(\x010\x01\x12P\n" +
+	"\vGetSegments\x12\x1e.game.v1.StreamSegmentsRequest\x1a\x1f.game.v1.StreamSegmentsResponse0\x01\x12?\n" +
+	"\bFindPath\x12\x18.game.v1.FindPathRequest\x1a\x19.game.v1.FindPathResponse\x127\n" +
+	"\x04Play\x12\x14.game.v1.PlayRequest\x1a\x15.game.v1.PlayResponse(\x010\x01\x12K\n" +
+	"\fGetTurnState\x12\x1c.game.v1.GetTurnStateRequest\x1a\x1d.game.v1.GetTurnStateResponseB\x80\x01\n" +
+	"\vcom.game.v1B\tGameProtoP\x01Z)github.com/openhexes/proto/game/v1;gamev1\xa2\x02\x03GXX\xaa\x02\aGame.V1\xca\x02\aGame\\V1\xe2\x02\x13Game\\V1\\GPBMetadata\xea\x02\bGame::V1b\x06proto3"
+
+var (
+	file_game_v1_game_proto_rawDescOnce sync.Once
+	file_game_v1_game_proto_rawDescData []byte
+)
+
+func file_game_v1_game_proto_rawDescGZIP() []byte {
+	file_game_v1_game_proto_rawDescOnce.Do(func() {
+		file_game_v1_game_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)))
+	})
+	return file_game_v1_game_proto_rawDescData
+}
+
+var file_game_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_game_v1_game_proto_goTypes = []any{
+	(TurnMode)(0),                  // 0: game.v1.TurnMode
+	(*GetSampleGridRequest)(nil),   // 1: game.v1.GetSampleGridRequest
+	(*GetSampleGridResponse)(nil),  // 2: game.v1.GetSampleGridResponse
+	(*StreamSegmentsRequest)(nil),  // 3: game.v1.StreamSegmentsRequest
+	(*StreamSegmentsResponse)(nil), // 4: game.v1.StreamSegmentsResponse
+	(*Visibility)(nil),             // 5: game.v1.Visibility
+	(*FindPathRequest)(nil),        // 6: game.v1.FindPathRequest
+	(*FindPathResponse)(nil),       // 7: game.v1.FindPathResponse
+	(*Date)(nil),                   // 8: game.v1.Date
+	(*TurnState)(nil),              // 9: game.v1.TurnState
+	(*GetTurnStateRequest)(nil),    // 10: game.v1.GetTurnStateRequest
+	(*GetTurnStateResponse)(nil),   // 11: game.v1.GetTurnStateResponse
+	(*Command)(nil),                // 12: game.v1.Command
+	(*Event)(nil),                  // 13: game.v1.Event
+	(*PlayRequest)(nil),            // 14: game.v1.PlayRequest
+	(*PlayResponse)(nil),           // 15: game.v1.PlayResponse
+	(*Visibility_Level)(nil),       // 16: game.v1.Visibility.Level
+	(*TurnState_Player)(nil),       // 17: game.v1.TurnState.Player
+	(*Command_MoveHero)(nil),       // 18: game.v1.Command.MoveHero
+	(*Command_EndTurn)(nil),        // 19: game.v1.Command.EndTurn
+	(*Event_Joined)(nil),           // 20: game.v1.Event.Joined
+	(*Event_Left)(nil),             // 21: game.v1.Event.Left
+	(*Event_TurnStarted)(nil),      // 22: game.v1.Event.TurnStarted
+	(*Event_PlayerDone)(nil),       // 23: game.v1.Event.PlayerDone
+	(*Event_HeroRecruited)(nil),    // 24: game.v1.Event.HeroRecruited
+	(*Event_HeroMoved)(nil),        // 25: game.v1.Event.HeroMoved
+	(*Event_SpellCast)(nil),        // 26: game.v1.Event.SpellCast
+	(*Event_TownUpdated)(nil),      // 27: game.v1.Event.TownUpdated
+	(*Event_SiteUpdated)(nil),      // 28: game.v1.Event.SiteUpdated
+	(*Event_ObjectUpdated)(nil),    // 29: game.v1.Event.ObjectUpdated
+	(*Event_Rejected)(nil),         // 30: game.v1.Event.Rejected
+	(*v1.Grid)(nil),                // 31: map.v1.Grid
+	(*v11.Progress)(nil),           // 32: progress.v1.Progress
+	(*v1.Segment_Bounds)(nil),      // 33: map.v1.Segment.Bounds
+	(*v1.Segment)(nil),             // 34: map.v1.Segment
+	(*v1.Tile_Coordinate)(nil),     // 35: map.v1.Tile.Coordinate
+	(*v12.Creature_Kind)(nil),      // 36: creatures.v1.Creature.Kind
+	(*durationpb.Duration)(nil),    // 37: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),  // 38: google.protobuf.Timestamp
+	(*v13.Hero)(nil),               // 39: heroes.v1.Hero
+	(*v14.Site)(nil),               // 40: economy.v1.Site
+	(*v15.Town)(nil),               // 41: towns.v1.Town
+	(*v16.Object)(nil),             // 42: objects.v1.Object
+}
+var file_game_v1_game_proto_depIdxs = []int32{
+	31, // 0: game.v1.GetSampleGridResponse.grid:type_name -> map.v1.Grid
+	32, // 1: game.v1.GetSampleGridResponse.progress:type_name -> progress.v1.Progress
+	33, // 2: game.v1.StreamSegmentsRequest.viewport:type_name -> map.v1.Segment.Bounds
+	31, // 3: game.v1.StreamSegmentsResponse.grid:type_name -> map.v1.Grid
+	34, // 4: game.v1.StreamSegmentsResponse.segments:type_name -> map.v1.Segment
+	16, // 5: game.v1.Visibility.levels:type_name -> game.v1.Visibility.Level
+	35, // 6: game.v1.FindPathRequest.start:type_name -> map.v1.Tile.Coordinate
+	35, // 7: game.v1.FindPathRequest.goal:type_name -> map.v1.Tile.Coordinate
+	36, // 8: game.v1.FindPathRequest.kind:type_name -> creatures.v1.Creature.Kind
+	35, // 9: game.v1.FindPathResponse.path:type_name -> map.v1.Tile.Coordinate
+	0,  // 10: game.v1.TurnState.mode:type_name -> game.v1.TurnMode
+	8,  // 11: game.v1.TurnState.date:type_name -> game.v1.Date
+	17, // 12: game.v1.TurnState.players:type_name -> game.v1.TurnState.Player
+	37, // 13: game.v1.TurnState.turn_duration:type_name -> google.protobuf.Duration
+	38, // 14: game.v1.TurnState.deadline:type_name -> google.protobuf.Timestamp
+	9,  // 15: game.v1.GetTurnStateResponse.state:type_name -> game.v1.TurnState
+	18, // 16: game.v1.Command.move_hero:type_name -> game.v1.Command.MoveHero
+	19, // 17: game.v1.Command.end_turn:type_name -> game.v1.Command.EndTurn
+	38, // 18: game.v1.Event.time:type_name -> google.protobuf.Timestamp
+	20, // 19: game.v1.Event.joined:type_name -> game.v1.Event.Joined
+	21, // 20: game.v1.Event.left:type_name -> game.v1.Event.Left
+	30, // 21: game.v1.Event.rejected:type_name -> game.v1.Event.Rejected
+	22, // 22: game.v1.Event.turn_started:type_name -> game.v1.Event.TurnStarted
+	23, // 23: game.v1.Event.player_done:type_name -> game.v1.Event.PlayerDone
+	24, // 24: game.v1.Event.hero_recruited:type_name -> game.v1.Event.HeroRecruited
+	25, // 25: game.v1.Event.hero_moved:type_name -> game.v1.Event.HeroMoved
+	26, // 26: game.v1.Event.spell_cast:type_name -> game.v1.Event.SpellCast
+	27, // 27: game.v1.Event.town_updated:type_name -> game.v1.Event.TownUpdated
+	28, // 28: game.v1.Event.site_updated:type_name -> game.v1.Event.SiteUpdated
+	29, // 29: game.v1.Event.object_updated:type_name -> game.v1.Event.ObjectUpdated
+	12, // 30: game.v1.PlayRequest.command:type_name -> game.v1.Command
+	13, // 31: game.v1.PlayResponse.events:type_name -> game.v1.Event
+	35, // 32: game.v1.Command.MoveHero.goal:type_name -> map.v1.Tile.Coordinate
+	9,  // 33: game.v1.Event.TurnStarted.state:type_name -> game.v1.TurnState
+	39, // 34: game.v1.Event.HeroRecruited.hero:type_name -> heroes.v1.Hero
+	39, // 35: game.v1.Event.HeroMoved.hero:type_name -> heroes.v1.Hero
+	35, // 36: game.v1.Event.HeroMoved.path:type_name -> map.v1.Tile.Coordinate
+	40, // 37: game.v1.Event.HeroMoved.visited:type_name -> economy.v1.Site
+	39, // 38: game.v1.Event.SpellCast.hero:type_name -> heroes.v1.Hero
+	41, // 39: game.v1.Event.TownUpdated.town:type_name -> towns.v1.Town
+	40, // 40: game.v1.Event.SiteUpdated.site:type_name -> economy.v1.Site
+	42, // 41: game.v1.Event.ObjectUpdated.object:type_name -> objects.v1.Object
+	1,  // 42: game.v1.GameService.GetSampleGrid:input_type -> game.v1.GetSampleGridRequest
+	3,  // 43: game.v1.GameService.StreamSegments:input_type -> game.v1.StreamSegmentsRequest
+	3,  // 44: game.v1.GameService.GetSegments:input_type -> game.v1.StreamSegmentsRequest
+	6,  // 45: game.v1.GameService.FindPath:input_type -> game.v1.FindPathRequest
+	14, // 46: game.v1.GameService.Play:input_type -> game.v1.PlayRequest
+	10, // 47: game.v1.GameService.GetTurnState:input_type -> game.v1.GetTurnStateRequest
+	2,  // 48: game.v1.GameService.GetSampleGrid:output_type -> game.v1.GetSampleGridResponse
+	4,  // 49: game.v1.GameService.StreamSegments:output_type -> game.v1.StreamSegmentsResponse
+	4,  // 50: game.v1.GameService.GetSegments:output_type -> game.v1.StreamSegmentsResponse
+	7,  // 51: game.v1.GameService.FindPath:output_type -> game.v1.FindPathResponse
+	15, // 52: game.v1.GameService.Play:output_type -> game.v1.PlayResponse
+	11, // 53: game.v1.GameService.GetTurnState:output_type -> game.v1.GetTurnStateResponse
+	48, // [48:54] is the sub-list for method output_type
+	42, // [42:48] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_game_v1_game_proto_init() }
+func file_game_v1_game_proto_init() {
+	if File_game_v1_game_proto != nil {
+		return
+	}
+	file_game_v1_game_proto_msgTypes[11].OneofWrappers = []any{
+		(*Command_MoveHero_)(nil),
+		(*Command_EndTurn_)(nil),
+	}
+	file_game_v1_game_proto_msgTypes[12].OneofWrappers = []any{
+		(*Event_Joined_)(nil),
+		(*Event_Left_)(nil),
+		(*Event_Rejected_)(nil),
+		(*Event_TurnStarted_)(nil),
+		(*Event_PlayerDone_)(nil),
+		(*Event_HeroRecruited_)(nil),
+		(*Event_HeroMoved_)(nil),
+		(*Event_SpellCast_)(nil),
+		(*Event_TownUpdated_)(nil),
+		(*Event_SiteUpdated_)(nil),
+		(*Event_ObjectUpdated_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_game_v1_game_proto_goTypes,
 		DependencyIndexes: file_game_v1_game_proto_depIdxs,
+		EnumInfos:         file_game_v1_game_proto_enumTypes,
 		MessageInfos:      file_game_v1_game_proto_msgTypes,
 	}.Build()
 	File_game_v1_game_proto = out.File
@@ -36,11 +36,29 @@ const (
 	// GameServiceGetSampleGridProcedure is the fully-qualified name of the GameService's GetSampleGrid
 	// RPC.
 	GameServiceGetSampleGridProcedure = "/game.v1.GameService/GetSampleGrid"
+	// GameServiceStreamSegmentsProcedure is the fully-qualified name of the GameService's
+	// StreamSegments RPC.
+	GameServiceStreamSegmentsProcedure = "/game.v1.GameService/StreamSegments"
+	// GameServiceGetSegmentsProcedure is the fully-qualified name of the GameService's GetSegments RPC.
+	GameServiceGetSegmentsProcedure = "/game.v1.GameService/GetSegments"
+	// GameServiceFindPathProcedure is the fully-qualified name of the GameService's FindPath RPC.
+	GameServiceFindPathProcedure = "/game.v1.GameService/FindPath"
+	// GameServicePlayProcedure is the fully-qualified name of the GameService's Play RPC.
+	GameServicePlayProcedure = "/game.v1.GameService/Play"
+	// GameServiceGetTurnStateProcedure is the fully-qualified name of the GameService's GetTurnState
+	// RPC.
+	GameServiceGetTurnStateProcedure = "/game.v1.GameService/GetTurnState"
 )
 
 // GameServiceClient is a client for the game.v1.GameService service.
 type GameServiceClient interface {
 	GetSampleGrid(context.Context, *connect.Request[v1.GetSampleGridRequest]) (*connect.ServerStreamForClient[v1.GetSampleGridResponse], error)
+	StreamSegments(context.Context) *connect.BidiStreamForClient[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse]
+	// same as StreamSegments for a single viewport, for clients that can't stream requests, e.g. browsers
+	GetSegments(context.Context, *connect.Request[v1.StreamSegmentsRequest]) (*connect.ServerStreamForClient[v1.StreamSegmentsResponse], error)
+	FindPath(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error)
+	Play(context.Context) *connect.BidiStreamForClient[v1.PlayRequest, v1.PlayResponse]
+	GetTurnState(context.Context, *connect.Request[v1.GetTurnStateRequest]) (*connect.Response[v1.GetTurnStateResponse], error)
 }
 
 // NewGameServiceClient constructs a client for the game.v1.GameService service. By default, it uses
@@ -60,12 +78,47 @@ func NewGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(gameServiceMethods.ByName("GetSampleGrid")),
 			connect.WithClientOptions(opts...),
 		),
+		streamSegments: connect.NewClient[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse](
+			httpClient,
+			baseURL+GameServiceStreamSegmentsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("StreamSegments")),
+			connect.WithClientOptions(opts...),
+		),
+		getSegments: connect.NewClient[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse](
+			httpClient,
+			baseURL+GameServiceGetSegmentsProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetSegments")),
+			connect.WithClientOptions(opts...),
+		),
+		findPath: connect.NewClient[v1.FindPathRequest, v1.FindPathResponse](
+			httpClient,
+			baseURL+GameServiceFindPathProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("FindPath")),
+			connect.WithClientOptions(opts...),
+		),
+		play: connect.NewClient[v1.PlayRequest, v1.PlayResponse](
+			httpClient,
+			baseURL+GameServicePlayProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("Play")),
+			connect.WithClientOptions(opts...),
+		),
+		getTurnState: connect.NewClient[v1.GetTurnStateRequest, v1.GetTurnStateResponse](
+			httpClient,
+			baseURL+GameServiceGetTurnStateProcedure,
+			connect.WithSchema(gameServiceMethods.ByName("GetTurnState")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // gameServiceClient implements GameServiceClient.
 type gameServiceClient struct {
-	getSampleGrid *connect.Client[v1.GetSampleGridRequest, v1.GetSampleGridResponse]
+	getSampleGrid  *connect.Client[v1.GetSampleGridRequest, v1.GetSampleGridResponse]
+	streamSegments *connect.Client[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse]
+	getSegments    *connect.Client[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse]
+	findPath       *connect.Client[v1.FindPathRequest, v1.FindPathResponse]
+	play           *connect.Client[v1.PlayRequest, v1.PlayResponse]
+	getTurnState   *connect.Client[v1.GetTurnStateRequest, v1.GetTurnStateResponse]
 }
 
 // GetSampleGrid calls game.v1.GameService.GetSampleGrid.
@@ -73,9 +126,40 @@ func (c *gameServiceClient) GetSampleGrid(ctx context.Context, req *connect.Requ
 	return c.getSampleGrid.CallServerStream(ctx, req)
 }
 
+// StreamSegments calls game.v1.GameService.StreamSegments.
+func (c *gameServiceClient) StreamSegments(ctx context.Context) *connect.BidiStreamForClient[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse] {
+	return c.streamSegments.CallBidiStream(ctx)
+}
+
+// GetSegments calls game.v1.GameService.GetSegments.
+func (c *gameServiceClient) GetSegments(ctx context.Context, req *connect.Request[v1.StreamSegmentsRequest]) (*connect.ServerStreamForClient[v1.StreamSegmentsResponse], error) {
+	return c.getSegments.CallServerStream(ctx, req)
+}
+
+// FindPath calls game.v1.GameService.FindPath.
+func (c *gameServiceClient) FindPath(ctx context.Context, req *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error) {
+	return c.findPath.CallUnary(ctx, req)
+}
+
+// Play calls game.v1.GameService.Play.
+func (c *gameServiceClient) Play(ctx context.Context) *connect.BidiStreamForClient[v1.PlayRequest, v1.PlayResponse] {
+	return c.play.CallBidiStream(ctx)
+}
+
+// GetTurnState calls game.v1.GameService.GetTurnState.
+func (c *gameServiceClient) GetTurnState(ctx context.Context, req *connect.Request[v1.GetTurnStateRequest]) (*connect.Response[v1.GetTurnStateResponse], error) {
+	return c.getTurnState.CallUnary(ctx, req)
+}
+
 // GameServiceHandler is an implementation of the game.v1.GameService service.
 type GameServiceHandler interface {
 	GetSampleGrid(context.Context, *connect.Request[v1.GetSampleGridRequest], *connect.ServerStream[v1.GetSampleGridResponse]) error
+	StreamSegments(context.Context, *connect.BidiStream[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse]) error
+	// same as StreamSegments for a single viewport, for clients that can't stream requests, e.g. browsers
+	GetSegments(context.Context, *connect.Request[v1.StreamSegmentsRequest], *connect.ServerStream[v1.StreamSegmentsResponse]) error
+	FindPath(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error)
+	Play(context.Context, *connect.BidiStream[v1.PlayRequest, v1.PlayResponse]) error
+	GetTurnState(context.Context, *connect.Request[v1.GetTurnStateRequest]) (*connect.Response[v1.GetTurnStateResponse], error)
 }
 
 // NewGameServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -91,10 +175,50 @@ func NewGameServiceHandler(svc GameServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(gameServiceMethods.ByName("GetSampleGrid")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameServiceStreamSegmentsHandler := connect.NewBidiStreamHandler(
+		GameServiceStreamSegmentsProcedure,
+		svc.StreamSegments,
+		connect.WithSchema(gameServiceMethods.ByName("StreamSegments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceGetSegmentsHandler := connect.NewServerStreamHandler(
+		GameServiceGetSegmentsProcedure,
+		svc.GetSegments,
+		connect.WithSchema(gameServiceMethods.ByName("GetSegments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceFindPathHandler := connect.NewUnaryHandler(
+		GameServiceFindPathProcedure,
+		svc.FindPath,
+		connect.WithSchema(gameServiceMethods.ByName("FindPath")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServicePlayHandler := connect.NewBidiStreamHandler(
+		GameServicePlayProcedure,
+		svc.Play,
+		connect.WithSchema(gameServiceMethods.ByName("Play")),
+		connect.WithHandlerOptions(opts...),
+	)
+	gameServiceGetTurnStateHandler := connect.NewUnaryHandler(
+		GameServiceGetTurnStateProcedure,
+		svc.GetTurnState,
+		connect.WithSchema(gameServiceMethods.ByName("GetTurnState")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/game.v1.GameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameServiceGetSampleGridProcedure:
 			gameServiceGetSampleGridHandler.ServeHTTP(w, r)
+		case GameServiceStreamSegmentsProcedure:
+			gameServiceStreamSegmentsHandler.ServeHTTP(w, r)
+		case GameServiceGetSegmentsProcedure:
+			gameServiceGetSegmentsHandler.ServeHTTP(w, r)
+		case GameServiceFindPathProcedure:
+			gameServiceFindPathHandler.ServeHTTP(w, r)
+		case GameServicePlayProcedure:
+			gameServicePlayHandler.ServeHTTP(w, r)
+		case GameServiceGetTurnStateProcedure:
+			gameServiceGetTurnStateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -107,3 +231,23 @@ type UnimplementedGameServiceHandler struct{}
 func (UnimplementedGameServiceHandler) GetSampleGrid(context.Context, *connect.Request[v1.GetSampleGridRequest], *connect.ServerStream[v1.GetSampleGridResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetSampleGrid is not implemented"))
 }
+
+func (UnimplementedGameServiceHandler) StreamSegments(context.Context, *connect.BidiStream[v1.StreamSegmentsRequest, v1.StreamSegmentsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.StreamSegments is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) GetSegments(context.Context, *connect.Request[v1.StreamSegmentsRequest], *connect.ServerStream[v1.StreamSegmentsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetSegments is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) FindPath(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.FindPath is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) Play(context.Context, *connect.BidiStream[v1.PlayRequest, v1.PlayResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.Play is not implemented"))
+}
+
+func (UnimplementedGameServiceHandler) GetTurnState(context.Context, *connect.Request[v1.GetTurnStateRequest]) (*connect.Response[v1.GetTurnStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GameService.GetTurnState is not implemented"))
+}
@@ -41,13 +41,76 @@ const (
 	// IAMServiceUpdateAccountActivationProcedure is the fully-qualified name of the IAMService's
 	// UpdateAccountActivation RPC.
 	IAMServiceUpdateAccountActivationProcedure = "/iam.v1.IAMService/UpdateAccountActivation"
+	// IAMServiceGrantRoleProcedure is the fully-qualified name of the IAMService's GrantRole RPC.
+	IAMServiceGrantRoleProcedure = "/iam.v1.IAMService/GrantRole"
+	// IAMServiceRevokeRoleProcedure is the fully-qualified name of the IAMService's RevokeRole RPC.
+	IAMServiceRevokeRoleProcedure = "/iam.v1.IAMService/RevokeRole"
+	// IAMServiceListIdentityProvidersProcedure is the fully-qualified name of the IAMService's
+	// ListIdentityProviders RPC.
+	IAMServiceListIdentityProvidersProcedure = "/iam.v1.IAMService/ListIdentityProviders"
+	// IAMServiceExchangeCodeProcedure is the fully-qualified name of the IAMService's ExchangeCode RPC.
+	IAMServiceExchangeCodeProcedure = "/iam.v1.IAMService/ExchangeCode"
+	// IAMServiceSignUpProcedure is the fully-qualified name of the IAMService's SignUp RPC.
+	IAMServiceSignUpProcedure = "/iam.v1.IAMService/SignUp"
+	// IAMServiceSignInProcedure is the fully-qualified name of the IAMService's SignIn RPC.
+	IAMServiceSignInProcedure = "/iam.v1.IAMService/SignIn"
+	// IAMServiceRefreshProcedure is the fully-qualified name of the IAMService's Refresh RPC.
+	IAMServiceRefreshProcedure = "/iam.v1.IAMService/Refresh"
+	// IAMServiceListSessionsProcedure is the fully-qualified name of the IAMService's ListSessions RPC.
+	IAMServiceListSessionsProcedure = "/iam.v1.IAMService/ListSessions"
+	// IAMServiceRevokeSessionProcedure is the fully-qualified name of the IAMService's RevokeSession
+	// RPC.
+	IAMServiceRevokeSessionProcedure = "/iam.v1.IAMService/RevokeSession"
+	// IAMServiceRevokeSessionsProcedure is the fully-qualified name of the IAMService's RevokeSessions
+	// RPC.
+	IAMServiceRevokeSessionsProcedure = "/iam.v1.IAMService/RevokeSessions"
+	// IAMServiceListIdentitiesProcedure is the fully-qualified name of the IAMService's ListIdentities
+	// RPC.
+	IAMServiceListIdentitiesProcedure = "/iam.v1.IAMService/ListIdentities"
+	// IAMServiceLinkIdentityProcedure is the fully-qualified name of the IAMService's LinkIdentity RPC.
+	IAMServiceLinkIdentityProcedure = "/iam.v1.IAMService/LinkIdentity"
+	// IAMServiceUnlinkIdentityProcedure is the fully-qualified name of the IAMService's UnlinkIdentity
+	// RPC.
+	IAMServiceUnlinkIdentityProcedure = "/iam.v1.IAMService/UnlinkIdentity"
+	// IAMServiceSetPasswordProcedure is the fully-qualified name of the IAMService's SetPassword RPC.
+	IAMServiceSetPasswordProcedure = "/iam.v1.IAMService/SetPassword"
 )
 
 // IAMServiceClient is a client for the iam.v1.IAMService service.
 type IAMServiceClient interface {
 	ResolveAccount(context.Context, *connect.Request[v1.ResolveAccountRequest]) (*connect.Response[v1.ResolveAccountResponse], error)
+	// ListAccounts streams a page of accounts in chunks.
 	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.ServerStreamForClient[v1.ListAccountsResponse], error)
+	// UpdateAccountActivation takes effect immediately.
 	UpdateAccountActivation(context.Context, *connect.Request[v1.UpdateAccountActivationRequest]) (*connect.Response[v1.UpdateAccountActivationResponse], error)
+	// GrantRole & RevokeRole take effect with the next request of the account.
+	GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error)
+	RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error)
+	// ListIdentityProviders, ExchangeCode, SignUp, SignIn & Refresh are available without signing in.
+	ListIdentityProviders(context.Context, *connect.Request[v1.ListIdentityProvidersRequest]) (*connect.Response[v1.ListIdentityProvidersResponse], error)
+	// ExchangeCode turns an authorization code into a credential of the provider,
+	// the state has to come from an authorize_url listed by ListIdentityProviders to the same client.
+	// Browsers keep the attempt in an HttpOnly cookie, other clients send it back as the cookie they received.
+	ExchangeCode(context.Context, *connect.Request[v1.ExchangeCodeRequest]) (*connect.Response[v1.ExchangeCodeResponse], error)
+	// SignUp creates an inactive account with a password login. It responds the same way when
+	// the email already has an account, so callers can't tell which emails are signed up.
+	SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error)
+	// SignIn exchanges a credential of the provider for tokens of a new session.
+	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
+	// Refresh issues new tokens of the session, the refresh token may only be used once,
+	// only a refresh token replaced moments ago is accepted again, then just the access token is issued.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// ListSessions, RevokeSession & RevokeSessions manage sessions of the caller.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeSessions(context.Context, *connect.Request[v1.RevokeSessionsRequest]) (*connect.Response[v1.RevokeSessionsResponse], error)
+	// ListIdentities, LinkIdentity & UnlinkIdentity manage logins of the caller.
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error)
+	// UnlinkIdentity keeps at least one login of the caller.
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// SetPassword adds or replaces the password login of the caller, signed in as the email of the account.
+	SetPassword(context.Context, *connect.Request[v1.SetPasswordRequest]) (*connect.Response[v1.SetPasswordResponse], error)
 }
 
 // NewIAMServiceClient constructs a client for the iam.v1.IAMService service. By default, it uses
@@ -79,6 +142,90 @@ func NewIAMServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(iAMServiceMethods.ByName("UpdateAccountActivation")),
 			connect.WithClientOptions(opts...),
 		),
+		grantRole: connect.NewClient[v1.GrantRoleRequest, v1.GrantRoleResponse](
+			httpClient,
+			baseURL+IAMServiceGrantRoleProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("GrantRole")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeRole: connect.NewClient[v1.RevokeRoleRequest, v1.RevokeRoleResponse](
+			httpClient,
+			baseURL+IAMServiceRevokeRoleProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("RevokeRole")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentityProviders: connect.NewClient[v1.ListIdentityProvidersRequest, v1.ListIdentityProvidersResponse](
+			httpClient,
+			baseURL+IAMServiceListIdentityProvidersProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("ListIdentityProviders")),
+			connect.WithClientOptions(opts...),
+		),
+		exchangeCode: connect.NewClient[v1.ExchangeCodeRequest, v1.ExchangeCodeResponse](
+			httpClient,
+			baseURL+IAMServiceExchangeCodeProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("ExchangeCode")),
+			connect.WithClientOptions(opts...),
+		),
+		signUp: connect.NewClient[v1.SignUpRequest, v1.SignUpResponse](
+			httpClient,
+			baseURL+IAMServiceSignUpProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("SignUp")),
+			connect.WithClientOptions(opts...),
+		),
+		signIn: connect.NewClient[v1.SignInRequest, v1.SignInResponse](
+			httpClient,
+			baseURL+IAMServiceSignInProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("SignIn")),
+			connect.WithClientOptions(opts...),
+		),
+		refresh: connect.NewClient[v1.RefreshRequest, v1.RefreshResponse](
+			httpClient,
+			baseURL+IAMServiceRefreshProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("Refresh")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+IAMServiceListSessionsProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+IAMServiceRevokeSessionProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSessions: connect.NewClient[v1.RevokeSessionsRequest, v1.RevokeSessionsResponse](
+			httpClient,
+			baseURL+IAMServiceRevokeSessionsProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("RevokeSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+IAMServiceListIdentitiesProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		linkIdentity: connect.NewClient[v1.LinkIdentityRequest, v1.LinkIdentityResponse](
+			httpClient,
+			baseURL+IAMServiceLinkIdentityProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("LinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+IAMServiceUnlinkIdentityProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		setPassword: connect.NewClient[v1.SetPasswordRequest, v1.SetPasswordResponse](
+			httpClient,
+			baseURL+IAMServiceSetPasswordProcedure,
+			connect.WithSchema(iAMServiceMethods.ByName("SetPassword")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -87,6 +234,20 @@ type iAMServiceClient struct {
 	resolveAccount          *connect.Client[v1.ResolveAccountRequest, v1.ResolveAccountResponse]
 	listAccounts            *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
 	updateAccountActivation *connect.Client[v1.UpdateAccountActivationRequest, v1.UpdateAccountActivationResponse]
+	grantRole               *connect.Client[v1.GrantRoleRequest, v1.GrantRoleResponse]
+	revokeRole              *connect.Client[v1.RevokeRoleRequest, v1.RevokeRoleResponse]
+	listIdentityProviders   *connect.Client[v1.ListIdentityProvidersRequest, v1.ListIdentityProvidersResponse]
+	exchangeCode            *connect.Client[v1.ExchangeCodeRequest, v1.ExchangeCodeResponse]
+	signUp                  *connect.Client[v1.SignUpRequest, v1.SignUpResponse]
+	signIn                  *connect.Client[v1.SignInRequest, v1.SignInResponse]
+	refresh                 *connect.Client[v1.RefreshRequest, v1.RefreshResponse]
+	listSessions            *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession           *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeSessions          *connect.Client[v1.RevokeSessionsRequest, v1.RevokeSessionsResponse]
+	listIdentities          *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	linkIdentity            *connect.Client[v1.LinkIdentityRequest, v1.LinkIdentityResponse]
+	unlinkIdentity          *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	setPassword             *connect.Client[v1.SetPasswordRequest, v1.SetPasswordResponse]
 }
 
 // ResolveAccount calls iam.v1.IAMService.ResolveAccount.
@@ -104,11 +265,111 @@ func (c *iAMServiceClient) UpdateAccountActivation(ctx context.Context, req *con
 	return c.updateAccountActivation.CallUnary(ctx, req)
 }
 
+// GrantRole calls iam.v1.IAMService.GrantRole.
+func (c *iAMServiceClient) GrantRole(ctx context.Context, req *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error) {
+	return c.grantRole.CallUnary(ctx, req)
+}
+
+// RevokeRole calls iam.v1.IAMService.RevokeRole.
+func (c *iAMServiceClient) RevokeRole(ctx context.Context, req *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error) {
+	return c.revokeRole.CallUnary(ctx, req)
+}
+
+// ListIdentityProviders calls iam.v1.IAMService.ListIdentityProviders.
+func (c *iAMServiceClient) ListIdentityProviders(ctx context.Context, req *connect.Request[v1.ListIdentityProvidersRequest]) (*connect.Response[v1.ListIdentityProvidersResponse], error) {
+	return c.listIdentityProviders.CallUnary(ctx, req)
+}
+
+// ExchangeCode calls iam.v1.IAMService.ExchangeCode.
+func (c *iAMServiceClient) ExchangeCode(ctx context.Context, req *connect.Request[v1.ExchangeCodeRequest]) (*connect.Response[v1.ExchangeCodeResponse], error) {
+	return c.exchangeCode.CallUnary(ctx, req)
+}
+
+// SignUp calls iam.v1.IAMService.SignUp.
+func (c *iAMServiceClient) SignUp(ctx context.Context, req *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error) {
+	return c.signUp.CallUnary(ctx, req)
+}
+
+// SignIn calls iam.v1.IAMService.SignIn.
+func (c *iAMServiceClient) SignIn(ctx context.Context, req *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error) {
+	return c.signIn.CallUnary(ctx, req)
+}
+
+// Refresh calls iam.v1.IAMService.Refresh.
+func (c *iAMServiceClient) Refresh(ctx context.Context, req *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return c.refresh.CallUnary(ctx, req)
+}
+
+// ListSessions calls iam.v1.IAMService.ListSessions.
+func (c *iAMServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls iam.v1.IAMService.RevokeSession.
+func (c *iAMServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeSessions calls iam.v1.IAMService.RevokeSessions.
+func (c *iAMServiceClient) RevokeSessions(ctx context.Context, req *connect.Request[v1.RevokeSessionsRequest]) (*connect.Response[v1.RevokeSessionsResponse], error) {
+	return c.revokeSessions.CallUnary(ctx, req)
+}
+
+// ListIdentities calls iam.v1.IAMService.ListIdentities.
+func (c *iAMServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// LinkIdentity calls iam.v1.IAMService.LinkIdentity.
+func (c *iAMServiceClient) LinkIdentity(ctx context.Context, req *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error) {
+	return c.linkIdentity.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls iam.v1.IAMService.UnlinkIdentity.
+func (c *iAMServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// SetPassword calls iam.v1.IAMService.SetPassword.
+func (c *iAMServiceClient) SetPassword(ctx context.Context, req *connect.Request[v1.SetPasswordRequest]) (*connect.Response[v1.SetPasswordResponse], error) {
+	return c.setPassword.CallUnary(ctx, req)
+}
+
 // IAMServiceHandler is an implementation of the iam.v1.IAMService service.
 type IAMServiceHandler interface {
 	ResolveAccount(context.Context, *connect.Request[v1.ResolveAccountRequest]) (*connect.Response[v1.ResolveAccountResponse], error)
+	// ListAccounts streams a page of accounts in chunks.
 	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest], *connect.ServerStream[v1.ListAccountsResponse]) error
+	// UpdateAccountActivation takes effect immediately.
 	UpdateAccountActivation(context.Context, *connect.Request[v1.UpdateAccountActivationRequest]) (*connect.Response[v1.UpdateAccountActivationResponse], error)
+	// GrantRole & RevokeRole take effect with the next request of the account.
+	GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error)
+	RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error)
+	// ListIdentityProviders, ExchangeCode, SignUp, SignIn & Refresh are available without signing in.
+	ListIdentityProviders(context.Context, *connect.Request[v1.ListIdentityProvidersRequest]) (*connect.Response[v1.ListIdentityProvidersResponse], error)
+	// ExchangeCode turns an authorization code into a credential of the provider,
+	// the state has to come from an authorize_url listed by ListIdentityProviders to the same client.
+	// Browsers keep the attempt in an HttpOnly cookie, other clients send it back as the cookie they received.
+	ExchangeCode(context.Context, *connect.Request[v1.ExchangeCodeRequest]) (*connect.Response[v1.ExchangeCodeResponse], error)
+	// SignUp creates an inactive account with a password login. It responds the same way when
+	// the email already has an account, so callers can't tell which emails are signed up.
+	SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error)
+	// SignIn exchanges a credential of the provider for tokens of a new session.
+	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
+	// Refresh issues new tokens of the session, the refresh token may only be used once,
+	// only a refresh token replaced moments ago is accepted again, then just the access token is issued.
+	Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error)
+	// ListSessions, RevokeSession & RevokeSessions manage sessions of the caller.
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	RevokeSessions(context.Context, *connect.Request[v1.RevokeSessionsRequest]) (*connect.Response[v1.RevokeSessionsResponse], error)
+	// ListIdentities, LinkIdentity & UnlinkIdentity manage logins of the caller.
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error)
+	// UnlinkIdentity keeps at least one login of the caller.
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// SetPassword adds or replaces the password login of the caller, signed in as the email of the account.
+	SetPassword(context.Context, *connect.Request[v1.SetPasswordRequest]) (*connect.Response[v1.SetPasswordResponse], error)
 }
 
 // NewIAMServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -136,6 +397,90 @@ func NewIAMServiceHandler(svc IAMServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(iAMServiceMethods.ByName("UpdateAccountActivation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	iAMServiceGrantRoleHandler := connect.NewUnaryHandler(
+		IAMServiceGrantRoleProcedure,
+		svc.GrantRole,
+		connect.WithSchema(iAMServiceMethods.ByName("GrantRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceRevokeRoleHandler := connect.NewUnaryHandler(
+		IAMServiceRevokeRoleProcedure,
+		svc.RevokeRole,
+		connect.WithSchema(iAMServiceMethods.ByName("RevokeRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceListIdentityProvidersHandler := connect.NewUnaryHandler(
+		IAMServiceListIdentityProvidersProcedure,
+		svc.ListIdentityProviders,
+		connect.WithSchema(iAMServiceMethods.ByName("ListIdentityProviders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceExchangeCodeHandler := connect.NewUnaryHandler(
+		IAMServiceExchangeCodeProcedure,
+		svc.ExchangeCode,
+		connect.WithSchema(iAMServiceMethods.ByName("ExchangeCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceSignUpHandler := connect.NewUnaryHandler(
+		IAMServiceSignUpProcedure,
+		svc.SignUp,
+		connect.WithSchema(iAMServiceMethods.ByName("SignUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceSignInHandler := connect.NewUnaryHandler(
+		IAMServiceSignInProcedure,
+		svc.SignIn,
+		connect.WithSchema(iAMServiceMethods.ByName("SignIn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceRefreshHandler := connect.NewUnaryHandler(
+		IAMServiceRefreshProcedure,
+		svc.Refresh,
+		connect.WithSchema(iAMServiceMethods.ByName("Refresh")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceListSessionsHandler := connect.NewUnaryHandler(
+		IAMServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(iAMServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		IAMServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(iAMServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceRevokeSessionsHandler := connect.NewUnaryHandler(
+		IAMServiceRevokeSessionsProcedure,
+		svc.RevokeSessions,
+		connect.WithSchema(iAMServiceMethods.ByName("RevokeSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		IAMServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(iAMServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceLinkIdentityHandler := connect.NewUnaryHandler(
+		IAMServiceLinkIdentityProcedure,
+		svc.LinkIdentity,
+		connect.WithSchema(iAMServiceMethods.ByName("LinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		IAMServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(iAMServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	iAMServiceSetPasswordHandler := connect.NewUnaryHandler(
+		IAMServiceSetPasswordProcedure,
+		svc.SetPassword,
+		connect.WithSchema(iAMServiceMethods.ByName("SetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/iam.v1.IAMService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IAMServiceResolveAccountProcedure:
@@ -144,6 +489,34 @@ func NewIAMServiceHandler(svc IAMServiceHandler, opts ...connect.HandlerOption)
 			iAMServiceListAccountsHandler.ServeHTTP(w, r)
 		case IAMServiceUpdateAccountActivationProcedure:
 			iAMServiceUpdateAccountActivationHandler.ServeHTTP(w, r)
+		case IAMServiceGrantRoleProcedure:
+			iAMServiceGrantRoleHandler.ServeHTTP(w, r)
+		case IAMServiceRevokeRoleProcedure:
+			iAMServiceRevokeRoleHandler.ServeHTTP(w, r)
+		case IAMServiceListIdentityProvidersProcedure:
+			iAMServiceListIdentityProvidersHandler.ServeHTTP(w, r)
+		case IAMServiceExchangeCodeProcedure:
+			iAMServiceExchangeCodeHandler.ServeHTTP(w, r)
+		case IAMServiceSignUpProcedure:
+			iAMServiceSignUpHandler.ServeHTTP(w, r)
+		case IAMServiceSignInProcedure:
+			iAMServiceSignInHandler.ServeHTTP(w, r)
+		case IAMServiceRefreshProcedure:
+			iAMServiceRefreshHandler.ServeHTTP(w, r)
+		case IAMServiceListSessionsProcedure:
+			iAMServiceListSessionsHandler.ServeHTTP(w, r)
+		case IAMServiceRevokeSessionProcedure:
+			iAMServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case IAMServiceRevokeSessionsProcedure:
+			iAMServiceRevokeSessionsHandler.ServeHTTP(w, r)
+		case IAMServiceListIdentitiesProcedure:
+			iAMServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case IAMServiceLinkIdentityProcedure:
+			iAMServiceLinkIdentityHandler.ServeHTTP(w, r)
+		case IAMServiceUnlinkIdentityProcedure:
+			iAMServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case IAMServiceSetPasswordProcedure:
+			iAMServiceSetPasswordHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -164,3 +537,59 @@ func (UnimplementedIAMServiceHandler) ListAccounts(context.Context, *connect.Req
 func (UnimplementedIAMServiceHandler) UpdateAccountActivation(context.Context, *connect.Request[v1.UpdateAccountActivationRequest]) (*connect.Response[v1.UpdateAccountActivationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.UpdateAccountActivation is not implemented"))
 }
+
+func (UnimplementedIAMServiceHandler) GrantRole(context.Context, *connect.Request[v1.GrantRoleRequest]) (*connect.Response[v1.GrantRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.GrantRole is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) RevokeRole(context.Context, *connect.Request[v1.RevokeRoleRequest]) (*connect.Response[v1.RevokeRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.RevokeRole is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) ListIdentityProviders(context.Context, *connect.Request[v1.ListIdentityProvidersRequest]) (*connect.Response[v1.ListIdentityProvidersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.ListIdentityProviders is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) ExchangeCode(context.Context, *connect.Request[v1.ExchangeCodeRequest]) (*connect.Response[v1.ExchangeCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.ExchangeCode is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) SignUp(context.Context, *connect.Request[v1.SignUpRequest]) (*connect.Response[v1.SignUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.SignUp is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.SignIn is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) Refresh(context.Context, *connect.Request[v1.RefreshRequest]) (*connect.Response[v1.RefreshResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.Refresh is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.ListSessions is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) RevokeSessions(context.Context, *connect.Request[v1.RevokeSessionsRequest]) (*connect.Response[v1.RevokeSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.RevokeSessions is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.LinkIdentity is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedIAMServiceHandler) SetPassword(context.Context, *connect.Request[v1.SetPasswordRequest]) (*connect.Response[v1.SetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("iam.v1.IAMService.SetPassword is not implemented"))
+}
@@ -21,10 +21,234 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Spell_School int32
+
+const (
+	Spell_SCHOOL_UNSPECIFIED Spell_School = 0
+	Spell_SCHOOL_FIRE        Spell_School = 1
+	Spell_SCHOOL_WATER       Spell_School = 2
+	Spell_SCHOOL_AIR         Spell_School = 3
+	Spell_SCHOOL_EARTH       Spell_School = 4
+)
+
+// Enum value maps for Spell_School.
+var (
+	Spell_School_name = map[int32]string{
+		0: "SCHOOL_UNSPECIFIED",
+		1: "SCHOOL_FIRE",
+		2: "SCHOOL_WATER",
+		3: "SCHOOL_AIR",
+		4: "SCHOOL_EARTH",
+	}
+	Spell_School_value = map[string]int32{
+		"SCHOOL_UNSPECIFIED": 0,
+		"SCHOOL_FIRE":        1,
+		"SCHOOL_WATER":       2,
+		"SCHOOL_AIR":         3,
+		"SCHOOL_EARTH":       4,
+	}
+)
+
+func (x Spell_School) Enum() *Spell_School {
+	p := new(Spell_School)
+	*p = x
+	return p
+}
+
+func (x Spell_School) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Spell_School) Descriptor() protoreflect.EnumDescriptor {
+	return file_magic_v1_spell_proto_enumTypes[0].Descriptor()
+}
+
+func (Spell_School) Type() protoreflect.EnumType {
+	return &file_magic_v1_spell_proto_enumTypes[0]
+}
+
+func (x Spell_School) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Spell_School.Descriptor instead.
+func (Spell_School) EnumDescriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Spell_Scope int32
+
+const (
+	Spell_SCOPE_UNSPECIFIED Spell_Scope = 0
+	Spell_SCOPE_COMBAT      Spell_Scope = 1
+	Spell_SCOPE_ADVENTURE   Spell_Scope = 2
+)
+
+// Enum value maps for Spell_Scope.
+var (
+	Spell_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "SCOPE_COMBAT",
+		2: "SCOPE_ADVENTURE",
+	}
+	Spell_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"SCOPE_COMBAT":      1,
+		"SCOPE_ADVENTURE":   2,
+	}
+)
+
+func (x Spell_Scope) Enum() *Spell_Scope {
+	p := new(Spell_Scope)
+	*p = x
+	return p
+}
+
+func (x Spell_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Spell_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_magic_v1_spell_proto_enumTypes[1].Descriptor()
+}
+
+func (Spell_Scope) Type() protoreflect.EnumType {
+	return &file_magic_v1_spell_proto_enumTypes[1]
+}
+
+func (x Spell_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Spell_Scope.Descriptor instead.
+func (Spell_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Spell_Targeting int32
+
+const (
+	Spell_TARGETING_UNSPECIFIED Spell_Targeting = 0
+	Spell_TARGETING_CASTER      Spell_Targeting = 1 // hero casting the spell
+	Spell_TARGETING_ALLY        Spell_Targeting = 2 // friendly stack
+	Spell_TARGETING_ENEMY       Spell_Targeting = 3 // hostile stack
+	Spell_TARGETING_AREA        Spell_Targeting = 4 // every stack within radius of a hex
+)
+
+// Enum value maps for Spell_Targeting.
+var (
+	Spell_Targeting_name = map[int32]string{
+		0: "TARGETING_UNSPECIFIED",
+		1: "TARGETING_CASTER",
+		2: "TARGETING_ALLY",
+		3: "TARGETING_ENEMY",
+		4: "TARGETING_AREA",
+	}
+	Spell_Targeting_value = map[string]int32{
+		"TARGETING_UNSPECIFIED": 0,
+		"TARGETING_CASTER":      1,
+		"TARGETING_ALLY":        2,
+		"TARGETING_ENEMY":       3,
+		"TARGETING_AREA":        4,
+	}
+)
+
+func (x Spell_Targeting) Enum() *Spell_Targeting {
+	p := new(Spell_Targeting)
+	*p = x
+	return p
+}
+
+func (x Spell_Targeting) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Spell_Targeting) Descriptor() protoreflect.EnumDescriptor {
+	return file_magic_v1_spell_proto_enumTypes[2].Descriptor()
+}
+
+func (Spell_Targeting) Type() protoreflect.EnumType {
+	return &file_magic_v1_spell_proto_enumTypes[2]
+}
+
+func (x Spell_Targeting) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Spell_Targeting.Descriptor instead.
+func (Spell_Targeting) EnumDescriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2}
+}
+
+type Spell_Stat int32
+
+const (
+	Spell_STAT_UNSPECIFIED Spell_Stat = 0
+	Spell_STAT_ATTACK      Spell_Stat = 1
+	Spell_STAT_DEFENCE     Spell_Stat = 2
+	Spell_STAT_SPEED       Spell_Stat = 3
+	Spell_STAT_MORALE      Spell_Stat = 4
+	Spell_STAT_LUCK        Spell_Stat = 5
+)
+
+// Enum value maps for Spell_Stat.
+var (
+	Spell_Stat_name = map[int32]string{
+		0: "STAT_UNSPECIFIED",
+		1: "STAT_ATTACK",
+		2: "STAT_DEFENCE",
+		3: "STAT_SPEED",
+		4: "STAT_MORALE",
+		5: "STAT_LUCK",
+	}
+	Spell_Stat_value = map[string]int32{
+		"STAT_UNSPECIFIED": 0,
+		"STAT_ATTACK":      1,
+		"STAT_DEFENCE":     2,
+		"STAT_SPEED":       3,
+		"STAT_MORALE":      4,
+		"STAT_LUCK":        5,
+	}
+)
+
+func (x Spell_Stat) Enum() *Spell_Stat {
+	p := new(Spell_Stat)
+	*p = x
+	return p
+}
+
+func (x Spell_Stat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Spell_Stat) Descriptor() protoreflect.EnumDescriptor {
+	return file_magic_v1_spell_proto_enumTypes[3].Descriptor()
+}
+
+func (Spell_Stat) Type() protoreflect.EnumType {
+	return &file_magic_v1_spell_proto_enumTypes[3]
+}
+
+func (x Spell_Stat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Spell_Stat.Descriptor instead.
+func (Spell_Stat) EnumDescriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 3}
+}
+
 type Spell struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	School        Spell_School           `protobuf:"varint,3,opt,name=school,proto3,enum=magic.v1.Spell_School" json:"school,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"` // 1-5, may be modified by terrain effects
+	ManaCost      uint32                 `protobuf:"varint,5,opt,name=mana_cost,json=manaCost,proto3" json:"mana_cost,omitempty"`
+	Scope         Spell_Scope            `protobuf:"varint,6,opt,name=scope,proto3,enum=magic.v1.Spell_Scope" json:"scope,omitempty"`
+	Targeting     Spell_Targeting        `protobuf:"varint,7,opt,name=targeting,proto3,enum=magic.v1.Spell_Targeting" json:"targeting,omitempty"`
+	Radius        uint32                 `protobuf:"varint,8,opt,name=radius,proto3" json:"radius,omitempty"` // for area targeting
+	Effects       []*Spell_Effect        `protobuf:"bytes,9,rep,name=effects,proto3" json:"effects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +297,55 @@ func (x *Spell) GetTags() []string {
 	return nil
 }
 
+func (x *Spell) GetSchool() Spell_School {
+	if x != nil {
+		return x.School
+	}
+	return Spell_SCHOOL_UNSPECIFIED
+}
+
+func (x *Spell) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Spell) GetManaCost() uint32 {
+	if x != nil {
+		return x.ManaCost
+	}
+	return 0
+}
+
+func (x *Spell) GetScope() Spell_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return Spell_SCOPE_UNSPECIFIED
+}
+
+func (x *Spell) GetTargeting() Spell_Targeting {
+	if x != nil {
+		return x.Targeting
+	}
+	return Spell_TARGETING_UNSPECIFIED
+}
+
+func (x *Spell) GetRadius() uint32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *Spell) GetEffects() []*Spell_Effect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
 type Spell_Filter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	All           bool                   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
@@ -149,14 +422,389 @@ func (x *Spell_Filter) GetExcludeTags() []string {
 	return nil
 }
 
+// Amount scales with spell power of the caster & effective spell level:
+// base + per_power * spell_power + per_level * (level - 1).
+type Spell_Amount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          uint32                 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	PerPower      uint32                 `protobuf:"varint,2,opt,name=per_power,json=perPower,proto3" json:"per_power,omitempty"`
+	PerLevel      uint32                 `protobuf:"varint,3,opt,name=per_level,json=perLevel,proto3" json:"per_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Amount) Reset() {
+	*x = Spell_Amount{}
+	mi := &file_magic_v1_spell_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Amount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Amount) ProtoMessage() {}
+
+func (x *Spell_Amount) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Amount.ProtoReflect.Descriptor instead.
+func (*Spell_Amount) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Spell_Amount) GetBase() uint32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *Spell_Amount) GetPerPower() uint32 {
+	if x != nil {
+		return x.PerPower
+	}
+	return 0
+}
+
+func (x *Spell_Amount) GetPerLevel() uint32 {
+	if x != nil {
+		return x.PerLevel
+	}
+	return 0
+}
+
+type Spell_Effect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*Spell_Effect_Damage_
+	//	*Spell_Effect_Heal_
+	//	*Spell_Effect_ModifyCreatureStat_
+	//	*Spell_Effect_ModifyMovementPoints_
+	Kind          isSpell_Effect_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Effect) Reset() {
+	*x = Spell_Effect{}
+	mi := &file_magic_v1_spell_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Effect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Effect) ProtoMessage() {}
+
+func (x *Spell_Effect) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Effect.ProtoReflect.Descriptor instead.
+func (*Spell_Effect) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Spell_Effect) GetKind() isSpell_Effect_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *Spell_Effect) GetDamage() *Spell_Effect_Damage {
+	if x != nil {
+		if x, ok := x.Kind.(*Spell_Effect_Damage_); ok {
+			return x.Damage
+		}
+	}
+	return nil
+}
+
+func (x *Spell_Effect) GetHeal() *Spell_Effect_Heal {
+	if x != nil {
+		if x, ok := x.Kind.(*Spell_Effect_Heal_); ok {
+			return x.Heal
+		}
+	}
+	return nil
+}
+
+func (x *Spell_Effect) GetModifyCreatureStat() *Spell_Effect_ModifyCreatureStat {
+	if x != nil {
+		if x, ok := x.Kind.(*Spell_Effect_ModifyCreatureStat_); ok {
+			return x.ModifyCreatureStat
+		}
+	}
+	return nil
+}
+
+func (x *Spell_Effect) GetModifyMovementPoints() *Spell_Effect_ModifyMovementPoints {
+	if x != nil {
+		if x, ok := x.Kind.(*Spell_Effect_ModifyMovementPoints_); ok {
+			return x.ModifyMovementPoints
+		}
+	}
+	return nil
+}
+
+type isSpell_Effect_Kind interface {
+	isSpell_Effect_Kind()
+}
+
+type Spell_Effect_Damage_ struct {
+	Damage *Spell_Effect_Damage `protobuf:"bytes,1,opt,name=damage,proto3,oneof"`
+}
+
+type Spell_Effect_Heal_ struct {
+	Heal *Spell_Effect_Heal `protobuf:"bytes,2,opt,name=heal,proto3,oneof"`
+}
+
+type Spell_Effect_ModifyCreatureStat_ struct {
+	ModifyCreatureStat *Spell_Effect_ModifyCreatureStat `protobuf:"bytes,3,opt,name=modify_creature_stat,json=modifyCreatureStat,proto3,oneof"`
+}
+
+type Spell_Effect_ModifyMovementPoints_ struct {
+	ModifyMovementPoints *Spell_Effect_ModifyMovementPoints `protobuf:"bytes,4,opt,name=modify_movement_points,json=modifyMovementPoints,proto3,oneof"`
+}
+
+func (*Spell_Effect_Damage_) isSpell_Effect_Kind() {}
+
+func (*Spell_Effect_Heal_) isSpell_Effect_Kind() {}
+
+func (*Spell_Effect_ModifyCreatureStat_) isSpell_Effect_Kind() {}
+
+func (*Spell_Effect_ModifyMovementPoints_) isSpell_Effect_Kind() {}
+
+type Spell_Effect_Damage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *Spell_Amount          `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Effect_Damage) Reset() {
+	*x = Spell_Effect_Damage{}
+	mi := &file_magic_v1_spell_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Effect_Damage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Effect_Damage) ProtoMessage() {}
+
+func (x *Spell_Effect_Damage) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Effect_Damage.ProtoReflect.Descriptor instead.
+func (*Spell_Effect_Damage) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *Spell_Effect_Damage) GetAmount() *Spell_Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type Spell_Effect_Heal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *Spell_Amount          `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"` // restores health of the top creature, doesn't resurrect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Effect_Heal) Reset() {
+	*x = Spell_Effect_Heal{}
+	mi := &file_magic_v1_spell_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Effect_Heal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Effect_Heal) ProtoMessage() {}
+
+func (x *Spell_Effect_Heal) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Effect_Heal.ProtoReflect.Descriptor instead.
+func (*Spell_Effect_Heal) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2, 1}
+}
+
+func (x *Spell_Effect_Heal) GetAmount() *Spell_Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type Spell_Effect_ModifyCreatureStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stat          Spell_Stat             `protobuf:"varint,1,opt,name=stat,proto3,enum=magic.v1.Spell_Stat" json:"stat,omitempty"`
+	Delta         int32                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	Rounds        *Spell_Amount          `protobuf:"bytes,3,opt,name=rounds,proto3" json:"rounds,omitempty"` // duration, lasts until the end of battle if unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Effect_ModifyCreatureStat) Reset() {
+	*x = Spell_Effect_ModifyCreatureStat{}
+	mi := &file_magic_v1_spell_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Effect_ModifyCreatureStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Effect_ModifyCreatureStat) ProtoMessage() {}
+
+func (x *Spell_Effect_ModifyCreatureStat) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Effect_ModifyCreatureStat.ProtoReflect.Descriptor instead.
+func (*Spell_Effect_ModifyCreatureStat) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2, 2}
+}
+
+func (x *Spell_Effect_ModifyCreatureStat) GetStat() Spell_Stat {
+	if x != nil {
+		return x.Stat
+	}
+	return Spell_STAT_UNSPECIFIED
+}
+
+func (x *Spell_Effect_ModifyCreatureStat) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *Spell_Effect_ModifyCreatureStat) GetRounds() *Spell_Amount {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type Spell_Effect_ModifyMovementPoints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *Spell_Amount          `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spell_Effect_ModifyMovementPoints) Reset() {
+	*x = Spell_Effect_ModifyMovementPoints{}
+	mi := &file_magic_v1_spell_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spell_Effect_ModifyMovementPoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spell_Effect_ModifyMovementPoints) ProtoMessage() {}
+
+func (x *Spell_Effect_ModifyMovementPoints) ProtoReflect() protoreflect.Message {
+	mi := &file_magic_v1_spell_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spell_Effect_ModifyMovementPoints.ProtoReflect.Descriptor instead.
+func (*Spell_Effect_ModifyMovementPoints) Descriptor() ([]byte, []int) {
+	return file_magic_v1_spell_proto_rawDescGZIP(), []int{0, 2, 3}
+}
+
+func (x *Spell_Effect_ModifyMovementPoints) GetAmount() *Spell_Amount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
 var File_magic_v1_spell_proto protoreflect.FileDescriptor
 
 const file_magic_v1_spell_proto_rawDesc = "" +
 	"\n" +
-	"\x14magic/v1/spell.proto\x12\bmagic.v1\"\xd0\x01\n" +
+	"\x14magic/v1/spell.proto\x12\bmagic.v1\"\xd9\f\n" +
 	"\x05Spell\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04tags\x18\x02 \x03(\tR\x04tags\x1a\xa2\x01\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\x12.\n" +
+	"\x06school\x18\x03 \x01(\x0e2\x16.magic.v1.Spell.SchoolR\x06school\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x1b\n" +
+	"\tmana_cost\x18\x05 \x01(\rR\bmanaCost\x12+\n" +
+	"\x05scope\x18\x06 \x01(\x0e2\x15.magic.v1.Spell.ScopeR\x05scope\x127\n" +
+	"\ttargeting\x18\a \x01(\x0e2\x19.magic.v1.Spell.TargetingR\ttargeting\x12\x16\n" +
+	"\x06radius\x18\b \x01(\rR\x06radius\x120\n" +
+	"\aeffects\x18\t \x03(\v2\x16.magic.v1.Spell.EffectR\aeffects\x1a\xa2\x01\n" +
 	"\x06Filter\x12\x10\n" +
 	"\x03all\x18\x01 \x01(\bR\x03all\x12\x1f\n" +
 	"\vinclude_ids\x18\x02 \x03(\tR\n" +
@@ -164,7 +812,52 @@ const file_magic_v1_spell_proto_rawDesc = "" +
 	"\vexclude_ids\x18\x03 \x03(\tR\n" +
 	"excludeIds\x12!\n" +
 	"\finclude_tags\x18\x04 \x03(\tR\vincludeTags\x12!\n" +
-	"\fexclude_tags\x18\x05 \x03(\tR\vexcludeTagsB\x88\x01\n" +
+	"\fexclude_tags\x18\x05 \x03(\tR\vexcludeTags\x1aV\n" +
+	"\x06Amount\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\rR\x04base\x12\x1b\n" +
+	"\tper_power\x18\x02 \x01(\rR\bperPower\x12\x1b\n" +
+	"\tper_level\x18\x03 \x01(\rR\bperLevel\x1a\x81\x05\n" +
+	"\x06Effect\x127\n" +
+	"\x06damage\x18\x01 \x01(\v2\x1d.magic.v1.Spell.Effect.DamageH\x00R\x06damage\x121\n" +
+	"\x04heal\x18\x02 \x01(\v2\x1b.magic.v1.Spell.Effect.HealH\x00R\x04heal\x12]\n" +
+	"\x14modify_creature_stat\x18\x03 \x01(\v2).magic.v1.Spell.Effect.ModifyCreatureStatH\x00R\x12modifyCreatureStat\x12c\n" +
+	"\x16modify_movement_points\x18\x04 \x01(\v2+.magic.v1.Spell.Effect.ModifyMovementPointsH\x00R\x14modifyMovementPoints\x1a8\n" +
+	"\x06Damage\x12.\n" +
+	"\x06amount\x18\x01 \x01(\v2\x16.magic.v1.Spell.AmountR\x06amount\x1a6\n" +
+	"\x04Heal\x12.\n" +
+	"\x06amount\x18\x01 \x01(\v2\x16.magic.v1.Spell.AmountR\x06amount\x1a\x84\x01\n" +
+	"\x12ModifyCreatureStat\x12(\n" +
+	"\x04stat\x18\x01 \x01(\x0e2\x14.magic.v1.Spell.StatR\x04stat\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\x12.\n" +
+	"\x06rounds\x18\x03 \x01(\v2\x16.magic.v1.Spell.AmountR\x06rounds\x1aF\n" +
+	"\x14ModifyMovementPoints\x12.\n" +
+	"\x06amount\x18\x01 \x01(\v2\x16.magic.v1.Spell.AmountR\x06amountB\x06\n" +
+	"\x04kind\"e\n" +
+	"\x06School\x12\x16\n" +
+	"\x12SCHOOL_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSCHOOL_FIRE\x10\x01\x12\x10\n" +
+	"\fSCHOOL_WATER\x10\x02\x12\x0e\n" +
+	"\n" +
+	"SCHOOL_AIR\x10\x03\x12\x10\n" +
+	"\fSCHOOL_EARTH\x10\x04\"E\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fSCOPE_COMBAT\x10\x01\x12\x13\n" +
+	"\x0fSCOPE_ADVENTURE\x10\x02\"y\n" +
+	"\tTargeting\x12\x19\n" +
+	"\x15TARGETING_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TARGETING_CASTER\x10\x01\x12\x12\n" +
+	"\x0eTARGETING_ALLY\x10\x02\x12\x13\n" +
+	"\x0fTARGETING_ENEMY\x10\x03\x12\x12\n" +
+	"\x0eTARGETING_AREA\x10\x04\"o\n" +
+	"\x04Stat\x12\x14\n" +
+	"\x10STAT_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSTAT_ATTACK\x10\x01\x12\x10\n" +
+	"\fSTAT_DEFENCE\x10\x02\x12\x0e\n" +
+	"\n" +
+	"STAT_SPEED\x10\x03\x12\x0f\n" +
+	"\vSTAT_MORALE\x10\x04\x12\r\n" +
+	"\tSTAT_LUCK\x10\x05B\x88\x01\n" +
 	"\fcom.magic.v1B\n" +
 	"SpellProtoP\x01Z+github.com/openhexes/proto/magic/v1;magicv1\xa2\x02\x03MXX\xaa\x02\bMagic.V1\xca\x02\bMagic\\V1\xe2\x02\x14Magic\\V1\\GPBMetadata\xea\x02\tMagic::V1b\x06proto3"
 
@@ -180,17 +873,41 @@ func file_magic_v1_spell_proto_rawDescGZIP() []byte {
 	return file_magic_v1_spell_proto_rawDescData
 }
 
-var file_magic_v1_spell_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_magic_v1_spell_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_magic_v1_spell_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_magic_v1_spell_proto_goTypes = []any{
-	(*Spell)(nil),        // 0: magic.v1.Spell
-	(*Spell_Filter)(nil), // 1: magic.v1.Spell.Filter
+	(Spell_School)(0),                         // 0: magic.v1.Spell.School
+	(Spell_Scope)(0),                          // 1: magic.v1.Spell.Scope
+	(Spell_Targeting)(0),                      // 2: magic.v1.Spell.Targeting
+	(Spell_Stat)(0),                           // 3: magic.v1.Spell.Stat
+	(*Spell)(nil),                             // 4: magic.v1.Spell
+	(*Spell_Filter)(nil),                      // 5: magic.v1.Spell.Filter
+	(*Spell_Amount)(nil),                      // 6: magic.v1.Spell.Amount
+	(*Spell_Effect)(nil),                      // 7: magic.v1.Spell.Effect
+	(*Spell_Effect_Damage)(nil),               // 8: magic.v1.Spell.Effect.Damage
+	(*Spell_Effect_Heal)(nil),                 // 9: magic.v1.Spell.Effect.Heal
+	(*Spell_Effect_ModifyCreatureStat)(nil),   // 10: magic.v1.Spell.Effect.ModifyCreatureStat
+	(*Spell_Effect_ModifyMovementPoints)(nil), // 11: magic.v1.Spell.Effect.ModifyMovementPoints
 }
 var file_magic_v1_spell_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: magic.v1.Spell.school:type_name -> magic.v1.Spell.School
+	1,  // 1: magic.v1.Spell.scope:type_name -> magic.v1.Spell.Scope
+	2,  // 2: magic.v1.Spell.targeting:type_name -> magic.v1.Spell.Targeting
+	7,  // 3: magic.v1.Spell.effects:type_name -> magic.v1.Spell.Effect
+	8,  // 4: magic.v1.Spell.Effect.damage:type_name -> magic.v1.Spell.Effect.Damage
+	9,  // 5: magic.v1.Spell.Effect.heal:type_name -> magic.v1.Spell.Effect.Heal
+	10, // 6: magic.v1.Spell.Effect.modify_creature_stat:type_name -> magic.v1.Spell.Effect.ModifyCreatureStat
+	11, // 7: magic.v1.Spell.Effect.modify_movement_points:type_name -> magic.v1.Spell.Effect.ModifyMovementPoints
+	6,  // 8: magic.v1.Spell.Effect.Damage.amount:type_name -> magic.v1.Spell.Amount
+	6,  // 9: magic.v1.Spell.Effect.Heal.amount:type_name -> magic.v1.Spell.Amount
+	3,  // 10: magic.v1.Spell.Effect.ModifyCreatureStat.stat:type_name -> magic.v1.Spell.Stat
+	6,  // 11: magic.v1.Spell.Effect.ModifyCreatureStat.rounds:type_name -> magic.v1.Spell.Amount
+	6,  // 12: magic.v1.Spell.Effect.ModifyMovementPoints.amount:type_name -> magic.v1.Spell.Amount
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_magic_v1_spell_proto_init() }
@@ -198,18 +915,25 @@ func file_magic_v1_spell_proto_init() {
 	if File_magic_v1_spell_proto != nil {
 		return
 	}
+	file_magic_v1_spell_proto_msgTypes[3].OneofWrappers = []any{
+		(*Spell_Effect_Damage_)(nil),
+		(*Spell_Effect_Heal_)(nil),
+		(*Spell_Effect_ModifyCreatureStat_)(nil),
+		(*Spell_Effect_ModifyMovementPoints_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_magic_v1_spell_proto_rawDesc), len(file_magic_v1_spell_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_magic_v1_spell_proto_goTypes,
 		DependencyIndexes: file_magic_v1_spell_proto_depIdxs,
+		EnumInfos:         file_magic_v1_spell_proto_enumTypes,
 		MessageInfos:      file_magic_v1_spell_proto_msgTypes,
 	}.Build()
 	File_magic_v1_spell_proto = out.File
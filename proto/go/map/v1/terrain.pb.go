@@ -86,6 +86,7 @@ type Terrain struct {
 	PassableWith    []Terrain_MovementType `protobuf:"varint,4,rep,packed,name=passable_with,json=passableWith,proto3,enum=map.v1.Terrain_MovementType" json:"passable_with,omitempty"`
 	Effects         []*Terrain_Effect      `protobuf:"bytes,5,rep,name=effects,proto3" json:"effects,omitempty"`
 	RenderingSpec   *Terrain_RenderingSpec `protobuf:"bytes,6,opt,name=rendering_spec,json=renderingSpec,proto3" json:"rendering_spec,omitempty"`
+	SightCost       uint32                 `protobuf:"varint,7,opt,name=sight_cost,json=sightCost,proto3" json:"sight_cost,omitempty"` // scouting range spent looking across the tile, 1 if unset
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -162,6 +163,13 @@ func (x *Terrain) GetRenderingSpec() *Terrain_RenderingSpec {
 	return nil
 }
 
+func (x *Terrain) GetSightCost() uint32 {
+	if x != nil {
+		return x.SightCost
+	}
+	return 0
+}
+
 type Terrain_Effect struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Kind:
@@ -880,14 +888,16 @@ var File_map_v1_terrain_proto protoreflect.FileDescriptor
 
 const file_map_v1_terrain_proto_rawDesc = "" +
 	"\n" +
-	"\x14map/v1/terrain.proto\x12\x06map.v1\x1a\x1bcreatures/v1/creature.proto\x1a\x14magic/v1/spell.proto\"\xe0\x15\n" +
+	"\x14map/v1/terrain.proto\x12\x06map.v1\x1a\x1bcreatures/v1/creature.proto\x1a\x14magic/v1/spell.proto\"\xff\x15\n" +
 	"\aTerrain\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04tags\x18\x02 \x03(\tR\x04tags\x12)\n" +
 	"\x10movement_penalty\x18\x03 \x01(\rR\x0fmovementPenalty\x12A\n" +
 	"\rpassable_with\x18\x04 \x03(\x0e2\x1c.map.v1.Terrain.MovementTypeR\fpassableWith\x120\n" +
 	"\aeffects\x18\x05 \x03(\v2\x16.map.v1.Terrain.EffectR\aeffects\x12D\n" +
-	"\x0erendering_spec\x18\x06 \x01(\v2\x1d.map.v1.Terrain.RenderingSpecR\rrenderingSpec\x1a\xe2\x11\n" +
+	"\x0erendering_spec\x18\x06 \x01(\v2\x1d.map.v1.Terrain.RenderingSpecR\rrenderingSpec\x12\x1d\n" +
+	"\n" +
+	"sight_cost\x18\a \x01(\rR\tsightCost\x1a\xe2\x11\n" +
 	"\x06Effect\x12W\n" +
 	"\x12modify_spell_level\x18\x01 \x01(\v2'.map.v1.Terrain.Effect.ModifySpellLevelH\x00R\x10modifySpellLevel\x12`\n" +
 	"\x15prevent_spell_casting\x18\x02 \x01(\v2*.map.v1.Terrain.Effect.PreventSpellCastingH\x00R\x13preventSpellCasting\x12y\n" +
@@ -21,11 +21,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Tile_Visibility int32
+
+const (
+	Tile_VISIBILITY_UNSPECIFIED Tile_Visibility = 0 // no fog of war, e.g. in map editor
+	Tile_VISIBILITY_HIDDEN      Tile_Visibility = 1 // never explored, terrain is masked
+	Tile_VISIBILITY_EXPLORED    Tile_Visibility = 2 // explored before, but not seen right now
+	Tile_VISIBILITY_VISIBLE     Tile_Visibility = 3 // seen by player's heroes or towns
+)
+
+// Enum value maps for Tile_Visibility.
+var (
+	Tile_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "VISIBILITY_HIDDEN",
+		2: "VISIBILITY_EXPLORED",
+		3: "VISIBILITY_VISIBLE",
+	}
+	Tile_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"VISIBILITY_HIDDEN":      1,
+		"VISIBILITY_EXPLORED":    2,
+		"VISIBILITY_VISIBLE":     3,
+	}
+)
+
+func (x Tile_Visibility) Enum() *Tile_Visibility {
+	p := new(Tile_Visibility)
+	*p = x
+	return p
+}
+
+func (x Tile_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Tile_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_map_v1_tile_proto_enumTypes[0].Descriptor()
+}
+
+func (Tile_Visibility) Type() protoreflect.EnumType {
+	return &file_map_v1_tile_proto_enumTypes[0]
+}
+
+func (x Tile_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Tile_Visibility.Descriptor instead.
+func (Tile_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_map_v1_tile_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Connector_Kind int32
+
+const (
+	Connector_KIND_UNSPECIFIED Connector_Kind = 0
+	Connector_KIND_STAIRWAY    Connector_Kind = 1
+	Connector_KIND_PORTAL      Connector_Kind = 2
+)
+
+// Enum value maps for Connector_Kind.
+var (
+	Connector_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_STAIRWAY",
+		2: "KIND_PORTAL",
+	}
+	Connector_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_STAIRWAY":    1,
+		"KIND_PORTAL":      2,
+	}
+)
+
+func (x Connector_Kind) Enum() *Connector_Kind {
+	p := new(Connector_Kind)
+	*p = x
+	return p
+}
+
+func (x Connector_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Connector_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_map_v1_tile_proto_enumTypes[1].Descriptor()
+}
+
+func (Connector_Kind) Type() protoreflect.EnumType {
+	return &file_map_v1_tile_proto_enumTypes[1]
+}
+
+func (x Connector_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Connector_Kind.Descriptor instead.
+func (Connector_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_map_v1_tile_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type Tile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coordinate    *Tile_Coordinate       `protobuf:"bytes,1,opt,name=coordinate,proto3" json:"coordinate,omitempty"`
 	TerrainId     string                 `protobuf:"bytes,2,opt,name=terrain_id,json=terrainId,proto3" json:"terrain_id,omitempty"`
 	RenderingSpec *Tile_RenderingSpec    `protobuf:"bytes,3,opt,name=rendering_spec,json=renderingSpec,proto3" json:"rendering_spec,omitempty"`
+	Visibility    Tile_Visibility        `protobuf:"varint,4,opt,name=visibility,proto3,enum=map.v1.Tile_Visibility" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,10 +183,18 @@ func (x *Tile) GetRenderingSpec() *Tile_RenderingSpec {
 	return nil
 }
 
+func (x *Tile) GetVisibility() Tile_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Tile_VISIBILITY_UNSPECIFIED
+}
+
 type Segment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bounds        *Segment_Bounds        `protobuf:"bytes,1,opt,name=bounds,proto3" json:"bounds,omitempty"`
 	Tiles         []*Tile                `protobuf:"bytes,2,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Connectors    []*Connector           `protobuf:"bytes,3,rep,name=connectors,proto3" json:"connectors,omitempty"` // with either end on tiles of the segment
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,18 +243,95 @@ func (x *Segment) GetTiles() []*Tile {
 	return nil
 }
 
+func (x *Segment) GetConnectors() []*Connector {
+	if x != nil {
+		return x.Connectors
+	}
+	return nil
+}
+
+// Connector links tiles on different levels of a map, heroes standing on one end may step onto the other.
+type Connector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          Connector_Kind         `protobuf:"varint,1,opt,name=kind,proto3,enum=map.v1.Connector_Kind" json:"kind,omitempty"`
+	From          *Tile_Coordinate       `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            *Tile_Coordinate       `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	OneWay        bool                   `protobuf:"varint,4,opt,name=one_way,json=oneWay,proto3" json:"one_way,omitempty"` // only leads from `from` to `to`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Connector) Reset() {
+	*x = Connector{}
+	mi := &file_map_v1_tile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Connector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connector) ProtoMessage() {}
+
+func (x *Connector) ProtoReflect() protoreflect.Message {
+	mi := &file_map_v1_tile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connector.ProtoReflect.Descriptor instead.
+func (*Connector) Descriptor() ([]byte, []int) {
+	return file_map_v1_tile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Connector) GetKind() Connector_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Connector_KIND_UNSPECIFIED
+}
+
+func (x *Connector) GetFrom() *Tile_Coordinate {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Connector) GetTo() *Tile_Coordinate {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Connector) GetOneWay() bool {
+	if x != nil {
+		return x.OneWay
+	}
+	return false
+}
+
 type Grid struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SegmentRows   []*Segment_Row         `protobuf:"bytes,1,rep,name=segment_rows,json=segmentRows,proto3" json:"segment_rows,omitempty"`
 	TotalRows     uint32                 `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
 	TotalColumns  uint32                 `protobuf:"varint,3,opt,name=total_columns,json=totalColumns,proto3" json:"total_columns,omitempty"`
+	Depths        uint32                 `protobuf:"varint,4,opt,name=depths,proto3" json:"depths,omitempty"` // number of levels, segment rows of every level follow the ones of the level above
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Grid) Reset() {
 	*x = Grid{}
-	mi := &file_map_v1_tile_proto_msgTypes[2]
+	mi := &file_map_v1_tile_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +343,7 @@ func (x *Grid) String() string {
 func (*Grid) ProtoMessage() {}
 
 func (x *Grid) ProtoReflect() protoreflect.Message {
-	mi := &file_map_v1_tile_proto_msgTypes[2]
+	mi := &file_map_v1_tile_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +356,7 @@ func (x *Grid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Grid.ProtoReflect.Descriptor instead.
 func (*Grid) Descriptor() ([]byte, []int) {
-	return file_map_v1_tile_proto_rawDescGZIP(), []int{2}
+	return file_map_v1_tile_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Grid) GetSegmentRows() []*Segment_Row {
@@ -193,6 +380,13 @@ func (x *Grid) GetTotalColumns() uint32 {
 	return 0
 }
 
+func (x *Grid) GetDepths() uint32 {
+	if x != nil {
+		return x.Depths
+	}
+	return 0
+}
+
 type Tile_Coordinate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Row           uint32                 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
@@ -204,7 +398,7 @@ type Tile_Coordinate struct {
 
 func (x *Tile_Coordinate) Reset() {
 	*x = Tile_Coordinate{}
-	mi := &file_map_v1_tile_proto_msgTypes[3]
+	mi := &file_map_v1_tile_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -216,7 +410,7 @@ func (x *Tile_Coordinate) String() string {
 func (*Tile_Coordinate) ProtoMessage() {}
 
 func (x *Tile_Coordinate) ProtoReflect() protoreflect.Message {
-	mi := &file_map_v1_tile_proto_msgTypes[3]
+	mi := &file_map_v1_tile_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -262,7 +456,7 @@ type Tile_RenderingSpec struct {
 
 func (x *Tile_RenderingSpec) Reset() {
 	*x = Tile_RenderingSpec{}
-	mi := &file_map_v1_tile_proto_msgTypes[4]
+	mi := &file_map_v1_tile_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -274,7 +468,7 @@ func (x *Tile_RenderingSpec) String() string {
 func (*Tile_RenderingSpec) ProtoMessage() {}
 
 func (x *Tile_RenderingSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_map_v1_tile_proto_msgTypes[4]
+	mi := &file_map_v1_tile_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,13 +497,14 @@ type Segment_Bounds struct {
 	MaxRow        int32                  `protobuf:"varint,2,opt,name=max_row,json=maxRow,proto3" json:"max_row,omitempty"`
 	MinColumn     int32                  `protobuf:"varint,3,opt,name=min_column,json=minColumn,proto3" json:"min_column,omitempty"`
 	MaxColumn     int32                  `protobuf:"varint,4,opt,name=max_column,json=maxColumn,proto3" json:"max_column,omitempty"`
+	Depth         uint32                 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"` // level the bounds lie on
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Segment_Bounds) Reset() {
 	*x = Segment_Bounds{}
-	mi := &file_map_v1_tile_proto_msgTypes[5]
+	mi := &file_map_v1_tile_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +516,7 @@ func (x *Segment_Bounds) String() string {
 func (*Segment_Bounds) ProtoMessage() {}
 
 func (x *Segment_Bounds) ProtoReflect() protoreflect.Message {
-	mi := &file_map_v1_tile_proto_msgTypes[5]
+	mi := &file_map_v1_tile_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,6 +560,13 @@ func (x *Segment_Bounds) GetMaxColumn() int32 {
 	return 0
 }
 
+func (x *Segment_Bounds) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
 type Segment_Row struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Segments      []*Segment             `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
@@ -374,7 +576,7 @@ type Segment_Row struct {
 
 func (x *Segment_Row) Reset() {
 	*x = Segment_Row{}
-	mi := &file_map_v1_tile_proto_msgTypes[6]
+	mi := &file_map_v1_tile_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -386,7 +588,7 @@ func (x *Segment_Row) String() string {
 func (*Segment_Row) ProtoMessage() {}
 
 func (x *Segment_Row) ProtoReflect() protoreflect.Message {
-	mi := &file_map_v1_tile_proto_msgTypes[6]
+	mi := &file_map_v1_tile_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,14 +615,17 @@ var File_map_v1_tile_proto protoreflect.FileDescriptor
 
 const file_map_v1_tile_proto_rawDesc = "" +
 	"\n" +
-	"\x11map/v1/tile.proto\x12\x06map.v1\"\xa1\x02\n" +
+	"\x11map/v1/tile.proto\x12\x06map.v1\"\xcc\x03\n" +
 	"\x04Tile\x127\n" +
 	"\n" +
 	"coordinate\x18\x01 \x01(\v2\x17.map.v1.Tile.CoordinateR\n" +
 	"coordinate\x12\x1d\n" +
 	"\n" +
 	"terrain_id\x18\x02 \x01(\tR\tterrainId\x12A\n" +
-	"\x0erendering_spec\x18\x03 \x01(\v2\x1a.map.v1.Tile.RenderingSpecR\rrenderingSpec\x1aL\n" +
+	"\x0erendering_spec\x18\x03 \x01(\v2\x1a.map.v1.Tile.RenderingSpecR\rrenderingSpec\x127\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2\x17.map.v1.Tile.VisibilityR\n" +
+	"visibility\x1aL\n" +
 	"\n" +
 	"Coordinate\x12\x10\n" +
 	"\x03row\x18\x01 \x01(\rR\x03row\x12\x16\n" +
@@ -428,24 +633,44 @@ const file_map_v1_tile_proto_rawDesc = "" +
 	"\x05depth\x18\x03 \x01(\rR\x05depth\x1a0\n" +
 	"\rRenderingSpec\x12\x1f\n" +
 	"\vfeature_ids\x18\x01 \x03(\tR\n" +
-	"featureIds\"\x8b\x02\n" +
+	"featureIds\"p\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11VISIBILITY_HIDDEN\x10\x01\x12\x17\n" +
+	"\x13VISIBILITY_EXPLORED\x10\x02\x12\x16\n" +
+	"\x12VISIBILITY_VISIBLE\x10\x03\"\xd5\x02\n" +
 	"\aSegment\x12.\n" +
 	"\x06bounds\x18\x01 \x01(\v2\x16.map.v1.Segment.BoundsR\x06bounds\x12\"\n" +
-	"\x05tiles\x18\x02 \x03(\v2\f.map.v1.TileR\x05tiles\x1ax\n" +
+	"\x05tiles\x18\x02 \x03(\v2\f.map.v1.TileR\x05tiles\x121\n" +
+	"\n" +
+	"connectors\x18\x03 \x03(\v2\x11.map.v1.ConnectorR\n" +
+	"connectors\x1a\x8e\x01\n" +
 	"\x06Bounds\x12\x17\n" +
 	"\amin_row\x18\x01 \x01(\x05R\x06minRow\x12\x17\n" +
 	"\amax_row\x18\x02 \x01(\x05R\x06maxRow\x12\x1d\n" +
 	"\n" +
 	"min_column\x18\x03 \x01(\x05R\tminColumn\x12\x1d\n" +
 	"\n" +
-	"max_column\x18\x04 \x01(\x05R\tmaxColumn\x1a2\n" +
+	"max_column\x18\x04 \x01(\x05R\tmaxColumn\x12\x14\n" +
+	"\x05depth\x18\x05 \x01(\rR\x05depth\x1a2\n" +
 	"\x03Row\x12+\n" +
-	"\bsegments\x18\x01 \x03(\v2\x0f.map.v1.SegmentR\bsegments\"\x82\x01\n" +
+	"\bsegments\x18\x01 \x03(\v2\x0f.map.v1.SegmentR\bsegments\"\xe8\x01\n" +
+	"\tConnector\x12*\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x16.map.v1.Connector.KindR\x04kind\x12+\n" +
+	"\x04from\x18\x02 \x01(\v2\x17.map.v1.Tile.CoordinateR\x04from\x12'\n" +
+	"\x02to\x18\x03 \x01(\v2\x17.map.v1.Tile.CoordinateR\x02to\x12\x17\n" +
+	"\aone_way\x18\x04 \x01(\bR\x06oneWay\"@\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rKIND_STAIRWAY\x10\x01\x12\x0f\n" +
+	"\vKIND_PORTAL\x10\x02\"\x9a\x01\n" +
 	"\x04Grid\x126\n" +
 	"\fsegment_rows\x18\x01 \x03(\v2\x13.map.v1.Segment.RowR\vsegmentRows\x12\x1d\n" +
 	"\n" +
 	"total_rows\x18\x02 \x01(\rR\ttotalRows\x12#\n" +
-	"\rtotal_columns\x18\x03 \x01(\rR\ftotalColumnsBy\n" +
+	"\rtotal_columns\x18\x03 \x01(\rR\ftotalColumns\x12\x16\n" +
+	"\x06depths\x18\x04 \x01(\rR\x06depthsBy\n" +
 	"\n" +
 	"com.map.v1B\tTileProtoP\x01Z'github.com/openhexes/proto/map/v1;mapv1\xa2\x02\x03MXX\xaa\x02\x06Map.V1\xca\x02\x06Map\\V1\xe2\x02\x12Map\\V1\\GPBMetadata\xea\x02\aMap::V1b\x06proto3"
 
@@ -461,28 +686,37 @@ func file_map_v1_tile_proto_rawDescGZIP() []byte {
 	return file_map_v1_tile_proto_rawDescData
 }
 
-var file_map_v1_tile_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_map_v1_tile_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_map_v1_tile_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_map_v1_tile_proto_goTypes = []any{
-	(*Tile)(nil),               // 0: map.v1.Tile
-	(*Segment)(nil),            // 1: map.v1.Segment
-	(*Grid)(nil),               // 2: map.v1.Grid
-	(*Tile_Coordinate)(nil),    // 3: map.v1.Tile.Coordinate
-	(*Tile_RenderingSpec)(nil), // 4: map.v1.Tile.RenderingSpec
-	(*Segment_Bounds)(nil),     // 5: map.v1.Segment.Bounds
-	(*Segment_Row)(nil),        // 6: map.v1.Segment.Row
+	(Tile_Visibility)(0),       // 0: map.v1.Tile.Visibility
+	(Connector_Kind)(0),        // 1: map.v1.Connector.Kind
+	(*Tile)(nil),               // 2: map.v1.Tile
+	(*Segment)(nil),            // 3: map.v1.Segment
+	(*Connector)(nil),          // 4: map.v1.Connector
+	(*Grid)(nil),               // 5: map.v1.Grid
+	(*Tile_Coordinate)(nil),    // 6: map.v1.Tile.Coordinate
+	(*Tile_RenderingSpec)(nil), // 7: map.v1.Tile.RenderingSpec
+	(*Segment_Bounds)(nil),     // 8: map.v1.Segment.Bounds
+	(*Segment_Row)(nil),        // 9: map.v1.Segment.Row
 }
 var file_map_v1_tile_proto_depIdxs = []int32{
-	3, // 0: map.v1.Tile.coordinate:type_name -> map.v1.Tile.Coordinate
-	4, // 1: map.v1.Tile.rendering_spec:type_name -> map.v1.Tile.RenderingSpec
-	5, // 2: map.v1.Segment.bounds:type_name -> map.v1.Segment.Bounds
-	0, // 3: map.v1.Segment.tiles:type_name -> map.v1.Tile
-	6, // 4: map.v1.Grid.segment_rows:type_name -> map.v1.Segment.Row
-	1, // 5: map.v1.Segment.Row.segments:type_name -> map.v1.Segment
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	6,  // 0: map.v1.Tile.coordinate:type_name -> map.v1.Tile.Coordinate
+	7,  // 1: map.v1.Tile.rendering_spec:type_name -> map.v1.Tile.RenderingSpec
+	0,  // 2: map.v1.Tile.visibility:type_name -> map.v1.Tile.Visibility
+	8,  // 3: map.v1.Segment.bounds:type_name -> map.v1.Segment.Bounds
+	2,  // 4: map.v1.Segment.tiles:type_name -> map.v1.Tile
+	4,  // 5: map.v1.Segment.connectors:type_name -> map.v1.Connector
+	1,  // 6: map.v1.Connector.kind:type_name -> map.v1.Connector.Kind
+	6,  // 7: map.v1.Connector.from:type_name -> map.v1.Tile.Coordinate
+	6,  // 8: map.v1.Connector.to:type_name -> map.v1.Tile.Coordinate
+	9,  // 9: map.v1.Grid.segment_rows:type_name -> map.v1.Segment.Row
+	3,  // 10: map.v1.Segment.Row.segments:type_name -> map.v1.Segment
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_map_v1_tile_proto_init() }
@@ -495,13 +729,14 @@ func file_map_v1_tile_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_map_v1_tile_proto_rawDesc), len(file_map_v1_tile_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_map_v1_tile_proto_goTypes,
 		DependencyIndexes: file_map_v1_tile_proto_depIdxs,
+		EnumInfos:         file_map_v1_tile_proto_enumTypes,
 		MessageInfos:      file_map_v1_tile_proto_msgTypes,
 	}.Build()
 	File_map_v1_tile_proto = out.File
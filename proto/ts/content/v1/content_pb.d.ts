@@ -0,0 +1,250 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file content/v1/content.proto (package content.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Terrain } from "../../map/v1/terrain_pb";
+import type { Creature_Kind } from "../../creatures/v1/creature_pb";
+import type { Spell } from "../../magic/v1/spell_pb";
+import type { Town_Kind } from "../../towns/v1/town_pb";
+import type { Resource } from "../../economy/v1/economy_pb";
+import type { Object_Kind } from "../../objects/v1/object_pb";
+
+/**
+ * Describes the file content/v1/content.proto.
+ */
+export declare const file_content_v1_content: GenFile;
+
+/**
+ * Manifest describes a content pack, stored as manifest.json in the root of pack directory or zip archive.
+ *
+ * @generated from message content.v1.Manifest
+ */
+export declare type Manifest = Message<"content.v1.Manifest"> & {
+  /**
+   * unique among packs, e.g. "core"
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string version = 2;
+   */
+  version: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * ids of packs loaded before this one, their definitions may be overridden
+   *
+   * @generated from field: repeated string dependencies = 5;
+   */
+  dependencies: string[];
+};
+
+/**
+ * Describes the message content.v1.Manifest.
+ * Use `create(ManifestSchema)` to create a new message.
+ */
+export declare const ManifestSchema: GenMessage<Manifest>;
+
+/**
+ * Bundle is the format of content data files, any number of them may be placed in a pack.
+ *
+ * @generated from message content.v1.Bundle
+ */
+export declare type Bundle = Message<"content.v1.Bundle"> & {
+  /**
+   * format version, currently 1
+   *
+   * @generated from field: uint32 version = 1;
+   */
+  version: number;
+
+  /**
+   * new definitions, ids must be unique across all packs
+   *
+   * @generated from field: repeated map.v1.Terrain terrains = 2;
+   */
+  terrains: Terrain[];
+
+  /**
+   * @generated from field: repeated creatures.v1.Creature.Kind creatures = 3;
+   */
+  creatures: Creature_Kind[];
+
+  /**
+   * @generated from field: repeated magic.v1.Spell spells = 4;
+   */
+  spells: Spell[];
+
+  /**
+   * @generated from field: repeated towns.v1.Town.Kind towns = 7;
+   */
+  towns: Town_Kind[];
+
+  /**
+   * @generated from field: repeated economy.v1.Resource resources = 8;
+   */
+  resources: Resource[];
+
+  /**
+   * @generated from field: repeated objects.v1.Object.Kind objects = 9;
+   */
+  objects: Object_Kind[];
+
+  /**
+   * overrides of definitions from dependencies, matched by id
+   *
+   * replace whole definitions
+   *
+   * @generated from field: content.v1.Bundle.Definitions replace = 5;
+   */
+  replace?: Bundle_Definitions;
+
+  /**
+   * merged into definitions, repeated fields are appended
+   *
+   * @generated from field: content.v1.Bundle.Definitions patch = 6;
+   */
+  patch?: Bundle_Definitions;
+};
+
+/**
+ * Describes the message content.v1.Bundle.
+ * Use `create(BundleSchema)` to create a new message.
+ */
+export declare const BundleSchema: GenMessage<Bundle>;
+
+/**
+ * @generated from message content.v1.Bundle.Definitions
+ */
+export declare type Bundle_Definitions = Message<"content.v1.Bundle.Definitions"> & {
+  /**
+   * @generated from field: repeated map.v1.Terrain terrains = 1;
+   */
+  terrains: Terrain[];
+
+  /**
+   * @generated from field: repeated creatures.v1.Creature.Kind creatures = 2;
+   */
+  creatures: Creature_Kind[];
+
+  /**
+   * @generated from field: repeated magic.v1.Spell spells = 3;
+   */
+  spells: Spell[];
+
+  /**
+   * @generated from field: repeated towns.v1.Town.Kind towns = 4;
+   */
+  towns: Town_Kind[];
+
+  /**
+   * @generated from field: repeated economy.v1.Resource resources = 5;
+   */
+  resources: Resource[];
+
+  /**
+   * @generated from field: repeated objects.v1.Object.Kind objects = 6;
+   */
+  objects: Object_Kind[];
+};
+
+/**
+ * Describes the message content.v1.Bundle.Definitions.
+ * Use `create(Bundle_DefinitionsSchema)` to create a new message.
+ */
+export declare const Bundle_DefinitionsSchema: GenMessage<Bundle_Definitions>;
+
+/**
+ * @generated from message content.v1.GetContentRequest
+ */
+export declare type GetContentRequest = Message<"content.v1.GetContentRequest"> & {
+};
+
+/**
+ * Describes the message content.v1.GetContentRequest.
+ * Use `create(GetContentRequestSchema)` to create a new message.
+ */
+export declare const GetContentRequestSchema: GenMessage<GetContentRequest>;
+
+/**
+ * @generated from message content.v1.GetContentResponse
+ */
+export declare type GetContentResponse = Message<"content.v1.GetContentResponse"> & {
+  /**
+   * @generated from field: repeated map.v1.Terrain terrains = 1;
+   */
+  terrains: Terrain[];
+
+  /**
+   * @generated from field: repeated creatures.v1.Creature.Kind creatures = 2;
+   */
+  creatures: Creature_Kind[];
+
+  /**
+   * @generated from field: repeated magic.v1.Spell spells = 3;
+   */
+  spells: Spell[];
+
+  /**
+   * @generated from field: repeated towns.v1.Town.Kind towns = 6;
+   */
+  towns: Town_Kind[];
+
+  /**
+   * @generated from field: repeated economy.v1.Resource resources = 7;
+   */
+  resources: Resource[];
+
+  /**
+   * @generated from field: repeated objects.v1.Object.Kind objects = 8;
+   */
+  objects: Object_Kind[];
+
+  /**
+   * in load order
+   *
+   * @generated from field: repeated content.v1.Manifest packs = 4;
+   */
+  packs: Manifest[];
+
+  /**
+   * send back in Hexes-Content-Checksum header to detect mismatched content
+   *
+   * @generated from field: string checksum = 5;
+   */
+  checksum: string;
+};
+
+/**
+ * Describes the message content.v1.GetContentResponse.
+ * Use `create(GetContentResponseSchema)` to create a new message.
+ */
+export declare const GetContentResponseSchema: GenMessage<GetContentResponse>;
+
+/**
+ * @generated from service content.v1.ContentService
+ */
+export declare const ContentService: GenService<{
+  /**
+   * @generated from rpc content.v1.ContentService.GetContent
+   */
+  getContent: {
+    methodKind: "unary";
+    input: typeof GetContentRequestSchema;
+    output: typeof GetContentResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,59 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file content/v1/content.proto (package content.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_creatures_v1_creature } from "../../creatures/v1/creature_pb";
+import { file_economy_v1_economy } from "../../economy/v1/economy_pb";
+import { file_magic_v1_spell } from "../../magic/v1/spell_pb";
+import { file_map_v1_terrain } from "../../map/v1/terrain_pb";
+import { file_objects_v1_object } from "../../objects/v1/object_pb";
+import { file_towns_v1_town } from "../../towns/v1/town_pb";
+
+/**
+ * Describes the file content/v1/content.proto.
+ */
+export const file_content_v1_content = /*@__PURE__*/
+  fileDesc("Chhjb250ZW50L3YxL2NvbnRlbnQucHJvdG8SCmNvbnRlbnQudjEiYQoITWFuaWZlc3QSCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoCRINCgV0aXRsZRgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRIUCgxkZXBlbmRlbmNpZXMYBSADKAki3wQKBkJ1bmRsZRIPCgd2ZXJzaW9uGAEgASgNEiEKCHRlcnJhaW5zGAIgAygLMg8ubWFwLnYxLlRlcnJhaW4SLgoJY3JlYXR1cmVzGAMgAygLMhsuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLktpbmQSHwoGc3BlbGxzGAQgAygLMg8ubWFnaWMudjEuU3BlbGwSIgoFdG93bnMYByADKAsyEy50b3ducy52MS5Ub3duLktpbmQSJwoJcmVzb3VyY2VzGAggAygLMhQuZWNvbm9teS52MS5SZXNvdXJjZRIoCgdvYmplY3RzGAkgAygLMhcub2JqZWN0cy52MS5PYmplY3QuS2luZBIvCgdyZXBsYWNlGAUgASgLMh4uY29udGVudC52MS5CdW5kbGUuRGVmaW5pdGlvbnMSLQoFcGF0Y2gYBiABKAsyHi5jb250ZW50LnYxLkJ1bmRsZS5EZWZpbml0aW9ucxr4AQoLRGVmaW5pdGlvbnMSIQoIdGVycmFpbnMYASADKAsyDy5tYXAudjEuVGVycmFpbhIuCgljcmVhdHVyZXMYAiADKAsyGy5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZBIfCgZzcGVsbHMYAyADKAsyDy5tYWdpYy52MS5TcGVsbBIiCgV0b3ducxgEIAMoCzITLnRvd25zLnYxLlRvd24uS2luZBInCglyZXNvdXJjZXMYBSADKAsyFC5lY29ub215LnYxLlJlc291cmNlEigKB29iamVjdHMYBiADKAsyFy5vYmplY3RzLnYxLk9iamVjdC5LaW5kIhMKEUdldENvbnRlbnRSZXF1ZXN0IrYCChJHZXRDb250ZW50UmVzcG9uc2USIQoIdGVycmFpbnMYASADKAsyDy5tYXAudjEuVGVycmFpbhIuCgljcmVhdHVyZXMYAiADKAsyGy5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZBIfCgZzcGVsbHMYAyADKAsyDy5tYWdpYy52MS5TcGVsbBIiCgV0b3ducxgGIAMoCzITLnRvd25zLnYxLlRvd24uS2luZBInCglyZXNvdXJjZXMYByADKAsyFC5lY29ub215LnYxLlJlc291cmNlEigKB29iamVjdHMYCCADKAsyFy5vYmplY3RzLnYxLk9iamVjdC5LaW5kEiMKBXBhY2tzGAQgAygLMhQuY29udGVudC52MS5NYW5pZmVzdBIQCghjaGVja3N1bRgFIAEoCTJdCg5Db250ZW50U2VydmljZRJLCgpHZXRDb250ZW50Eh0uY29udGVudC52MS5HZXRDb250ZW50UmVxdWVzdBoeLmNvbnRlbnQudjEuR2V0Q29udGVudFJlc3BvbnNlQpgBCg5jb20uY29udGVudC52MUIMQ29udGVudFByb3RvUAFaL2dpdGh1Yi5jb20vb3BlbmhleGVzL3Byb3RvL2NvbnRlbnQvdjE7Y29udGVudHYxogIDQ1hYqgIKQ29udGVudC5WMcoCCkNvbnRlbnRcVjHiAhZDb250ZW50XFYxXEdQQk1ldGFkYXRh6gILQ29udGVudDo6VjFiBnByb3RvMw", [file_creatures_v1_creature, file_economy_v1_economy, file_magic_v1_spell, file_map_v1_terrain, file_objects_v1_object, file_towns_v1_town]);
+
+/**
+ * Describes the message content.v1.Manifest.
+ * Use `create(ManifestSchema)` to create a new message.
+ */
+export const ManifestSchema = /*@__PURE__*/
+  messageDesc(file_content_v1_content, 0);
+
+/**
+ * Describes the message content.v1.Bundle.
+ * Use `create(BundleSchema)` to create a new message.
+ */
+export const BundleSchema = /*@__PURE__*/
+  messageDesc(file_content_v1_content, 1);
+
+/**
+ * Describes the message content.v1.Bundle.Definitions.
+ * Use `create(Bundle_DefinitionsSchema)` to create a new message.
+ */
+export const Bundle_DefinitionsSchema = /*@__PURE__*/
+  messageDesc(file_content_v1_content, 1, 0);
+
+/**
+ * Describes the message content.v1.GetContentRequest.
+ * Use `create(GetContentRequestSchema)` to create a new message.
+ */
+export const GetContentRequestSchema = /*@__PURE__*/
+  messageDesc(file_content_v1_content, 2);
+
+/**
+ * Describes the message content.v1.GetContentResponse.
+ * Use `create(GetContentResponseSchema)` to create a new message.
+ */
+export const GetContentResponseSchema = /*@__PURE__*/
+  messageDesc(file_content_v1_content, 3);
+
+/**
+ * @generated from service content.v1.ContentService
+ */
+export const ContentService = /*@__PURE__*/
+  serviceDesc(file_content_v1_content, 0);
+
@@ -48,6 +48,68 @@ export declare type Creature_AttributeModification = Message<"creatures.v1.Creat
  */
 export declare const Creature_AttributeModificationSchema: GenMessage<Creature_AttributeModification>;
 
+/**
+ * Stats are base values of a single creature, before terrain effects & hero bonuses.
+ *
+ * @generated from message creatures.v1.Creature.Stats
+ */
+export declare type Creature_Stats = Message<"creatures.v1.Creature.Stats"> & {
+  /**
+   * @generated from field: int32 attack = 1;
+   */
+  attack: number;
+
+  /**
+   * @generated from field: int32 defence = 2;
+   */
+  defence: number;
+
+  /**
+   * @generated from field: uint32 min_damage = 3;
+   */
+  minDamage: number;
+
+  /**
+   * @generated from field: uint32 max_damage = 4;
+   */
+  maxDamage: number;
+
+  /**
+   * @generated from field: uint32 health = 5;
+   */
+  health: number;
+
+  /**
+   * hexes per turn on the battlefield
+   *
+   * @generated from field: int32 speed = 6;
+   */
+  speed: number;
+
+  /**
+   * ranged attacks per battle, zero for melee-only creatures
+   *
+   * @generated from field: uint32 shots = 7;
+   */
+  shots: number;
+
+  /**
+   * @generated from field: int32 morale = 8;
+   */
+  morale: number;
+
+  /**
+   * @generated from field: int32 luck = 9;
+   */
+  luck: number;
+};
+
+/**
+ * Describes the message creatures.v1.Creature.Stats.
+ * Use `create(Creature_StatsSchema)` to create a new message.
+ */
+export declare const Creature_StatsSchema: GenMessage<Creature_Stats>;
+
 /**
  * @generated from message creatures.v1.Creature.Kind
  */
@@ -71,6 +133,11 @@ export declare type Creature_Kind = Message<"creatures.v1.Creature.Kind"> & {
    * @generated from field: repeated string native_terrains = 4;
    */
   nativeTerrains: string[];
+
+  /**
+   * @generated from field: creatures.v1.Creature.Stats stats = 5;
+   */
+  stats?: Creature_Stats;
 };
 
 /**
@@ -0,0 +1,752 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file economy/v1/economy.proto (package economy.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Tile_Coordinate } from "../../map/v1/tile_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file economy/v1/economy.proto.
+ */
+export declare const file_economy_v1_economy: GenFile;
+
+/**
+ * Resource is a kind of currency players collect & spend, e.g. gold or wood.
+ *
+ * @generated from message economy.v1.Resource
+ */
+export declare type Resource = Message<"economy.v1.Resource"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[];
+
+  /**
+   * worth in gold, defines marketplace exchange rates
+   *
+   * @generated from field: uint32 value = 3;
+   */
+  value: number;
+
+  /**
+   * granted to every player when a game starts
+   *
+   * @generated from field: uint64 starting_amount = 4;
+   */
+  startingAmount: bigint;
+};
+
+/**
+ * Describes the message economy.v1.Resource.
+ * Use `create(ResourceSchema)` to create a new message.
+ */
+export declare const ResourceSchema: GenMessage<Resource>;
+
+/**
+ * Treasury keeps resources of a player within a game.
+ *
+ * @generated from message economy.v1.Treasury
+ */
+export declare type Treasury = Message<"economy.v1.Treasury"> & {
+  /**
+   * resource id -> amount
+   *
+   * @generated from field: map<string, uint64> amounts = 1;
+   */
+  amounts: { [key: string]: bigint };
+};
+
+/**
+ * Describes the message economy.v1.Treasury.
+ * Use `create(TreasurySchema)` to create a new message.
+ */
+export declare const TreasurySchema: GenMessage<Treasury>;
+
+/**
+ * Site is a source of resources placed on a tile.
+ *
+ * @generated from message economy.v1.Site
+ */
+export declare type Site = Message<"economy.v1.Site"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: economy.v1.Site.Kind kind = 3;
+   */
+  kind: Site_Kind;
+
+  /**
+   * @generated from field: string resource_id = 4;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: uint32 amount = 5;
+   */
+  amount: number;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate position = 6;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * mines only, empty until visited
+   *
+   * @generated from field: string owner_id = 7;
+   */
+  ownerId: string;
+};
+
+/**
+ * Describes the message economy.v1.Site.
+ * Use `create(SiteSchema)` to create a new message.
+ */
+export declare const SiteSchema: GenMessage<Site>;
+
+/**
+ * @generated from enum economy.v1.Site.Kind
+ */
+export enum Site_Kind {
+  /**
+   * @generated from enum value: KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * yields amount every day to the player whose hero visited it last
+   *
+   * @generated from enum value: KIND_MINE = 1;
+   */
+  MINE = 1,
+
+  /**
+   * yields amount once to the first hero stepping on it, then disappears
+   *
+   * @generated from enum value: KIND_PICKUP = 2;
+   */
+  PICKUP = 2,
+}
+
+/**
+ * Describes the enum economy.v1.Site.Kind.
+ */
+export declare const Site_KindSchema: GenEnum<Site_Kind>;
+
+/**
+ * Transaction is an entry of the ledger recording every change of player treasuries.
+ *
+ * @generated from message economy.v1.Transaction
+ */
+export declare type Transaction = Message<"economy.v1.Transaction"> & {
+  /**
+   * increasing, used as a cursor
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId: string;
+
+  /**
+   * @generated from field: uint32 day = 3;
+   */
+  day: number;
+
+  /**
+   * @generated from field: string resource_id = 4;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: int64 delta = 5;
+   */
+  delta: bigint;
+
+  /**
+   * after the transaction
+   *
+   * @generated from field: uint64 balance = 6;
+   */
+  balance: bigint;
+
+  /**
+   * e.g. "income", "build" or "trade"
+   *
+   * @generated from field: string reason = 7;
+   */
+  reason: string;
+
+  /**
+   * id of a town, site or trade the transaction comes from, links both sides of an exchange
+   *
+   * @generated from field: string reference = 8;
+   */
+  reference: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 9;
+   */
+  time?: Timestamp;
+};
+
+/**
+ * Describes the message economy.v1.Transaction.
+ * Use `create(TransactionSchema)` to create a new message.
+ */
+export declare const TransactionSchema: GenMessage<Transaction>;
+
+/**
+ * Trade is an offer to exchange resources between two players.
+ *
+ * @generated from message economy.v1.Trade
+ */
+export declare type Trade = Message<"economy.v1.Trade"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * proposer
+   *
+   * @generated from field: string from_id = 3;
+   */
+  fromId: string;
+
+  /**
+   * recipient
+   *
+   * @generated from field: string to_id = 4;
+   */
+  toId: string;
+
+  /**
+   * resources offered by the proposer
+   *
+   * @generated from field: map<string, uint64> give = 5;
+   */
+  give: { [key: string]: bigint };
+
+  /**
+   * resources requested in return
+   *
+   * @generated from field: map<string, uint64> take = 6;
+   */
+  take: { [key: string]: bigint };
+
+  /**
+   * @generated from field: economy.v1.Trade.State state = 7;
+   */
+  state: Trade_State;
+
+  /**
+   * when proposed
+   *
+   * @generated from field: uint32 day = 8;
+   */
+  day: number;
+};
+
+/**
+ * Describes the message economy.v1.Trade.
+ * Use `create(TradeSchema)` to create a new message.
+ */
+export declare const TradeSchema: GenMessage<Trade>;
+
+/**
+ * @generated from enum economy.v1.Trade.State
+ */
+export enum Trade_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: STATE_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * by the recipient
+   *
+   * @generated from enum value: STATE_DECLINED = 3;
+   */
+  DECLINED = 3,
+
+  /**
+   * by the proposer
+   *
+   * @generated from enum value: STATE_CANCELLED = 4;
+   */
+  CANCELLED = 4,
+}
+
+/**
+ * Describes the enum economy.v1.Trade.State.
+ */
+export declare const Trade_StateSchema: GenEnum<Trade_State>;
+
+/**
+ * @generated from message economy.v1.GetTreasuryRequest
+ */
+export declare type GetTreasuryRequest = Message<"economy.v1.GetTreasuryRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message economy.v1.GetTreasuryRequest.
+ * Use `create(GetTreasuryRequestSchema)` to create a new message.
+ */
+export declare const GetTreasuryRequestSchema: GenMessage<GetTreasuryRequest>;
+
+/**
+ * @generated from message economy.v1.GetTreasuryResponse
+ */
+export declare type GetTreasuryResponse = Message<"economy.v1.GetTreasuryResponse"> & {
+  /**
+   * @generated from field: economy.v1.Treasury treasury = 1;
+   */
+  treasury?: Treasury;
+
+  /**
+   * expected at the start of next day
+   *
+   * @generated from field: map<string, uint64> income = 2;
+   */
+  income: { [key: string]: bigint };
+};
+
+/**
+ * Describes the message economy.v1.GetTreasuryResponse.
+ * Use `create(GetTreasuryResponseSchema)` to create a new message.
+ */
+export declare const GetTreasuryResponseSchema: GenMessage<GetTreasuryResponse>;
+
+/**
+ * @generated from message economy.v1.ListSitesRequest
+ */
+export declare type ListSitesRequest = Message<"economy.v1.ListSitesRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * sites placed on a map of the caller instead of a game
+   *
+   * @generated from field: string map_id = 2;
+   */
+  mapId: string;
+};
+
+/**
+ * Describes the message economy.v1.ListSitesRequest.
+ * Use `create(ListSitesRequestSchema)` to create a new message.
+ */
+export declare const ListSitesRequestSchema: GenMessage<ListSitesRequest>;
+
+/**
+ * @generated from message economy.v1.ListSitesResponse
+ */
+export declare type ListSitesResponse = Message<"economy.v1.ListSitesResponse"> & {
+  /**
+   * on tiles explored by the caller
+   *
+   * @generated from field: repeated economy.v1.Site sites = 1;
+   */
+  sites: Site[];
+};
+
+/**
+ * Describes the message economy.v1.ListSitesResponse.
+ * Use `create(ListSitesResponseSchema)` to create a new message.
+ */
+export declare const ListSitesResponseSchema: GenMessage<ListSitesResponse>;
+
+/**
+ * @generated from message economy.v1.PlaceSiteRequest
+ */
+export declare type PlaceSiteRequest = Message<"economy.v1.PlaceSiteRequest"> & {
+  /**
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: economy.v1.Site.Kind kind = 2;
+   */
+  kind: Site_Kind;
+
+  /**
+   * @generated from field: string resource_id = 3;
+   */
+  resourceId: string;
+
+  /**
+   * @generated from field: uint32 amount = 4;
+   */
+  amount: number;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate position = 5;
+   */
+  position?: Tile_Coordinate;
+};
+
+/**
+ * Describes the message economy.v1.PlaceSiteRequest.
+ * Use `create(PlaceSiteRequestSchema)` to create a new message.
+ */
+export declare const PlaceSiteRequestSchema: GenMessage<PlaceSiteRequest>;
+
+/**
+ * @generated from message economy.v1.PlaceSiteResponse
+ */
+export declare type PlaceSiteResponse = Message<"economy.v1.PlaceSiteResponse"> & {
+  /**
+   * @generated from field: economy.v1.Site site = 1;
+   */
+  site?: Site;
+};
+
+/**
+ * Describes the message economy.v1.PlaceSiteResponse.
+ * Use `create(PlaceSiteResponseSchema)` to create a new message.
+ */
+export declare const PlaceSiteResponseSchema: GenMessage<PlaceSiteResponse>;
+
+/**
+ * @generated from message economy.v1.ListTransactionsRequest
+ */
+export declare type ListTransactionsRequest = Message<"economy.v1.ListTransactionsRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * defaults to 100
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * only older transactions, for pagination
+   *
+   * @generated from field: int64 before_id = 3;
+   */
+  beforeId: bigint;
+};
+
+/**
+ * Describes the message economy.v1.ListTransactionsRequest.
+ * Use `create(ListTransactionsRequestSchema)` to create a new message.
+ */
+export declare const ListTransactionsRequestSchema: GenMessage<ListTransactionsRequest>;
+
+/**
+ * @generated from message economy.v1.ListTransactionsResponse
+ */
+export declare type ListTransactionsResponse = Message<"economy.v1.ListTransactionsResponse"> & {
+  /**
+   * of the caller, newest first
+   *
+   * @generated from field: repeated economy.v1.Transaction transactions = 1;
+   */
+  transactions: Transaction[];
+};
+
+/**
+ * Describes the message economy.v1.ListTransactionsResponse.
+ * Use `create(ListTransactionsResponseSchema)` to create a new message.
+ */
+export declare const ListTransactionsResponseSchema: GenMessage<ListTransactionsResponse>;
+
+/**
+ * @generated from message economy.v1.ExchangeRequest
+ */
+export declare type ExchangeRequest = Message<"economy.v1.ExchangeRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string give_resource_id = 2;
+   */
+  giveResourceId: string;
+
+  /**
+   * @generated from field: string take_resource_id = 3;
+   */
+  takeResourceId: string;
+
+  /**
+   * of the given resource
+   *
+   * @generated from field: uint64 amount = 4;
+   */
+  amount: bigint;
+};
+
+/**
+ * Describes the message economy.v1.ExchangeRequest.
+ * Use `create(ExchangeRequestSchema)` to create a new message.
+ */
+export declare const ExchangeRequestSchema: GenMessage<ExchangeRequest>;
+
+/**
+ * @generated from message economy.v1.ExchangeResponse
+ */
+export declare type ExchangeResponse = Message<"economy.v1.ExchangeResponse"> & {
+  /**
+   * @generated from field: economy.v1.Treasury treasury = 1;
+   */
+  treasury?: Treasury;
+
+  /**
+   * @generated from field: uint64 received = 2;
+   */
+  received: bigint;
+};
+
+/**
+ * Describes the message economy.v1.ExchangeResponse.
+ * Use `create(ExchangeResponseSchema)` to create a new message.
+ */
+export declare const ExchangeResponseSchema: GenMessage<ExchangeResponse>;
+
+/**
+ * @generated from message economy.v1.ProposeTradeRequest
+ */
+export declare type ProposeTradeRequest = Message<"economy.v1.ProposeTradeRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string to_id = 2;
+   */
+  toId: string;
+
+  /**
+   * @generated from field: map<string, uint64> give = 3;
+   */
+  give: { [key: string]: bigint };
+
+  /**
+   * @generated from field: map<string, uint64> take = 4;
+   */
+  take: { [key: string]: bigint };
+};
+
+/**
+ * Describes the message economy.v1.ProposeTradeRequest.
+ * Use `create(ProposeTradeRequestSchema)` to create a new message.
+ */
+export declare const ProposeTradeRequestSchema: GenMessage<ProposeTradeRequest>;
+
+/**
+ * @generated from message economy.v1.ProposeTradeResponse
+ */
+export declare type ProposeTradeResponse = Message<"economy.v1.ProposeTradeResponse"> & {
+  /**
+   * @generated from field: economy.v1.Trade trade = 1;
+   */
+  trade?: Trade;
+};
+
+/**
+ * Describes the message economy.v1.ProposeTradeResponse.
+ * Use `create(ProposeTradeResponseSchema)` to create a new message.
+ */
+export declare const ProposeTradeResponseSchema: GenMessage<ProposeTradeResponse>;
+
+/**
+ * @generated from message economy.v1.RespondToTradeRequest
+ */
+export declare type RespondToTradeRequest = Message<"economy.v1.RespondToTradeRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string trade_id = 2;
+   */
+  tradeId: string;
+
+  /**
+   * recipient only, proposer may only cancel
+   *
+   * @generated from field: bool accept = 3;
+   */
+  accept: boolean;
+};
+
+/**
+ * Describes the message economy.v1.RespondToTradeRequest.
+ * Use `create(RespondToTradeRequestSchema)` to create a new message.
+ */
+export declare const RespondToTradeRequestSchema: GenMessage<RespondToTradeRequest>;
+
+/**
+ * @generated from message economy.v1.RespondToTradeResponse
+ */
+export declare type RespondToTradeResponse = Message<"economy.v1.RespondToTradeResponse"> & {
+  /**
+   * @generated from field: economy.v1.Trade trade = 1;
+   */
+  trade?: Trade;
+};
+
+/**
+ * Describes the message economy.v1.RespondToTradeResponse.
+ * Use `create(RespondToTradeResponseSchema)` to create a new message.
+ */
+export declare const RespondToTradeResponseSchema: GenMessage<RespondToTradeResponse>;
+
+/**
+ * @generated from message economy.v1.ListTradesRequest
+ */
+export declare type ListTradesRequest = Message<"economy.v1.ListTradesRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message economy.v1.ListTradesRequest.
+ * Use `create(ListTradesRequestSchema)` to create a new message.
+ */
+export declare const ListTradesRequestSchema: GenMessage<ListTradesRequest>;
+
+/**
+ * @generated from message economy.v1.ListTradesResponse
+ */
+export declare type ListTradesResponse = Message<"economy.v1.ListTradesResponse"> & {
+  /**
+   * pending trades of the caller
+   *
+   * @generated from field: repeated economy.v1.Trade trades = 1;
+   */
+  trades: Trade[];
+};
+
+/**
+ * Describes the message economy.v1.ListTradesResponse.
+ * Use `create(ListTradesResponseSchema)` to create a new message.
+ */
+export declare const ListTradesResponseSchema: GenMessage<ListTradesResponse>;
+
+/**
+ * @generated from service economy.v1.EconomyService
+ */
+export declare const EconomyService: GenService<{
+  /**
+   * @generated from rpc economy.v1.EconomyService.GetTreasury
+   */
+  getTreasury: {
+    methodKind: "unary";
+    input: typeof GetTreasuryRequestSchema;
+    output: typeof GetTreasuryResponseSchema;
+  },
+  /**
+   * @generated from rpc economy.v1.EconomyService.ListSites
+   */
+  listSites: {
+    methodKind: "unary";
+    input: typeof ListSitesRequestSchema;
+    output: typeof ListSitesResponseSchema;
+  },
+  /**
+   * PlaceSite is used by the owner of a map to set up its scenario, sites are copied into games
+   * played on the map once they start.
+   *
+   * @generated from rpc economy.v1.EconomyService.PlaceSite
+   */
+  placeSite: {
+    methodKind: "unary";
+    input: typeof PlaceSiteRequestSchema;
+    output: typeof PlaceSiteResponseSchema;
+  },
+  /**
+   * @generated from rpc economy.v1.EconomyService.ListTransactions
+   */
+  listTransactions: {
+    methodKind: "unary";
+    input: typeof ListTransactionsRequestSchema;
+    output: typeof ListTransactionsResponseSchema;
+  },
+  /**
+   * Exchange trades resources at the marketplace, requires a town with one.
+   *
+   * @generated from rpc economy.v1.EconomyService.Exchange
+   */
+  exchange: {
+    methodKind: "unary";
+    input: typeof ExchangeRequestSchema;
+    output: typeof ExchangeResponseSchema;
+  },
+  /**
+   * @generated from rpc economy.v1.EconomyService.ProposeTrade
+   */
+  proposeTrade: {
+    methodKind: "unary";
+    input: typeof ProposeTradeRequestSchema;
+    output: typeof ProposeTradeResponseSchema;
+  },
+  /**
+   * @generated from rpc economy.v1.EconomyService.RespondToTrade
+   */
+  respondToTrade: {
+    methodKind: "unary";
+    input: typeof RespondToTradeRequestSchema;
+    output: typeof RespondToTradeResponseSchema;
+  },
+  /**
+   * @generated from rpc economy.v1.EconomyService.ListTrades
+   */
+  listTrades: {
+    methodKind: "unary";
+    input: typeof ListTradesRequestSchema;
+    output: typeof ListTradesResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,191 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file economy/v1/economy.proto (package economy.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_map_v1_tile } from "../../map/v1/tile_pb";
+
+/**
+ * Describes the file economy/v1/economy.proto.
+ */
+export const file_economy_v1_economy = /*@__PURE__*/
+  fileDesc("ChhlY29ub215L3YxL2Vjb25vbXkucHJvdG8SCmVjb25vbXkudjEiTAoIUmVzb3VyY2USCgoCaWQYASABKAkSDAoEdGFncxgCIAMoCRINCgV2YWx1ZRgDIAEoDRIXCg9zdGFydGluZ19hbW91bnQYBCABKAQibgoIVHJlYXN1cnkSMgoHYW1vdW50cxgBIAMoCzIhLmVjb25vbXkudjEuVHJlYXN1cnkuQW1vdW50c0VudHJ5Gi4KDEFtb3VudHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAQ6AjgBIugBCgRTaXRlEgoKAmlkGAEgASgJEg8KB2dhbWVfaWQYAiABKAkSIwoEa2luZBgDIAEoDjIVLmVjb25vbXkudjEuU2l0ZS5LaW5kEhMKC3Jlc291cmNlX2lkGAQgASgJEg4KBmFtb3VudBgFIAEoDRIpCghwb3NpdGlvbhgGIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSEAoIb3duZXJfaWQYByABKAkiPAoES2luZBIUChBLSU5EX1VOU1BFQ0lGSUVEEAASDQoJS0lORF9NSU5FEAESDwoLS0lORF9QSUNLVVAQAiK8AQoLVHJhbnNhY3Rpb24SCgoCaWQYASABKAMSEgoKYWNjb3VudF9pZBgCIAEoCRILCgNkYXkYAyABKA0SEwoLcmVzb3VyY2VfaWQYBCABKAkSDQoFZGVsdGEYBSABKAMSDwoHYmFsYW5jZRgGIAEoBBIOCgZyZWFzb24YByABKAkSEQoJcmVmZXJlbmNlGAggASgJEigKBHRpbWUYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIpkDCgVUcmFkZRIKCgJpZBgBIAEoCRIPCgdnYW1lX2lkGAIgASgJEg8KB2Zyb21faWQYAyABKAkSDQoFdG9faWQYBCABKAkSKQoEZ2l2ZRgFIAMoCzIbLmVjb25vbXkudjEuVHJhZGUuR2l2ZUVudHJ5EikKBHRha2UYBiADKAsyGy5lY29ub215LnYxLlRyYWRlLlRha2VFbnRyeRImCgVzdGF0ZRgHIAEoDjIXLmVjb25vbXkudjEuVHJhZGUuU3RhdGUSCwoDZGF5GAggASgNGisKCUdpdmVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAQ6AjgBGisKCVRha2VFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAQ6AjgBIm4KBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASEQoNU1RBVEVfUEVORElORxABEhIKDlNUQVRFX0FDQ0VQVEVEEAISEgoOU1RBVEVfREVDTElORUQQAxITCg9TVEFURV9DQU5DRUxMRUQQBCIlChJHZXRUcmVhc3VyeVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCSKpAQoTR2V0VHJlYXN1cnlSZXNwb25zZRImCgh0cmVhc3VyeRgBIAEoCzIULmVjb25vbXkudjEuVHJlYXN1cnkSOwoGaW5jb21lGAIgAygLMisuZWNvbm9teS52MS5HZXRUcmVhc3VyeVJlc3BvbnNlLkluY29tZUVudHJ5Gi0KC0luY29tZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBDoCOAEiMwoQTGlzdFNpdGVzUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg4KBm1hcF9pZBgCIAEoCSI0ChFMaXN0U2l0ZXNSZXNwb25zZRIfCgVzaXRlcxgBIAMoCzIQLmVjb25vbXkudjEuU2l0ZSKXAQoQUGxhY2VTaXRlUmVxdWVzdBIOCgZtYXBfaWQYASABKAkSIwoEa2luZBgCIAEoDjIVLmVjb25vbXkudjEuU2l0ZS5LaW5kEhMKC3Jlc291cmNlX2lkGAMgASgJEg4KBmFtb3VudBgEIAEoDRIpCghwb3NpdGlvbhgFIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUiMwoRUGxhY2VTaXRlUmVzcG9uc2USHgoEc2l0ZRgBIAEoCzIQLmVjb25vbXkudjEuU2l0ZSJMChdMaXN0VHJhbnNhY3Rpb25zUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg0KBWxpbWl0GAIgASgNEhEKCWJlZm9yZV9pZBgDIAEoAyJJChhMaXN0VHJhbnNhY3Rpb25zUmVzcG9uc2USLQoMdHJhbnNhY3Rpb25zGAEgAygLMhcuZWNvbm9teS52MS5UcmFuc2FjdGlvbiJmCg9FeGNoYW5nZVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIYChBnaXZlX3Jlc291cmNlX2lkGAIgASgJEhgKEHRha2VfcmVzb3VyY2VfaWQYAyABKAkSDgoGYW1vdW50GAQgASgEIkwKEEV4Y2hhbmdlUmVzcG9uc2USJgoIdHJlYXN1cnkYASABKAsyFC5lY29ub215LnYxLlRyZWFzdXJ5EhAKCHJlY2VpdmVkGAIgASgEIoECChNQcm9wb3NlVHJhZGVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDQoFdG9faWQYAiABKAkSNwoEZ2l2ZRgDIAMoCzIpLmVjb25vbXkudjEuUHJvcG9zZVRyYWRlUmVxdWVzdC5HaXZlRW50cnkSNwoEdGFrZRgEIAMoCzIpLmVjb25vbXkudjEuUHJvcG9zZVRyYWRlUmVxdWVzdC5UYWtlRW50cnkaKwoJR2l2ZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBDoCOAEaKwoJVGFrZUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBDoCOAEiOAoUUHJvcG9zZVRyYWRlUmVzcG9uc2USIAoFdHJhZGUYASABKAsyES5lY29ub215LnYxLlRyYWRlIkoKFVJlc3BvbmRUb1RyYWRlUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEhAKCHRyYWRlX2lkGAIgASgJEg4KBmFjY2VwdBgDIAEoCCI6ChZSZXNwb25kVG9UcmFkZVJlc3BvbnNlEiAKBXRyYWRlGAEgASgLMhEuZWNvbm9teS52MS5UcmFkZSIkChFMaXN0VHJhZGVzUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIjcKEkxpc3RUcmFkZXNSZXNwb25zZRIhCgZ0cmFkZXMYASADKAsyES5lY29ub215LnYxLlRyYWRlMpMFCg5FY29ub215U2VydmljZRJOCgtHZXRUcmVhc3VyeRIeLmVjb25vbXkudjEuR2V0VHJlYXN1cnlSZXF1ZXN0Gh8uZWNvbm9teS52MS5HZXRUcmVhc3VyeVJlc3BvbnNlEkgKCUxpc3RTaXRlcxIcLmVjb25vbXkudjEuTGlzdFNpdGVzUmVxdWVzdBodLmVjb25vbXkudjEuTGlzdFNpdGVzUmVzcG9uc2USSAoJUGxhY2VTaXRlEhwuZWNvbm9teS52MS5QbGFjZVNpdGVSZXF1ZXN0Gh0uZWNvbm9teS52MS5QbGFjZVNpdGVSZXNwb25zZRJdChBMaXN0VHJhbnNhY3Rpb25zEiMuZWNvbm9teS52MS5MaXN0VHJhbnNhY3Rpb25zUmVxdWVzdBokLmVjb25vbXkudjEuTGlzdFRyYW5zYWN0aW9uc1Jlc3BvbnNlEkUKCEV4Y2hhbmdlEhsuZWNvbm9teS52MS5FeGNoYW5nZVJlcXVlc3QaHC5lY29ub215LnYxLkV4Y2hhbmdlUmVzcG9uc2USUQoMUHJvcG9zZVRyYWRlEh8uZWNvbm9teS52MS5Qcm9wb3NlVHJhZGVSZXF1ZXN0GiAuZWNvbm9teS52MS5Qcm9wb3NlVHJhZGVSZXNwb25zZRJXCg5SZXNwb25kVG9UcmFkZRIhLmVjb25vbXkudjEuUmVzcG9uZFRvVHJhZGVSZXF1ZXN0GiIuZWNvbm9teS52MS5SZXNwb25kVG9UcmFkZVJlc3BvbnNlEksKCkxpc3RUcmFkZXMSHS5lY29ub215LnYxLkxpc3RUcmFkZXNSZXF1ZXN0Gh4uZWNvbm9teS52MS5MaXN0VHJhZGVzUmVzcG9uc2VCmAEKDmNvbS5lY29ub215LnYxQgxFY29ub215UHJvdG9QAVovZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vZWNvbm9teS92MTtlY29ub215djGiAgNFWFiqAgpFY29ub215LlYxygIKRWNvbm9teVxWMeICFkVjb25vbXlcVjFcR1BCTWV0YWRhdGHqAgtFY29ub215OjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_map_v1_tile]);
+
+/**
+ * Describes the message economy.v1.Resource.
+ * Use `create(ResourceSchema)` to create a new message.
+ */
+export const ResourceSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 0);
+
+/**
+ * Describes the message economy.v1.Treasury.
+ * Use `create(TreasurySchema)` to create a new message.
+ */
+export const TreasurySchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 1);
+
+/**
+ * Describes the message economy.v1.Site.
+ * Use `create(SiteSchema)` to create a new message.
+ */
+export const SiteSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 2);
+
+/**
+ * Describes the enum economy.v1.Site.Kind.
+ */
+export const Site_KindSchema = /*@__PURE__*/
+  enumDesc(file_economy_v1_economy, 2, 0);
+
+/**
+ * @generated from enum economy.v1.Site.Kind
+ */
+export const Site_Kind = /*@__PURE__*/
+  tsEnum(Site_KindSchema);
+
+/**
+ * Describes the message economy.v1.Transaction.
+ * Use `create(TransactionSchema)` to create a new message.
+ */
+export const TransactionSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 3);
+
+/**
+ * Describes the message economy.v1.Trade.
+ * Use `create(TradeSchema)` to create a new message.
+ */
+export const TradeSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 4);
+
+/**
+ * Describes the enum economy.v1.Trade.State.
+ */
+export const Trade_StateSchema = /*@__PURE__*/
+  enumDesc(file_economy_v1_economy, 4, 0);
+
+/**
+ * @generated from enum economy.v1.Trade.State
+ */
+export const Trade_State = /*@__PURE__*/
+  tsEnum(Trade_StateSchema);
+
+/**
+ * Describes the message economy.v1.GetTreasuryRequest.
+ * Use `create(GetTreasuryRequestSchema)` to create a new message.
+ */
+export const GetTreasuryRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 5);
+
+/**
+ * Describes the message economy.v1.GetTreasuryResponse.
+ * Use `create(GetTreasuryResponseSchema)` to create a new message.
+ */
+export const GetTreasuryResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 6);
+
+/**
+ * Describes the message economy.v1.ListSitesRequest.
+ * Use `create(ListSitesRequestSchema)` to create a new message.
+ */
+export const ListSitesRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 7);
+
+/**
+ * Describes the message economy.v1.ListSitesResponse.
+ * Use `create(ListSitesResponseSchema)` to create a new message.
+ */
+export const ListSitesResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 8);
+
+/**
+ * Describes the message economy.v1.PlaceSiteRequest.
+ * Use `create(PlaceSiteRequestSchema)` to create a new message.
+ */
+export const PlaceSiteRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 9);
+
+/**
+ * Describes the message economy.v1.PlaceSiteResponse.
+ * Use `create(PlaceSiteResponseSchema)` to create a new message.
+ */
+export const PlaceSiteResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 10);
+
+/**
+ * Describes the message economy.v1.ListTransactionsRequest.
+ * Use `create(ListTransactionsRequestSchema)` to create a new message.
+ */
+export const ListTransactionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 11);
+
+/**
+ * Describes the message economy.v1.ListTransactionsResponse.
+ * Use `create(ListTransactionsResponseSchema)` to create a new message.
+ */
+export const ListTransactionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 12);
+
+/**
+ * Describes the message economy.v1.ExchangeRequest.
+ * Use `create(ExchangeRequestSchema)` to create a new message.
+ */
+export const ExchangeRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 13);
+
+/**
+ * Describes the message economy.v1.ExchangeResponse.
+ * Use `create(ExchangeResponseSchema)` to create a new message.
+ */
+export const ExchangeResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 14);
+
+/**
+ * Describes the message economy.v1.ProposeTradeRequest.
+ * Use `create(ProposeTradeRequestSchema)` to create a new message.
+ */
+export const ProposeTradeRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 15);
+
+/**
+ * Describes the message economy.v1.ProposeTradeResponse.
+ * Use `create(ProposeTradeResponseSchema)` to create a new message.
+ */
+export const ProposeTradeResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 16);
+
+/**
+ * Describes the message economy.v1.RespondToTradeRequest.
+ * Use `create(RespondToTradeRequestSchema)` to create a new message.
+ */
+export const RespondToTradeRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 17);
+
+/**
+ * Describes the message economy.v1.RespondToTradeResponse.
+ * Use `create(RespondToTradeResponseSchema)` to create a new message.
+ */
+export const RespondToTradeResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 18);
+
+/**
+ * Describes the message economy.v1.ListTradesRequest.
+ * Use `create(ListTradesRequestSchema)` to create a new message.
+ */
+export const ListTradesRequestSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 19);
+
+/**
+ * Describes the message economy.v1.ListTradesResponse.
+ * Use `create(ListTradesResponseSchema)` to create a new message.
+ */
+export const ListTradesResponseSchema = /*@__PURE__*/
+  messageDesc(file_economy_v1_economy, 20);
+
+/**
+ * @generated from service economy.v1.EconomyService
+ */
+export const EconomyService = /*@__PURE__*/
+  serviceDesc(file_economy_v1_economy, 0);
+
@@ -2,10 +2,16 @@
 // @generated from file game/v1/game.proto (package game.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
-import type { Grid } from "../../map/v1/tile_pb";
+import type { Grid, Segment, Segment_Bounds, Tile_Coordinate } from "../../map/v1/tile_pb";
 import type { Progress } from "../../progress/v1/progress_pb";
+import type { Creature_Kind } from "../../creatures/v1/creature_pb";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import type { Hero } from "../../heroes/v1/hero_pb";
+import type { Site } from "../../economy/v1/economy_pb";
+import type { Town } from "../../towns/v1/town_pb";
+import type { Object } from "../../objects/v1/object_pb";
 
 /**
  * Describes the file game/v1/game.proto.
@@ -17,24 +23,46 @@ export declare const file_game_v1_game: GenFile;
  */
 export declare type GetSampleGridRequest = Message<"game.v1.GetSampleGridRequest"> & {
   /**
+   * up to 1024
+   *
    * @generated from field: uint32 total_rows = 1;
    */
   totalRows: number;
 
   /**
+   * up to 1024
+   *
    * @generated from field: uint32 total_columns = 2;
    */
   totalColumns: number;
 
   /**
+   * up to 64
+   *
    * @generated from field: uint32 max_rows_per_segment = 3;
    */
   maxRowsPerSegment: number;
 
   /**
+   * up to 64
+   *
    * @generated from field: uint32 max_columns_per_segment = 4;
    */
   maxColumnsPerSegment: number;
+
+  /**
+   * same seed always produces the same map, random if omitted
+   *
+   * @generated from field: int64 seed = 5;
+   */
+  seed: bigint;
+
+  /**
+   * number of levels, single level if omitted
+   *
+   * @generated from field: uint32 depths = 6;
+   */
+  depths: number;
 };
 
 /**
@@ -58,6 +86,13 @@ export declare type GetSampleGridResponse = Message<"game.v1.GetSampleGridRespon
    * @generated from field: progress.v1.Progress progress = 2;
    */
   progress?: Progress;
+
+  /**
+   * seed the grid was generated with
+   *
+   * @generated from field: int64 seed = 3;
+   */
+  seed: bigint;
 };
 
 /**
@@ -66,6 +101,859 @@ export declare type GetSampleGridResponse = Message<"game.v1.GetSampleGridRespon
  */
 export declare const GetSampleGridResponseSchema: GenMessage<GetSampleGridResponse>;
 
+/**
+ * @generated from message game.v1.StreamSegmentsRequest
+ */
+export declare type StreamSegmentsRequest = Message<"game.v1.StreamSegmentsRequest"> & {
+  /**
+   * sample grid to stream, see GetSampleGridRequest; only read from the first message
+   *
+   * @generated from field: uint32 total_rows = 1;
+   */
+  totalRows: number;
+
+  /**
+   * @generated from field: uint32 total_columns = 2;
+   */
+  totalColumns: number;
+
+  /**
+   * @generated from field: uint32 max_rows_per_segment = 3;
+   */
+  maxRowsPerSegment: number;
+
+  /**
+   * @generated from field: uint32 max_columns_per_segment = 4;
+   */
+  maxColumnsPerSegment: number;
+
+  /**
+   * @generated from field: int64 seed = 5;
+   */
+  seed: bigint;
+
+  /**
+   * tiles visible to the client on a single level, max values are exclusive;
+   * extended by margin it may span up to 256 rows & columns
+   *
+   * @generated from field: map.v1.Segment.Bounds viewport = 6;
+   */
+  viewport?: Segment_Bounds;
+
+  /**
+   * tiles around the viewport to prefetch
+   *
+   * @generated from field: int32 margin = 7;
+   */
+  margin: number;
+
+  /**
+   * stream map of the game as seen by the player instead of a sample grid, tiles hidden by fog of war are masked;
+   * only read from the first message
+   *
+   * @generated from field: string game_id = 8;
+   */
+  gameId: string;
+
+  /**
+   * sample grid only, see GetSampleGridRequest
+   *
+   * @generated from field: uint32 depths = 9;
+   */
+  depths: number;
+};
+
+/**
+ * Describes the message game.v1.StreamSegmentsRequest.
+ * Use `create(StreamSegmentsRequestSchema)` to create a new message.
+ */
+export declare const StreamSegmentsRequestSchema: GenMessage<StreamSegmentsRequest>;
+
+/**
+ * @generated from message game.v1.StreamSegmentsResponse
+ */
+export declare type StreamSegmentsResponse = Message<"game.v1.StreamSegmentsResponse"> & {
+  /**
+   * only set in the first message, without segments
+   *
+   * @generated from field: map.v1.Grid grid = 1;
+   */
+  grid?: Grid;
+
+  /**
+   * only set in the first message
+   *
+   * @generated from field: int64 seed = 2;
+   */
+  seed: bigint;
+
+  /**
+   * segments the client hasn't received yet or whose visibility changed, closest to viewport first
+   *
+   * @generated from field: repeated map.v1.Segment segments = 3;
+   */
+  segments: Segment[];
+};
+
+/**
+ * Describes the message game.v1.StreamSegmentsResponse.
+ * Use `create(StreamSegmentsResponseSchema)` to create a new message.
+ */
+export declare const StreamSegmentsResponseSchema: GenMessage<StreamSegmentsResponse>;
+
+/**
+ * Visibility is stored per player, it remembers tiles explored so far.
+ *
+ * @generated from message game.v1.Visibility
+ */
+export declare type Visibility = Message<"game.v1.Visibility"> & {
+  /**
+   * @generated from field: repeated game.v1.Visibility.Level levels = 1;
+   */
+  levels: Visibility_Level[];
+};
+
+/**
+ * Describes the message game.v1.Visibility.
+ * Use `create(VisibilitySchema)` to create a new message.
+ */
+export declare const VisibilitySchema: GenMessage<Visibility>;
+
+/**
+ * @generated from message game.v1.Visibility.Level
+ */
+export declare type Visibility_Level = Message<"game.v1.Visibility.Level"> & {
+  /**
+   * @generated from field: uint32 depth = 1;
+   */
+  depth: number;
+
+  /**
+   * bitset of tiles in row-major order
+   *
+   * @generated from field: bytes explored = 2;
+   */
+  explored: Uint8Array;
+};
+
+/**
+ * Describes the message game.v1.Visibility.Level.
+ * Use `create(Visibility_LevelSchema)` to create a new message.
+ */
+export declare const Visibility_LevelSchema: GenMessage<Visibility_Level>;
+
+/**
+ * @generated from message game.v1.FindPathRequest
+ */
+export declare type FindPathRequest = Message<"game.v1.FindPathRequest"> & {
+  /**
+   * sample grid to search across, see GetSampleGridRequest
+   *
+   * @generated from field: uint32 total_rows = 1;
+   */
+  totalRows: number;
+
+  /**
+   * @generated from field: uint32 total_columns = 2;
+   */
+  totalColumns: number;
+
+  /**
+   * @generated from field: int64 seed = 3;
+   */
+  seed: bigint;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate start = 4;
+   */
+  start?: Tile_Coordinate;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate goal = 5;
+   */
+  goal?: Tile_Coordinate;
+
+  /**
+   * @generated from field: creatures.v1.Creature.Kind kind = 6;
+   */
+  kind?: Creature_Kind;
+
+  /**
+   * @generated from field: uint32 depths = 7;
+   */
+  depths: number;
+};
+
+/**
+ * Describes the message game.v1.FindPathRequest.
+ * Use `create(FindPathRequestSchema)` to create a new message.
+ */
+export declare const FindPathRequestSchema: GenMessage<FindPathRequest>;
+
+/**
+ * @generated from message game.v1.FindPathResponse
+ */
+export declare type FindPathResponse = Message<"game.v1.FindPathResponse"> & {
+  /**
+   * including start & goal
+   *
+   * @generated from field: repeated map.v1.Tile.Coordinate path = 1;
+   */
+  path: Tile_Coordinate[];
+
+  /**
+   * @generated from field: uint32 total_cost = 2;
+   */
+  totalCost: number;
+};
+
+/**
+ * Describes the message game.v1.FindPathResponse.
+ * Use `create(FindPathResponseSchema)` to create a new message.
+ */
+export declare const FindPathResponseSchema: GenMessage<FindPathResponse>;
+
+/**
+ * @generated from message game.v1.Date
+ */
+export declare type Date = Message<"game.v1.Date"> & {
+  /**
+   * starting from 1
+   *
+   * @generated from field: uint32 month = 1;
+   */
+  month: number;
+
+  /**
+   * of month, 1-4
+   *
+   * @generated from field: uint32 week = 2;
+   */
+  week: number;
+
+  /**
+   * of week, 1-7
+   *
+   * @generated from field: uint32 day = 3;
+   */
+  day: number;
+};
+
+/**
+ * Describes the message game.v1.Date.
+ * Use `create(DateSchema)` to create a new message.
+ */
+export declare const DateSchema: GenMessage<Date>;
+
+/**
+ * @generated from message game.v1.TurnState
+ */
+export declare type TurnState = Message<"game.v1.TurnState"> & {
+  /**
+   * @generated from field: game.v1.TurnMode mode = 1;
+   */
+  mode: TurnMode;
+
+  /**
+   * days since start of the game, starting from 1
+   *
+   * @generated from field: uint32 day = 2;
+   */
+  day: number;
+
+  /**
+   * @generated from field: game.v1.Date date = 3;
+   */
+  date?: Date;
+
+  /**
+   * in turn order
+   *
+   * @generated from field: repeated game.v1.TurnState.Player players = 4;
+   */
+  players: TurnState_Player[];
+
+  /**
+   * index of the acting player, sequential mode only
+   *
+   * @generated from field: uint32 current = 5;
+   */
+  current: number;
+
+  /**
+   * unlimited if omitted
+   *
+   * @generated from field: google.protobuf.Duration turn_duration = 6;
+   */
+  turnDuration?: Duration;
+
+  /**
+   * current turn ends automatically at this time, unset if unlimited
+   *
+   * @generated from field: google.protobuf.Timestamp deadline = 7;
+   */
+  deadline?: Timestamp;
+};
+
+/**
+ * Describes the message game.v1.TurnState.
+ * Use `create(TurnStateSchema)` to create a new message.
+ */
+export declare const TurnStateSchema: GenMessage<TurnState>;
+
+/**
+ * @generated from message game.v1.TurnState.Player
+ */
+export declare type TurnState_Player = Message<"game.v1.TurnState.Player"> & {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+
+  /**
+   * @generated from field: uint32 slot = 2;
+   */
+  slot: number;
+
+  /**
+   * ended their turn today
+   *
+   * @generated from field: bool done = 3;
+   */
+  done: boolean;
+};
+
+/**
+ * Describes the message game.v1.TurnState.Player.
+ * Use `create(TurnState_PlayerSchema)` to create a new message.
+ */
+export declare const TurnState_PlayerSchema: GenMessage<TurnState_Player>;
+
+/**
+ * @generated from message game.v1.GetTurnStateRequest
+ */
+export declare type GetTurnStateRequest = Message<"game.v1.GetTurnStateRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message game.v1.GetTurnStateRequest.
+ * Use `create(GetTurnStateRequestSchema)` to create a new message.
+ */
+export declare const GetTurnStateRequestSchema: GenMessage<GetTurnStateRequest>;
+
+/**
+ * @generated from message game.v1.GetTurnStateResponse
+ */
+export declare type GetTurnStateResponse = Message<"game.v1.GetTurnStateResponse"> & {
+  /**
+   * @generated from field: game.v1.TurnState state = 1;
+   */
+  state?: TurnState;
+};
+
+/**
+ * Describes the message game.v1.GetTurnStateResponse.
+ * Use `create(GetTurnStateResponseSchema)` to create a new message.
+ */
+export declare const GetTurnStateResponseSchema: GenMessage<GetTurnStateResponse>;
+
+/**
+ * @generated from message game.v1.Command
+ */
+export declare type Command = Message<"game.v1.Command"> & {
+  /**
+   * chosen by the client, referenced by resulting events
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from oneof game.v1.Command.kind
+   */
+  kind: {
+    /**
+     * @generated from field: game.v1.Command.MoveHero move_hero = 2;
+     */
+    value: Command_MoveHero;
+    case: "moveHero";
+  } | {
+    /**
+     * @generated from field: game.v1.Command.EndTurn end_turn = 3;
+     */
+    value: Command_EndTurn;
+    case: "endTurn";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message game.v1.Command.
+ * Use `create(CommandSchema)` to create a new message.
+ */
+export declare const CommandSchema: GenMessage<Command>;
+
+/**
+ * @generated from message game.v1.Command.MoveHero
+ */
+export declare type Command_MoveHero = Message<"game.v1.Command.MoveHero"> & {
+  /**
+   * @generated from field: string hero_id = 1;
+   */
+  heroId: string;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate goal = 2;
+   */
+  goal?: Tile_Coordinate;
+};
+
+/**
+ * Describes the message game.v1.Command.MoveHero.
+ * Use `create(Command_MoveHeroSchema)` to create a new message.
+ */
+export declare const Command_MoveHeroSchema: GenMessage<Command_MoveHero>;
+
+/**
+ * @generated from message game.v1.Command.EndTurn
+ */
+export declare type Command_EndTurn = Message<"game.v1.Command.EndTurn"> & {
+};
+
+/**
+ * Describes the message game.v1.Command.EndTurn.
+ * Use `create(Command_EndTurnSchema)` to create a new message.
+ */
+export declare const Command_EndTurnSchema: GenMessage<Command_EndTurn>;
+
+/**
+ * @generated from message game.v1.Event
+ */
+export declare type Event = Message<"game.v1.Event"> & {
+  /**
+   * increments within a game, gaps are events withheld from the recipient, zero for rejections only sent to their author
+   *
+   * @generated from field: uint64 sequence = 1;
+   */
+  sequence: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * command that caused the event, if any
+   *
+   * @generated from field: string command_id = 3;
+   */
+  commandId: string;
+
+  /**
+   * @generated from oneof game.v1.Event.kind
+   */
+  kind: {
+    /**
+     * @generated from field: game.v1.Event.Joined joined = 4;
+     */
+    value: Event_Joined;
+    case: "joined";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.Left left = 5;
+     */
+    value: Event_Left;
+    case: "left";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.Rejected rejected = 6;
+     */
+    value: Event_Rejected;
+    case: "rejected";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.TurnStarted turn_started = 7;
+     */
+    value: Event_TurnStarted;
+    case: "turnStarted";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.PlayerDone player_done = 8;
+     */
+    value: Event_PlayerDone;
+    case: "playerDone";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.HeroRecruited hero_recruited = 9;
+     */
+    value: Event_HeroRecruited;
+    case: "heroRecruited";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.HeroMoved hero_moved = 10;
+     */
+    value: Event_HeroMoved;
+    case: "heroMoved";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.SpellCast spell_cast = 11;
+     */
+    value: Event_SpellCast;
+    case: "spellCast";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.TownUpdated town_updated = 12;
+     */
+    value: Event_TownUpdated;
+    case: "townUpdated";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.SiteUpdated site_updated = 13;
+     */
+    value: Event_SiteUpdated;
+    case: "siteUpdated";
+  } | {
+    /**
+     * @generated from field: game.v1.Event.ObjectUpdated object_updated = 14;
+     */
+    value: Event_ObjectUpdated;
+    case: "objectUpdated";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message game.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export declare const EventSchema: GenMessage<Event>;
+
+/**
+ * @generated from message game.v1.Event.Joined
+ */
+export declare type Event_Joined = Message<"game.v1.Event.Joined"> & {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+};
+
+/**
+ * Describes the message game.v1.Event.Joined.
+ * Use `create(Event_JoinedSchema)` to create a new message.
+ */
+export declare const Event_JoinedSchema: GenMessage<Event_Joined>;
+
+/**
+ * @generated from message game.v1.Event.Left
+ */
+export declare type Event_Left = Message<"game.v1.Event.Left"> & {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+};
+
+/**
+ * Describes the message game.v1.Event.Left.
+ * Use `create(Event_LeftSchema)` to create a new message.
+ */
+export declare const Event_LeftSchema: GenMessage<Event_Left>;
+
+/**
+ * @generated from message game.v1.Event.TurnStarted
+ */
+export declare type Event_TurnStarted = Message<"game.v1.Event.TurnStarted"> & {
+  /**
+   * @generated from field: game.v1.TurnState state = 1;
+   */
+  state?: TurnState;
+
+  /**
+   * @generated from field: bool new_day = 2;
+   */
+  newDay: boolean;
+
+  /**
+   * @generated from field: bool new_week = 3;
+   */
+  newWeek: boolean;
+
+  /**
+   * @generated from field: bool new_month = 4;
+   */
+  newMonth: boolean;
+
+  /**
+   * previous turn ended because its time ran out
+   *
+   * @generated from field: bool timed_out = 5;
+   */
+  timedOut: boolean;
+};
+
+/**
+ * Describes the message game.v1.Event.TurnStarted.
+ * Use `create(Event_TurnStartedSchema)` to create a new message.
+ */
+export declare const Event_TurnStartedSchema: GenMessage<Event_TurnStarted>;
+
+/**
+ * @generated from message game.v1.Event.PlayerDone
+ */
+export declare type Event_PlayerDone = Message<"game.v1.Event.PlayerDone"> & {
+  /**
+   * waiting for other players, simultaneous mode only
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+};
+
+/**
+ * Describes the message game.v1.Event.PlayerDone.
+ * Use `create(Event_PlayerDoneSchema)` to create a new message.
+ */
+export declare const Event_PlayerDoneSchema: GenMessage<Event_PlayerDone>;
+
+/**
+ * @generated from message game.v1.Event.HeroRecruited
+ */
+export declare type Event_HeroRecruited = Message<"game.v1.Event.HeroRecruited"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+};
+
+/**
+ * Describes the message game.v1.Event.HeroRecruited.
+ * Use `create(Event_HeroRecruitedSchema)` to create a new message.
+ */
+export declare const Event_HeroRecruitedSchema: GenMessage<Event_HeroRecruited>;
+
+/**
+ * @generated from message game.v1.Event.HeroMoved
+ */
+export declare type Event_HeroMoved = Message<"game.v1.Event.HeroMoved"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+
+  /**
+   * including start
+   *
+   * @generated from field: repeated map.v1.Tile.Coordinate path = 2;
+   */
+  path: Tile_Coordinate[];
+
+  /**
+   * @generated from field: repeated economy.v1.Site visited = 3;
+   */
+  visited: Site[];
+
+  /**
+   * object visited at the end of the path, see ObjectUpdated
+   *
+   * @generated from field: string object_id = 4;
+   */
+  objectId: string;
+
+  /**
+   * hero lost a battle & is gone
+   *
+   * @generated from field: bool defeated = 5;
+   */
+  defeated: boolean;
+};
+
+/**
+ * Describes the message game.v1.Event.HeroMoved.
+ * Use `create(Event_HeroMovedSchema)` to create a new message.
+ */
+export declare const Event_HeroMovedSchema: GenMessage<Event_HeroMoved>;
+
+/**
+ * @generated from message game.v1.Event.SpellCast
+ */
+export declare type Event_SpellCast = Message<"game.v1.Event.SpellCast"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+
+  /**
+   * @generated from field: string spell_id = 2;
+   */
+  spellId: string;
+
+  /**
+   * @generated from field: int32 level = 3;
+   */
+  level: number;
+};
+
+/**
+ * Describes the message game.v1.Event.SpellCast.
+ * Use `create(Event_SpellCastSchema)` to create a new message.
+ */
+export declare const Event_SpellCastSchema: GenMessage<Event_SpellCast>;
+
+/**
+ * @generated from message game.v1.Event.TownUpdated
+ */
+export declare type Event_TownUpdated = Message<"game.v1.Event.TownUpdated"> & {
+  /**
+   * placed, built or recruited in
+   *
+   * @generated from field: towns.v1.Town town = 1;
+   */
+  town?: Town;
+};
+
+/**
+ * Describes the message game.v1.Event.TownUpdated.
+ * Use `create(Event_TownUpdatedSchema)` to create a new message.
+ */
+export declare const Event_TownUpdatedSchema: GenMessage<Event_TownUpdated>;
+
+/**
+ * @generated from message game.v1.Event.SiteUpdated
+ */
+export declare type Event_SiteUpdated = Message<"game.v1.Event.SiteUpdated"> & {
+  /**
+   * @generated from field: economy.v1.Site site = 1;
+   */
+  site?: Site;
+};
+
+/**
+ * Describes the message game.v1.Event.SiteUpdated.
+ * Use `create(Event_SiteUpdatedSchema)` to create a new message.
+ */
+export declare const Event_SiteUpdatedSchema: GenMessage<Event_SiteUpdated>;
+
+/**
+ * @generated from message game.v1.Event.ObjectUpdated
+ */
+export declare type Event_ObjectUpdated = Message<"game.v1.Event.ObjectUpdated"> & {
+  /**
+   * placed or visited
+   *
+   * @generated from field: objects.v1.Object object = 1;
+   */
+  object?: Object;
+
+  /**
+   * e.g. a looted treasure or defeated guards
+   *
+   * @generated from field: bool removed = 2;
+   */
+  removed: boolean;
+};
+
+/**
+ * Describes the message game.v1.Event.ObjectUpdated.
+ * Use `create(Event_ObjectUpdatedSchema)` to create a new message.
+ */
+export declare const Event_ObjectUpdatedSchema: GenMessage<Event_ObjectUpdated>;
+
+/**
+ * @generated from message game.v1.Event.Rejected
+ */
+export declare type Event_Rejected = Message<"game.v1.Event.Rejected"> & {
+  /**
+   * connect error code, e.g. "invalid_argument"
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message game.v1.Event.Rejected.
+ * Use `create(Event_RejectedSchema)` to create a new message.
+ */
+export declare const Event_RejectedSchema: GenMessage<Event_Rejected>;
+
+/**
+ * @generated from message game.v1.PlayRequest
+ */
+export declare type PlayRequest = Message<"game.v1.PlayRequest"> & {
+  /**
+   * only read from the first message
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: game.v1.Command command = 2;
+   */
+  command?: Command;
+};
+
+/**
+ * Describes the message game.v1.PlayRequest.
+ * Use `create(PlayRequestSchema)` to create a new message.
+ */
+export declare const PlayRequestSchema: GenMessage<PlayRequest>;
+
+/**
+ * @generated from message game.v1.PlayResponse
+ */
+export declare type PlayResponse = Message<"game.v1.PlayResponse"> & {
+  /**
+   * @generated from field: repeated game.v1.Event events = 1;
+   */
+  events: Event[];
+};
+
+/**
+ * Describes the message game.v1.PlayResponse.
+ * Use `create(PlayResponseSchema)` to create a new message.
+ */
+export declare const PlayResponseSchema: GenMessage<PlayResponse>;
+
+/**
+ * @generated from enum game.v1.TurnMode
+ */
+export enum TurnMode {
+  /**
+   * sequential
+   *
+   * @generated from enum value: TURN_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * players act one after another
+   *
+   * @generated from enum value: TURN_MODE_SEQUENTIAL = 1;
+   */
+  SEQUENTIAL = 1,
+
+  /**
+   * players act at the same time, day ends once everyone is done
+   *
+   * @generated from enum value: TURN_MODE_SIMULTANEOUS = 2;
+   */
+  SIMULTANEOUS = 2,
+}
+
+/**
+ * Describes the enum game.v1.TurnMode.
+ */
+export declare const TurnModeSchema: GenEnum<TurnMode>;
+
 /**
  * @generated from service game.v1.GameService
  */
@@ -78,5 +966,47 @@ export declare const GameService: GenService<{
     input: typeof GetSampleGridRequestSchema;
     output: typeof GetSampleGridResponseSchema;
   },
+  /**
+   * @generated from rpc game.v1.GameService.StreamSegments
+   */
+  streamSegments: {
+    methodKind: "bidi_streaming";
+    input: typeof StreamSegmentsRequestSchema;
+    output: typeof StreamSegmentsResponseSchema;
+  },
+  /**
+   * same as StreamSegments for a single viewport, for clients that can't stream requests, e.g. browsers
+   *
+   * @generated from rpc game.v1.GameService.GetSegments
+   */
+  getSegments: {
+    methodKind: "server_streaming";
+    input: typeof StreamSegmentsRequestSchema;
+    output: typeof StreamSegmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc game.v1.GameService.FindPath
+   */
+  findPath: {
+    methodKind: "unary";
+    input: typeof FindPathRequestSchema;
+    output: typeof FindPathResponseSchema;
+  },
+  /**
+   * @generated from rpc game.v1.GameService.Play
+   */
+  play: {
+    methodKind: "bidi_streaming";
+    input: typeof PlayRequestSchema;
+    output: typeof PlayResponseSchema;
+  },
+  /**
+   * @generated from rpc game.v1.GameService.GetTurnState
+   */
+  getTurnState: {
+    methodKind: "unary";
+    input: typeof GetTurnStateRequestSchema;
+    output: typeof GetTurnStateResponseSchema;
+  },
 }>;
 
@@ -2,15 +2,21 @@
 // @generated from file game/v1/game.proto (package game.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_creatures_v1_creature } from "../../creatures/v1/creature_pb";
+import { file_economy_v1_economy } from "../../economy/v1/economy_pb";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_heroes_v1_hero } from "../../heroes/v1/hero_pb";
 import { file_map_v1_tile } from "../../map/v1/tile_pb";
+import { file_objects_v1_object } from "../../objects/v1/object_pb";
 import { file_progress_v1_progress } from "../../progress/v1/progress_pb";
+import { file_towns_v1_town } from "../../towns/v1/town_pb";
 
 /**
  * Describes the file game/v1/game.proto.
  */
 export const file_game_v1_game = /*@__PURE__*/
-  fileDesc("ChJnYW1lL3YxL2dhbWUucHJvdG8SB2dhbWUudjEigAEKFEdldFNhbXBsZUdyaWRSZXF1ZXN0EhIKCnRvdGFsX3Jvd3MYASABKA0SFQoNdG90YWxfY29sdW1ucxgCIAEoDRIcChRtYXhfcm93c19wZXJfc2VnbWVudBgDIAEoDRIfChdtYXhfY29sdW1uc19wZXJfc2VnbWVudBgEIAEoDSJcChVHZXRTYW1wbGVHcmlkUmVzcG9uc2USGgoEZ3JpZBgBIAEoCzIMLm1hcC52MS5HcmlkEicKCHByb2dyZXNzGAIgASgLMhUucHJvZ3Jlc3MudjEuUHJvZ3Jlc3MyXwoLR2FtZVNlcnZpY2USUAoNR2V0U2FtcGxlR3JpZBIdLmdhbWUudjEuR2V0U2FtcGxlR3JpZFJlcXVlc3QaHi5nYW1lLnYxLkdldFNhbXBsZUdyaWRSZXNwb25zZTABQoABCgtjb20uZ2FtZS52MUIJR2FtZVByb3RvUAFaKWdpdGh1Yi5jb20vb3BlbmhleGVzL3Byb3RvL2dhbWUvdjE7Z2FtZXYxogIDR1hYqgIHR2FtZS5WMcoCB0dhbWVcVjHiAhNHYW1lXFYxXEdQQk1ldGFkYXRh6gIIR2FtZTo6VjFiBnByb3RvMw", [file_map_v1_tile, file_progress_v1_progress]);
+  fileDesc("ChJnYW1lL3YxL2dhbWUucHJvdG8SB2dhbWUudjEingEKFEdldFNhbXBsZUdyaWRSZXF1ZXN0EhIKCnRvdGFsX3Jvd3MYASABKA0SFQoNdG90YWxfY29sdW1ucxgCIAEoDRIcChRtYXhfcm93c19wZXJfc2VnbWVudBgDIAEoDRIfChdtYXhfY29sdW1uc19wZXJfc2VnbWVudBgEIAEoDRIMCgRzZWVkGAUgASgDEg4KBmRlcHRocxgGIAEoDSJqChVHZXRTYW1wbGVHcmlkUmVzcG9uc2USGgoEZ3JpZBgBIAEoCzIMLm1hcC52MS5HcmlkEicKCHByb2dyZXNzGAIgASgLMhUucHJvZ3Jlc3MudjEuUHJvZ3Jlc3MSDAoEc2VlZBgDIAEoAyLqAQoVU3RyZWFtU2VnbWVudHNSZXF1ZXN0EhIKCnRvdGFsX3Jvd3MYASABKA0SFQoNdG90YWxfY29sdW1ucxgCIAEoDRIcChRtYXhfcm93c19wZXJfc2VnbWVudBgDIAEoDRIfChdtYXhfY29sdW1uc19wZXJfc2VnbWVudBgEIAEoDRIMCgRzZWVkGAUgASgDEigKCHZpZXdwb3J0GAYgASgLMhYubWFwLnYxLlNlZ21lbnQuQm91bmRzEg4KBm1hcmdpbhgHIAEoBRIPCgdnYW1lX2lkGAggASgJEg4KBmRlcHRocxgJIAEoDSJlChZTdHJlYW1TZWdtZW50c1Jlc3BvbnNlEhoKBGdyaWQYASABKAsyDC5tYXAudjEuR3JpZBIMCgRzZWVkGAIgASgDEiEKCHNlZ21lbnRzGAMgAygLMg8ubWFwLnYxLlNlZ21lbnQiYQoKVmlzaWJpbGl0eRIpCgZsZXZlbHMYASADKAsyGS5nYW1lLnYxLlZpc2liaWxpdHkuTGV2ZWwaKAoFTGV2ZWwSDQoFZGVwdGgYASABKA0SEAoIZXhwbG9yZWQYAiABKAwi1AEKD0ZpbmRQYXRoUmVxdWVzdBISCgp0b3RhbF9yb3dzGAEgASgNEhUKDXRvdGFsX2NvbHVtbnMYAiABKA0SDAoEc2VlZBgDIAEoAxImCgVzdGFydBgEIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSJQoEZ29hbBgFIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSKQoEa2luZBgGIAEoCzIbLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5LaW5kEg4KBmRlcHRocxgHIAEoDSJNChBGaW5kUGF0aFJlc3BvbnNlEiUKBHBhdGgYASADKAsyFy5tYXAudjEuVGlsZS5Db29yZGluYXRlEhIKCnRvdGFsX2Nvc3QYAiABKA0iMAoERGF0ZRINCgVtb250aBgBIAEoDRIMCgR3ZWVrGAIgASgNEgsKA2RheRgDIAEoDSKtAgoJVHVyblN0YXRlEh8KBG1vZGUYASABKA4yES5nYW1lLnYxLlR1cm5Nb2RlEgsKA2RheRgCIAEoDRIbCgRkYXRlGAMgASgLMg0uZ2FtZS52MS5EYXRlEioKB3BsYXllcnMYBCADKAsyGS5nYW1lLnYxLlR1cm5TdGF0ZS5QbGF5ZXISDwoHY3VycmVudBgFIAEoDRIwCg10dXJuX2R1cmF0aW9uGAYgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEiwKCGRlYWRsaW5lGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBo4CgZQbGF5ZXISEgoKYWNjb3VudF9pZBgBIAEoCRIMCgRzbG90GAIgASgNEgwKBGRvbmUYAyABKAgiJgoTR2V0VHVyblN0YXRlUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIjkKFEdldFR1cm5TdGF0ZVJlc3BvbnNlEiEKBXN0YXRlGAEgASgLMhIuZ2FtZS52MS5UdXJuU3RhdGUiygEKB0NvbW1hbmQSCgoCaWQYASABKAkSLgoJbW92ZV9oZXJvGAIgASgLMhkuZ2FtZS52MS5Db21tYW5kLk1vdmVIZXJvSAASLAoIZW5kX3R1cm4YAyABKAsyGC5nYW1lLnYxLkNvbW1hbmQuRW5kVHVybkgAGkIKCE1vdmVIZXJvEg8KB2hlcm9faWQYASABKAkSJQoEZ29hbBgCIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUaCQoHRW5kVHVybkIGCgRraW5kIrUKCgVFdmVudBIQCghzZXF1ZW5jZRgBIAEoBBIoCgR0aW1lGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpjb21tYW5kX2lkGAMgASgJEicKBmpvaW5lZBgEIAEoCzIVLmdhbWUudjEuRXZlbnQuSm9pbmVkSAASIwoEbGVmdBgFIAEoCzITLmdhbWUudjEuRXZlbnQuTGVmdEgAEisKCHJlamVjdGVkGAYgASgLMhcuZ2FtZS52MS5FdmVudC5SZWplY3RlZEgAEjIKDHR1cm5fc3RhcnRlZBgHIAEoCzIaLmdhbWUudjEuRXZlbnQuVHVyblN0YXJ0ZWRIABIwCgtwbGF5ZXJfZG9uZRgIIAEoCzIZLmdhbWUudjEuRXZlbnQuUGxheWVyRG9uZUgAEjYKDmhlcm9fcmVjcnVpdGVkGAkgASgLMhwuZ2FtZS52MS5FdmVudC5IZXJvUmVjcnVpdGVkSAASLgoKaGVyb19tb3ZlZBgKIAEoCzIYLmdhbWUudjEuRXZlbnQuSGVyb01vdmVkSAASLgoKc3BlbGxfY2FzdBgLIAEoCzIYLmdhbWUudjEuRXZlbnQuU3BlbGxDYXN0SAASMgoMdG93bl91cGRhdGVkGAwgASgLMhouZ2FtZS52MS5FdmVudC5Ub3duVXBkYXRlZEgAEjIKDHNpdGVfdXBkYXRlZBgNIAEoCzIaLmdhbWUudjEuRXZlbnQuU2l0ZVVwZGF0ZWRIABI2Cg5vYmplY3RfdXBkYXRlZBgOIAEoCzIcLmdhbWUudjEuRXZlbnQuT2JqZWN0VXBkYXRlZEgAGhwKBkpvaW5lZBISCgphY2NvdW50X2lkGAEgASgJGhoKBExlZnQSEgoKYWNjb3VudF9pZBgBIAEoCRp5CgtUdXJuU3RhcnRlZBIhCgVzdGF0ZRgBIAEoCzISLmdhbWUudjEuVHVyblN0YXRlEg8KB25ld19kYXkYAiABKAgSEAoIbmV3X3dlZWsYAyABKAgSEQoJbmV3X21vbnRoGAQgASgIEhEKCXRpbWVkX291dBgFIAEoCBogCgpQbGF5ZXJEb25lEhIKCmFjY291bnRfaWQYASABKAkaLgoNSGVyb1JlY3J1aXRlZBIdCgRoZXJvGAEgASgLMg8uaGVyb2VzLnYxLkhlcm8amQEKCUhlcm9Nb3ZlZBIdCgRoZXJvGAEgASgLMg8uaGVyb2VzLnYxLkhlcm8SJQoEcGF0aBgCIAMoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSIQoHdmlzaXRlZBgDIAMoCzIQLmVjb25vbXkudjEuU2l0ZRIRCglvYmplY3RfaWQYBCABKAkSEAoIZGVmZWF0ZWQYBSABKAgaSwoJU3BlbGxDYXN0Eh0KBGhlcm8YASABKAsyDy5oZXJvZXMudjEuSGVybxIQCghzcGVsbF9pZBgCIAEoCRINCgVsZXZlbBgDIAEoBRorCgtUb3duVXBkYXRlZBIcCgR0b3duGAEgASgLMg4udG93bnMudjEuVG93bhotCgtTaXRlVXBkYXRlZBIeCgRzaXRlGAEgASgLMhAuZWNvbm9teS52MS5TaXRlGkQKDU9iamVjdFVwZGF0ZWQSIgoGb2JqZWN0GAEgASgLMhIub2JqZWN0cy52MS5PYmplY3QSDwoHcmVtb3ZlZBgCIAEoCBopCghSZWplY3RlZBIMCgRjb2RlGAEgASgJEg8KB21lc3NhZ2UYAiABKAlCBgoEa2luZCJBCgtQbGF5UmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEiEKB2NvbW1hbmQYAiABKAsyEC5nYW1lLnYxLkNvbW1hbmQiLgoMUGxheVJlc3BvbnNlEh4KBmV2ZW50cxgBIAMoCzIOLmdhbWUudjEuRXZlbnQqWwoIVHVybk1vZGUSGQoVVFVSTl9NT0RFX1VOU1BFQ0lGSUVEEAASGAoUVFVSTl9NT0RFX1NFUVVFTlRJQUwQARIaChZUVVJOX01PREVfU0lNVUxUQU5FT1VTEAIyzwMKC0dhbWVTZXJ2aWNlElAKDUdldFNhbXBsZUdyaWQSHS5nYW1lLnYxLkdldFNhbXBsZUdyaWRSZXF1ZXN0Gh4uZ2FtZS52MS5HZXRTYW1wbGVHcmlkUmVzcG9uc2UwARJVCg5TdHJlYW1TZWdtZW50cxIeLmdhbWUudjEuU3RyZWFtU2VnbWVudHNSZXF1ZXN0Gh8uZ2FtZS52MS5TdHJlYW1TZWdtZW50c1Jlc3BvbnNlKAEwARJQCgtHZXRTZWdtZW50cxIeLmdhbWUudjEuU3RyZWFtU2VnbWVudHNSZXF1ZXN0Gh8uZ2FtZS52MS5TdHJlYW1TZWdtZW50c1Jlc3BvbnNlMAESPwoIRmluZFBhdGgSGC5nYW1lLnYxLkZpbmRQYXRoUmVxdWVzdBoZLmdhbWUudjEuRmluZFBhdGhSZXNwb25zZRI3CgRQbGF5EhQuZ2FtZS52MS5QbGF5UmVxdWVzdBoVLmdhbWUudjEuUGxheVJlc3BvbnNlKAEwARJLCgxHZXRUdXJuU3RhdGUSHC5nYW1lLnYxLkdldFR1cm5TdGF0ZVJlcXVlc3QaHS5nYW1lLnYxLkdldFR1cm5TdGF0ZVJlc3BvbnNlQoABCgtjb20uZ2FtZS52MUIJR2FtZVByb3RvUAFaKWdpdGh1Yi5jb20vb3BlbmhleGVzL3Byb3RvL2dhbWUvdjE7Z2FtZXYxogIDR1hYqgIHR2FtZS5WMcoCB0dhbWVcVjHiAhNHYW1lXFYxXEdQQk1ldGFkYXRh6gIIR2FtZTo6VjFiBnByb3RvMw", [file_creatures_v1_creature, file_economy_v1_economy, file_google_protobuf_duration, file_google_protobuf_timestamp, file_heroes_v1_hero, file_map_v1_tile, file_objects_v1_object, file_progress_v1_progress, file_towns_v1_town]);
 
 /**
  * Describes the message game.v1.GetSampleGridRequest.
@@ -26,6 +32,214 @@ export const GetSampleGridRequestSchema = /*@__PURE__*/
 export const GetSampleGridResponseSchema = /*@__PURE__*/
   messageDesc(file_game_v1_game, 1);
 
+/**
+ * Describes the message game.v1.StreamSegmentsRequest.
+ * Use `create(StreamSegmentsRequestSchema)` to create a new message.
+ */
+export const StreamSegmentsRequestSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 2);
+
+/**
+ * Describes the message game.v1.StreamSegmentsResponse.
+ * Use `create(StreamSegmentsResponseSchema)` to create a new message.
+ */
+export const StreamSegmentsResponseSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 3);
+
+/**
+ * Describes the message game.v1.Visibility.
+ * Use `create(VisibilitySchema)` to create a new message.
+ */
+export const VisibilitySchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 4);
+
+/**
+ * Describes the message game.v1.Visibility.Level.
+ * Use `create(Visibility_LevelSchema)` to create a new message.
+ */
+export const Visibility_LevelSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 4, 0);
+
+/**
+ * Describes the message game.v1.FindPathRequest.
+ * Use `create(FindPathRequestSchema)` to create a new message.
+ */
+export const FindPathRequestSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 5);
+
+/**
+ * Describes the message game.v1.FindPathResponse.
+ * Use `create(FindPathResponseSchema)` to create a new message.
+ */
+export const FindPathResponseSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 6);
+
+/**
+ * Describes the message game.v1.Date.
+ * Use `create(DateSchema)` to create a new message.
+ */
+export const DateSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 7);
+
+/**
+ * Describes the message game.v1.TurnState.
+ * Use `create(TurnStateSchema)` to create a new message.
+ */
+export const TurnStateSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 8);
+
+/**
+ * Describes the message game.v1.TurnState.Player.
+ * Use `create(TurnState_PlayerSchema)` to create a new message.
+ */
+export const TurnState_PlayerSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 8, 0);
+
+/**
+ * Describes the message game.v1.GetTurnStateRequest.
+ * Use `create(GetTurnStateRequestSchema)` to create a new message.
+ */
+export const GetTurnStateRequestSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 9);
+
+/**
+ * Describes the message game.v1.GetTurnStateResponse.
+ * Use `create(GetTurnStateResponseSchema)` to create a new message.
+ */
+export const GetTurnStateResponseSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 10);
+
+/**
+ * Describes the message game.v1.Command.
+ * Use `create(CommandSchema)` to create a new message.
+ */
+export const CommandSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 11);
+
+/**
+ * Describes the message game.v1.Command.MoveHero.
+ * Use `create(Command_MoveHeroSchema)` to create a new message.
+ */
+export const Command_MoveHeroSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 11, 0);
+
+/**
+ * Describes the message game.v1.Command.EndTurn.
+ * Use `create(Command_EndTurnSchema)` to create a new message.
+ */
+export const Command_EndTurnSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 11, 1);
+
+/**
+ * Describes the message game.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export const EventSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12);
+
+/**
+ * Describes the message game.v1.Event.Joined.
+ * Use `create(Event_JoinedSchema)` to create a new message.
+ */
+export const Event_JoinedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 0);
+
+/**
+ * Describes the message game.v1.Event.Left.
+ * Use `create(Event_LeftSchema)` to create a new message.
+ */
+export const Event_LeftSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 1);
+
+/**
+ * Describes the message game.v1.Event.TurnStarted.
+ * Use `create(Event_TurnStartedSchema)` to create a new message.
+ */
+export const Event_TurnStartedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 2);
+
+/**
+ * Describes the message game.v1.Event.PlayerDone.
+ * Use `create(Event_PlayerDoneSchema)` to create a new message.
+ */
+export const Event_PlayerDoneSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 3);
+
+/**
+ * Describes the message game.v1.Event.HeroRecruited.
+ * Use `create(Event_HeroRecruitedSchema)` to create a new message.
+ */
+export const Event_HeroRecruitedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 4);
+
+/**
+ * Describes the message game.v1.Event.HeroMoved.
+ * Use `create(Event_HeroMovedSchema)` to create a new message.
+ */
+export const Event_HeroMovedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 5);
+
+/**
+ * Describes the message game.v1.Event.SpellCast.
+ * Use `create(Event_SpellCastSchema)` to create a new message.
+ */
+export const Event_SpellCastSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 6);
+
+/**
+ * Describes the message game.v1.Event.TownUpdated.
+ * Use `create(Event_TownUpdatedSchema)` to create a new message.
+ */
+export const Event_TownUpdatedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 7);
+
+/**
+ * Describes the message game.v1.Event.SiteUpdated.
+ * Use `create(Event_SiteUpdatedSchema)` to create a new message.
+ */
+export const Event_SiteUpdatedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 8);
+
+/**
+ * Describes the message game.v1.Event.ObjectUpdated.
+ * Use `create(Event_ObjectUpdatedSchema)` to create a new message.
+ */
+export const Event_ObjectUpdatedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 9);
+
+/**
+ * Describes the message game.v1.Event.Rejected.
+ * Use `create(Event_RejectedSchema)` to create a new message.
+ */
+export const Event_RejectedSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 12, 10);
+
+/**
+ * Describes the message game.v1.PlayRequest.
+ * Use `create(PlayRequestSchema)` to create a new message.
+ */
+export const PlayRequestSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 13);
+
+/**
+ * Describes the message game.v1.PlayResponse.
+ * Use `create(PlayResponseSchema)` to create a new message.
+ */
+export const PlayResponseSchema = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 14);
+
+/**
+ * Describes the enum game.v1.TurnMode.
+ */
+export const TurnModeSchema = /*@__PURE__*/
+  enumDesc(file_game_v1_game, 0);
+
+/**
+ * @generated from enum game.v1.TurnMode
+ */
+export const TurnMode = /*@__PURE__*/
+  tsEnum(TurnModeSchema);
+
 /**
  * @generated from service game.v1.GameService
  */
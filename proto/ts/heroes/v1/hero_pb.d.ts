@@ -0,0 +1,386 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file heroes/v1/hero.proto (package heroes.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Tile_Coordinate } from "../../map/v1/tile_pb";
+import type { Site } from "../../economy/v1/economy_pb";
+
+/**
+ * Describes the file heroes/v1/hero.proto.
+ */
+export declare const file_heroes_v1_hero: GenFile;
+
+/**
+ * @generated from message heroes.v1.Hero
+ */
+export declare type Hero = Message<"heroes.v1.Hero"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * account controlling the hero
+   *
+   * @generated from field: string owner_id = 3;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate position = 5;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * left for today
+   *
+   * @generated from field: uint32 movement_points = 6;
+   */
+  movementPoints: number;
+
+  /**
+   * restored every day
+   *
+   * @generated from field: uint32 max_movement_points = 7;
+   */
+  maxMovementPoints: number;
+
+  /**
+   * @generated from field: heroes.v1.Hero.Stats stats = 8;
+   */
+  stats?: Hero_Stats;
+
+  /**
+   * up to 7 stacks
+   *
+   * @generated from field: repeated heroes.v1.Hero.Stack army = 9;
+   */
+  army: Hero_Stack[];
+
+  /**
+   * restored every day up to 10 per point of knowledge
+   *
+   * @generated from field: uint32 mana = 10;
+   */
+  mana: number;
+
+  /**
+   * ids of spells the hero knows
+   *
+   * @generated from field: repeated string spells = 11;
+   */
+  spells: string[];
+};
+
+/**
+ * Describes the message heroes.v1.Hero.
+ * Use `create(HeroSchema)` to create a new message.
+ */
+export declare const HeroSchema: GenMessage<Hero>;
+
+/**
+ * @generated from message heroes.v1.Hero.Stats
+ */
+export declare type Hero_Stats = Message<"heroes.v1.Hero.Stats"> & {
+  /**
+   * @generated from field: int32 attack = 1;
+   */
+  attack: number;
+
+  /**
+   * @generated from field: int32 defence = 2;
+   */
+  defence: number;
+
+  /**
+   * @generated from field: int32 spell_power = 3;
+   */
+  spellPower: number;
+
+  /**
+   * @generated from field: int32 knowledge = 4;
+   */
+  knowledge: number;
+};
+
+/**
+ * Describes the message heroes.v1.Hero.Stats.
+ * Use `create(Hero_StatsSchema)` to create a new message.
+ */
+export declare const Hero_StatsSchema: GenMessage<Hero_Stats>;
+
+/**
+ * @generated from message heroes.v1.Hero.Stack
+ */
+export declare type Hero_Stack = Message<"heroes.v1.Hero.Stack"> & {
+  /**
+   * @generated from field: string creature_id = 1;
+   */
+  creatureId: string;
+
+  /**
+   * @generated from field: uint32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message heroes.v1.Hero.Stack.
+ * Use `create(Hero_StackSchema)` to create a new message.
+ */
+export declare const Hero_StackSchema: GenMessage<Hero_Stack>;
+
+/**
+ * @generated from message heroes.v1.ListHeroesRequest
+ */
+export declare type ListHeroesRequest = Message<"heroes.v1.ListHeroesRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message heroes.v1.ListHeroesRequest.
+ * Use `create(ListHeroesRequestSchema)` to create a new message.
+ */
+export declare const ListHeroesRequestSchema: GenMessage<ListHeroesRequest>;
+
+/**
+ * @generated from message heroes.v1.ListHeroesResponse
+ */
+export declare type ListHeroesResponse = Message<"heroes.v1.ListHeroesResponse"> & {
+  /**
+   * @generated from field: repeated heroes.v1.Hero heroes = 1;
+   */
+  heroes: Hero[];
+};
+
+/**
+ * Describes the message heroes.v1.ListHeroesResponse.
+ * Use `create(ListHeroesResponseSchema)` to create a new message.
+ */
+export declare const ListHeroesResponseSchema: GenMessage<ListHeroesResponse>;
+
+/**
+ * @generated from message heroes.v1.RecruitHeroRequest
+ */
+export declare type RecruitHeroRequest = Message<"heroes.v1.RecruitHeroRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * town of the caller the hero appears in, with army & spells of its hero class
+   *
+   * @generated from field: string town_id = 6;
+   */
+  townId: string;
+};
+
+/**
+ * Describes the message heroes.v1.RecruitHeroRequest.
+ * Use `create(RecruitHeroRequestSchema)` to create a new message.
+ */
+export declare const RecruitHeroRequestSchema: GenMessage<RecruitHeroRequest>;
+
+/**
+ * @generated from message heroes.v1.RecruitHeroResponse
+ */
+export declare type RecruitHeroResponse = Message<"heroes.v1.RecruitHeroResponse"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+};
+
+/**
+ * Describes the message heroes.v1.RecruitHeroResponse.
+ * Use `create(RecruitHeroResponseSchema)` to create a new message.
+ */
+export declare const RecruitHeroResponseSchema: GenMessage<RecruitHeroResponse>;
+
+/**
+ * @generated from message heroes.v1.MoveHeroRequest
+ */
+export declare type MoveHeroRequest = Message<"heroes.v1.MoveHeroRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string hero_id = 2;
+   */
+  heroId: string;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate goal = 3;
+   */
+  goal?: Tile_Coordinate;
+};
+
+/**
+ * Describes the message heroes.v1.MoveHeroRequest.
+ * Use `create(MoveHeroRequestSchema)` to create a new message.
+ */
+export declare const MoveHeroRequestSchema: GenMessage<MoveHeroRequest>;
+
+/**
+ * @generated from message heroes.v1.MoveHeroResponse
+ */
+export declare type MoveHeroResponse = Message<"heroes.v1.MoveHeroResponse"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+
+  /**
+   * tiles actually traveled, including start
+   *
+   * @generated from field: repeated map.v1.Tile.Coordinate path = 2;
+   */
+  path: Tile_Coordinate[];
+
+  /**
+   * false if movement points ran out on the way
+   *
+   * @generated from field: bool arrived = 3;
+   */
+  arrived: boolean;
+
+  /**
+   * sites along the path, pickups are gone once visited
+   *
+   * @generated from field: repeated economy.v1.Site visited = 4;
+   */
+  visited: Site[];
+
+  /**
+   * object visited at the end of the path
+   *
+   * @generated from field: string object_id = 5;
+   */
+  objectId: string;
+
+  /**
+   * hero lost a battle against guards of the object & is gone
+   *
+   * @generated from field: bool defeated = 6;
+   */
+  defeated: boolean;
+};
+
+/**
+ * Describes the message heroes.v1.MoveHeroResponse.
+ * Use `create(MoveHeroResponseSchema)` to create a new message.
+ */
+export declare const MoveHeroResponseSchema: GenMessage<MoveHeroResponse>;
+
+/**
+ * @generated from message heroes.v1.CastSpellRequest
+ */
+export declare type CastSpellRequest = Message<"heroes.v1.CastSpellRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string hero_id = 2;
+   */
+  heroId: string;
+
+  /**
+   * adventure spell targeting the caster
+   *
+   * @generated from field: string spell_id = 3;
+   */
+  spellId: string;
+};
+
+/**
+ * Describes the message heroes.v1.CastSpellRequest.
+ * Use `create(CastSpellRequestSchema)` to create a new message.
+ */
+export declare const CastSpellRequestSchema: GenMessage<CastSpellRequest>;
+
+/**
+ * @generated from message heroes.v1.CastSpellResponse
+ */
+export declare type CastSpellResponse = Message<"heroes.v1.CastSpellResponse"> & {
+  /**
+   * @generated from field: heroes.v1.Hero hero = 1;
+   */
+  hero?: Hero;
+
+  /**
+   * effective spell level after terrain effects
+   *
+   * @generated from field: int32 level = 2;
+   */
+  level: number;
+};
+
+/**
+ * Describes the message heroes.v1.CastSpellResponse.
+ * Use `create(CastSpellResponseSchema)` to create a new message.
+ */
+export declare const CastSpellResponseSchema: GenMessage<CastSpellResponse>;
+
+/**
+ * @generated from service heroes.v1.HeroService
+ */
+export declare const HeroService: GenService<{
+  /**
+   * @generated from rpc heroes.v1.HeroService.ListHeroes
+   */
+  listHeroes: {
+    methodKind: "unary";
+    input: typeof ListHeroesRequestSchema;
+    output: typeof ListHeroesResponseSchema;
+  },
+  /**
+   * @generated from rpc heroes.v1.HeroService.RecruitHero
+   */
+  recruitHero: {
+    methodKind: "unary";
+    input: typeof RecruitHeroRequestSchema;
+    output: typeof RecruitHeroResponseSchema;
+  },
+  /**
+   * @generated from rpc heroes.v1.HeroService.MoveHero
+   */
+  moveHero: {
+    methodKind: "unary";
+    input: typeof MoveHeroRequestSchema;
+    output: typeof MoveHeroResponseSchema;
+  },
+  /**
+   * @generated from rpc heroes.v1.HeroService.CastSpell
+   */
+  castSpell: {
+    methodKind: "unary";
+    input: typeof CastSpellRequestSchema;
+    output: typeof CastSpellResponseSchema;
+  },
+}>;
+
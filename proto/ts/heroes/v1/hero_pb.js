@@ -0,0 +1,97 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file heroes/v1/hero.proto (package heroes.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_economy_v1_economy } from "../../economy/v1/economy_pb";
+import { file_map_v1_tile } from "../../map/v1/tile_pb";
+
+/**
+ * Describes the file heroes/v1/hero.proto.
+ */
+export const file_heroes_v1_hero = /*@__PURE__*/
+  fileDesc("ChRoZXJvZXMvdjEvaGVyby5wcm90bxIJaGVyb2VzLnYxIowDCgRIZXJvEgoKAmlkGAEgASgJEg8KB2dhbWVfaWQYAiABKAkSEAoIb3duZXJfaWQYAyABKAkSDAoEbmFtZRgEIAEoCRIpCghwb3NpdGlvbhgFIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSFwoPbW92ZW1lbnRfcG9pbnRzGAYgASgNEhsKE21heF9tb3ZlbWVudF9wb2ludHMYByABKA0SJAoFc3RhdHMYCCABKAsyFS5oZXJvZXMudjEuSGVyby5TdGF0cxIjCgRhcm15GAkgAygLMhUuaGVyb2VzLnYxLkhlcm8uU3RhY2sSDAoEbWFuYRgKIAEoDRIOCgZzcGVsbHMYCyADKAkaUAoFU3RhdHMSDgoGYXR0YWNrGAEgASgFEg8KB2RlZmVuY2UYAiABKAUSEwoLc3BlbGxfcG93ZXIYAyABKAUSEQoJa25vd2xlZGdlGAQgASgFGisKBVN0YWNrEhMKC2NyZWF0dXJlX2lkGAEgASgJEg0KBWNvdW50GAIgASgNIiQKEUxpc3RIZXJvZXNSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkiNQoSTGlzdEhlcm9lc1Jlc3BvbnNlEh8KBmhlcm9lcxgBIAMoCzIPLmhlcm9lcy52MS5IZXJvIlYKElJlY3J1aXRIZXJvUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSDwoHdG93bl9pZBgGIAEoCUoECAMQBEoECAQQBUoECAUQBiI0ChNSZWNydWl0SGVyb1Jlc3BvbnNlEh0KBGhlcm8YASABKAsyDy5oZXJvZXMudjEuSGVybyJaCg9Nb3ZlSGVyb1JlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIPCgdoZXJvX2lkGAIgASgJEiUKBGdvYWwYAyABKAsyFy5tYXAudjEuVGlsZS5Db29yZGluYXRlIrEBChBNb3ZlSGVyb1Jlc3BvbnNlEh0KBGhlcm8YASABKAsyDy5oZXJvZXMudjEuSGVybxIlCgRwYXRoGAIgAygLMhcubWFwLnYxLlRpbGUuQ29vcmRpbmF0ZRIPCgdhcnJpdmVkGAMgASgIEiEKB3Zpc2l0ZWQYBCADKAsyEC5lY29ub215LnYxLlNpdGUSEQoJb2JqZWN0X2lkGAUgASgJEhAKCGRlZmVhdGVkGAYgASgIIkYKEENhc3RTcGVsbFJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIPCgdoZXJvX2lkGAIgASgJEhAKCHNwZWxsX2lkGAMgASgJIkEKEUNhc3RTcGVsbFJlc3BvbnNlEh0KBGhlcm8YASABKAsyDy5oZXJvZXMudjEuSGVybxINCgVsZXZlbBgCIAEoBTKzAgoLSGVyb1NlcnZpY2USSQoKTGlzdEhlcm9lcxIcLmhlcm9lcy52MS5MaXN0SGVyb2VzUmVxdWVzdBodLmhlcm9lcy52MS5MaXN0SGVyb2VzUmVzcG9uc2USTAoLUmVjcnVpdEhlcm8SHS5oZXJvZXMudjEuUmVjcnVpdEhlcm9SZXF1ZXN0Gh4uaGVyb2VzLnYxLlJlY3J1aXRIZXJvUmVzcG9uc2USQwoITW92ZUhlcm8SGi5oZXJvZXMudjEuTW92ZUhlcm9SZXF1ZXN0GhsuaGVyb2VzLnYxLk1vdmVIZXJvUmVzcG9uc2USRgoJQ2FzdFNwZWxsEhsuaGVyb2VzLnYxLkNhc3RTcGVsbFJlcXVlc3QaHC5oZXJvZXMudjEuQ2FzdFNwZWxsUmVzcG9uc2VCjgEKDWNvbS5oZXJvZXMudjFCCUhlcm9Qcm90b1ABWi1naXRodWIuY29tL29wZW5oZXhlcy9wcm90by9oZXJvZXMvdjE7aGVyb2VzdjGiAgNIWFiqAglIZXJvZXMuVjHKAglIZXJvZXNcVjHiAhVIZXJvZXNcVjFcR1BCTWV0YWRhdGHqAgpIZXJvZXM6OlYxYgZwcm90bzM", [file_economy_v1_economy, file_map_v1_tile]);
+
+/**
+ * Describes the message heroes.v1.Hero.
+ * Use `create(HeroSchema)` to create a new message.
+ */
+export const HeroSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 0);
+
+/**
+ * Describes the message heroes.v1.Hero.Stats.
+ * Use `create(Hero_StatsSchema)` to create a new message.
+ */
+export const Hero_StatsSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 0, 0);
+
+/**
+ * Describes the message heroes.v1.Hero.Stack.
+ * Use `create(Hero_StackSchema)` to create a new message.
+ */
+export const Hero_StackSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 0, 1);
+
+/**
+ * Describes the message heroes.v1.ListHeroesRequest.
+ * Use `create(ListHeroesRequestSchema)` to create a new message.
+ */
+export const ListHeroesRequestSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 1);
+
+/**
+ * Describes the message heroes.v1.ListHeroesResponse.
+ * Use `create(ListHeroesResponseSchema)` to create a new message.
+ */
+export const ListHeroesResponseSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 2);
+
+/**
+ * Describes the message heroes.v1.RecruitHeroRequest.
+ * Use `create(RecruitHeroRequestSchema)` to create a new message.
+ */
+export const RecruitHeroRequestSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 3);
+
+/**
+ * Describes the message heroes.v1.RecruitHeroResponse.
+ * Use `create(RecruitHeroResponseSchema)` to create a new message.
+ */
+export const RecruitHeroResponseSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 4);
+
+/**
+ * Describes the message heroes.v1.MoveHeroRequest.
+ * Use `create(MoveHeroRequestSchema)` to create a new message.
+ */
+export const MoveHeroRequestSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 5);
+
+/**
+ * Describes the message heroes.v1.MoveHeroResponse.
+ * Use `create(MoveHeroResponseSchema)` to create a new message.
+ */
+export const MoveHeroResponseSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 6);
+
+/**
+ * Describes the message heroes.v1.CastSpellRequest.
+ * Use `create(CastSpellRequestSchema)` to create a new message.
+ */
+export const CastSpellRequestSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 7);
+
+/**
+ * Describes the message heroes.v1.CastSpellResponse.
+ * Use `create(CastSpellResponseSchema)` to create a new message.
+ */
+export const CastSpellResponseSchema = /*@__PURE__*/
+  messageDesc(file_heroes_v1_hero, 8);
+
+/**
+ * @generated from service heroes.v1.HeroService
+ */
+export const HeroService = /*@__PURE__*/
+  serviceDesc(file_heroes_v1_hero, 0);
+
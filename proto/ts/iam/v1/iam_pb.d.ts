@@ -2,7 +2,7 @@
 // @generated from file iam/v1/iam.proto (package iam.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 
@@ -29,6 +29,13 @@ export declare type Account = Message<"iam.v1.Account"> & {
    * @generated from field: string email = 3;
    */
   email: string;
+
+  /**
+   * ids of granted roles, only set for admins
+   *
+   * @generated from field: repeated string roles = 4;
+   */
+  roles: string[];
 };
 
 /**
@@ -68,6 +75,206 @@ export declare type Account_Meta = Message<"iam.v1.Account.Meta"> & {
  */
 export declare const Account_MetaSchema: GenMessage<Account_Meta>;
 
+/**
+ * @generated from message iam.v1.IdentityProvider
+ */
+export declare type IdentityProvider = Message<"iam.v1.IdentityProvider"> & {
+  /**
+   * provider of SignInRequest
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: iam.v1.IdentityProvider.Kind kind = 2;
+   */
+  kind: IdentityProvider_Kind;
+
+  /**
+   * @generated from field: string client_id = 3;
+   */
+  clientId: string;
+
+  /**
+   * starts a new attempt, only set when codes may be exchanged & redirect_uri is given
+   *
+   * @generated from field: string authorize_url = 4;
+   */
+  authorizeUrl: string;
+};
+
+/**
+ * Describes the message iam.v1.IdentityProvider.
+ * Use `create(IdentityProviderSchema)` to create a new message.
+ */
+export declare const IdentityProviderSchema: GenMessage<IdentityProvider>;
+
+/**
+ * @generated from enum iam.v1.IdentityProvider.Kind
+ */
+export enum IdentityProvider_Kind {
+  /**
+   * @generated from enum value: KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * credential is an ID token signed by the provider
+   *
+   * @generated from enum value: KIND_ID_TOKEN = 1;
+   */
+  ID_TOKEN = 1,
+
+  /**
+   * credential is an OAuth access token
+   *
+   * @generated from enum value: KIND_ACCESS_TOKEN = 2;
+   */
+  ACCESS_TOKEN = 2,
+
+  /**
+   * credential is base64 of "email:password"
+   *
+   * @generated from enum value: KIND_PASSWORD = 3;
+   */
+  PASSWORD = 3,
+}
+
+/**
+ * Describes the enum iam.v1.IdentityProvider.Kind.
+ */
+export declare const IdentityProvider_KindSchema: GenEnum<IdentityProvider_Kind>;
+
+/**
+ * Identity is a login linked to an account.
+ *
+ * @generated from message iam.v1.Identity
+ */
+export declare type Identity = Message<"iam.v1.Identity"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * id of the login within the provider
+   *
+   * @generated from field: string subject = 2;
+   */
+  subject: string;
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message iam.v1.Identity.
+ * Use `create(IdentitySchema)` to create a new message.
+ */
+export declare const IdentitySchema: GenMessage<Identity>;
+
+/**
+ * Session is a sign-in of an account on a device.
+ *
+ * @generated from message iam.v1.Session
+ */
+export declare type Session = Message<"iam.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * signed in with
+   *
+   * @generated from field: string provider = 2;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp refreshed_at = 5;
+   */
+  refreshedAt?: Timestamp;
+
+  /**
+   * unless refreshed
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * session of the request
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message iam.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export declare const SessionSchema: GenMessage<Session>;
+
+/**
+ * Tokens are sent as "Authorization: Bearer <access_token>" or in the "hexes.session" cookie.
+ *
+ * @generated from message iam.v1.Tokens
+ */
+export declare type Tokens = Message<"iam.v1.Tokens"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string access_token = 2;
+   */
+  accessToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp access_expires_at = 3;
+   */
+  accessExpiresAt?: Timestamp;
+
+  /**
+   * single use, Refresh returns a new one, unset when it's kept in the refresh cookie
+   *
+   * @generated from field: string refresh_token = 4;
+   */
+  refreshToken: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp refresh_expires_at = 5;
+   */
+  refreshExpiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message iam.v1.Tokens.
+ * Use `create(TokensSchema)` to create a new message.
+ */
+export declare const TokensSchema: GenMessage<Tokens>;
+
 /**
  * @generated from message iam.v1.ResolveAccountRequest
  */
@@ -100,6 +307,43 @@ export declare const ResolveAccountResponseSchema: GenMessage<ResolveAccountResp
  * @generated from message iam.v1.ListAccountsRequest
  */
 export declare type ListAccountsRequest = Message<"iam.v1.ListAccountsRequest"> & {
+  /**
+   * @generated from field: optional bool active = 1;
+   */
+  active?: boolean;
+
+  /**
+   * only accounts granted the role
+   *
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+
+  /**
+   * case-insensitive substring
+   *
+   * @generated from field: string email = 3;
+   */
+  email: string;
+
+  /**
+   * @generated from field: iam.v1.ListAccountsRequest.Order order = 4;
+   */
+  order: ListAccountsRequest_Order;
+
+  /**
+   * accounts per page, defaults to 100
+   *
+   * @generated from field: uint32 limit = 5;
+   */
+  limit: number;
+
+  /**
+   * next_cursor of the previous page, filters & order must stay the same
+   *
+   * @generated from field: string cursor = 6;
+   */
+  cursor: string;
 };
 
 /**
@@ -108,6 +352,33 @@ export declare type ListAccountsRequest = Message<"iam.v1.ListAccountsRequest">
  */
 export declare const ListAccountsRequestSchema: GenMessage<ListAccountsRequest>;
 
+/**
+ * @generated from enum iam.v1.ListAccountsRequest.Order
+ */
+export enum ListAccountsRequest_Order {
+  /**
+   * same as ORDER_NEWEST_FIRST
+   *
+   * @generated from enum value: ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORDER_NEWEST_FIRST = 1;
+   */
+  NEWEST_FIRST = 1,
+
+  /**
+   * @generated from enum value: ORDER_EMAIL = 2;
+   */
+  EMAIL = 2,
+}
+
+/**
+ * Describes the enum iam.v1.ListAccountsRequest.Order.
+ */
+export declare const ListAccountsRequest_OrderSchema: GenEnum<ListAccountsRequest_Order>;
+
 /**
  * @generated from message iam.v1.ListAccountsResponse
  */
@@ -116,6 +387,13 @@ export declare type ListAccountsResponse = Message<"iam.v1.ListAccountsResponse"
    * @generated from field: repeated iam.v1.Account accounts = 1;
    */
   accounts: Account[];
+
+  /**
+   * only set in the last message, empty if there are no more pages
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
 };
 
 /**
@@ -129,6 +407,8 @@ export declare const ListAccountsResponseSchema: GenMessage<ListAccountsResponse
  */
 export declare type UpdateAccountActivationRequest = Message<"iam.v1.UpdateAccountActivationRequest"> & {
   /**
+   * account id -> active
+   *
    * @generated from field: map<string, bool> id_to_activation = 1;
    */
   idToActivation: { [key: string]: boolean };
@@ -152,6 +432,514 @@ export declare type UpdateAccountActivationResponse = Message<"iam.v1.UpdateAcco
  */
 export declare const UpdateAccountActivationResponseSchema: GenMessage<UpdateAccountActivationResponse>;
 
+/**
+ * @generated from message iam.v1.GrantRoleRequest
+ */
+export declare type GrantRoleRequest = Message<"iam.v1.GrantRoleRequest"> & {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message iam.v1.GrantRoleRequest.
+ * Use `create(GrantRoleRequestSchema)` to create a new message.
+ */
+export declare const GrantRoleRequestSchema: GenMessage<GrantRoleRequest>;
+
+/**
+ * @generated from message iam.v1.GrantRoleResponse
+ */
+export declare type GrantRoleResponse = Message<"iam.v1.GrantRoleResponse"> & {
+  /**
+   * with updated roles
+   *
+   * @generated from field: iam.v1.Account account = 1;
+   */
+  account?: Account;
+};
+
+/**
+ * Describes the message iam.v1.GrantRoleResponse.
+ * Use `create(GrantRoleResponseSchema)` to create a new message.
+ */
+export declare const GrantRoleResponseSchema: GenMessage<GrantRoleResponse>;
+
+/**
+ * @generated from message iam.v1.RevokeRoleRequest
+ */
+export declare type RevokeRoleRequest = Message<"iam.v1.RevokeRoleRequest"> & {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId: string;
+
+  /**
+   * @generated from field: string role_id = 2;
+   */
+  roleId: string;
+};
+
+/**
+ * Describes the message iam.v1.RevokeRoleRequest.
+ * Use `create(RevokeRoleRequestSchema)` to create a new message.
+ */
+export declare const RevokeRoleRequestSchema: GenMessage<RevokeRoleRequest>;
+
+/**
+ * @generated from message iam.v1.RevokeRoleResponse
+ */
+export declare type RevokeRoleResponse = Message<"iam.v1.RevokeRoleResponse"> & {
+  /**
+   * with updated roles
+   *
+   * @generated from field: iam.v1.Account account = 1;
+   */
+  account?: Account;
+};
+
+/**
+ * Describes the message iam.v1.RevokeRoleResponse.
+ * Use `create(RevokeRoleResponseSchema)` to create a new message.
+ */
+export declare const RevokeRoleResponseSchema: GenMessage<RevokeRoleResponse>;
+
+/**
+ * @generated from message iam.v1.ListIdentityProvidersRequest
+ */
+export declare type ListIdentityProvidersRequest = Message<"iam.v1.ListIdentityProvidersRequest"> & {
+  /**
+   * where providers send users back with the code & state, has to be allowed by the server
+   *
+   * @generated from field: string redirect_uri = 1;
+   */
+  redirectUri: string;
+};
+
+/**
+ * Describes the message iam.v1.ListIdentityProvidersRequest.
+ * Use `create(ListIdentityProvidersRequestSchema)` to create a new message.
+ */
+export declare const ListIdentityProvidersRequestSchema: GenMessage<ListIdentityProvidersRequest>;
+
+/**
+ * @generated from message iam.v1.ListIdentityProvidersResponse
+ */
+export declare type ListIdentityProvidersResponse = Message<"iam.v1.ListIdentityProvidersResponse"> & {
+  /**
+   * @generated from field: repeated iam.v1.IdentityProvider providers = 1;
+   */
+  providers: IdentityProvider[];
+};
+
+/**
+ * Describes the message iam.v1.ListIdentityProvidersResponse.
+ * Use `create(ListIdentityProvidersResponseSchema)` to create a new message.
+ */
+export declare const ListIdentityProvidersResponseSchema: GenMessage<ListIdentityProvidersResponse>;
+
+/**
+ * @generated from message iam.v1.ExchangeCodeRequest
+ */
+export declare type ExchangeCodeRequest = Message<"iam.v1.ExchangeCodeRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string code = 2;
+   */
+  code: string;
+
+  /**
+   * same as when listing providers
+   *
+   * @generated from field: string redirect_uri = 3;
+   */
+  redirectUri: string;
+
+  /**
+   * as sent back by the provider
+   *
+   * @generated from field: string state = 4;
+   */
+  state: string;
+};
+
+/**
+ * Describes the message iam.v1.ExchangeCodeRequest.
+ * Use `create(ExchangeCodeRequestSchema)` to create a new message.
+ */
+export declare const ExchangeCodeRequestSchema: GenMessage<ExchangeCodeRequest>;
+
+/**
+ * @generated from message iam.v1.ExchangeCodeResponse
+ */
+export declare type ExchangeCodeResponse = Message<"iam.v1.ExchangeCodeResponse"> & {
+  /**
+   * @generated from field: string credential = 1;
+   */
+  credential: string;
+};
+
+/**
+ * Describes the message iam.v1.ExchangeCodeResponse.
+ * Use `create(ExchangeCodeResponseSchema)` to create a new message.
+ */
+export declare const ExchangeCodeResponseSchema: GenMessage<ExchangeCodeResponse>;
+
+/**
+ * @generated from message iam.v1.SignUpRequest
+ */
+export declare type SignUpRequest = Message<"iam.v1.SignUpRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+};
+
+/**
+ * Describes the message iam.v1.SignUpRequest.
+ * Use `create(SignUpRequestSchema)` to create a new message.
+ */
+export declare const SignUpRequestSchema: GenMessage<SignUpRequest>;
+
+/**
+ * @generated from message iam.v1.SignUpResponse
+ */
+export declare type SignUpResponse = Message<"iam.v1.SignUpResponse"> & {
+};
+
+/**
+ * Describes the message iam.v1.SignUpResponse.
+ * Use `create(SignUpResponseSchema)` to create a new message.
+ */
+export declare const SignUpResponseSchema: GenMessage<SignUpResponse>;
+
+/**
+ * @generated from message iam.v1.SignInRequest
+ */
+export declare type SignInRequest = Message<"iam.v1.SignInRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string credential = 2;
+   */
+  credential: string;
+
+  /**
+   * keep the refresh token in the HttpOnly "hexes.refresh" cookie only sent to Refresh
+   *
+   * @generated from field: bool refresh_cookie = 3;
+   */
+  refreshCookie: boolean;
+};
+
+/**
+ * Describes the message iam.v1.SignInRequest.
+ * Use `create(SignInRequestSchema)` to create a new message.
+ */
+export declare const SignInRequestSchema: GenMessage<SignInRequest>;
+
+/**
+ * @generated from message iam.v1.SignInResponse
+ */
+export declare type SignInResponse = Message<"iam.v1.SignInResponse"> & {
+  /**
+   * @generated from field: iam.v1.Tokens tokens = 1;
+   */
+  tokens?: Tokens;
+
+  /**
+   * @generated from field: iam.v1.Account account = 2;
+   */
+  account?: Account;
+};
+
+/**
+ * Describes the message iam.v1.SignInResponse.
+ * Use `create(SignInResponseSchema)` to create a new message.
+ */
+export declare const SignInResponseSchema: GenMessage<SignInResponse>;
+
+/**
+ * @generated from message iam.v1.RefreshRequest
+ */
+export declare type RefreshRequest = Message<"iam.v1.RefreshRequest"> & {
+  /**
+   * the refresh cookie is used & replaced if unset
+   *
+   * @generated from field: string refresh_token = 1;
+   */
+  refreshToken: string;
+};
+
+/**
+ * Describes the message iam.v1.RefreshRequest.
+ * Use `create(RefreshRequestSchema)` to create a new message.
+ */
+export declare const RefreshRequestSchema: GenMessage<RefreshRequest>;
+
+/**
+ * @generated from message iam.v1.RefreshResponse
+ */
+export declare type RefreshResponse = Message<"iam.v1.RefreshResponse"> & {
+  /**
+   * @generated from field: iam.v1.Tokens tokens = 1;
+   */
+  tokens?: Tokens;
+};
+
+/**
+ * Describes the message iam.v1.RefreshResponse.
+ * Use `create(RefreshResponseSchema)` to create a new message.
+ */
+export declare const RefreshResponseSchema: GenMessage<RefreshResponse>;
+
+/**
+ * @generated from message iam.v1.ListSessionsRequest
+ */
+export declare type ListSessionsRequest = Message<"iam.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message iam.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export declare const ListSessionsRequestSchema: GenMessage<ListSessionsRequest>;
+
+/**
+ * @generated from message iam.v1.ListSessionsResponse
+ */
+export declare type ListSessionsResponse = Message<"iam.v1.ListSessionsResponse"> & {
+  /**
+   * most recently refreshed first
+   *
+   * @generated from field: repeated iam.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message iam.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export declare const ListSessionsResponseSchema: GenMessage<ListSessionsResponse>;
+
+/**
+ * @generated from message iam.v1.RevokeSessionRequest
+ */
+export declare type RevokeSessionRequest = Message<"iam.v1.RevokeSessionRequest"> & {
+  /**
+   * the current session if empty
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message iam.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export declare const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest>;
+
+/**
+ * @generated from message iam.v1.RevokeSessionResponse
+ */
+export declare type RevokeSessionResponse = Message<"iam.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message iam.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export declare const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse>;
+
+/**
+ * @generated from message iam.v1.RevokeSessionsRequest
+ */
+export declare type RevokeSessionsRequest = Message<"iam.v1.RevokeSessionsRequest"> & {
+  /**
+   * signs out other devices only
+   *
+   * @generated from field: bool keep_current = 1;
+   */
+  keepCurrent: boolean;
+};
+
+/**
+ * Describes the message iam.v1.RevokeSessionsRequest.
+ * Use `create(RevokeSessionsRequestSchema)` to create a new message.
+ */
+export declare const RevokeSessionsRequestSchema: GenMessage<RevokeSessionsRequest>;
+
+/**
+ * @generated from message iam.v1.RevokeSessionsResponse
+ */
+export declare type RevokeSessionsResponse = Message<"iam.v1.RevokeSessionsResponse"> & {
+  /**
+   * @generated from field: uint32 revoked = 1;
+   */
+  revoked: number;
+};
+
+/**
+ * Describes the message iam.v1.RevokeSessionsResponse.
+ * Use `create(RevokeSessionsResponseSchema)` to create a new message.
+ */
+export declare const RevokeSessionsResponseSchema: GenMessage<RevokeSessionsResponse>;
+
+/**
+ * @generated from message iam.v1.ListIdentitiesRequest
+ */
+export declare type ListIdentitiesRequest = Message<"iam.v1.ListIdentitiesRequest"> & {
+};
+
+/**
+ * Describes the message iam.v1.ListIdentitiesRequest.
+ * Use `create(ListIdentitiesRequestSchema)` to create a new message.
+ */
+export declare const ListIdentitiesRequestSchema: GenMessage<ListIdentitiesRequest>;
+
+/**
+ * @generated from message iam.v1.ListIdentitiesResponse
+ */
+export declare type ListIdentitiesResponse = Message<"iam.v1.ListIdentitiesResponse"> & {
+  /**
+   * @generated from field: repeated iam.v1.Identity identities = 1;
+   */
+  identities: Identity[];
+};
+
+/**
+ * Describes the message iam.v1.ListIdentitiesResponse.
+ * Use `create(ListIdentitiesResponseSchema)` to create a new message.
+ */
+export declare const ListIdentitiesResponseSchema: GenMessage<ListIdentitiesResponse>;
+
+/**
+ * @generated from message iam.v1.LinkIdentityRequest
+ */
+export declare type LinkIdentityRequest = Message<"iam.v1.LinkIdentityRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string credential = 2;
+   */
+  credential: string;
+};
+
+/**
+ * Describes the message iam.v1.LinkIdentityRequest.
+ * Use `create(LinkIdentityRequestSchema)` to create a new message.
+ */
+export declare const LinkIdentityRequestSchema: GenMessage<LinkIdentityRequest>;
+
+/**
+ * @generated from message iam.v1.LinkIdentityResponse
+ */
+export declare type LinkIdentityResponse = Message<"iam.v1.LinkIdentityResponse"> & {
+  /**
+   * @generated from field: iam.v1.Identity identity = 1;
+   */
+  identity?: Identity;
+};
+
+/**
+ * Describes the message iam.v1.LinkIdentityResponse.
+ * Use `create(LinkIdentityResponseSchema)` to create a new message.
+ */
+export declare const LinkIdentityResponseSchema: GenMessage<LinkIdentityResponse>;
+
+/**
+ * @generated from message iam.v1.UnlinkIdentityRequest
+ */
+export declare type UnlinkIdentityRequest = Message<"iam.v1.UnlinkIdentityRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string subject = 2;
+   */
+  subject: string;
+};
+
+/**
+ * Describes the message iam.v1.UnlinkIdentityRequest.
+ * Use `create(UnlinkIdentityRequestSchema)` to create a new message.
+ */
+export declare const UnlinkIdentityRequestSchema: GenMessage<UnlinkIdentityRequest>;
+
+/**
+ * @generated from message iam.v1.UnlinkIdentityResponse
+ */
+export declare type UnlinkIdentityResponse = Message<"iam.v1.UnlinkIdentityResponse"> & {
+};
+
+/**
+ * Describes the message iam.v1.UnlinkIdentityResponse.
+ * Use `create(UnlinkIdentityResponseSchema)` to create a new message.
+ */
+export declare const UnlinkIdentityResponseSchema: GenMessage<UnlinkIdentityResponse>;
+
+/**
+ * @generated from message iam.v1.SetPasswordRequest
+ */
+export declare type SetPasswordRequest = Message<"iam.v1.SetPasswordRequest"> & {
+  /**
+   * @generated from field: string password = 1;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message iam.v1.SetPasswordRequest.
+ * Use `create(SetPasswordRequestSchema)` to create a new message.
+ */
+export declare const SetPasswordRequestSchema: GenMessage<SetPasswordRequest>;
+
+/**
+ * @generated from message iam.v1.SetPasswordResponse
+ */
+export declare type SetPasswordResponse = Message<"iam.v1.SetPasswordResponse"> & {
+  /**
+   * @generated from field: iam.v1.Identity identity = 1;
+   */
+  identity?: Identity;
+};
+
+/**
+ * Describes the message iam.v1.SetPasswordResponse.
+ * Use `create(SetPasswordResponseSchema)` to create a new message.
+ */
+export declare const SetPasswordResponseSchema: GenMessage<SetPasswordResponse>;
+
 /**
  * @generated from service iam.v1.IAMService
  */
@@ -165,6 +953,8 @@ export declare const IAMService: GenService<{
     output: typeof ResolveAccountResponseSchema;
   },
   /**
+   * ListAccounts streams a page of accounts in chunks.
+   *
    * @generated from rpc iam.v1.IAMService.ListAccounts
    */
   listAccounts: {
@@ -173,6 +963,8 @@ export declare const IAMService: GenService<{
     output: typeof ListAccountsResponseSchema;
   },
   /**
+   * UpdateAccountActivation takes effect immediately.
+   *
    * @generated from rpc iam.v1.IAMService.UpdateAccountActivation
    */
   updateAccountActivation: {
@@ -180,5 +972,141 @@ export declare const IAMService: GenService<{
     input: typeof UpdateAccountActivationRequestSchema;
     output: typeof UpdateAccountActivationResponseSchema;
   },
+  /**
+   * GrantRole & RevokeRole take effect with the next request of the account.
+   *
+   * @generated from rpc iam.v1.IAMService.GrantRole
+   */
+  grantRole: {
+    methodKind: "unary";
+    input: typeof GrantRoleRequestSchema;
+    output: typeof GrantRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc iam.v1.IAMService.RevokeRole
+   */
+  revokeRole: {
+    methodKind: "unary";
+    input: typeof RevokeRoleRequestSchema;
+    output: typeof RevokeRoleResponseSchema;
+  },
+  /**
+   * ListIdentityProviders, ExchangeCode, SignUp, SignIn & Refresh are available without signing in.
+   *
+   * @generated from rpc iam.v1.IAMService.ListIdentityProviders
+   */
+  listIdentityProviders: {
+    methodKind: "unary";
+    input: typeof ListIdentityProvidersRequestSchema;
+    output: typeof ListIdentityProvidersResponseSchema;
+  },
+  /**
+   * ExchangeCode turns an authorization code into a credential of the provider,
+   * the state has to come from an authorize_url listed by ListIdentityProviders to the same client.
+   * Browsers keep the attempt in an HttpOnly cookie, other clients send it back as the cookie they received.
+   *
+   * @generated from rpc iam.v1.IAMService.ExchangeCode
+   */
+  exchangeCode: {
+    methodKind: "unary";
+    input: typeof ExchangeCodeRequestSchema;
+    output: typeof ExchangeCodeResponseSchema;
+  },
+  /**
+   * SignUp creates an inactive account with a password login. It responds the same way when
+   * the email already has an account, so callers can't tell which emails are signed up.
+   *
+   * @generated from rpc iam.v1.IAMService.SignUp
+   */
+  signUp: {
+    methodKind: "unary";
+    input: typeof SignUpRequestSchema;
+    output: typeof SignUpResponseSchema;
+  },
+  /**
+   * SignIn exchanges a credential of the provider for tokens of a new session.
+   *
+   * @generated from rpc iam.v1.IAMService.SignIn
+   */
+  signIn: {
+    methodKind: "unary";
+    input: typeof SignInRequestSchema;
+    output: typeof SignInResponseSchema;
+  },
+  /**
+   * Refresh issues new tokens of the session, the refresh token may only be used once,
+   * only a refresh token replaced moments ago is accepted again, then just the access token is issued.
+   *
+   * @generated from rpc iam.v1.IAMService.Refresh
+   */
+  refresh: {
+    methodKind: "unary";
+    input: typeof RefreshRequestSchema;
+    output: typeof RefreshResponseSchema;
+  },
+  /**
+   * ListSessions, RevokeSession & RevokeSessions manage sessions of the caller.
+   *
+   * @generated from rpc iam.v1.IAMService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc iam.v1.IAMService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc iam.v1.IAMService.RevokeSessions
+   */
+  revokeSessions: {
+    methodKind: "unary";
+    input: typeof RevokeSessionsRequestSchema;
+    output: typeof RevokeSessionsResponseSchema;
+  },
+  /**
+   * ListIdentities, LinkIdentity & UnlinkIdentity manage logins of the caller.
+   *
+   * @generated from rpc iam.v1.IAMService.ListIdentities
+   */
+  listIdentities: {
+    methodKind: "unary";
+    input: typeof ListIdentitiesRequestSchema;
+    output: typeof ListIdentitiesResponseSchema;
+  },
+  /**
+   * @generated from rpc iam.v1.IAMService.LinkIdentity
+   */
+  linkIdentity: {
+    methodKind: "unary";
+    input: typeof LinkIdentityRequestSchema;
+    output: typeof LinkIdentityResponseSchema;
+  },
+  /**
+   * UnlinkIdentity keeps at least one login of the caller.
+   *
+   * @generated from rpc iam.v1.IAMService.UnlinkIdentity
+   */
+  unlinkIdentity: {
+    methodKind: "unary";
+    input: typeof UnlinkIdentityRequestSchema;
+    output: typeof UnlinkIdentityResponseSchema;
+  },
+  /**
+   * SetPassword adds or replaces the password login of the caller, signed in as the email of the account.
+   *
+   * @generated from rpc iam.v1.IAMService.SetPassword
+   */
+  setPassword: {
+    methodKind: "unary";
+    input: typeof SetPasswordRequestSchema;
+    output: typeof SetPasswordResponseSchema;
+  },
 }>;
 
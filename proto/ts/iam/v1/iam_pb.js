@@ -2,14 +2,14 @@
 // @generated from file iam/v1/iam.proto (package iam.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
  * Describes the file iam/v1/iam.proto.
  */
 export const file_iam_v1_iam = /*@__PURE__*/
-  fileDesc("ChBpYW0vdjEvaWFtLnByb3RvEgZpYW0udjEitwEKB0FjY291bnQSCgoCaWQYASABKAkSIgoEbWV0YRgCIAEoCzIULmlhbS52MS5BY2NvdW50Lk1ldGESDQoFZW1haWwYAyABKAkabQoETWV0YRIOCgZhY3RpdmUYASABKAgSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZGlzcGxheV9uYW1lGAMgASgJEg8KB3BpY3R1cmUYBCABKAkiFwoVUmVzb2x2ZUFjY291bnRSZXF1ZXN0IjoKFlJlc29sdmVBY2NvdW50UmVzcG9uc2USIAoHYWNjb3VudBgBIAEoCzIPLmlhbS52MS5BY2NvdW50IhUKE0xpc3RBY2NvdW50c1JlcXVlc3QiOQoUTGlzdEFjY291bnRzUmVzcG9uc2USIQoIYWNjb3VudHMYASADKAsyDy5pYW0udjEuQWNjb3VudCKtAQoeVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXF1ZXN0ElQKEGlkX3RvX2FjdGl2YXRpb24YASADKAsyOi5pYW0udjEuVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXF1ZXN0LklkVG9BY3RpdmF0aW9uRW50cnkaNQoTSWRUb0FjdGl2YXRpb25FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAg6AjgBIiEKH1VwZGF0ZUFjY291bnRBY3RpdmF0aW9uUmVzcG9uc2UylgIKCklBTVNlcnZpY2USTwoOUmVzb2x2ZUFjY291bnQSHS5pYW0udjEuUmVzb2x2ZUFjY291bnRSZXF1ZXN0Gh4uaWFtLnYxLlJlc29sdmVBY2NvdW50UmVzcG9uc2USSwoMTGlzdEFjY291bnRzEhsuaWFtLnYxLkxpc3RBY2NvdW50c1JlcXVlc3QaHC5pYW0udjEuTGlzdEFjY291bnRzUmVzcG9uc2UwARJqChdVcGRhdGVBY2NvdW50QWN0aXZhdGlvbhImLmlhbS52MS5VcGRhdGVBY2NvdW50QWN0aXZhdGlvblJlcXVlc3QaJy5pYW0udjEuVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXNwb25zZUJ4Cgpjb20uaWFtLnYxQghJYW1Qcm90b1ABWidnaXRodWIuY29tL29wZW5oZXhlcy9wcm90by9pYW0vdjE7aWFtdjGiAgNJWFiqAgZJYW0uVjHKAgZJYW1cVjHiAhJJYW1cVjFcR1BCTWV0YWRhdGHqAgdJYW06OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChBpYW0vdjEvaWFtLnByb3RvEgZpYW0udjEixgEKB0FjY291bnQSCgoCaWQYASABKAkSIgoEbWV0YRgCIAEoCzIULmlhbS52MS5BY2NvdW50Lk1ldGESDQoFZW1haWwYAyABKAkSDQoFcm9sZXMYBCADKAkabQoETWV0YRIOCgZhY3RpdmUYASABKAgSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZGlzcGxheV9uYW1lGAMgASgJEg8KB3BpY3R1cmUYBCABKAki0gEKEElkZW50aXR5UHJvdmlkZXISDAoEbmFtZRgBIAEoCRIrCgRraW5kGAIgASgOMh0uaWFtLnYxLklkZW50aXR5UHJvdmlkZXIuS2luZBIRCgljbGllbnRfaWQYAyABKAkSFQoNYXV0aG9yaXplX3VybBgEIAEoCSJZCgRLaW5kEhQKEEtJTkRfVU5TUEVDSUZJRUQQABIRCg1LSU5EX0lEX1RPS0VOEAESFQoRS0lORF9BQ0NFU1NfVE9LRU4QAhIRCg1LSU5EX1BBU1NXT1JEEAMibAoISWRlbnRpdHkSEAoIcHJvdmlkZXIYASABKAkSDwoHc3ViamVjdBgCIAEoCRINCgVlbWFpbBgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLeAQoHU2Vzc2lvbhIKCgJpZBgBIAEoCRIQCghwcm92aWRlchgCIAEoCRISCgp1c2VyX2FnZW50GAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDHJlZnJlc2hlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKZXhwaXJlc19hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHY3VycmVudBgHIAEoCCK4AQoGVG9rZW5zEhIKCnNlc3Npb25faWQYASABKAkSFAoMYWNjZXNzX3Rva2VuGAIgASgJEjUKEWFjY2Vzc19leHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1yZWZyZXNoX3Rva2VuGAQgASgJEjYKEnJlZnJlc2hfZXhwaXJlc19hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiFwoVUmVzb2x2ZUFjY291bnRSZXF1ZXN0IjoKFlJlc29sdmVBY2NvdW50UmVzcG9uc2USIAoHYWNjb3VudBgBIAEoCzIPLmlhbS52MS5BY2NvdW50Iu8BChNMaXN0QWNjb3VudHNSZXF1ZXN0EhMKBmFjdGl2ZRgBIAEoCEgAiAEBEg8KB3JvbGVfaWQYAiABKAkSDQoFZW1haWwYAyABKAkSMAoFb3JkZXIYBCABKA4yIS5pYW0udjEuTGlzdEFjY291bnRzUmVxdWVzdC5PcmRlchINCgVsaW1pdBgFIAEoDRIOCgZjdXJzb3IYBiABKAkiRwoFT3JkZXISFQoRT1JERVJfVU5TUEVDSUZJRUQQABIWChJPUkRFUl9ORVdFU1RfRklSU1QQARIPCgtPUkRFUl9FTUFJTBACQgkKB19hY3RpdmUiTgoUTGlzdEFjY291bnRzUmVzcG9uc2USIQoIYWNjb3VudHMYASADKAsyDy5pYW0udjEuQWNjb3VudBITCgtuZXh0X2N1cnNvchgCIAEoCSKtAQoeVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXF1ZXN0ElQKEGlkX3RvX2FjdGl2YXRpb24YASADKAsyOi5pYW0udjEuVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXF1ZXN0LklkVG9BY3RpdmF0aW9uRW50cnkaNQoTSWRUb0FjdGl2YXRpb25FbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAg6AjgBIiEKH1VwZGF0ZUFjY291bnRBY3RpdmF0aW9uUmVzcG9uc2UiNwoQR3JhbnRSb2xlUmVxdWVzdBISCgphY2NvdW50X2lkGAEgASgJEg8KB3JvbGVfaWQYAiABKAkiNQoRR3JhbnRSb2xlUmVzcG9uc2USIAoHYWNjb3VudBgBIAEoCzIPLmlhbS52MS5BY2NvdW50IjgKEVJldm9rZVJvbGVSZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAkSDwoHcm9sZV9pZBgCIAEoCSI2ChJSZXZva2VSb2xlUmVzcG9uc2USIAoHYWNjb3VudBgBIAEoCzIPLmlhbS52MS5BY2NvdW50IjQKHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QSFAoMcmVkaXJlY3RfdXJpGAEgASgJIkwKHUxpc3RJZGVudGl0eVByb3ZpZGVyc1Jlc3BvbnNlEisKCXByb3ZpZGVycxgBIAMoCzIYLmlhbS52MS5JZGVudGl0eVByb3ZpZGVyIloKE0V4Y2hhbmdlQ29kZVJlcXVlc3QSEAoIcHJvdmlkZXIYASABKAkSDAoEY29kZRgCIAEoCRIUCgxyZWRpcmVjdF91cmkYAyABKAkSDQoFc3RhdGUYBCABKAkiKgoURXhjaGFuZ2VDb2RlUmVzcG9uc2USEgoKY3JlZGVudGlhbBgBIAEoCSJGCg1TaWduVXBSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCSIWCg5TaWduVXBSZXNwb25zZUoECAEQAiJNCg1TaWduSW5SZXF1ZXN0EhAKCHByb3ZpZGVyGAEgASgJEhIKCmNyZWRlbnRpYWwYAiABKAkSFgoOcmVmcmVzaF9jb29raWUYAyABKAgiUgoOU2lnbkluUmVzcG9uc2USHgoGdG9rZW5zGAEgASgLMg4uaWFtLnYxLlRva2VucxIgCgdhY2NvdW50GAIgASgLMg8uaWFtLnYxLkFjY291bnQiJwoOUmVmcmVzaFJlcXVlc3QSFQoNcmVmcmVzaF90b2tlbhgBIAEoCSIxCg9SZWZyZXNoUmVzcG9uc2USHgoGdG9rZW5zGAEgASgLMg4uaWFtLnYxLlRva2VucyIVChNMaXN0U2Vzc2lvbnNSZXF1ZXN0IjkKFExpc3RTZXNzaW9uc1Jlc3BvbnNlEiEKCHNlc3Npb25zGAEgAygLMg8uaWFtLnYxLlNlc3Npb24iIgoUUmV2b2tlU2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkiFwoVUmV2b2tlU2Vzc2lvblJlc3BvbnNlIi0KFVJldm9rZVNlc3Npb25zUmVxdWVzdBIUCgxrZWVwX2N1cnJlbnQYASABKAgiKQoWUmV2b2tlU2Vzc2lvbnNSZXNwb25zZRIPCgdyZXZva2VkGAEgASgNIhcKFUxpc3RJZGVudGl0aWVzUmVxdWVzdCI+ChZMaXN0SWRlbnRpdGllc1Jlc3BvbnNlEiQKCmlkZW50aXRpZXMYASADKAsyEC5pYW0udjEuSWRlbnRpdHkiOwoTTGlua0lkZW50aXR5UmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRISCgpjcmVkZW50aWFsGAIgASgJIjoKFExpbmtJZGVudGl0eVJlc3BvbnNlEiIKCGlkZW50aXR5GAEgASgLMhAuaWFtLnYxLklkZW50aXR5IjoKFVVubGlua0lkZW50aXR5UmVxdWVzdBIQCghwcm92aWRlchgBIAEoCRIPCgdzdWJqZWN0GAIgASgJIhgKFlVubGlua0lkZW50aXR5UmVzcG9uc2UiJgoSU2V0UGFzc3dvcmRSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIjkKE1NldFBhc3N3b3JkUmVzcG9uc2USIgoIaWRlbnRpdHkYASABKAsyEC5pYW0udjEuSWRlbnRpdHkymwoKCklBTVNlcnZpY2USTwoOUmVzb2x2ZUFjY291bnQSHS5pYW0udjEuUmVzb2x2ZUFjY291bnRSZXF1ZXN0Gh4uaWFtLnYxLlJlc29sdmVBY2NvdW50UmVzcG9uc2USSwoMTGlzdEFjY291bnRzEhsuaWFtLnYxLkxpc3RBY2NvdW50c1JlcXVlc3QaHC5pYW0udjEuTGlzdEFjY291bnRzUmVzcG9uc2UwARJqChdVcGRhdGVBY2NvdW50QWN0aXZhdGlvbhImLmlhbS52MS5VcGRhdGVBY2NvdW50QWN0aXZhdGlvblJlcXVlc3QaJy5pYW0udjEuVXBkYXRlQWNjb3VudEFjdGl2YXRpb25SZXNwb25zZRJACglHcmFudFJvbGUSGC5pYW0udjEuR3JhbnRSb2xlUmVxdWVzdBoZLmlhbS52MS5HcmFudFJvbGVSZXNwb25zZRJDCgpSZXZva2VSb2xlEhkuaWFtLnYxLlJldm9rZVJvbGVSZXF1ZXN0GhouaWFtLnYxLlJldm9rZVJvbGVSZXNwb25zZRJkChVMaXN0SWRlbnRpdHlQcm92aWRlcnMSJC5pYW0udjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVxdWVzdBolLmlhbS52MS5MaXN0SWRlbnRpdHlQcm92aWRlcnNSZXNwb25zZRJJCgxFeGNoYW5nZUNvZGUSGy5pYW0udjEuRXhjaGFuZ2VDb2RlUmVxdWVzdBocLmlhbS52MS5FeGNoYW5nZUNvZGVSZXNwb25zZRI3CgZTaWduVXASFS5pYW0udjEuU2lnblVwUmVxdWVzdBoWLmlhbS52MS5TaWduVXBSZXNwb25zZRI3CgZTaWduSW4SFS5pYW0udjEuU2lnbkluUmVxdWVzdBoWLmlhbS52MS5TaWduSW5SZXNwb25zZRI6CgdSZWZyZXNoEhYuaWFtLnYxLlJlZnJlc2hSZXF1ZXN0GhcuaWFtLnYxLlJlZnJlc2hSZXNwb25zZRJJCgxMaXN0U2Vzc2lvbnMSGy5pYW0udjEuTGlzdFNlc3Npb25zUmVxdWVzdBocLmlhbS52MS5MaXN0U2Vzc2lvbnNSZXNwb25zZRJMCg1SZXZva2VTZXNzaW9uEhwuaWFtLnYxLlJldm9rZVNlc3Npb25SZXF1ZXN0Gh0uaWFtLnYxLlJldm9rZVNlc3Npb25SZXNwb25zZRJPCg5SZXZva2VTZXNzaW9ucxIdLmlhbS52MS5SZXZva2VTZXNzaW9uc1JlcXVlc3QaHi5pYW0udjEuUmV2b2tlU2Vzc2lvbnNSZXNwb25zZRJPCg5MaXN0SWRlbnRpdGllcxIdLmlhbS52MS5MaXN0SWRlbnRpdGllc1JlcXVlc3QaHi5pYW0udjEuTGlzdElkZW50aXRpZXNSZXNwb25zZRJJCgxMaW5rSWRlbnRpdHkSGy5pYW0udjEuTGlua0lkZW50aXR5UmVxdWVzdBocLmlhbS52MS5MaW5rSWRlbnRpdHlSZXNwb25zZRJPCg5VbmxpbmtJZGVudGl0eRIdLmlhbS52MS5VbmxpbmtJZGVudGl0eVJlcXVlc3QaHi5pYW0udjEuVW5saW5rSWRlbnRpdHlSZXNwb25zZRJGCgtTZXRQYXNzd29yZBIaLmlhbS52MS5TZXRQYXNzd29yZFJlcXVlc3QaGy5pYW0udjEuU2V0UGFzc3dvcmRSZXNwb25zZUJ4Cgpjb20uaWFtLnYxQghJYW1Qcm90b1ABWidnaXRodWIuY29tL29wZW5oZXhlcy9wcm90by9pYW0vdjE7aWFtdjGiAgNJWFiqAgZJYW0uVjHKAgZJYW1cVjHiAhJJYW1cVjFcR1BCTWV0YWRhdGHqAgdJYW06OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * Describes the message iam.v1.Account.
@@ -25,47 +25,295 @@ export const AccountSchema = /*@__PURE__*/
 export const Account_MetaSchema = /*@__PURE__*/
   messageDesc(file_iam_v1_iam, 0, 0);
 
+/**
+ * Describes the message iam.v1.IdentityProvider.
+ * Use `create(IdentityProviderSchema)` to create a new message.
+ */
+export const IdentityProviderSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 1);
+
+/**
+ * Describes the enum iam.v1.IdentityProvider.Kind.
+ */
+export const IdentityProvider_KindSchema = /*@__PURE__*/
+  enumDesc(file_iam_v1_iam, 1, 0);
+
+/**
+ * @generated from enum iam.v1.IdentityProvider.Kind
+ */
+export const IdentityProvider_Kind = /*@__PURE__*/
+  tsEnum(IdentityProvider_KindSchema);
+
+/**
+ * Describes the message iam.v1.Identity.
+ * Use `create(IdentitySchema)` to create a new message.
+ */
+export const IdentitySchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 2);
+
+/**
+ * Describes the message iam.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 3);
+
+/**
+ * Describes the message iam.v1.Tokens.
+ * Use `create(TokensSchema)` to create a new message.
+ */
+export const TokensSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 4);
+
 /**
  * Describes the message iam.v1.ResolveAccountRequest.
  * Use `create(ResolveAccountRequestSchema)` to create a new message.
  */
 export const ResolveAccountRequestSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 1);
+  messageDesc(file_iam_v1_iam, 5);
 
 /**
  * Describes the message iam.v1.ResolveAccountResponse.
  * Use `create(ResolveAccountResponseSchema)` to create a new message.
  */
 export const ResolveAccountResponseSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 2);
+  messageDesc(file_iam_v1_iam, 6);
 
 /**
  * Describes the message iam.v1.ListAccountsRequest.
  * Use `create(ListAccountsRequestSchema)` to create a new message.
  */
 export const ListAccountsRequestSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 3);
+  messageDesc(file_iam_v1_iam, 7);
+
+/**
+ * Describes the enum iam.v1.ListAccountsRequest.Order.
+ */
+export const ListAccountsRequest_OrderSchema = /*@__PURE__*/
+  enumDesc(file_iam_v1_iam, 7, 0);
+
+/**
+ * @generated from enum iam.v1.ListAccountsRequest.Order
+ */
+export const ListAccountsRequest_Order = /*@__PURE__*/
+  tsEnum(ListAccountsRequest_OrderSchema);
 
 /**
  * Describes the message iam.v1.ListAccountsResponse.
  * Use `create(ListAccountsResponseSchema)` to create a new message.
  */
 export const ListAccountsResponseSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 4);
+  messageDesc(file_iam_v1_iam, 8);
 
 /**
  * Describes the message iam.v1.UpdateAccountActivationRequest.
  * Use `create(UpdateAccountActivationRequestSchema)` to create a new message.
  */
 export const UpdateAccountActivationRequestSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 5);
+  messageDesc(file_iam_v1_iam, 9);
 
 /**
  * Describes the message iam.v1.UpdateAccountActivationResponse.
  * Use `create(UpdateAccountActivationResponseSchema)` to create a new message.
  */
 export const UpdateAccountActivationResponseSchema = /*@__PURE__*/
-  messageDesc(file_iam_v1_iam, 6);
+  messageDesc(file_iam_v1_iam, 10);
+
+/**
+ * Describes the message iam.v1.GrantRoleRequest.
+ * Use `create(GrantRoleRequestSchema)` to create a new message.
+ */
+export const GrantRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 11);
+
+/**
+ * Describes the message iam.v1.GrantRoleResponse.
+ * Use `create(GrantRoleResponseSchema)` to create a new message.
+ */
+export const GrantRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 12);
+
+/**
+ * Describes the message iam.v1.RevokeRoleRequest.
+ * Use `create(RevokeRoleRequestSchema)` to create a new message.
+ */
+export const RevokeRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 13);
+
+/**
+ * Describes the message iam.v1.RevokeRoleResponse.
+ * Use `create(RevokeRoleResponseSchema)` to create a new message.
+ */
+export const RevokeRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 14);
+
+/**
+ * Describes the message iam.v1.ListIdentityProvidersRequest.
+ * Use `create(ListIdentityProvidersRequestSchema)` to create a new message.
+ */
+export const ListIdentityProvidersRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 15);
+
+/**
+ * Describes the message iam.v1.ListIdentityProvidersResponse.
+ * Use `create(ListIdentityProvidersResponseSchema)` to create a new message.
+ */
+export const ListIdentityProvidersResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 16);
+
+/**
+ * Describes the message iam.v1.ExchangeCodeRequest.
+ * Use `create(ExchangeCodeRequestSchema)` to create a new message.
+ */
+export const ExchangeCodeRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 17);
+
+/**
+ * Describes the message iam.v1.ExchangeCodeResponse.
+ * Use `create(ExchangeCodeResponseSchema)` to create a new message.
+ */
+export const ExchangeCodeResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 18);
+
+/**
+ * Describes the message iam.v1.SignUpRequest.
+ * Use `create(SignUpRequestSchema)` to create a new message.
+ */
+export const SignUpRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 19);
+
+/**
+ * Describes the message iam.v1.SignUpResponse.
+ * Use `create(SignUpResponseSchema)` to create a new message.
+ */
+export const SignUpResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 20);
+
+/**
+ * Describes the message iam.v1.SignInRequest.
+ * Use `create(SignInRequestSchema)` to create a new message.
+ */
+export const SignInRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 21);
+
+/**
+ * Describes the message iam.v1.SignInResponse.
+ * Use `create(SignInResponseSchema)` to create a new message.
+ */
+export const SignInResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 22);
+
+/**
+ * Describes the message iam.v1.RefreshRequest.
+ * Use `create(RefreshRequestSchema)` to create a new message.
+ */
+export const RefreshRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 23);
+
+/**
+ * Describes the message iam.v1.RefreshResponse.
+ * Use `create(RefreshResponseSchema)` to create a new message.
+ */
+export const RefreshResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 24);
+
+/**
+ * Describes the message iam.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 25);
+
+/**
+ * Describes the message iam.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 26);
+
+/**
+ * Describes the message iam.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 27);
+
+/**
+ * Describes the message iam.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 28);
+
+/**
+ * Describes the message iam.v1.RevokeSessionsRequest.
+ * Use `create(RevokeSessionsRequestSchema)` to create a new message.
+ */
+export const RevokeSessionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 29);
+
+/**
+ * Describes the message iam.v1.RevokeSessionsResponse.
+ * Use `create(RevokeSessionsResponseSchema)` to create a new message.
+ */
+export const RevokeSessionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 30);
+
+/**
+ * Describes the message iam.v1.ListIdentitiesRequest.
+ * Use `create(ListIdentitiesRequestSchema)` to create a new message.
+ */
+export const ListIdentitiesRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 31);
+
+/**
+ * Describes the message iam.v1.ListIdentitiesResponse.
+ * Use `create(ListIdentitiesResponseSchema)` to create a new message.
+ */
+export const ListIdentitiesResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 32);
+
+/**
+ * Describes the message iam.v1.LinkIdentityRequest.
+ * Use `create(LinkIdentityRequestSchema)` to create a new message.
+ */
+export const LinkIdentityRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 33);
+
+/**
+ * Describes the message iam.v1.LinkIdentityResponse.
+ * Use `create(LinkIdentityResponseSchema)` to create a new message.
+ */
+export const LinkIdentityResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 34);
+
+/**
+ * Describes the message iam.v1.UnlinkIdentityRequest.
+ * Use `create(UnlinkIdentityRequestSchema)` to create a new message.
+ */
+export const UnlinkIdentityRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 35);
+
+/**
+ * Describes the message iam.v1.UnlinkIdentityResponse.
+ * Use `create(UnlinkIdentityResponseSchema)` to create a new message.
+ */
+export const UnlinkIdentityResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 36);
+
+/**
+ * Describes the message iam.v1.SetPasswordRequest.
+ * Use `create(SetPasswordRequestSchema)` to create a new message.
+ */
+export const SetPasswordRequestSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 37);
+
+/**
+ * Describes the message iam.v1.SetPasswordResponse.
+ * Use `create(SetPasswordResponseSchema)` to create a new message.
+ */
+export const SetPasswordResponseSchema = /*@__PURE__*/
+  messageDesc(file_iam_v1_iam, 38);
 
 /**
  * @generated from service iam.v1.IAMService
@@ -0,0 +1,520 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file lobby/v1/lobby.proto (package lobby.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Duration, Timestamp } from "@bufbuild/protobuf/wkt";
+import type { TurnMode } from "../../game/v1/game_pb";
+
+/**
+ * Describes the file lobby/v1/lobby.proto.
+ */
+export declare const file_lobby_v1_lobby: GenFile;
+
+/**
+ * @generated from message lobby.v1.Settings
+ */
+export declare type Settings = Message<"lobby.v1.Settings"> & {
+  /**
+   * unlimited if omitted
+   *
+   * @generated from field: google.protobuf.Duration turn_duration = 1;
+   */
+  turnDuration?: Duration;
+
+  /**
+   * @generated from field: game.v1.TurnMode turn_mode = 2;
+   */
+  turnMode: TurnMode;
+};
+
+/**
+ * Describes the message lobby.v1.Settings.
+ * Use `create(SettingsSchema)` to create a new message.
+ */
+export declare const SettingsSchema: GenMessage<Settings>;
+
+/**
+ * @generated from message lobby.v1.Game
+ */
+export declare type Game = Message<"lobby.v1.Game"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string host_id = 2;
+   */
+  hostId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string map_id = 4;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: lobby.v1.Game.State state = 5;
+   */
+  state: Game_State;
+
+  /**
+   * @generated from field: uint32 max_players = 6;
+   */
+  maxPlayers: number;
+
+  /**
+   * @generated from field: lobby.v1.Settings settings = 7;
+   */
+  settings?: Settings;
+
+  /**
+   * ordered by slot
+   *
+   * @generated from field: repeated lobby.v1.Game.Player players = 8;
+   */
+  players: Game_Player[];
+
+  /**
+   * content the game is played with
+   *
+   * @generated from field: string content_checksum = 9;
+   */
+  contentChecksum: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 11;
+   */
+  startedAt?: Timestamp;
+};
+
+/**
+ * Describes the message lobby.v1.Game.
+ * Use `create(GameSchema)` to create a new message.
+ */
+export declare const GameSchema: GenMessage<Game>;
+
+/**
+ * @generated from message lobby.v1.Game.Player
+ */
+export declare type Game_Player = Message<"lobby.v1.Game.Player"> & {
+  /**
+   * @generated from field: uint32 slot = 1;
+   */
+  slot: number;
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string picture = 4;
+   */
+  picture: string;
+
+  /**
+   * @generated from field: bool ready = 5;
+   */
+  ready: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 6;
+   */
+  joinedAt?: Timestamp;
+};
+
+/**
+ * Describes the message lobby.v1.Game.Player.
+ * Use `create(Game_PlayerSchema)` to create a new message.
+ */
+export declare const Game_PlayerSchema: GenMessage<Game_Player>;
+
+/**
+ * @generated from enum lobby.v1.Game.State
+ */
+export enum Game_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * waiting for players
+   *
+   * @generated from enum value: STATE_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: STATE_STARTED = 2;
+   */
+  STARTED = 2,
+
+  /**
+   * @generated from enum value: STATE_FINISHED = 3;
+   */
+  FINISHED = 3,
+}
+
+/**
+ * Describes the enum lobby.v1.Game.State.
+ */
+export declare const Game_StateSchema: GenEnum<Game_State>;
+
+/**
+ * @generated from message lobby.v1.CreateGameRequest
+ */
+export declare type CreateGameRequest = Message<"lobby.v1.CreateGameRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * one of caller's maps
+   *
+   * @generated from field: string map_id = 2;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: uint32 max_players = 3;
+   */
+  maxPlayers: number;
+
+  /**
+   * @generated from field: lobby.v1.Settings settings = 4;
+   */
+  settings?: Settings;
+};
+
+/**
+ * Describes the message lobby.v1.CreateGameRequest.
+ * Use `create(CreateGameRequestSchema)` to create a new message.
+ */
+export declare const CreateGameRequestSchema: GenMessage<CreateGameRequest>;
+
+/**
+ * @generated from message lobby.v1.CreateGameResponse
+ */
+export declare type CreateGameResponse = Message<"lobby.v1.CreateGameResponse"> & {
+  /**
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.CreateGameResponse.
+ * Use `create(CreateGameResponseSchema)` to create a new message.
+ */
+export declare const CreateGameResponseSchema: GenMessage<CreateGameResponse>;
+
+/**
+ * @generated from message lobby.v1.GetGameRequest
+ */
+export declare type GetGameRequest = Message<"lobby.v1.GetGameRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message lobby.v1.GetGameRequest.
+ * Use `create(GetGameRequestSchema)` to create a new message.
+ */
+export declare const GetGameRequestSchema: GenMessage<GetGameRequest>;
+
+/**
+ * @generated from message lobby.v1.GetGameResponse
+ */
+export declare type GetGameResponse = Message<"lobby.v1.GetGameResponse"> & {
+  /**
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.GetGameResponse.
+ * Use `create(GetGameResponseSchema)` to create a new message.
+ */
+export declare const GetGameResponseSchema: GenMessage<GetGameResponse>;
+
+/**
+ * @generated from message lobby.v1.ListGamesRequest
+ */
+export declare type ListGamesRequest = Message<"lobby.v1.ListGamesRequest"> & {
+  /**
+   * games caller takes part in, in any state; open games otherwise
+   *
+   * @generated from field: bool mine = 1;
+   */
+  mine: boolean;
+
+  /**
+   * games per page, defaults to 50
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * next_cursor of the previous page, mine must stay the same
+   *
+   * @generated from field: string cursor = 3;
+   */
+  cursor: string;
+};
+
+/**
+ * Describes the message lobby.v1.ListGamesRequest.
+ * Use `create(ListGamesRequestSchema)` to create a new message.
+ */
+export declare const ListGamesRequestSchema: GenMessage<ListGamesRequest>;
+
+/**
+ * @generated from message lobby.v1.ListGamesResponse
+ */
+export declare type ListGamesResponse = Message<"lobby.v1.ListGamesResponse"> & {
+  /**
+   * @generated from field: repeated lobby.v1.Game games = 1;
+   */
+  games: Game[];
+
+  /**
+   * empty if there are no more pages
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+};
+
+/**
+ * Describes the message lobby.v1.ListGamesResponse.
+ * Use `create(ListGamesResponseSchema)` to create a new message.
+ */
+export declare const ListGamesResponseSchema: GenMessage<ListGamesResponse>;
+
+/**
+ * @generated from message lobby.v1.JoinGameRequest
+ */
+export declare type JoinGameRequest = Message<"lobby.v1.JoinGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * first free slot if omitted
+   *
+   * @generated from field: optional uint32 slot = 2;
+   */
+  slot?: number;
+};
+
+/**
+ * Describes the message lobby.v1.JoinGameRequest.
+ * Use `create(JoinGameRequestSchema)` to create a new message.
+ */
+export declare const JoinGameRequestSchema: GenMessage<JoinGameRequest>;
+
+/**
+ * @generated from message lobby.v1.JoinGameResponse
+ */
+export declare type JoinGameResponse = Message<"lobby.v1.JoinGameResponse"> & {
+  /**
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.JoinGameResponse.
+ * Use `create(JoinGameResponseSchema)` to create a new message.
+ */
+export declare const JoinGameResponseSchema: GenMessage<JoinGameResponse>;
+
+/**
+ * @generated from message lobby.v1.LeaveGameRequest
+ */
+export declare type LeaveGameRequest = Message<"lobby.v1.LeaveGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message lobby.v1.LeaveGameRequest.
+ * Use `create(LeaveGameRequestSchema)` to create a new message.
+ */
+export declare const LeaveGameRequestSchema: GenMessage<LeaveGameRequest>;
+
+/**
+ * @generated from message lobby.v1.LeaveGameResponse
+ */
+export declare type LeaveGameResponse = Message<"lobby.v1.LeaveGameResponse"> & {
+  /**
+   * unset if the game was cancelled by its host
+   *
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.LeaveGameResponse.
+ * Use `create(LeaveGameResponseSchema)` to create a new message.
+ */
+export declare const LeaveGameResponseSchema: GenMessage<LeaveGameResponse>;
+
+/**
+ * @generated from message lobby.v1.SetReadyRequest
+ */
+export declare type SetReadyRequest = Message<"lobby.v1.SetReadyRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: bool ready = 2;
+   */
+  ready: boolean;
+};
+
+/**
+ * Describes the message lobby.v1.SetReadyRequest.
+ * Use `create(SetReadyRequestSchema)` to create a new message.
+ */
+export declare const SetReadyRequestSchema: GenMessage<SetReadyRequest>;
+
+/**
+ * @generated from message lobby.v1.SetReadyResponse
+ */
+export declare type SetReadyResponse = Message<"lobby.v1.SetReadyResponse"> & {
+  /**
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.SetReadyResponse.
+ * Use `create(SetReadyResponseSchema)` to create a new message.
+ */
+export declare const SetReadyResponseSchema: GenMessage<SetReadyResponse>;
+
+/**
+ * @generated from message lobby.v1.StartGameRequest
+ */
+export declare type StartGameRequest = Message<"lobby.v1.StartGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message lobby.v1.StartGameRequest.
+ * Use `create(StartGameRequestSchema)` to create a new message.
+ */
+export declare const StartGameRequestSchema: GenMessage<StartGameRequest>;
+
+/**
+ * @generated from message lobby.v1.StartGameResponse
+ */
+export declare type StartGameResponse = Message<"lobby.v1.StartGameResponse"> & {
+  /**
+   * @generated from field: lobby.v1.Game game = 1;
+   */
+  game?: Game;
+};
+
+/**
+ * Describes the message lobby.v1.StartGameResponse.
+ * Use `create(StartGameResponseSchema)` to create a new message.
+ */
+export declare const StartGameResponseSchema: GenMessage<StartGameResponse>;
+
+/**
+ * @generated from service lobby.v1.LobbyService
+ */
+export declare const LobbyService: GenService<{
+  /**
+   * @generated from rpc lobby.v1.LobbyService.CreateGame
+   */
+  createGame: {
+    methodKind: "unary";
+    input: typeof CreateGameRequestSchema;
+    output: typeof CreateGameResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.GetGame
+   */
+  getGame: {
+    methodKind: "unary";
+    input: typeof GetGameRequestSchema;
+    output: typeof GetGameResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.ListGames
+   */
+  listGames: {
+    methodKind: "unary";
+    input: typeof ListGamesRequestSchema;
+    output: typeof ListGamesResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.JoinGame
+   */
+  joinGame: {
+    methodKind: "unary";
+    input: typeof JoinGameRequestSchema;
+    output: typeof JoinGameResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.LeaveGame
+   */
+  leaveGame: {
+    methodKind: "unary";
+    input: typeof LeaveGameRequestSchema;
+    output: typeof LeaveGameResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.SetReady
+   */
+  setReady: {
+    methodKind: "unary";
+    input: typeof SetReadyRequestSchema;
+    output: typeof SetReadyResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.StartGame
+   */
+  startGame: {
+    methodKind: "unary";
+    input: typeof StartGameRequestSchema;
+    output: typeof StartGameResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,151 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file lobby/v1/lobby.proto (package lobby.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_game_v1_game } from "../../game/v1/game_pb";
+import { file_google_protobuf_duration, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file lobby/v1/lobby.proto.
+ */
+export const file_lobby_v1_lobby = /*@__PURE__*/
+  fileDesc("ChRsb2JieS92MS9sb2JieS5wcm90bxIIbG9iYnkudjEiYgoIU2V0dGluZ3MSMAoNdHVybl9kdXJhdGlvbhgBIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIkCgl0dXJuX21vZGUYAiABKA4yES5nYW1lLnYxLlR1cm5Nb2RlIqwECgRHYW1lEgoKAmlkGAEgASgJEg8KB2hvc3RfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRIOCgZtYXBfaWQYBCABKAkSIwoFc3RhdGUYBSABKA4yFC5sb2JieS52MS5HYW1lLlN0YXRlEhMKC21heF9wbGF5ZXJzGAYgASgNEiQKCHNldHRpbmdzGAcgASgLMhIubG9iYnkudjEuU2V0dGluZ3MSJgoHcGxheWVycxgIIAMoCzIVLmxvYmJ5LnYxLkdhbWUuUGxheWVyEhgKEGNvbnRlbnRfY2hlY2tzdW0YCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKc3RhcnRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAajwEKBlBsYXllchIMCgRzbG90GAEgASgNEhIKCmFjY291bnRfaWQYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEg8KB3BpY3R1cmUYBCABKAkSDQoFcmVhZHkYBSABKAgSLQoJam9pbmVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJVCgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEg4KClNUQVRFX09QRU4QARIRCg1TVEFURV9TVEFSVEVEEAISEgoOU1RBVEVfRklOSVNIRUQQAyJsChFDcmVhdGVHYW1lUmVxdWVzdBIMCgRuYW1lGAEgASgJEg4KBm1hcF9pZBgCIAEoCRITCgttYXhfcGxheWVycxgDIAEoDRIkCghzZXR0aW5ncxgEIAEoCzISLmxvYmJ5LnYxLlNldHRpbmdzIjIKEkNyZWF0ZUdhbWVSZXNwb25zZRIcCgRnYW1lGAEgASgLMg4ubG9iYnkudjEuR2FtZSIcCg5HZXRHYW1lUmVxdWVzdBIKCgJpZBgBIAEoCSIvCg9HZXRHYW1lUmVzcG9uc2USHAoEZ2FtZRgBIAEoCzIOLmxvYmJ5LnYxLkdhbWUiPwoQTGlzdEdhbWVzUmVxdWVzdBIMCgRtaW5lGAEgASgIEg0KBWxpbWl0GAIgASgNEg4KBmN1cnNvchgDIAEoCSJHChFMaXN0R2FtZXNSZXNwb25zZRIdCgVnYW1lcxgBIAMoCzIOLmxvYmJ5LnYxLkdhbWUSEwoLbmV4dF9jdXJzb3IYAiABKAkiPgoPSm9pbkdhbWVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSEQoEc2xvdBgCIAEoDUgAiAEBQgcKBV9zbG90IjAKEEpvaW5HYW1lUmVzcG9uc2USHAoEZ2FtZRgBIAEoCzIOLmxvYmJ5LnYxLkdhbWUiIwoQTGVhdmVHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIjEKEUxlYXZlR2FtZVJlc3BvbnNlEhwKBGdhbWUYASABKAsyDi5sb2JieS52MS5HYW1lIjEKD1NldFJlYWR5UmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg0KBXJlYWR5GAIgASgIIjAKEFNldFJlYWR5UmVzcG9uc2USHAoEZ2FtZRgBIAEoCzIOLmxvYmJ5LnYxLkdhbWUiIwoQU3RhcnRHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIjEKEVN0YXJ0R2FtZVJlc3BvbnNlEhwKBGdhbWUYASABKAsyDi5sb2JieS52MS5HYW1lMu8DCgxMb2JieVNlcnZpY2USRwoKQ3JlYXRlR2FtZRIbLmxvYmJ5LnYxLkNyZWF0ZUdhbWVSZXF1ZXN0GhwubG9iYnkudjEuQ3JlYXRlR2FtZVJlc3BvbnNlEj4KB0dldEdhbWUSGC5sb2JieS52MS5HZXRHYW1lUmVxdWVzdBoZLmxvYmJ5LnYxLkdldEdhbWVSZXNwb25zZRJECglMaXN0R2FtZXMSGi5sb2JieS52MS5MaXN0R2FtZXNSZXF1ZXN0GhsubG9iYnkudjEuTGlzdEdhbWVzUmVzcG9uc2USQQoISm9pbkdhbWUSGS5sb2JieS52MS5Kb2luR2FtZVJlcXVlc3QaGi5sb2JieS52MS5Kb2luR2FtZVJlc3BvbnNlEkQKCUxlYXZlR2FtZRIaLmxvYmJ5LnYxLkxlYXZlR2FtZVJlcXVlc3QaGy5sb2JieS52MS5MZWF2ZUdhbWVSZXNwb25zZRJBCghTZXRSZWFkeRIZLmxvYmJ5LnYxLlNldFJlYWR5UmVxdWVzdBoaLmxvYmJ5LnYxLlNldFJlYWR5UmVzcG9uc2USRAoJU3RhcnRHYW1lEhoubG9iYnkudjEuU3RhcnRHYW1lUmVxdWVzdBobLmxvYmJ5LnYxLlN0YXJ0R2FtZVJlc3BvbnNlQogBCgxjb20ubG9iYnkudjFCCkxvYmJ5UHJvdG9QAVorZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vbG9iYnkvdjE7bG9iYnl2MaICA0xYWKoCCExvYmJ5LlYxygIITG9iYnlcVjHiAhRMb2JieVxWMVxHUEJNZXRhZGF0YeoCCUxvYmJ5OjpWMWIGcHJvdG8z", [file_game_v1_game, file_google_protobuf_duration, file_google_protobuf_timestamp]);
+
+/**
+ * Describes the message lobby.v1.Settings.
+ * Use `create(SettingsSchema)` to create a new message.
+ */
+export const SettingsSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 0);
+
+/**
+ * Describes the message lobby.v1.Game.
+ * Use `create(GameSchema)` to create a new message.
+ */
+export const GameSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 1);
+
+/**
+ * Describes the message lobby.v1.Game.Player.
+ * Use `create(Game_PlayerSchema)` to create a new message.
+ */
+export const Game_PlayerSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 1, 0);
+
+/**
+ * Describes the enum lobby.v1.Game.State.
+ */
+export const Game_StateSchema = /*@__PURE__*/
+  enumDesc(file_lobby_v1_lobby, 1, 0);
+
+/**
+ * @generated from enum lobby.v1.Game.State
+ */
+export const Game_State = /*@__PURE__*/
+  tsEnum(Game_StateSchema);
+
+/**
+ * Describes the message lobby.v1.CreateGameRequest.
+ * Use `create(CreateGameRequestSchema)` to create a new message.
+ */
+export const CreateGameRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 2);
+
+/**
+ * Describes the message lobby.v1.CreateGameResponse.
+ * Use `create(CreateGameResponseSchema)` to create a new message.
+ */
+export const CreateGameResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 3);
+
+/**
+ * Describes the message lobby.v1.GetGameRequest.
+ * Use `create(GetGameRequestSchema)` to create a new message.
+ */
+export const GetGameRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 4);
+
+/**
+ * Describes the message lobby.v1.GetGameResponse.
+ * Use `create(GetGameResponseSchema)` to create a new message.
+ */
+export const GetGameResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 5);
+
+/**
+ * Describes the message lobby.v1.ListGamesRequest.
+ * Use `create(ListGamesRequestSchema)` to create a new message.
+ */
+export const ListGamesRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 6);
+
+/**
+ * Describes the message lobby.v1.ListGamesResponse.
+ * Use `create(ListGamesResponseSchema)` to create a new message.
+ */
+export const ListGamesResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 7);
+
+/**
+ * Describes the message lobby.v1.JoinGameRequest.
+ * Use `create(JoinGameRequestSchema)` to create a new message.
+ */
+export const JoinGameRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 8);
+
+/**
+ * Describes the message lobby.v1.JoinGameResponse.
+ * Use `create(JoinGameResponseSchema)` to create a new message.
+ */
+export const JoinGameResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 9);
+
+/**
+ * Describes the message lobby.v1.LeaveGameRequest.
+ * Use `create(LeaveGameRequestSchema)` to create a new message.
+ */
+export const LeaveGameRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 10);
+
+/**
+ * Describes the message lobby.v1.LeaveGameResponse.
+ * Use `create(LeaveGameResponseSchema)` to create a new message.
+ */
+export const LeaveGameResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 11);
+
+/**
+ * Describes the message lobby.v1.SetReadyRequest.
+ * Use `create(SetReadyRequestSchema)` to create a new message.
+ */
+export const SetReadyRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 12);
+
+/**
+ * Describes the message lobby.v1.SetReadyResponse.
+ * Use `create(SetReadyResponseSchema)` to create a new message.
+ */
+export const SetReadyResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 13);
+
+/**
+ * Describes the message lobby.v1.StartGameRequest.
+ * Use `create(StartGameRequestSchema)` to create a new message.
+ */
+export const StartGameRequestSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 14);
+
+/**
+ * Describes the message lobby.v1.StartGameResponse.
+ * Use `create(StartGameResponseSchema)` to create a new message.
+ */
+export const StartGameResponseSchema = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 15);
+
+/**
+ * @generated from service lobby.v1.LobbyService
+ */
+export const LobbyService = /*@__PURE__*/
+  serviceDesc(file_lobby_v1_lobby, 0);
+
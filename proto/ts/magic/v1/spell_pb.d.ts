@@ -2,7 +2,7 @@
 // @generated from file magic/v1/spell.proto (package magic.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
@@ -23,6 +23,45 @@ export declare type Spell = Message<"magic.v1.Spell"> & {
    * @generated from field: repeated string tags = 2;
    */
   tags: string[];
+
+  /**
+   * @generated from field: magic.v1.Spell.School school = 3;
+   */
+  school: Spell_School;
+
+  /**
+   * 1-5, may be modified by terrain effects
+   *
+   * @generated from field: int32 level = 4;
+   */
+  level: number;
+
+  /**
+   * @generated from field: uint32 mana_cost = 5;
+   */
+  manaCost: number;
+
+  /**
+   * @generated from field: magic.v1.Spell.Scope scope = 6;
+   */
+  scope: Spell_Scope;
+
+  /**
+   * @generated from field: magic.v1.Spell.Targeting targeting = 7;
+   */
+  targeting: Spell_Targeting;
+
+  /**
+   * for area targeting
+   *
+   * @generated from field: uint32 radius = 8;
+   */
+  radius: number;
+
+  /**
+   * @generated from field: repeated magic.v1.Spell.Effect effects = 9;
+   */
+  effects: Spell_Effect[];
 };
 
 /**
@@ -67,3 +106,293 @@ export declare type Spell_Filter = Message<"magic.v1.Spell.Filter"> & {
  */
 export declare const Spell_FilterSchema: GenMessage<Spell_Filter>;
 
+/**
+ * Amount scales with spell power of the caster & effective spell level:
+ * base + per_power * spell_power + per_level * (level - 1).
+ *
+ * @generated from message magic.v1.Spell.Amount
+ */
+export declare type Spell_Amount = Message<"magic.v1.Spell.Amount"> & {
+  /**
+   * @generated from field: uint32 base = 1;
+   */
+  base: number;
+
+  /**
+   * @generated from field: uint32 per_power = 2;
+   */
+  perPower: number;
+
+  /**
+   * @generated from field: uint32 per_level = 3;
+   */
+  perLevel: number;
+};
+
+/**
+ * Describes the message magic.v1.Spell.Amount.
+ * Use `create(Spell_AmountSchema)` to create a new message.
+ */
+export declare const Spell_AmountSchema: GenMessage<Spell_Amount>;
+
+/**
+ * @generated from message magic.v1.Spell.Effect
+ */
+export declare type Spell_Effect = Message<"magic.v1.Spell.Effect"> & {
+  /**
+   * @generated from oneof magic.v1.Spell.Effect.kind
+   */
+  kind: {
+    /**
+     * @generated from field: magic.v1.Spell.Effect.Damage damage = 1;
+     */
+    value: Spell_Effect_Damage;
+    case: "damage";
+  } | {
+    /**
+     * @generated from field: magic.v1.Spell.Effect.Heal heal = 2;
+     */
+    value: Spell_Effect_Heal;
+    case: "heal";
+  } | {
+    /**
+     * @generated from field: magic.v1.Spell.Effect.ModifyCreatureStat modify_creature_stat = 3;
+     */
+    value: Spell_Effect_ModifyCreatureStat;
+    case: "modifyCreatureStat";
+  } | {
+    /**
+     * @generated from field: magic.v1.Spell.Effect.ModifyMovementPoints modify_movement_points = 4;
+     */
+    value: Spell_Effect_ModifyMovementPoints;
+    case: "modifyMovementPoints";
+  } | { case: undefined; value?: undefined };
+};
+
+/**
+ * Describes the message magic.v1.Spell.Effect.
+ * Use `create(Spell_EffectSchema)` to create a new message.
+ */
+export declare const Spell_EffectSchema: GenMessage<Spell_Effect>;
+
+/**
+ * @generated from message magic.v1.Spell.Effect.Damage
+ */
+export declare type Spell_Effect_Damage = Message<"magic.v1.Spell.Effect.Damage"> & {
+  /**
+   * @generated from field: magic.v1.Spell.Amount amount = 1;
+   */
+  amount?: Spell_Amount;
+};
+
+/**
+ * Describes the message magic.v1.Spell.Effect.Damage.
+ * Use `create(Spell_Effect_DamageSchema)` to create a new message.
+ */
+export declare const Spell_Effect_DamageSchema: GenMessage<Spell_Effect_Damage>;
+
+/**
+ * @generated from message magic.v1.Spell.Effect.Heal
+ */
+export declare type Spell_Effect_Heal = Message<"magic.v1.Spell.Effect.Heal"> & {
+  /**
+   * restores health of the top creature, doesn't resurrect
+   *
+   * @generated from field: magic.v1.Spell.Amount amount = 1;
+   */
+  amount?: Spell_Amount;
+};
+
+/**
+ * Describes the message magic.v1.Spell.Effect.Heal.
+ * Use `create(Spell_Effect_HealSchema)` to create a new message.
+ */
+export declare const Spell_Effect_HealSchema: GenMessage<Spell_Effect_Heal>;
+
+/**
+ * @generated from message magic.v1.Spell.Effect.ModifyCreatureStat
+ */
+export declare type Spell_Effect_ModifyCreatureStat = Message<"magic.v1.Spell.Effect.ModifyCreatureStat"> & {
+  /**
+   * @generated from field: magic.v1.Spell.Stat stat = 1;
+   */
+  stat: Spell_Stat;
+
+  /**
+   * @generated from field: int32 delta = 2;
+   */
+  delta: number;
+
+  /**
+   * duration, lasts until the end of battle if unset
+   *
+   * @generated from field: magic.v1.Spell.Amount rounds = 3;
+   */
+  rounds?: Spell_Amount;
+};
+
+/**
+ * Describes the message magic.v1.Spell.Effect.ModifyCreatureStat.
+ * Use `create(Spell_Effect_ModifyCreatureStatSchema)` to create a new message.
+ */
+export declare const Spell_Effect_ModifyCreatureStatSchema: GenMessage<Spell_Effect_ModifyCreatureStat>;
+
+/**
+ * @generated from message magic.v1.Spell.Effect.ModifyMovementPoints
+ */
+export declare type Spell_Effect_ModifyMovementPoints = Message<"magic.v1.Spell.Effect.ModifyMovementPoints"> & {
+  /**
+   * @generated from field: magic.v1.Spell.Amount amount = 1;
+   */
+  amount?: Spell_Amount;
+};
+
+/**
+ * Describes the message magic.v1.Spell.Effect.ModifyMovementPoints.
+ * Use `create(Spell_Effect_ModifyMovementPointsSchema)` to create a new message.
+ */
+export declare const Spell_Effect_ModifyMovementPointsSchema: GenMessage<Spell_Effect_ModifyMovementPoints>;
+
+/**
+ * @generated from enum magic.v1.Spell.School
+ */
+export enum Spell_School {
+  /**
+   * @generated from enum value: SCHOOL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCHOOL_FIRE = 1;
+   */
+  FIRE = 1,
+
+  /**
+   * @generated from enum value: SCHOOL_WATER = 2;
+   */
+  WATER = 2,
+
+  /**
+   * @generated from enum value: SCHOOL_AIR = 3;
+   */
+  AIR = 3,
+
+  /**
+   * @generated from enum value: SCHOOL_EARTH = 4;
+   */
+  EARTH = 4,
+}
+
+/**
+ * Describes the enum magic.v1.Spell.School.
+ */
+export declare const Spell_SchoolSchema: GenEnum<Spell_School>;
+
+/**
+ * @generated from enum magic.v1.Spell.Scope
+ */
+export enum Spell_Scope {
+  /**
+   * @generated from enum value: SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SCOPE_COMBAT = 1;
+   */
+  COMBAT = 1,
+
+  /**
+   * @generated from enum value: SCOPE_ADVENTURE = 2;
+   */
+  ADVENTURE = 2,
+}
+
+/**
+ * Describes the enum magic.v1.Spell.Scope.
+ */
+export declare const Spell_ScopeSchema: GenEnum<Spell_Scope>;
+
+/**
+ * @generated from enum magic.v1.Spell.Targeting
+ */
+export enum Spell_Targeting {
+  /**
+   * @generated from enum value: TARGETING_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * hero casting the spell
+   *
+   * @generated from enum value: TARGETING_CASTER = 1;
+   */
+  CASTER = 1,
+
+  /**
+   * friendly stack
+   *
+   * @generated from enum value: TARGETING_ALLY = 2;
+   */
+  ALLY = 2,
+
+  /**
+   * hostile stack
+   *
+   * @generated from enum value: TARGETING_ENEMY = 3;
+   */
+  ENEMY = 3,
+
+  /**
+   * every stack within radius of a hex
+   *
+   * @generated from enum value: TARGETING_AREA = 4;
+   */
+  AREA = 4,
+}
+
+/**
+ * Describes the enum magic.v1.Spell.Targeting.
+ */
+export declare const Spell_TargetingSchema: GenEnum<Spell_Targeting>;
+
+/**
+ * @generated from enum magic.v1.Spell.Stat
+ */
+export enum Spell_Stat {
+  /**
+   * @generated from enum value: STAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STAT_ATTACK = 1;
+   */
+  ATTACK = 1,
+
+  /**
+   * @generated from enum value: STAT_DEFENCE = 2;
+   */
+  DEFENCE = 2,
+
+  /**
+   * @generated from enum value: STAT_SPEED = 3;
+   */
+  SPEED = 3,
+
+  /**
+   * @generated from enum value: STAT_MORALE = 4;
+   */
+  MORALE = 4,
+
+  /**
+   * @generated from enum value: STAT_LUCK = 5;
+   */
+  LUCK = 5,
+}
+
+/**
+ * Describes the enum magic.v1.Spell.Stat.
+ */
+export declare const Spell_StatSchema: GenEnum<Spell_Stat>;
+
@@ -2,13 +2,13 @@
 // @generated from file magic/v1/spell.proto (package magic.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 
 /**
  * Describes the file magic/v1/spell.proto.
  */
 export const file_magic_v1_spell = /*@__PURE__*/
-  fileDesc("ChRtYWdpYy92MS9zcGVsbC5wcm90bxIIbWFnaWMudjEijgEKBVNwZWxsEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkaawoGRmlsdGVyEgsKA2FsbBgBIAEoCBITCgtpbmNsdWRlX2lkcxgCIAMoCRITCgtleGNsdWRlX2lkcxgDIAMoCRIUCgxpbmNsdWRlX3RhZ3MYBCADKAkSFAoMZXhjbHVkZV90YWdzGAUgAygJQogBCgxjb20ubWFnaWMudjFCClNwZWxsUHJvdG9QAVorZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vbWFnaWMvdjE7bWFnaWN2MaICA01YWKoCCE1hZ2ljLlYxygIITWFnaWNcVjHiAhRNYWdpY1xWMVxHUEJNZXRhZGF0YeoCCU1hZ2ljOjpWMWIGcHJvdG8z");
+  fileDesc("ChRtYWdpYy92MS9zcGVsbC5wcm90bxIIbWFnaWMudjEi2woKBVNwZWxsEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkSJgoGc2Nob29sGAMgASgOMhYubWFnaWMudjEuU3BlbGwuU2Nob29sEg0KBWxldmVsGAQgASgFEhEKCW1hbmFfY29zdBgFIAEoDRIkCgVzY29wZRgGIAEoDjIVLm1hZ2ljLnYxLlNwZWxsLlNjb3BlEiwKCXRhcmdldGluZxgHIAEoDjIZLm1hZ2ljLnYxLlNwZWxsLlRhcmdldGluZxIOCgZyYWRpdXMYCCABKA0SJwoHZWZmZWN0cxgJIAMoCzIWLm1hZ2ljLnYxLlNwZWxsLkVmZmVjdBprCgZGaWx0ZXISCwoDYWxsGAEgASgIEhMKC2luY2x1ZGVfaWRzGAIgAygJEhMKC2V4Y2x1ZGVfaWRzGAMgAygJEhQKDGluY2x1ZGVfdGFncxgEIAMoCRIUCgxleGNsdWRlX3RhZ3MYBSADKAkaPAoGQW1vdW50EgwKBGJhc2UYASABKA0SEQoJcGVyX3Bvd2VyGAIgASgNEhEKCXBlcl9sZXZlbBgDIAEoDRqbBAoGRWZmZWN0Ei8KBmRhbWFnZRgBIAEoCzIdLm1hZ2ljLnYxLlNwZWxsLkVmZmVjdC5EYW1hZ2VIABIrCgRoZWFsGAIgASgLMhsubWFnaWMudjEuU3BlbGwuRWZmZWN0LkhlYWxIABJJChRtb2RpZnlfY3JlYXR1cmVfc3RhdBgDIAEoCzIpLm1hZ2ljLnYxLlNwZWxsLkVmZmVjdC5Nb2RpZnlDcmVhdHVyZVN0YXRIABJNChZtb2RpZnlfbW92ZW1lbnRfcG9pbnRzGAQgASgLMisubWFnaWMudjEuU3BlbGwuRWZmZWN0Lk1vZGlmeU1vdmVtZW50UG9pbnRzSAAaMAoGRGFtYWdlEiYKBmFtb3VudBgBIAEoCzIWLm1hZ2ljLnYxLlNwZWxsLkFtb3VudBouCgRIZWFsEiYKBmFtb3VudBgBIAEoCzIWLm1hZ2ljLnYxLlNwZWxsLkFtb3VudBpvChJNb2RpZnlDcmVhdHVyZVN0YXQSIgoEc3RhdBgBIAEoDjIULm1hZ2ljLnYxLlNwZWxsLlN0YXQSDQoFZGVsdGEYAiABKAUSJgoGcm91bmRzGAMgASgLMhYubWFnaWMudjEuU3BlbGwuQW1vdW50Gj4KFE1vZGlmeU1vdmVtZW50UG9pbnRzEiYKBmFtb3VudBgBIAEoCzIWLm1hZ2ljLnYxLlNwZWxsLkFtb3VudEIGCgRraW5kImUKBlNjaG9vbBIWChJTQ0hPT0xfVU5TUEVDSUZJRUQQABIPCgtTQ0hPT0xfRklSRRABEhAKDFNDSE9PTF9XQVRFUhACEg4KClNDSE9PTF9BSVIQAxIQCgxTQ0hPT0xfRUFSVEgQBCJFCgVTY29wZRIVChFTQ09QRV9VTlNQRUNJRklFRBAAEhAKDFNDT1BFX0NPTUJBVBABEhMKD1NDT1BFX0FEVkVOVFVSRRACInkKCVRhcmdldGluZxIZChVUQVJHRVRJTkdfVU5TUEVDSUZJRUQQABIUChBUQVJHRVRJTkdfQ0FTVEVSEAESEgoOVEFSR0VUSU5HX0FMTFkQAhITCg9UQVJHRVRJTkdfRU5FTVkQAxISCg5UQVJHRVRJTkdfQVJFQRAEIm8KBFN0YXQSFAoQU1RBVF9VTlNQRUNJRklFRBAAEg8KC1NUQVRfQVRUQUNLEAESEAoMU1RBVF9ERUZFTkNFEAISDgoKU1RBVF9TUEVFRBADEg8KC1NUQVRfTU9SQUxFEAQSDQoJU1RBVF9MVUNLEAVCiAEKDGNvbS5tYWdpYy52MUIKU3BlbGxQcm90b1ABWitnaXRodWIuY29tL29wZW5oZXhlcy9wcm90by9tYWdpYy92MTttYWdpY3YxogIDTVhYqgIITWFnaWMuVjHKAghNYWdpY1xWMeICFE1hZ2ljXFYxXEdQQk1ldGFkYXRh6gIJTWFnaWM6OlYxYgZwcm90bzM");
 
 /**
  * Describes the message magic.v1.Spell.
@@ -24,3 +24,93 @@ export const SpellSchema = /*@__PURE__*/
 export const Spell_FilterSchema = /*@__PURE__*/
   messageDesc(file_magic_v1_spell, 0, 0);
 
+/**
+ * Describes the message magic.v1.Spell.Amount.
+ * Use `create(Spell_AmountSchema)` to create a new message.
+ */
+export const Spell_AmountSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 1);
+
+/**
+ * Describes the message magic.v1.Spell.Effect.
+ * Use `create(Spell_EffectSchema)` to create a new message.
+ */
+export const Spell_EffectSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 2);
+
+/**
+ * Describes the message magic.v1.Spell.Effect.Damage.
+ * Use `create(Spell_Effect_DamageSchema)` to create a new message.
+ */
+export const Spell_Effect_DamageSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 2, 0);
+
+/**
+ * Describes the message magic.v1.Spell.Effect.Heal.
+ * Use `create(Spell_Effect_HealSchema)` to create a new message.
+ */
+export const Spell_Effect_HealSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 2, 1);
+
+/**
+ * Describes the message magic.v1.Spell.Effect.ModifyCreatureStat.
+ * Use `create(Spell_Effect_ModifyCreatureStatSchema)` to create a new message.
+ */
+export const Spell_Effect_ModifyCreatureStatSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 2, 2);
+
+/**
+ * Describes the message magic.v1.Spell.Effect.ModifyMovementPoints.
+ * Use `create(Spell_Effect_ModifyMovementPointsSchema)` to create a new message.
+ */
+export const Spell_Effect_ModifyMovementPointsSchema = /*@__PURE__*/
+  messageDesc(file_magic_v1_spell, 0, 2, 3);
+
+/**
+ * Describes the enum magic.v1.Spell.School.
+ */
+export const Spell_SchoolSchema = /*@__PURE__*/
+  enumDesc(file_magic_v1_spell, 0, 0);
+
+/**
+ * @generated from enum magic.v1.Spell.School
+ */
+export const Spell_School = /*@__PURE__*/
+  tsEnum(Spell_SchoolSchema);
+
+/**
+ * Describes the enum magic.v1.Spell.Scope.
+ */
+export const Spell_ScopeSchema = /*@__PURE__*/
+  enumDesc(file_magic_v1_spell, 0, 1);
+
+/**
+ * @generated from enum magic.v1.Spell.Scope
+ */
+export const Spell_Scope = /*@__PURE__*/
+  tsEnum(Spell_ScopeSchema);
+
+/**
+ * Describes the enum magic.v1.Spell.Targeting.
+ */
+export const Spell_TargetingSchema = /*@__PURE__*/
+  enumDesc(file_magic_v1_spell, 0, 2);
+
+/**
+ * @generated from enum magic.v1.Spell.Targeting
+ */
+export const Spell_Targeting = /*@__PURE__*/
+  tsEnum(Spell_TargetingSchema);
+
+/**
+ * Describes the enum magic.v1.Spell.Stat.
+ */
+export const Spell_StatSchema = /*@__PURE__*/
+  enumDesc(file_magic_v1_spell, 0, 3);
+
+/**
+ * @generated from enum magic.v1.Spell.Stat
+ */
+export const Spell_Stat = /*@__PURE__*/
+  tsEnum(Spell_StatSchema);
+
@@ -0,0 +1,449 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file map/v1/map.proto (package map.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import type { Connector, Grid, Segment, Segment_Bounds, Tile } from "./tile_pb";
+
+/**
+ * Describes the file map/v1/map.proto.
+ */
+export declare const file_map_v1_map: GenFile;
+
+/**
+ * @generated from message map.v1.Map
+ */
+export declare type Map = Message<"map.v1.Map"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string owner_id = 2;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: uint32 total_rows = 4;
+   */
+  totalRows: number;
+
+  /**
+   * @generated from field: uint32 total_columns = 5;
+   */
+  totalColumns: number;
+
+  /**
+   * @generated from field: uint32 max_rows_per_segment = 6;
+   */
+  maxRowsPerSegment: number;
+
+  /**
+   * @generated from field: uint32 max_columns_per_segment = 7;
+   */
+  maxColumnsPerSegment: number;
+
+  /**
+   * seed initial terrain was generated with
+   *
+   * @generated from field: int64 seed = 8;
+   */
+  seed: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * number of levels, surface is at depth 0
+   *
+   * @generated from field: uint32 depths = 11;
+   */
+  depths: number;
+};
+
+/**
+ * Describes the message map.v1.Map.
+ * Use `create(MapSchema)` to create a new message.
+ */
+export declare const MapSchema: GenMessage<Map>;
+
+/**
+ * @generated from message map.v1.CreateMapRequest
+ */
+export declare type CreateMapRequest = Message<"map.v1.CreateMapRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: uint32 total_rows = 2;
+   */
+  totalRows: number;
+
+  /**
+   * @generated from field: uint32 total_columns = 3;
+   */
+  totalColumns: number;
+
+  /**
+   * @generated from field: uint32 max_rows_per_segment = 4;
+   */
+  maxRowsPerSegment: number;
+
+  /**
+   * @generated from field: uint32 max_columns_per_segment = 5;
+   */
+  maxColumnsPerSegment: number;
+
+  /**
+   * random if omitted
+   *
+   * @generated from field: int64 seed = 6;
+   */
+  seed: bigint;
+
+  /**
+   * single level if omitted
+   *
+   * @generated from field: uint32 depths = 7;
+   */
+  depths: number;
+};
+
+/**
+ * Describes the message map.v1.CreateMapRequest.
+ * Use `create(CreateMapRequestSchema)` to create a new message.
+ */
+export declare const CreateMapRequestSchema: GenMessage<CreateMapRequest>;
+
+/**
+ * @generated from message map.v1.CreateMapResponse
+ */
+export declare type CreateMapResponse = Message<"map.v1.CreateMapResponse"> & {
+  /**
+   * @generated from field: map.v1.Map map = 1;
+   */
+  map?: Map;
+};
+
+/**
+ * Describes the message map.v1.CreateMapResponse.
+ * Use `create(CreateMapResponseSchema)` to create a new message.
+ */
+export declare const CreateMapResponseSchema: GenMessage<CreateMapResponse>;
+
+/**
+ * @generated from message map.v1.GetMapRequest
+ */
+export declare type GetMapRequest = Message<"map.v1.GetMapRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message map.v1.GetMapRequest.
+ * Use `create(GetMapRequestSchema)` to create a new message.
+ */
+export declare const GetMapRequestSchema: GenMessage<GetMapRequest>;
+
+/**
+ * @generated from message map.v1.GetMapResponse
+ */
+export declare type GetMapResponse = Message<"map.v1.GetMapResponse"> & {
+  /**
+   * only set in the first message
+   *
+   * @generated from field: map.v1.Map map = 1;
+   */
+  map?: Map;
+
+  /**
+   * may be partial, containing a subset of segment rows
+   *
+   * @generated from field: map.v1.Grid grid = 2;
+   */
+  grid?: Grid;
+};
+
+/**
+ * Describes the message map.v1.GetMapResponse.
+ * Use `create(GetMapResponseSchema)` to create a new message.
+ */
+export declare const GetMapResponseSchema: GenMessage<GetMapResponse>;
+
+/**
+ * @generated from message map.v1.StreamSegmentsRequest
+ */
+export declare type StreamSegmentsRequest = Message<"map.v1.StreamSegmentsRequest"> & {
+  /**
+   * only read from the first message
+   *
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * tiles visible to the client on a single level, max values are exclusive
+   *
+   * @generated from field: map.v1.Segment.Bounds viewport = 2;
+   */
+  viewport?: Segment_Bounds;
+
+  /**
+   * tiles around the viewport to prefetch
+   *
+   * @generated from field: int32 margin = 3;
+   */
+  margin: number;
+};
+
+/**
+ * Describes the message map.v1.StreamSegmentsRequest.
+ * Use `create(StreamSegmentsRequestSchema)` to create a new message.
+ */
+export declare const StreamSegmentsRequestSchema: GenMessage<StreamSegmentsRequest>;
+
+/**
+ * @generated from message map.v1.StreamSegmentsResponse
+ */
+export declare type StreamSegmentsResponse = Message<"map.v1.StreamSegmentsResponse"> & {
+  /**
+   * only set in the first message
+   *
+   * @generated from field: map.v1.Map map = 1;
+   */
+  map?: Map;
+
+  /**
+   * segments the client hasn't received yet, closest to viewport first
+   *
+   * @generated from field: repeated map.v1.Segment segments = 2;
+   */
+  segments: Segment[];
+};
+
+/**
+ * Describes the message map.v1.StreamSegmentsResponse.
+ * Use `create(StreamSegmentsResponseSchema)` to create a new message.
+ */
+export declare const StreamSegmentsResponseSchema: GenMessage<StreamSegmentsResponse>;
+
+/**
+ * @generated from message map.v1.ListMapsRequest
+ */
+export declare type ListMapsRequest = Message<"map.v1.ListMapsRequest"> & {
+};
+
+/**
+ * Describes the message map.v1.ListMapsRequest.
+ * Use `create(ListMapsRequestSchema)` to create a new message.
+ */
+export declare const ListMapsRequestSchema: GenMessage<ListMapsRequest>;
+
+/**
+ * @generated from message map.v1.ListMapsResponse
+ */
+export declare type ListMapsResponse = Message<"map.v1.ListMapsResponse"> & {
+  /**
+   * @generated from field: repeated map.v1.Map maps = 1;
+   */
+  maps: Map[];
+};
+
+/**
+ * Describes the message map.v1.ListMapsResponse.
+ * Use `create(ListMapsResponseSchema)` to create a new message.
+ */
+export declare const ListMapsResponseSchema: GenMessage<ListMapsResponse>;
+
+/**
+ * @generated from message map.v1.UpdateTilesRequest
+ */
+export declare type UpdateTilesRequest = Message<"map.v1.UpdateTilesRequest"> & {
+  /**
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * replace tiles at their coordinates
+   *
+   * @generated from field: repeated map.v1.Tile tiles = 2;
+   */
+  tiles: Tile[];
+};
+
+/**
+ * Describes the message map.v1.UpdateTilesRequest.
+ * Use `create(UpdateTilesRequestSchema)` to create a new message.
+ */
+export declare const UpdateTilesRequestSchema: GenMessage<UpdateTilesRequest>;
+
+/**
+ * @generated from message map.v1.UpdateTilesResponse
+ */
+export declare type UpdateTilesResponse = Message<"map.v1.UpdateTilesResponse"> & {
+  /**
+   * @generated from field: map.v1.Map map = 1;
+   */
+  map?: Map;
+};
+
+/**
+ * Describes the message map.v1.UpdateTilesResponse.
+ * Use `create(UpdateTilesResponseSchema)` to create a new message.
+ */
+export declare const UpdateTilesResponseSchema: GenMessage<UpdateTilesResponse>;
+
+/**
+ * @generated from message map.v1.UpdateConnectorsRequest
+ */
+export declare type UpdateConnectorsRequest = Message<"map.v1.UpdateConnectorsRequest"> & {
+  /**
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: repeated map.v1.Connector add = 2;
+   */
+  add: Connector[];
+
+  /**
+   * matched by their ends
+   *
+   * @generated from field: repeated map.v1.Connector remove = 3;
+   */
+  remove: Connector[];
+};
+
+/**
+ * Describes the message map.v1.UpdateConnectorsRequest.
+ * Use `create(UpdateConnectorsRequestSchema)` to create a new message.
+ */
+export declare const UpdateConnectorsRequestSchema: GenMessage<UpdateConnectorsRequest>;
+
+/**
+ * @generated from message map.v1.UpdateConnectorsResponse
+ */
+export declare type UpdateConnectorsResponse = Message<"map.v1.UpdateConnectorsResponse"> & {
+  /**
+   * @generated from field: map.v1.Map map = 1;
+   */
+  map?: Map;
+};
+
+/**
+ * Describes the message map.v1.UpdateConnectorsResponse.
+ * Use `create(UpdateConnectorsResponseSchema)` to create a new message.
+ */
+export declare const UpdateConnectorsResponseSchema: GenMessage<UpdateConnectorsResponse>;
+
+/**
+ * @generated from message map.v1.DeleteMapRequest
+ */
+export declare type DeleteMapRequest = Message<"map.v1.DeleteMapRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message map.v1.DeleteMapRequest.
+ * Use `create(DeleteMapRequestSchema)` to create a new message.
+ */
+export declare const DeleteMapRequestSchema: GenMessage<DeleteMapRequest>;
+
+/**
+ * @generated from message map.v1.DeleteMapResponse
+ */
+export declare type DeleteMapResponse = Message<"map.v1.DeleteMapResponse"> & {
+};
+
+/**
+ * Describes the message map.v1.DeleteMapResponse.
+ * Use `create(DeleteMapResponseSchema)` to create a new message.
+ */
+export declare const DeleteMapResponseSchema: GenMessage<DeleteMapResponse>;
+
+/**
+ * @generated from service map.v1.MapService
+ */
+export declare const MapService: GenService<{
+  /**
+   * @generated from rpc map.v1.MapService.CreateMap
+   */
+  createMap: {
+    methodKind: "unary";
+    input: typeof CreateMapRequestSchema;
+    output: typeof CreateMapResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.GetMap
+   */
+  getMap: {
+    methodKind: "server_streaming";
+    input: typeof GetMapRequestSchema;
+    output: typeof GetMapResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.StreamSegments
+   */
+  streamSegments: {
+    methodKind: "bidi_streaming";
+    input: typeof StreamSegmentsRequestSchema;
+    output: typeof StreamSegmentsResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.ListMaps
+   */
+  listMaps: {
+    methodKind: "unary";
+    input: typeof ListMapsRequestSchema;
+    output: typeof ListMapsResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.UpdateTiles
+   */
+  updateTiles: {
+    methodKind: "unary";
+    input: typeof UpdateTilesRequestSchema;
+    output: typeof UpdateTilesResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.UpdateConnectors
+   */
+  updateConnectors: {
+    methodKind: "unary";
+    input: typeof UpdateConnectorsRequestSchema;
+    output: typeof UpdateConnectorsResponseSchema;
+  },
+  /**
+   * @generated from rpc map.v1.MapService.DeleteMap
+   */
+  deleteMap: {
+    methodKind: "unary";
+    input: typeof DeleteMapRequestSchema;
+    output: typeof DeleteMapResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,125 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file map/v1/map.proto (package map.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import { file_map_v1_tile } from "./tile_pb";
+
+/**
+ * Describes the file map/v1/map.proto.
+ */
+export const file_map_v1_map = /*@__PURE__*/
+  fileDesc("ChBtYXAvdjEvbWFwLnByb3RvEgZtYXAudjEimQIKA01hcBIKCgJpZBgBIAEoCRIQCghvd25lcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEhIKCnRvdGFsX3Jvd3MYBCABKA0SFQoNdG90YWxfY29sdW1ucxgFIAEoDRIcChRtYXhfcm93c19wZXJfc2VnbWVudBgGIAEoDRIfChdtYXhfY29sdW1uc19wZXJfc2VnbWVudBgHIAEoDRIMCgRzZWVkGAggASgDEi4KCmNyZWF0ZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBmRlcHRocxgLIAEoDSKoAQoQQ3JlYXRlTWFwUmVxdWVzdBIMCgRuYW1lGAEgASgJEhIKCnRvdGFsX3Jvd3MYAiABKA0SFQoNdG90YWxfY29sdW1ucxgDIAEoDRIcChRtYXhfcm93c19wZXJfc2VnbWVudBgEIAEoDRIfChdtYXhfY29sdW1uc19wZXJfc2VnbWVudBgFIAEoDRIMCgRzZWVkGAYgASgDEg4KBmRlcHRocxgHIAEoDSItChFDcmVhdGVNYXBSZXNwb25zZRIYCgNtYXAYASABKAsyCy5tYXAudjEuTWFwIhsKDUdldE1hcFJlcXVlc3QSCgoCaWQYASABKAkiRgoOR2V0TWFwUmVzcG9uc2USGAoDbWFwGAEgASgLMgsubWFwLnYxLk1hcBIaCgRncmlkGAIgASgLMgwubWFwLnYxLkdyaWQiYQoVU3RyZWFtU2VnbWVudHNSZXF1ZXN0Eg4KBm1hcF9pZBgBIAEoCRIoCgh2aWV3cG9ydBgCIAEoCzIWLm1hcC52MS5TZWdtZW50LkJvdW5kcxIOCgZtYXJnaW4YAyABKAUiVQoWU3RyZWFtU2VnbWVudHNSZXNwb25zZRIYCgNtYXAYASABKAsyCy5tYXAudjEuTWFwEiEKCHNlZ21lbnRzGAIgAygLMg8ubWFwLnYxLlNlZ21lbnQiEQoPTGlzdE1hcHNSZXF1ZXN0Ii0KEExpc3RNYXBzUmVzcG9uc2USGQoEbWFwcxgBIAMoCzILLm1hcC52MS5NYXAiQQoSVXBkYXRlVGlsZXNSZXF1ZXN0Eg4KBm1hcF9pZBgBIAEoCRIbCgV0aWxlcxgCIAMoCzIMLm1hcC52MS5UaWxlIi8KE1VwZGF0ZVRpbGVzUmVzcG9uc2USGAoDbWFwGAEgASgLMgsubWFwLnYxLk1hcCJsChdVcGRhdGVDb25uZWN0b3JzUmVxdWVzdBIOCgZtYXBfaWQYASABKAkSHgoDYWRkGAIgAygLMhEubWFwLnYxLkNvbm5lY3RvchIhCgZyZW1vdmUYAyADKAsyES5tYXAudjEuQ29ubmVjdG9yIjQKGFVwZGF0ZUNvbm5lY3RvcnNSZXNwb25zZRIYCgNtYXAYASABKAsyCy5tYXAudjEuTWFwIh4KEERlbGV0ZU1hcFJlcXVlc3QSCgoCaWQYASABKAkiEwoRRGVsZXRlTWFwUmVzcG9uc2Uy/gMKCk1hcFNlcnZpY2USQAoJQ3JlYXRlTWFwEhgubWFwLnYxLkNyZWF0ZU1hcFJlcXVlc3QaGS5tYXAudjEuQ3JlYXRlTWFwUmVzcG9uc2USOQoGR2V0TWFwEhUubWFwLnYxLkdldE1hcFJlcXVlc3QaFi5tYXAudjEuR2V0TWFwUmVzcG9uc2UwARJTCg5TdHJlYW1TZWdtZW50cxIdLm1hcC52MS5TdHJlYW1TZWdtZW50c1JlcXVlc3QaHi5tYXAudjEuU3RyZWFtU2VnbWVudHNSZXNwb25zZSgBMAESPQoITGlzdE1hcHMSFy5tYXAudjEuTGlzdE1hcHNSZXF1ZXN0GhgubWFwLnYxLkxpc3RNYXBzUmVzcG9uc2USRgoLVXBkYXRlVGlsZXMSGi5tYXAudjEuVXBkYXRlVGlsZXNSZXF1ZXN0GhsubWFwLnYxLlVwZGF0ZVRpbGVzUmVzcG9uc2USVQoQVXBkYXRlQ29ubmVjdG9ycxIfLm1hcC52MS5VcGRhdGVDb25uZWN0b3JzUmVxdWVzdBogLm1hcC52MS5VcGRhdGVDb25uZWN0b3JzUmVzcG9uc2USQAoJRGVsZXRlTWFwEhgubWFwLnYxLkRlbGV0ZU1hcFJlcXVlc3QaGS5tYXAudjEuRGVsZXRlTWFwUmVzcG9uc2VCeAoKY29tLm1hcC52MUIITWFwUHJvdG9QAVonZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vbWFwL3YxO21hcHYxogIDTVhYqgIGTWFwLlYxygIGTWFwXFYx4gISTWFwXFYxXEdQQk1ldGFkYXRh6gIHTWFwOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_map_v1_tile]);
+
+/**
+ * Describes the message map.v1.Map.
+ * Use `create(MapSchema)` to create a new message.
+ */
+export const MapSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 0);
+
+/**
+ * Describes the message map.v1.CreateMapRequest.
+ * Use `create(CreateMapRequestSchema)` to create a new message.
+ */
+export const CreateMapRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 1);
+
+/**
+ * Describes the message map.v1.CreateMapResponse.
+ * Use `create(CreateMapResponseSchema)` to create a new message.
+ */
+export const CreateMapResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 2);
+
+/**
+ * Describes the message map.v1.GetMapRequest.
+ * Use `create(GetMapRequestSchema)` to create a new message.
+ */
+export const GetMapRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 3);
+
+/**
+ * Describes the message map.v1.GetMapResponse.
+ * Use `create(GetMapResponseSchema)` to create a new message.
+ */
+export const GetMapResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 4);
+
+/**
+ * Describes the message map.v1.StreamSegmentsRequest.
+ * Use `create(StreamSegmentsRequestSchema)` to create a new message.
+ */
+export const StreamSegmentsRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 5);
+
+/**
+ * Describes the message map.v1.StreamSegmentsResponse.
+ * Use `create(StreamSegmentsResponseSchema)` to create a new message.
+ */
+export const StreamSegmentsResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 6);
+
+/**
+ * Describes the message map.v1.ListMapsRequest.
+ * Use `create(ListMapsRequestSchema)` to create a new message.
+ */
+export const ListMapsRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 7);
+
+/**
+ * Describes the message map.v1.ListMapsResponse.
+ * Use `create(ListMapsResponseSchema)` to create a new message.
+ */
+export const ListMapsResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 8);
+
+/**
+ * Describes the message map.v1.UpdateTilesRequest.
+ * Use `create(UpdateTilesRequestSchema)` to create a new message.
+ */
+export const UpdateTilesRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 9);
+
+/**
+ * Describes the message map.v1.UpdateTilesResponse.
+ * Use `create(UpdateTilesResponseSchema)` to create a new message.
+ */
+export const UpdateTilesResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 10);
+
+/**
+ * Describes the message map.v1.UpdateConnectorsRequest.
+ * Use `create(UpdateConnectorsRequestSchema)` to create a new message.
+ */
+export const UpdateConnectorsRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 11);
+
+/**
+ * Describes the message map.v1.UpdateConnectorsResponse.
+ * Use `create(UpdateConnectorsResponseSchema)` to create a new message.
+ */
+export const UpdateConnectorsResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 12);
+
+/**
+ * Describes the message map.v1.DeleteMapRequest.
+ * Use `create(DeleteMapRequestSchema)` to create a new message.
+ */
+export const DeleteMapRequestSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 13);
+
+/**
+ * Describes the message map.v1.DeleteMapResponse.
+ * Use `create(DeleteMapResponseSchema)` to create a new message.
+ */
+export const DeleteMapResponseSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_map, 14);
+
+/**
+ * @generated from service map.v1.MapService
+ */
+export const MapService = /*@__PURE__*/
+  serviceDesc(file_map_v1_map, 0);
+
@@ -45,6 +45,13 @@ export declare type Terrain = Message<"map.v1.Terrain"> & {
    * @generated from field: map.v1.Terrain.RenderingSpec rendering_spec = 6;
    */
   renderingSpec?: Terrain_RenderingSpec;
+
+  /**
+   * scouting range spent looking across the tile, 1 if unset
+   *
+   * @generated from field: uint32 sight_cost = 7;
+   */
+  sightCost: number;
 };
 
 /**
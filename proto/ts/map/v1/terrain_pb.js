@@ -10,7 +10,7 @@ import { file_magic_v1_spell } from "../../magic/v1/spell_pb";
  * Describes the file map/v1/terrain.proto.
  */
 export const file_map_v1_terrain = /*@__PURE__*/
-  fileDesc("ChRtYXAvdjEvdGVycmFpbi5wcm90bxIGbWFwLnYxIpESCgdUZXJyYWluEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkSGAoQbW92ZW1lbnRfcGVuYWx0eRgDIAEoDRIzCg1wYXNzYWJsZV93aXRoGAQgAygOMhwubWFwLnYxLlRlcnJhaW4uTW92ZW1lbnRUeXBlEicKB2VmZmVjdHMYBSADKAsyFi5tYXAudjEuVGVycmFpbi5FZmZlY3QSNQoOcmVuZGVyaW5nX3NwZWMYBiABKAsyHS5tYXAudjEuVGVycmFpbi5SZW5kZXJpbmdTcGVjGugOCgZFZmZlY3QSRQoSbW9kaWZ5X3NwZWxsX2xldmVsGAEgASgLMicubWFwLnYxLlRlcnJhaW4uRWZmZWN0Lk1vZGlmeVNwZWxsTGV2ZWxIABJLChVwcmV2ZW50X3NwZWxsX2Nhc3RpbmcYAiABKAsyKi5tYXAudjEuVGVycmFpbi5FZmZlY3QuUHJldmVudFNwZWxsQ2FzdGluZ0gAElwKHmRpc2FibGVfbmF0aXZlX3RlcnJhaW5fYm9udXNlcxgDIAEoCzIyLm1hcC52MS5UZXJyYWluLkVmZmVjdC5EaXNhYmxlTmF0aXZlVGVycmFpbkJvbnVzZXNIABJaCh1tb2RpZnlfY3JlYXR1cmVfbW92ZW1lbnRfdHlwZRgEIAEoCzIxLm1hcC52MS5UZXJyYWluLkVmZmVjdC5Nb2RpZnlDcmVhdHVyZU1vdmVtZW50VHlwZUgAEk0KFm1vZGlmeV9jcmVhdHVyZV9tb3JhbGUYBSABKAsyKy5tYXAudjEuVGVycmFpbi5FZmZlY3QuTW9kaWZ5Q3JlYXR1cmVNb3JhbGVIABJJChRtb2RpZnlfY3JlYXR1cmVfbHVjaxgGIAEoCzIpLm1hcC52MS5UZXJyYWluLkVmZmVjdC5Nb2RpZnlDcmVhdHVyZUx1Y2tIABJNChZtb2RpZnlfY3JlYXR1cmVfYXR0YWNrGAcgASgLMisubWFwLnYxLlRlcnJhaW4uRWZmZWN0Lk1vZGlmeUNyZWF0dXJlQXR0YWNrSAASTwoXbW9kaWZ5X2NyZWF0dXJlX2RlZmVuY2UYCCABKAsyLC5tYXAudjEuVGVycmFpbi5FZmZlY3QuTW9kaWZ5Q3JlYXR1cmVEZWZlbmNlSAASSwoVbW9kaWZ5X2NyZWF0dXJlX3NwZWVkGAkgASgLMioubWFwLnYxLlRlcnJhaW4uRWZmZWN0Lk1vZGlmeUNyZWF0dXJlU3BlZWRIABpJChBNb2RpZnlTcGVsbExldmVsEiYKBmZpbHRlchgBIAEoCzIWLm1hZ2ljLnYxLlNwZWxsLkZpbHRlchINCgVkZWx0YRgCIAEoBRqJAQoTUHJldmVudFNwZWxsQ2FzdGluZxImCgZmaWx0ZXIYASABKAsyFi5tYWdpYy52MS5TcGVsbC5GaWx0ZXISFgoJbGV2ZWxfZ3RlGAIgASgFSACIAQESFgoJbGV2ZWxfbHRlGAMgASgFSAGIAQFCDAoKX2xldmVsX2d0ZUIMCgpfbGV2ZWxfbHRlGh0KG0Rpc2FibGVOYXRpdmVUZXJyYWluQm9udXNlcxq3AQoaTW9kaWZ5Q3JlYXR1cmVNb3ZlbWVudFR5cGUSMgoGZmlsdGVyGAEgASgLMiIuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLktpbmQuRmlsdGVyEjMKBnJlbW92ZRgCIAMoDjIjLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5Nb3ZlbWVudFR5cGUSMAoDYWRkGAMgAygOMiMuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLk1vdmVtZW50VHlwZRqOAQoUTW9kaWZ5Q3JlYXR1cmVNb3JhbGUSMgoGZmlsdGVyGAEgASgLMiIuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLktpbmQuRmlsdGVyEkIKDG1vZGlmaWNhdGlvbhgCIAEoCzIsLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5BdHRyaWJ1dGVNb2RpZmljYXRpb24ajAEKEk1vZGlmeUNyZWF0dXJlTHVjaxIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISQgoMbW9kaWZpY2F0aW9uGAIgASgLMiwuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLkF0dHJpYnV0ZU1vZGlmaWNhdGlvbhqOAQoUTW9kaWZ5Q3JlYXR1cmVBdHRhY2sSMgoGZmlsdGVyGAEgASgLMiIuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLktpbmQuRmlsdGVyEkIKDG1vZGlmaWNhdGlvbhgCIAEoCzIsLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5BdHRyaWJ1dGVNb2RpZmljYXRpb24ajwEKFU1vZGlmeUNyZWF0dXJlRGVmZW5jZRIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISQgoMbW9kaWZpY2F0aW9uGAIgASgLMiwuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLkF0dHJpYnV0ZU1vZGlmaWNhdGlvbhqNAQoTTW9kaWZ5Q3JlYXR1cmVTcGVlZBIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISQgoMbW9kaWZpY2F0aW9uGAIgASgLMiwuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLkF0dHJpYnV0ZU1vZGlmaWNhdGlvbkIGCgRraW5kGjQKDVJlbmRlcmluZ1NwZWMSEgoKY2xhc3NfbmFtZRgBIAEoCRIPCgd0ZXh0dXJlGAIgASgJIpsBCgxNb3ZlbWVudFR5cGUSHQoZTU9WRU1FTlRfVFlQRV9VTlNQRUNJRklFRBAAEhkKFU1PVkVNRU5UX1RZUEVfV0FMS0lORxABEhoKFk1PVkVNRU5UX1RZUEVfU1dJTU1JTkcQAhIYChRNT1ZFTUVOVF9UWVBFX0ZMWUlORxADEhsKF01PVkVNRU5UX1RZUEVfUE9SVEFMSU5HEARCfAoKY29tLm1hcC52MUIMVGVycmFpblByb3RvUAFaJ2dpdGh1Yi5jb20vb3BlbmhleGVzL3Byb3RvL21hcC92MTttYXB2MaICA01YWKoCBk1hcC5WMcoCBk1hcFxWMeICEk1hcFxWMVxHUEJNZXRhZGF0YeoCB01hcDo6VjFiBnByb3RvMw", [file_creatures_v1_creature, file_magic_v1_spell]);
+  fileDesc("ChRtYXAvdjEvdGVycmFpbi5wcm90bxIGbWFwLnYxIqUSCgdUZXJyYWluEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkSGAoQbW92ZW1lbnRfcGVuYWx0eRgDIAEoDRIzCg1wYXNzYWJsZV93aXRoGAQgAygOMhwubWFwLnYxLlRlcnJhaW4uTW92ZW1lbnRUeXBlEicKB2VmZmVjdHMYBSADKAsyFi5tYXAudjEuVGVycmFpbi5FZmZlY3QSNQoOcmVuZGVyaW5nX3NwZWMYBiABKAsyHS5tYXAudjEuVGVycmFpbi5SZW5kZXJpbmdTcGVjEhIKCnNpZ2h0X2Nvc3QYByABKA0a6A4KBkVmZmVjdBJFChJtb2RpZnlfc3BlbGxfbGV2ZWwYASABKAsyJy5tYXAudjEuVGVycmFpbi5FZmZlY3QuTW9kaWZ5U3BlbGxMZXZlbEgAEksKFXByZXZlbnRfc3BlbGxfY2FzdGluZxgCIAEoCzIqLm1hcC52MS5UZXJyYWluLkVmZmVjdC5QcmV2ZW50U3BlbGxDYXN0aW5nSAASXAoeZGlzYWJsZV9uYXRpdmVfdGVycmFpbl9ib251c2VzGAMgASgLMjIubWFwLnYxLlRlcnJhaW4uRWZmZWN0LkRpc2FibGVOYXRpdmVUZXJyYWluQm9udXNlc0gAEloKHW1vZGlmeV9jcmVhdHVyZV9tb3ZlbWVudF90eXBlGAQgASgLMjEubWFwLnYxLlRlcnJhaW4uRWZmZWN0Lk1vZGlmeUNyZWF0dXJlTW92ZW1lbnRUeXBlSAASTQoWbW9kaWZ5X2NyZWF0dXJlX21vcmFsZRgFIAEoCzIrLm1hcC52MS5UZXJyYWluLkVmZmVjdC5Nb2RpZnlDcmVhdHVyZU1vcmFsZUgAEkkKFG1vZGlmeV9jcmVhdHVyZV9sdWNrGAYgASgLMikubWFwLnYxLlRlcnJhaW4uRWZmZWN0Lk1vZGlmeUNyZWF0dXJlTHVja0gAEk0KFm1vZGlmeV9jcmVhdHVyZV9hdHRhY2sYByABKAsyKy5tYXAudjEuVGVycmFpbi5FZmZlY3QuTW9kaWZ5Q3JlYXR1cmVBdHRhY2tIABJPChdtb2RpZnlfY3JlYXR1cmVfZGVmZW5jZRgIIAEoCzIsLm1hcC52MS5UZXJyYWluLkVmZmVjdC5Nb2RpZnlDcmVhdHVyZURlZmVuY2VIABJLChVtb2RpZnlfY3JlYXR1cmVfc3BlZWQYCSABKAsyKi5tYXAudjEuVGVycmFpbi5FZmZlY3QuTW9kaWZ5Q3JlYXR1cmVTcGVlZEgAGkkKEE1vZGlmeVNwZWxsTGV2ZWwSJgoGZmlsdGVyGAEgASgLMhYubWFnaWMudjEuU3BlbGwuRmlsdGVyEg0KBWRlbHRhGAIgASgFGokBChNQcmV2ZW50U3BlbGxDYXN0aW5nEiYKBmZpbHRlchgBIAEoCzIWLm1hZ2ljLnYxLlNwZWxsLkZpbHRlchIWCglsZXZlbF9ndGUYAiABKAVIAIgBARIWCglsZXZlbF9sdGUYAyABKAVIAYgBAUIMCgpfbGV2ZWxfZ3RlQgwKCl9sZXZlbF9sdGUaHQobRGlzYWJsZU5hdGl2ZVRlcnJhaW5Cb251c2VzGrcBChpNb2RpZnlDcmVhdHVyZU1vdmVtZW50VHlwZRIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISMwoGcmVtb3ZlGAIgAygOMiMuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLk1vdmVtZW50VHlwZRIwCgNhZGQYAyADKA4yIy5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuTW92ZW1lbnRUeXBlGo4BChRNb2RpZnlDcmVhdHVyZU1vcmFsZRIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISQgoMbW9kaWZpY2F0aW9uGAIgASgLMiwuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLkF0dHJpYnV0ZU1vZGlmaWNhdGlvbhqMAQoSTW9kaWZ5Q3JlYXR1cmVMdWNrEjIKBmZpbHRlchgBIAEoCzIiLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5LaW5kLkZpbHRlchJCCgxtb2RpZmljYXRpb24YAiABKAsyLC5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuQXR0cmlidXRlTW9kaWZpY2F0aW9uGo4BChRNb2RpZnlDcmVhdHVyZUF0dGFjaxIyCgZmaWx0ZXIYASABKAsyIi5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuS2luZC5GaWx0ZXISQgoMbW9kaWZpY2F0aW9uGAIgASgLMiwuY3JlYXR1cmVzLnYxLkNyZWF0dXJlLkF0dHJpYnV0ZU1vZGlmaWNhdGlvbhqPAQoVTW9kaWZ5Q3JlYXR1cmVEZWZlbmNlEjIKBmZpbHRlchgBIAEoCzIiLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5LaW5kLkZpbHRlchJCCgxtb2RpZmljYXRpb24YAiABKAsyLC5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuQXR0cmlidXRlTW9kaWZpY2F0aW9uGo0BChNNb2RpZnlDcmVhdHVyZVNwZWVkEjIKBmZpbHRlchgBIAEoCzIiLmNyZWF0dXJlcy52MS5DcmVhdHVyZS5LaW5kLkZpbHRlchJCCgxtb2RpZmljYXRpb24YAiABKAsyLC5jcmVhdHVyZXMudjEuQ3JlYXR1cmUuQXR0cmlidXRlTW9kaWZpY2F0aW9uQgYKBGtpbmQaNAoNUmVuZGVyaW5nU3BlYxISCgpjbGFzc19uYW1lGAEgASgJEg8KB3RleHR1cmUYAiABKAkimwEKDE1vdmVtZW50VHlwZRIdChlNT1ZFTUVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVTU9WRU1FTlRfVFlQRV9XQUxLSU5HEAESGgoWTU9WRU1FTlRfVFlQRV9TV0lNTUlORxACEhgKFE1PVkVNRU5UX1RZUEVfRkxZSU5HEAMSGwoXTU9WRU1FTlRfVFlQRV9QT1JUQUxJTkcQBEJ8Cgpjb20ubWFwLnYxQgxUZXJyYWluUHJvdG9QAVonZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vbWFwL3YxO21hcHYxogIDTVhYqgIGTWFwLlYxygIGTWFwXFYx4gISTWFwXFYxXEdQQk1ldGFkYXRh6gIHTWFwOjpWMWIGcHJvdG8z", [file_creatures_v1_creature, file_magic_v1_spell]);
 
 /**
  * Describes the message map.v1.Terrain.
@@ -2,7 +2,7 @@
 // @generated from file map/v1/tile.proto (package map.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
@@ -28,6 +28,11 @@ export declare type Tile = Message<"map.v1.Tile"> & {
    * @generated from field: map.v1.Tile.RenderingSpec rendering_spec = 3;
    */
   renderingSpec?: Tile_RenderingSpec;
+
+  /**
+   * @generated from field: map.v1.Tile.Visibility visibility = 4;
+   */
+  visibility: Tile_Visibility;
 };
 
 /**
@@ -80,6 +85,44 @@ export declare type Tile_RenderingSpec = Message<"map.v1.Tile.RenderingSpec"> &
  */
 export declare const Tile_RenderingSpecSchema: GenMessage<Tile_RenderingSpec>;
 
+/**
+ * @generated from enum map.v1.Tile.Visibility
+ */
+export enum Tile_Visibility {
+  /**
+   * no fog of war, e.g. in map editor
+   *
+   * @generated from enum value: VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * never explored, terrain is masked
+   *
+   * @generated from enum value: VISIBILITY_HIDDEN = 1;
+   */
+  HIDDEN = 1,
+
+  /**
+   * explored before, but not seen right now
+   *
+   * @generated from enum value: VISIBILITY_EXPLORED = 2;
+   */
+  EXPLORED = 2,
+
+  /**
+   * seen by player's heroes or towns
+   *
+   * @generated from enum value: VISIBILITY_VISIBLE = 3;
+   */
+  VISIBLE = 3,
+}
+
+/**
+ * Describes the enum map.v1.Tile.Visibility.
+ */
+export declare const Tile_VisibilitySchema: GenEnum<Tile_Visibility>;
+
 /**
  * @generated from message map.v1.Segment
  */
@@ -93,6 +136,13 @@ export declare type Segment = Message<"map.v1.Segment"> & {
    * @generated from field: repeated map.v1.Tile tiles = 2;
    */
   tiles: Tile[];
+
+  /**
+   * with either end on tiles of the segment
+   *
+   * @generated from field: repeated map.v1.Connector connectors = 3;
+   */
+  connectors: Connector[];
 };
 
 /**
@@ -124,6 +174,13 @@ export declare type Segment_Bounds = Message<"map.v1.Segment.Bounds"> & {
    * @generated from field: int32 max_column = 4;
    */
   maxColumn: number;
+
+  /**
+   * level the bounds lie on
+   *
+   * @generated from field: uint32 depth = 5;
+   */
+  depth: number;
 };
 
 /**
@@ -148,6 +205,66 @@ export declare type Segment_Row = Message<"map.v1.Segment.Row"> & {
  */
 export declare const Segment_RowSchema: GenMessage<Segment_Row>;
 
+/**
+ * Connector links tiles on different levels of a map, heroes standing on one end may step onto the other.
+ *
+ * @generated from message map.v1.Connector
+ */
+export declare type Connector = Message<"map.v1.Connector"> & {
+  /**
+   * @generated from field: map.v1.Connector.Kind kind = 1;
+   */
+  kind: Connector_Kind;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate from = 2;
+   */
+  from?: Tile_Coordinate;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate to = 3;
+   */
+  to?: Tile_Coordinate;
+
+  /**
+   * only leads from `from` to `to`
+   *
+   * @generated from field: bool one_way = 4;
+   */
+  oneWay: boolean;
+};
+
+/**
+ * Describes the message map.v1.Connector.
+ * Use `create(ConnectorSchema)` to create a new message.
+ */
+export declare const ConnectorSchema: GenMessage<Connector>;
+
+/**
+ * @generated from enum map.v1.Connector.Kind
+ */
+export enum Connector_Kind {
+  /**
+   * @generated from enum value: KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: KIND_STAIRWAY = 1;
+   */
+  STAIRWAY = 1,
+
+  /**
+   * @generated from enum value: KIND_PORTAL = 2;
+   */
+  PORTAL = 2,
+}
+
+/**
+ * Describes the enum map.v1.Connector.Kind.
+ */
+export declare const Connector_KindSchema: GenEnum<Connector_Kind>;
+
 /**
  * @generated from message map.v1.Grid
  */
@@ -166,6 +283,13 @@ export declare type Grid = Message<"map.v1.Grid"> & {
    * @generated from field: uint32 total_columns = 3;
    */
   totalColumns: number;
+
+  /**
+   * number of levels, segment rows of every level follow the ones of the level above
+   *
+   * @generated from field: uint32 depths = 4;
+   */
+  depths: number;
 };
 
 /**
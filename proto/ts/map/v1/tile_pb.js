@@ -2,13 +2,13 @@
 // @generated from file map/v1/tile.proto (package map.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
 
 /**
  * Describes the file map/v1/tile.proto.
  */
 export const file_map_v1_tile = /*@__PURE__*/
-  fileDesc("ChFtYXAvdjEvdGlsZS5wcm90bxIGbWFwLnYxItsBCgRUaWxlEisKCmNvb3JkaW5hdGUYASABKAsyFy5tYXAudjEuVGlsZS5Db29yZGluYXRlEhIKCnRlcnJhaW5faWQYAiABKAkSMgoOcmVuZGVyaW5nX3NwZWMYAyABKAsyGi5tYXAudjEuVGlsZS5SZW5kZXJpbmdTcGVjGjgKCkNvb3JkaW5hdGUSCwoDcm93GAEgASgNEg4KBmNvbHVtbhgCIAEoDRINCgVkZXB0aBgDIAEoDRokCg1SZW5kZXJpbmdTcGVjEhMKC2ZlYXR1cmVfaWRzGAEgAygJIswBCgdTZWdtZW50EiYKBmJvdW5kcxgBIAEoCzIWLm1hcC52MS5TZWdtZW50LkJvdW5kcxIbCgV0aWxlcxgCIAMoCzIMLm1hcC52MS5UaWxlGlIKBkJvdW5kcxIPCgdtaW5fcm93GAEgASgFEg8KB21heF9yb3cYAiABKAUSEgoKbWluX2NvbHVtbhgDIAEoBRISCgptYXhfY29sdW1uGAQgASgFGigKA1JvdxIhCghzZWdtZW50cxgBIAMoCzIPLm1hcC52MS5TZWdtZW50IlwKBEdyaWQSKQoMc2VnbWVudF9yb3dzGAEgAygLMhMubWFwLnYxLlNlZ21lbnQuUm93EhIKCnRvdGFsX3Jvd3MYAiABKA0SFQoNdG90YWxfY29sdW1ucxgDIAEoDUJ5Cgpjb20ubWFwLnYxQglUaWxlUHJvdG9QAVonZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vbWFwL3YxO21hcHYxogIDTVhYqgIGTWFwLlYxygIGTWFwXFYx4gISTWFwXFYxXEdQQk1ldGFkYXRh6gIHTWFwOjpWMWIGcHJvdG8z");
+  fileDesc("ChFtYXAvdjEvdGlsZS5wcm90bxIGbWFwLnYxIvoCCgRUaWxlEisKCmNvb3JkaW5hdGUYASABKAsyFy5tYXAudjEuVGlsZS5Db29yZGluYXRlEhIKCnRlcnJhaW5faWQYAiABKAkSMgoOcmVuZGVyaW5nX3NwZWMYAyABKAsyGi5tYXAudjEuVGlsZS5SZW5kZXJpbmdTcGVjEisKCnZpc2liaWxpdHkYBCABKA4yFy5tYXAudjEuVGlsZS5WaXNpYmlsaXR5GjgKCkNvb3JkaW5hdGUSCwoDcm93GAEgASgNEg4KBmNvbHVtbhgCIAEoDRINCgVkZXB0aBgDIAEoDRokCg1SZW5kZXJpbmdTcGVjEhMKC2ZlYXR1cmVfaWRzGAEgAygJInAKClZpc2liaWxpdHkSGgoWVklTSUJJTElUWV9VTlNQRUNJRklFRBAAEhUKEVZJU0lCSUxJVFlfSElEREVOEAESFwoTVklTSUJJTElUWV9FWFBMT1JFRBACEhYKElZJU0lCSUxJVFlfVklTSUJMRRADIoICCgdTZWdtZW50EiYKBmJvdW5kcxgBIAEoCzIWLm1hcC52MS5TZWdtZW50LkJvdW5kcxIbCgV0aWxlcxgCIAMoCzIMLm1hcC52MS5UaWxlEiUKCmNvbm5lY3RvcnMYAyADKAsyES5tYXAudjEuQ29ubmVjdG9yGmEKBkJvdW5kcxIPCgdtaW5fcm93GAEgASgFEg8KB21heF9yb3cYAiABKAUSEgoKbWluX2NvbHVtbhgDIAEoBRISCgptYXhfY29sdW1uGAQgASgFEg0KBWRlcHRoGAUgASgNGigKA1JvdxIhCghzZWdtZW50cxgBIAMoCzIPLm1hcC52MS5TZWdtZW50ItABCglDb25uZWN0b3ISJAoEa2luZBgBIAEoDjIWLm1hcC52MS5Db25uZWN0b3IuS2luZBIlCgRmcm9tGAIgASgLMhcubWFwLnYxLlRpbGUuQ29vcmRpbmF0ZRIjCgJ0bxgDIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSDwoHb25lX3dheRgEIAEoCCJACgRLaW5kEhQKEEtJTkRfVU5TUEVDSUZJRUQQABIRCg1LSU5EX1NUQUlSV0FZEAESDwoLS0lORF9QT1JUQUwQAiJsCgRHcmlkEikKDHNlZ21lbnRfcm93cxgBIAMoCzITLm1hcC52MS5TZWdtZW50LlJvdxISCgp0b3RhbF9yb3dzGAIgASgNEhUKDXRvdGFsX2NvbHVtbnMYAyABKA0SDgoGZGVwdGhzGAQgASgNQnkKCmNvbS5tYXAudjFCCVRpbGVQcm90b1ABWidnaXRodWIuY29tL29wZW5oZXhlcy9wcm90by9tYXAvdjE7bWFwdjGiAgNNWFiqAgZNYXAuVjHKAgZNYXBcVjHiAhJNYXBcVjFcR1BCTWV0YWRhdGHqAgdNYXA6OlYxYgZwcm90bzM");
 
 /**
  * Describes the message map.v1.Tile.
@@ -31,6 +31,18 @@ export const Tile_CoordinateSchema = /*@__PURE__*/
 export const Tile_RenderingSpecSchema = /*@__PURE__*/
   messageDesc(file_map_v1_tile, 0, 1);
 
+/**
+ * Describes the enum map.v1.Tile.Visibility.
+ */
+export const Tile_VisibilitySchema = /*@__PURE__*/
+  enumDesc(file_map_v1_tile, 0, 0);
+
+/**
+ * @generated from enum map.v1.Tile.Visibility
+ */
+export const Tile_Visibility = /*@__PURE__*/
+  tsEnum(Tile_VisibilitySchema);
+
 /**
  * Describes the message map.v1.Segment.
  * Use `create(SegmentSchema)` to create a new message.
@@ -52,10 +64,29 @@ export const Segment_BoundsSchema = /*@__PURE__*/
 export const Segment_RowSchema = /*@__PURE__*/
   messageDesc(file_map_v1_tile, 1, 1);
 
+/**
+ * Describes the message map.v1.Connector.
+ * Use `create(ConnectorSchema)` to create a new message.
+ */
+export const ConnectorSchema = /*@__PURE__*/
+  messageDesc(file_map_v1_tile, 2);
+
+/**
+ * Describes the enum map.v1.Connector.Kind.
+ */
+export const Connector_KindSchema = /*@__PURE__*/
+  enumDesc(file_map_v1_tile, 2, 0);
+
+/**
+ * @generated from enum map.v1.Connector.Kind
+ */
+export const Connector_Kind = /*@__PURE__*/
+  tsEnum(Connector_KindSchema);
+
 /**
  * Describes the message map.v1.Grid.
  * Use `create(GridSchema)` to create a new message.
  */
 export const GridSchema = /*@__PURE__*/
-  messageDesc(file_map_v1_tile, 2);
+  messageDesc(file_map_v1_tile, 3);
 
@@ -0,0 +1,313 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file objects/v1/object.proto (package objects.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Tile_Coordinate } from "../../map/v1/tile_pb";
+import type { Hero_Stack } from "../../heroes/v1/hero_pb";
+
+/**
+ * Describes the file objects/v1/object.proto.
+ */
+export declare const file_objects_v1_object: GenFile;
+
+/**
+ * Object is placed on the adventure map & does something when visited by a hero,
+ * e.g. a treasure chest, a shrine or a teleporter.
+ *
+ * @generated from message objects.v1.Object
+ */
+export declare type Object = Message<"objects.v1.Object"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string kind_id = 3;
+   */
+  kindId: string;
+
+  /**
+   * anchor, offsets of the kind are relative to it
+   *
+   * @generated from field: map.v1.Tile.Coordinate position = 4;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * dwelling: account of the last visiting player, empty until visited
+   *
+   * @generated from field: string owner_id = 5;
+   */
+  ownerId: string;
+
+  /**
+   * ids of heroes that visited the object
+   *
+   * @generated from field: repeated string visited_by = 6;
+   */
+  visitedBy: string[];
+
+  /**
+   * teleporter: object heroes come out of
+   *
+   * @generated from field: string target_id = 7;
+   */
+  targetId: string;
+
+  /**
+   * guard: remaining creatures
+   *
+   * @generated from field: repeated heroes.v1.Hero.Stack army = 8;
+   */
+  army: Hero_Stack[];
+
+  /**
+   * dwelling: creatures ready to join, grows every week
+   *
+   * @generated from field: uint32 available = 9;
+   */
+  available: number;
+};
+
+/**
+ * Describes the message objects.v1.Object.
+ * Use `create(ObjectSchema)` to create a new message.
+ */
+export declare const ObjectSchema: GenMessage<Object>;
+
+/**
+ * Offset is a position relative to the anchor of an object, in axial coordinates on the same level.
+ *
+ * @generated from message objects.v1.Object.Offset
+ */
+export declare type Object_Offset = Message<"objects.v1.Object.Offset"> & {
+  /**
+   * @generated from field: int32 q = 1;
+   */
+  q: number;
+
+  /**
+   * @generated from field: int32 r = 2;
+   */
+  r: number;
+};
+
+/**
+ * Describes the message objects.v1.Object.Offset.
+ * Use `create(Object_OffsetSchema)` to create a new message.
+ */
+export declare const Object_OffsetSchema: GenMessage<Object_Offset>;
+
+/**
+ * Kind is a type of objects defined by content packs.
+ *
+ * @generated from message objects.v1.Object.Kind
+ */
+export declare type Object_Kind = Message<"objects.v1.Object.Kind"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[];
+
+  /**
+   * tiles covered by the object, only the anchor if empty
+   *
+   * @generated from field: repeated objects.v1.Object.Offset footprint = 3;
+   */
+  footprint: Object_Offset[];
+
+  /**
+   * tiles of the footprint heroes visit the object from, only the anchor if empty
+   *
+   * @generated from field: repeated objects.v1.Object.Offset entrances = 4;
+   */
+  entrances: Object_Offset[];
+
+  /**
+   * interaction, e.g. "treasure", "shrine", "dwelling", "teleporter" or "guard"
+   *
+   * @generated from field: string handler = 5;
+   */
+  handler: string;
+
+  /**
+   * parameters of handlers
+   *
+   * treasure: resource id -> amount granted once
+   *
+   * @generated from field: map<string, uint32> resources = 6;
+   */
+  resources: { [key: string]: number };
+
+  /**
+   * shrine: spells taught to visiting heroes
+   *
+   * @generated from field: repeated string spell_ids = 7;
+   */
+  spellIds: string[];
+
+  /**
+   * dwelling: creature joining visiting heroes
+   *
+   * @generated from field: string creature_id = 8;
+   */
+  creatureId: string;
+
+  /**
+   * dwelling
+   *
+   * @generated from field: uint32 weekly_growth = 9;
+   */
+  weeklyGrowth: number;
+
+  /**
+   * dwelling: resource id -> amount per creature
+   *
+   * @generated from field: map<string, uint32> cost = 10;
+   */
+  cost: { [key: string]: number };
+
+  /**
+   * guard: army fighting visiting heroes
+   *
+   * @generated from field: repeated heroes.v1.Hero.Stack guards = 11;
+   */
+  guards: Hero_Stack[];
+};
+
+/**
+ * Describes the message objects.v1.Object.Kind.
+ * Use `create(Object_KindSchema)` to create a new message.
+ */
+export declare const Object_KindSchema: GenMessage<Object_Kind>;
+
+/**
+ * @generated from message objects.v1.ListObjectsRequest
+ */
+export declare type ListObjectsRequest = Message<"objects.v1.ListObjectsRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * objects placed on a map of the caller instead of a game
+   *
+   * @generated from field: string map_id = 2;
+   */
+  mapId: string;
+};
+
+/**
+ * Describes the message objects.v1.ListObjectsRequest.
+ * Use `create(ListObjectsRequestSchema)` to create a new message.
+ */
+export declare const ListObjectsRequestSchema: GenMessage<ListObjectsRequest>;
+
+/**
+ * @generated from message objects.v1.ListObjectsResponse
+ */
+export declare type ListObjectsResponse = Message<"objects.v1.ListObjectsResponse"> & {
+  /**
+   * on tiles explored by the caller
+   *
+   * @generated from field: repeated objects.v1.Object objects = 1;
+   */
+  objects: Object[];
+};
+
+/**
+ * Describes the message objects.v1.ListObjectsResponse.
+ * Use `create(ListObjectsResponseSchema)` to create a new message.
+ */
+export declare const ListObjectsResponseSchema: GenMessage<ListObjectsResponse>;
+
+/**
+ * @generated from message objects.v1.PlaceObjectRequest
+ */
+export declare type PlaceObjectRequest = Message<"objects.v1.PlaceObjectRequest"> & {
+  /**
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: string kind_id = 2;
+   */
+  kindId: string;
+
+  /**
+   * anchor
+   *
+   * @generated from field: map.v1.Tile.Coordinate position = 3;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * teleporters only
+   *
+   * @generated from field: string target_id = 4;
+   */
+  targetId: string;
+};
+
+/**
+ * Describes the message objects.v1.PlaceObjectRequest.
+ * Use `create(PlaceObjectRequestSchema)` to create a new message.
+ */
+export declare const PlaceObjectRequestSchema: GenMessage<PlaceObjectRequest>;
+
+/**
+ * @generated from message objects.v1.PlaceObjectResponse
+ */
+export declare type PlaceObjectResponse = Message<"objects.v1.PlaceObjectResponse"> & {
+  /**
+   * @generated from field: objects.v1.Object object = 1;
+   */
+  object?: Object;
+};
+
+/**
+ * Describes the message objects.v1.PlaceObjectResponse.
+ * Use `create(PlaceObjectResponseSchema)` to create a new message.
+ */
+export declare const PlaceObjectResponseSchema: GenMessage<PlaceObjectResponse>;
+
+/**
+ * @generated from service objects.v1.ObjectService
+ */
+export declare const ObjectService: GenService<{
+  /**
+   * @generated from rpc objects.v1.ObjectService.ListObjects
+   */
+  listObjects: {
+    methodKind: "unary";
+    input: typeof ListObjectsRequestSchema;
+    output: typeof ListObjectsResponseSchema;
+  },
+  /**
+   * PlaceObject is used by the owner of a map to set up its scenario, objects are copied into games
+   * played on the map once they start.
+   *
+   * @generated from rpc objects.v1.ObjectService.PlaceObject
+   */
+  placeObject: {
+    methodKind: "unary";
+    input: typeof PlaceObjectRequestSchema;
+    output: typeof PlaceObjectResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,69 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file objects/v1/object.proto (package objects.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_heroes_v1_hero } from "../../heroes/v1/hero_pb";
+import { file_map_v1_tile } from "../../map/v1/tile_pb";
+
+/**
+ * Describes the file objects/v1/object.proto.
+ */
+export const file_objects_v1_object = /*@__PURE__*/
+  fileDesc("ChdvYmplY3RzL3YxL29iamVjdC5wcm90bxIKb2JqZWN0cy52MSKzBQoGT2JqZWN0EgoKAmlkGAEgASgJEg8KB2dhbWVfaWQYAiABKAkSDwoHa2luZF9pZBgDIAEoCRIpCghwb3NpdGlvbhgEIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSEAoIb3duZXJfaWQYBSABKAkSEgoKdmlzaXRlZF9ieRgGIAMoCRIRCgl0YXJnZXRfaWQYByABKAkSIwoEYXJteRgIIAMoCzIVLmhlcm9lcy52MS5IZXJvLlN0YWNrEhEKCWF2YWlsYWJsZRgJIAEoDRoeCgZPZmZzZXQSCQoBcRgBIAEoBRIJCgFyGAIgASgFGr4DCgRLaW5kEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkSLAoJZm9vdHByaW50GAMgAygLMhkub2JqZWN0cy52MS5PYmplY3QuT2Zmc2V0EiwKCWVudHJhbmNlcxgEIAMoCzIZLm9iamVjdHMudjEuT2JqZWN0Lk9mZnNldBIPCgdoYW5kbGVyGAUgASgJEjkKCXJlc291cmNlcxgGIAMoCzImLm9iamVjdHMudjEuT2JqZWN0LktpbmQuUmVzb3VyY2VzRW50cnkSEQoJc3BlbGxfaWRzGAcgAygJEhMKC2NyZWF0dXJlX2lkGAggASgJEhUKDXdlZWtseV9ncm93dGgYCSABKA0SLwoEY29zdBgKIAMoCzIhLm9iamVjdHMudjEuT2JqZWN0LktpbmQuQ29zdEVudHJ5EiUKBmd1YXJkcxgLIAMoCzIVLmhlcm9lcy52MS5IZXJvLlN0YWNrGjAKDlJlc291cmNlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoDToCOAEaKwoJQ29zdEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoDToCOAEiNQoSTGlzdE9iamVjdHNSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDgoGbWFwX2lkGAIgASgJIjoKE0xpc3RPYmplY3RzUmVzcG9uc2USIwoHb2JqZWN0cxgBIAMoCzISLm9iamVjdHMudjEuT2JqZWN0InMKElBsYWNlT2JqZWN0UmVxdWVzdBIOCgZtYXBfaWQYASABKAkSDwoHa2luZF9pZBgCIAEoCRIpCghwb3NpdGlvbhgDIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSEQoJdGFyZ2V0X2lkGAQgASgJIjkKE1BsYWNlT2JqZWN0UmVzcG9uc2USIgoGb2JqZWN0GAEgASgLMhIub2JqZWN0cy52MS5PYmplY3QyrwEKDU9iamVjdFNlcnZpY2USTgoLTGlzdE9iamVjdHMSHi5vYmplY3RzLnYxLkxpc3RPYmplY3RzUmVxdWVzdBofLm9iamVjdHMudjEuTGlzdE9iamVjdHNSZXNwb25zZRJOCgtQbGFjZU9iamVjdBIeLm9iamVjdHMudjEuUGxhY2VPYmplY3RSZXF1ZXN0Gh8ub2JqZWN0cy52MS5QbGFjZU9iamVjdFJlc3BvbnNlQpcBCg5jb20ub2JqZWN0cy52MUILT2JqZWN0UHJvdG9QAVovZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vb2JqZWN0cy92MTtvYmplY3RzdjGiAgNPWFiqAgpPYmplY3RzLlYxygIKT2JqZWN0c1xWMeICFk9iamVjdHNcVjFcR1BCTWV0YWRhdGHqAgtPYmplY3RzOjpWMWIGcHJvdG8z", [file_heroes_v1_hero, file_map_v1_tile]);
+
+/**
+ * Describes the message objects.v1.Object.
+ * Use `create(ObjectSchema)` to create a new message.
+ */
+export const ObjectSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 0);
+
+/**
+ * Describes the message objects.v1.Object.Offset.
+ * Use `create(Object_OffsetSchema)` to create a new message.
+ */
+export const Object_OffsetSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 0, 0);
+
+/**
+ * Describes the message objects.v1.Object.Kind.
+ * Use `create(Object_KindSchema)` to create a new message.
+ */
+export const Object_KindSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 0, 1);
+
+/**
+ * Describes the message objects.v1.ListObjectsRequest.
+ * Use `create(ListObjectsRequestSchema)` to create a new message.
+ */
+export const ListObjectsRequestSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 1);
+
+/**
+ * Describes the message objects.v1.ListObjectsResponse.
+ * Use `create(ListObjectsResponseSchema)` to create a new message.
+ */
+export const ListObjectsResponseSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 2);
+
+/**
+ * Describes the message objects.v1.PlaceObjectRequest.
+ * Use `create(PlaceObjectRequestSchema)` to create a new message.
+ */
+export const PlaceObjectRequestSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 3);
+
+/**
+ * Describes the message objects.v1.PlaceObjectResponse.
+ * Use `create(PlaceObjectResponseSchema)` to create a new message.
+ */
+export const PlaceObjectResponseSchema = /*@__PURE__*/
+  messageDesc(file_objects_v1_object, 4);
+
+/**
+ * @generated from service objects.v1.ObjectService
+ */
+export const ObjectService = /*@__PURE__*/
+  serviceDesc(file_objects_v1_object, 0);
+
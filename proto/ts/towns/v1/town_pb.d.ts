@@ -0,0 +1,521 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file towns/v1/town.proto (package towns.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Tile_Coordinate } from "../../map/v1/tile_pb";
+import type { Hero, Hero_Stack } from "../../heroes/v1/hero_pb";
+
+/**
+ * Describes the file towns/v1/town.proto.
+ */
+export declare const file_towns_v1_town: GenFile;
+
+/**
+ * @generated from message towns.v1.Town
+ */
+export declare type Town = Message<"towns.v1.Town"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * account controlling the town, empty for neutral towns
+   *
+   * @generated from field: string owner_id = 3;
+   */
+  ownerId: string;
+
+  /**
+   * @generated from field: string kind_id = 4;
+   */
+  kindId: string;
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * heroes standing here visit the town
+   *
+   * @generated from field: map.v1.Tile.Coordinate position = 6;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * in order of construction
+   *
+   * @generated from field: repeated string buildings = 7;
+   */
+  buildings: string[];
+
+  /**
+   * creatures ready to be recruited
+   *
+   * @generated from field: repeated towns.v1.Town.Available available = 8;
+   */
+  available: Town_Available[];
+
+  /**
+   * up to 7 stacks
+   *
+   * @generated from field: repeated heroes.v1.Hero.Stack garrison = 9;
+   */
+  garrison: Hero_Stack[];
+
+  /**
+   * a single building may be built per day
+   *
+   * @generated from field: bool built_today = 10;
+   */
+  builtToday: boolean;
+
+  /**
+   * towns of maps only: slot of the player owning the town from the start
+   *
+   * @generated from field: optional uint32 slot = 11;
+   */
+  slot?: number;
+};
+
+/**
+ * Describes the message towns.v1.Town.
+ * Use `create(TownSchema)` to create a new message.
+ */
+export declare const TownSchema: GenMessage<Town>;
+
+/**
+ * Dwelling lets creatures be recruited in a town, their number grows every week.
+ *
+ * @generated from message towns.v1.Town.Dwelling
+ */
+export declare type Town_Dwelling = Message<"towns.v1.Town.Dwelling"> & {
+  /**
+   * @generated from field: string creature_id = 1;
+   */
+  creatureId: string;
+
+  /**
+   * @generated from field: uint32 weekly_growth = 2;
+   */
+  weeklyGrowth: number;
+
+  /**
+   * resource id -> amount per creature
+   *
+   * @generated from field: map<string, uint32> cost = 3;
+   */
+  cost: { [key: string]: number };
+};
+
+/**
+ * Describes the message towns.v1.Town.Dwelling.
+ * Use `create(Town_DwellingSchema)` to create a new message.
+ */
+export declare const Town_DwellingSchema: GenMessage<Town_Dwelling>;
+
+/**
+ * @generated from message towns.v1.Town.Building
+ */
+export declare type Town_Building = Message<"towns.v1.Town.Building"> & {
+  /**
+   * unique within a town kind
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * ids of buildings built before this one
+   *
+   * @generated from field: repeated string requires = 2;
+   */
+  requires: string[];
+
+  /**
+   * resource id -> amount
+   *
+   * @generated from field: map<string, uint32> cost = 3;
+   */
+  cost: { [key: string]: number };
+
+  /**
+   * @generated from field: towns.v1.Town.Dwelling dwelling = 4;
+   */
+  dwelling?: Town_Dwelling;
+
+  /**
+   * resource id -> amount added every day
+   *
+   * @generated from field: map<string, uint32> income = 5;
+   */
+  income: { [key: string]: number };
+
+  /**
+   * lets the owner exchange resources, more marketplaces lower the fee
+   *
+   * @generated from field: bool marketplace = 6;
+   */
+  marketplace: boolean;
+
+  /**
+   * ids of spells taught to heroes recruited in the town, e.g. by a mage guild
+   *
+   * @generated from field: repeated string spells = 7;
+   */
+  spells: string[];
+};
+
+/**
+ * Describes the message towns.v1.Town.Building.
+ * Use `create(Town_BuildingSchema)` to create a new message.
+ */
+export declare const Town_BuildingSchema: GenMessage<Town_Building>;
+
+/**
+ * HeroClass describes heroes recruited in towns of a kind.
+ *
+ * @generated from message towns.v1.Town.HeroClass
+ */
+export declare type Town_HeroClass = Message<"towns.v1.Town.HeroClass"> & {
+  /**
+   * resource id -> amount
+   *
+   * @generated from field: map<string, uint32> cost = 1;
+   */
+  cost: { [key: string]: number };
+
+  /**
+   * starting army, up to 7 stacks
+   *
+   * @generated from field: repeated heroes.v1.Hero.Stack army = 2;
+   */
+  army: Hero_Stack[];
+
+  /**
+   * ids of spells heroes know from the start
+   *
+   * @generated from field: repeated string spells = 3;
+   */
+  spells: string[];
+};
+
+/**
+ * Describes the message towns.v1.Town.HeroClass.
+ * Use `create(Town_HeroClassSchema)` to create a new message.
+ */
+export declare const Town_HeroClassSchema: GenMessage<Town_HeroClass>;
+
+/**
+ * Kind is a faction of towns, e.g. castle or necropolis, defined by content packs.
+ *
+ * @generated from message towns.v1.Town.Kind
+ */
+export declare type Town_Kind = Message<"towns.v1.Town.Kind"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: repeated string native_terrains = 3;
+   */
+  nativeTerrains: string[];
+
+  /**
+   * @generated from field: repeated towns.v1.Town.Building buildings = 4;
+   */
+  buildings: Town_Building[];
+
+  /**
+   * built as soon as a town is placed
+   *
+   * @generated from field: repeated string initial_buildings = 5;
+   */
+  initialBuildings: string[];
+
+  /**
+   * heroes can't be recruited in towns without one
+   *
+   * @generated from field: towns.v1.Town.HeroClass hero_class = 6;
+   */
+  heroClass?: Town_HeroClass;
+};
+
+/**
+ * Describes the message towns.v1.Town.Kind.
+ * Use `create(Town_KindSchema)` to create a new message.
+ */
+export declare const Town_KindSchema: GenMessage<Town_Kind>;
+
+/**
+ * @generated from message towns.v1.Town.Available
+ */
+export declare type Town_Available = Message<"towns.v1.Town.Available"> & {
+  /**
+   * @generated from field: string creature_id = 1;
+   */
+  creatureId: string;
+
+  /**
+   * @generated from field: uint32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message towns.v1.Town.Available.
+ * Use `create(Town_AvailableSchema)` to create a new message.
+ */
+export declare const Town_AvailableSchema: GenMessage<Town_Available>;
+
+/**
+ * @generated from message towns.v1.ListTownsRequest
+ */
+export declare type ListTownsRequest = Message<"towns.v1.ListTownsRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * towns placed on a map of the caller instead of a game
+   *
+   * @generated from field: string map_id = 2;
+   */
+  mapId: string;
+};
+
+/**
+ * Describes the message towns.v1.ListTownsRequest.
+ * Use `create(ListTownsRequestSchema)` to create a new message.
+ */
+export declare const ListTownsRequestSchema: GenMessage<ListTownsRequest>;
+
+/**
+ * @generated from message towns.v1.ListTownsResponse
+ */
+export declare type ListTownsResponse = Message<"towns.v1.ListTownsResponse"> & {
+  /**
+   * @generated from field: repeated towns.v1.Town towns = 1;
+   */
+  towns: Town[];
+};
+
+/**
+ * Describes the message towns.v1.ListTownsResponse.
+ * Use `create(ListTownsResponseSchema)` to create a new message.
+ */
+export declare const ListTownsResponseSchema: GenMessage<ListTownsResponse>;
+
+/**
+ * @generated from message towns.v1.PlaceTownRequest
+ */
+export declare type PlaceTownRequest = Message<"towns.v1.PlaceTownRequest"> & {
+  /**
+   * @generated from field: string map_id = 1;
+   */
+  mapId: string;
+
+  /**
+   * @generated from field: string kind_id = 2;
+   */
+  kindId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: map.v1.Tile.Coordinate position = 4;
+   */
+  position?: Tile_Coordinate;
+
+  /**
+   * player slot owning the town once a game starts, neutral if omitted or the slot is empty
+   *
+   * @generated from field: optional uint32 slot = 5;
+   */
+  slot?: number;
+};
+
+/**
+ * Describes the message towns.v1.PlaceTownRequest.
+ * Use `create(PlaceTownRequestSchema)` to create a new message.
+ */
+export declare const PlaceTownRequestSchema: GenMessage<PlaceTownRequest>;
+
+/**
+ * @generated from message towns.v1.PlaceTownResponse
+ */
+export declare type PlaceTownResponse = Message<"towns.v1.PlaceTownResponse"> & {
+  /**
+   * @generated from field: towns.v1.Town town = 1;
+   */
+  town?: Town;
+};
+
+/**
+ * Describes the message towns.v1.PlaceTownResponse.
+ * Use `create(PlaceTownResponseSchema)` to create a new message.
+ */
+export declare const PlaceTownResponseSchema: GenMessage<PlaceTownResponse>;
+
+/**
+ * @generated from message towns.v1.BuildRequest
+ */
+export declare type BuildRequest = Message<"towns.v1.BuildRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string town_id = 2;
+   */
+  townId: string;
+
+  /**
+   * @generated from field: string building_id = 3;
+   */
+  buildingId: string;
+};
+
+/**
+ * Describes the message towns.v1.BuildRequest.
+ * Use `create(BuildRequestSchema)` to create a new message.
+ */
+export declare const BuildRequestSchema: GenMessage<BuildRequest>;
+
+/**
+ * @generated from message towns.v1.BuildResponse
+ */
+export declare type BuildResponse = Message<"towns.v1.BuildResponse"> & {
+  /**
+   * @generated from field: towns.v1.Town town = 1;
+   */
+  town?: Town;
+};
+
+/**
+ * Describes the message towns.v1.BuildResponse.
+ * Use `create(BuildResponseSchema)` to create a new message.
+ */
+export declare const BuildResponseSchema: GenMessage<BuildResponse>;
+
+/**
+ * @generated from message towns.v1.RecruitCreaturesRequest
+ */
+export declare type RecruitCreaturesRequest = Message<"towns.v1.RecruitCreaturesRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string town_id = 2;
+   */
+  townId: string;
+
+  /**
+   * @generated from field: string creature_id = 3;
+   */
+  creatureId: string;
+
+  /**
+   * @generated from field: uint32 count = 4;
+   */
+  count: number;
+
+  /**
+   * hero visiting the town to join, garrison if empty
+   *
+   * @generated from field: string hero_id = 5;
+   */
+  heroId: string;
+};
+
+/**
+ * Describes the message towns.v1.RecruitCreaturesRequest.
+ * Use `create(RecruitCreaturesRequestSchema)` to create a new message.
+ */
+export declare const RecruitCreaturesRequestSchema: GenMessage<RecruitCreaturesRequest>;
+
+/**
+ * @generated from message towns.v1.RecruitCreaturesResponse
+ */
+export declare type RecruitCreaturesResponse = Message<"towns.v1.RecruitCreaturesResponse"> & {
+  /**
+   * @generated from field: towns.v1.Town town = 1;
+   */
+  town?: Town;
+
+  /**
+   * set if creatures joined a hero
+   *
+   * @generated from field: heroes.v1.Hero hero = 2;
+   */
+  hero?: Hero;
+};
+
+/**
+ * Describes the message towns.v1.RecruitCreaturesResponse.
+ * Use `create(RecruitCreaturesResponseSchema)` to create a new message.
+ */
+export declare const RecruitCreaturesResponseSchema: GenMessage<RecruitCreaturesResponse>;
+
+/**
+ * @generated from service towns.v1.TownService
+ */
+export declare const TownService: GenService<{
+  /**
+   * @generated from rpc towns.v1.TownService.ListTowns
+   */
+  listTowns: {
+    methodKind: "unary";
+    input: typeof ListTownsRequestSchema;
+    output: typeof ListTownsResponseSchema;
+  },
+  /**
+   * PlaceTown is used by the owner of a map to set up its scenario, towns are copied into games
+   * played on the map once they start.
+   *
+   * @generated from rpc towns.v1.TownService.PlaceTown
+   */
+  placeTown: {
+    methodKind: "unary";
+    input: typeof PlaceTownRequestSchema;
+    output: typeof PlaceTownResponseSchema;
+  },
+  /**
+   * @generated from rpc towns.v1.TownService.Build
+   */
+  build: {
+    methodKind: "unary";
+    input: typeof BuildRequestSchema;
+    output: typeof BuildResponseSchema;
+  },
+  /**
+   * @generated from rpc towns.v1.TownService.RecruitCreatures
+   */
+  recruitCreatures: {
+    methodKind: "unary";
+    input: typeof RecruitCreaturesRequestSchema;
+    output: typeof RecruitCreaturesResponseSchema;
+  },
+}>;
+
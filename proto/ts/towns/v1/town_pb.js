@@ -0,0 +1,118 @@
+// @generated by protoc-gen-es v2.6.3
+// @generated from file towns/v1/town.proto (package towns.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_heroes_v1_hero } from "../../heroes/v1/hero_pb";
+import { file_map_v1_tile } from "../../map/v1/tile_pb";
+
+/**
+ * Describes the file towns/v1/town.proto.
+ */
+export const file_towns_v1_town = /*@__PURE__*/
+  fileDesc("ChN0b3ducy92MS90b3duLnByb3RvEgh0b3ducy52MSLxCAoEVG93bhIKCgJpZBgBIAEoCRIPCgdnYW1lX2lkGAIgASgJEhAKCG93bmVyX2lkGAMgASgJEg8KB2tpbmRfaWQYBCABKAkSDAoEbmFtZRgFIAEoCRIpCghwb3NpdGlvbhgGIAEoCzIXLm1hcC52MS5UaWxlLkNvb3JkaW5hdGUSEQoJYnVpbGRpbmdzGAcgAygJEisKCWF2YWlsYWJsZRgIIAMoCzIYLnRvd25zLnYxLlRvd24uQXZhaWxhYmxlEicKCGdhcnJpc29uGAkgAygLMhUuaGVyb2VzLnYxLkhlcm8uU3RhY2sSEwoLYnVpbHRfdG9kYXkYCiABKAgSEQoEc2xvdBgLIAEoDUgAiAEBGpQBCghEd2VsbGluZxITCgtjcmVhdHVyZV9pZBgBIAEoCRIVCg13ZWVrbHlfZ3Jvd3RoGAIgASgNEi8KBGNvc3QYAyADKAsyIS50b3ducy52MS5Ub3duLkR3ZWxsaW5nLkNvc3RFbnRyeRorCglDb3N0RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgNOgI4ARq6AgoIQnVpbGRpbmcSCgoCaWQYASABKAkSEAoIcmVxdWlyZXMYAiADKAkSLwoEY29zdBgDIAMoCzIhLnRvd25zLnYxLlRvd24uQnVpbGRpbmcuQ29zdEVudHJ5EikKCGR3ZWxsaW5nGAQgASgLMhcudG93bnMudjEuVG93bi5Ed2VsbGluZxIzCgZpbmNvbWUYBSADKAsyIy50b3ducy52MS5Ub3duLkJ1aWxkaW5nLkluY29tZUVudHJ5EhMKC21hcmtldHBsYWNlGAYgASgIEg4KBnNwZWxscxgHIAMoCRorCglDb3N0RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgNOgI4ARotCgtJbmNvbWVFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKA06AjgBGp8BCglIZXJvQ2xhc3MSMAoEY29zdBgBIAMoCzIiLnRvd25zLnYxLlRvd24uSGVyb0NsYXNzLkNvc3RFbnRyeRIjCgRhcm15GAIgAygLMhUuaGVyb2VzLnYxLkhlcm8uU3RhY2sSDgoGc3BlbGxzGAMgAygJGisKCUNvc3RFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKA06AjgBGq4BCgRLaW5kEgoKAmlkGAEgASgJEgwKBHRhZ3MYAiADKAkSFwoPbmF0aXZlX3RlcnJhaW5zGAMgAygJEioKCWJ1aWxkaW5ncxgEIAMoCzIXLnRvd25zLnYxLlRvd24uQnVpbGRpbmcSGQoRaW5pdGlhbF9idWlsZGluZ3MYBSADKAkSLAoKaGVyb19jbGFzcxgGIAEoCzIYLnRvd25zLnYxLlRvd24uSGVyb0NsYXNzGi8KCUF2YWlsYWJsZRITCgtjcmVhdHVyZV9pZBgBIAEoCRINCgVjb3VudBgCIAEoDUIHCgVfc2xvdCIzChBMaXN0VG93bnNSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDgoGbWFwX2lkGAIgASgJIjIKEUxpc3RUb3duc1Jlc3BvbnNlEh0KBXRvd25zGAEgAygLMg4udG93bnMudjEuVG93biKIAQoQUGxhY2VUb3duUmVxdWVzdBIOCgZtYXBfaWQYASABKAkSDwoHa2luZF9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEikKCHBvc2l0aW9uGAQgASgLMhcubWFwLnYxLlRpbGUuQ29vcmRpbmF0ZRIRCgRzbG90GAUgASgNSACIAQFCBwoFX3Nsb3QiMQoRUGxhY2VUb3duUmVzcG9uc2USHAoEdG93bhgBIAEoCzIOLnRvd25zLnYxLlRvd24iRQoMQnVpbGRSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdG93bl9pZBgCIAEoCRITCgtidWlsZGluZ19pZBgDIAEoCSItCg1CdWlsZFJlc3BvbnNlEhwKBHRvd24YASABKAsyDi50b3ducy52MS5Ub3duInAKF1JlY3J1aXRDcmVhdHVyZXNSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdG93bl9pZBgCIAEoCRITCgtjcmVhdHVyZV9pZBgDIAEoCRINCgVjb3VudBgEIAEoDRIPCgdoZXJvX2lkGAUgASgJIlcKGFJlY3J1aXRDcmVhdHVyZXNSZXNwb25zZRIcCgR0b3duGAEgASgLMg4udG93bnMudjEuVG93bhIdCgRoZXJvGAIgASgLMg8uaGVyb2VzLnYxLkhlcm8yrgIKC1Rvd25TZXJ2aWNlEkQKCUxpc3RUb3ducxIaLnRvd25zLnYxLkxpc3RUb3duc1JlcXVlc3QaGy50b3ducy52MS5MaXN0VG93bnNSZXNwb25zZRJECglQbGFjZVRvd24SGi50b3ducy52MS5QbGFjZVRvd25SZXF1ZXN0GhsudG93bnMudjEuUGxhY2VUb3duUmVzcG9uc2USOAoFQnVpbGQSFi50b3ducy52MS5CdWlsZFJlcXVlc3QaFy50b3ducy52MS5CdWlsZFJlc3BvbnNlElkKEFJlY3J1aXRDcmVhdHVyZXMSIS50b3ducy52MS5SZWNydWl0Q3JlYXR1cmVzUmVxdWVzdBoiLnRvd25zLnYxLlJlY3J1aXRDcmVhdHVyZXNSZXNwb25zZUKHAQoMY29tLnRvd25zLnYxQglUb3duUHJvdG9QAVorZ2l0aHViLmNvbS9vcGVuaGV4ZXMvcHJvdG8vdG93bnMvdjE7dG93bnN2MaICA1RYWKoCCFRvd25zLlYxygIIVG93bnNcVjHiAhRUb3duc1xWMVxHUEJNZXRhZGF0YeoCCVRvd25zOjpWMWIGcHJvdG8z", [file_heroes_v1_hero, file_map_v1_tile]);
+
+/**
+ * Describes the message towns.v1.Town.
+ * Use `create(TownSchema)` to create a new message.
+ */
+export const TownSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0);
+
+/**
+ * Describes the message towns.v1.Town.Dwelling.
+ * Use `create(Town_DwellingSchema)` to create a new message.
+ */
+export const Town_DwellingSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0, 0);
+
+/**
+ * Describes the message towns.v1.Town.Building.
+ * Use `create(Town_BuildingSchema)` to create a new message.
+ */
+export const Town_BuildingSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0, 1);
+
+/**
+ * Describes the message towns.v1.Town.HeroClass.
+ * Use `create(Town_HeroClassSchema)` to create a new message.
+ */
+export const Town_HeroClassSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0, 2);
+
+/**
+ * Describes the message towns.v1.Town.Kind.
+ * Use `create(Town_KindSchema)` to create a new message.
+ */
+export const Town_KindSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0, 3);
+
+/**
+ * Describes the message towns.v1.Town.Available.
+ * Use `create(Town_AvailableSchema)` to create a new message.
+ */
+export const Town_AvailableSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 0, 4);
+
+/**
+ * Describes the message towns.v1.ListTownsRequest.
+ * Use `create(ListTownsRequestSchema)` to create a new message.
+ */
+export const ListTownsRequestSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 1);
+
+/**
+ * Describes the message towns.v1.ListTownsResponse.
+ * Use `create(ListTownsResponseSchema)` to create a new message.
+ */
+export const ListTownsResponseSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 2);
+
+/**
+ * Describes the message towns.v1.PlaceTownRequest.
+ * Use `create(PlaceTownRequestSchema)` to create a new message.
+ */
+export const PlaceTownRequestSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 3);
+
+/**
+ * Describes the message towns.v1.PlaceTownResponse.
+ * Use `create(PlaceTownResponseSchema)` to create a new message.
+ */
+export const PlaceTownResponseSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 4);
+
+/**
+ * Describes the message towns.v1.BuildRequest.
+ * Use `create(BuildRequestSchema)` to create a new message.
+ */
+export const BuildRequestSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 5);
+
+/**
+ * Describes the message towns.v1.BuildResponse.
+ * Use `create(BuildResponseSchema)` to create a new message.
+ */
+export const BuildResponseSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 6);
+
+/**
+ * Describes the message towns.v1.RecruitCreaturesRequest.
+ * Use `create(RecruitCreaturesRequestSchema)` to create a new message.
+ */
+export const RecruitCreaturesRequestSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 7);
+
+/**
+ * Describes the message towns.v1.RecruitCreaturesResponse.
+ * Use `create(RecruitCreaturesResponseSchema)` to create a new message.
+ */
+export const RecruitCreaturesResponseSchema = /*@__PURE__*/
+  messageDesc(file_towns_v1_town, 8);
+
+/**
+ * @generated from service towns.v1.TownService
+ */
+export const TownService = /*@__PURE__*/
+  serviceDesc(file_towns_v1_town, 0);
+
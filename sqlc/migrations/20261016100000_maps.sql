@@ -0,0 +1,6 @@
+-- Create "maps" table
+CREATE TABLE "public"."maps" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "owner_id" uuid NOT NULL, "name" character varying(256) NOT NULL, "total_rows" integer NOT NULL, "total_columns" integer NOT NULL, "rows_per_segment" integer NOT NULL, "columns_per_segment" integer NOT NULL, "seed" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "maps_owner_id_fkey" FOREIGN KEY ("owner_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "maps_owner_id_idx" to table: "maps"
+CREATE INDEX "maps_owner_id_idx" ON "public"."maps" ("owner_id");
+-- Create "map_segments" table
+CREATE TABLE "public"."map_segments" ("map_id" uuid NOT NULL, "depth" integer NOT NULL, "segment_row" integer NOT NULL, "segment_column" integer NOT NULL, "data" bytea NOT NULL, PRIMARY KEY ("map_id", "depth", "segment_row", "segment_column"), CONSTRAINT "map_segments_map_id_fkey" FOREIGN KEY ("map_id") REFERENCES "public"."maps" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
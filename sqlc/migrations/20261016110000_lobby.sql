@@ -0,0 +1,8 @@
+-- Create "games" table
+CREATE TABLE "public"."games" ("id" uuid NOT NULL DEFAULT gen_random_uuid(), "host_id" uuid NOT NULL, "map_id" uuid NOT NULL, "name" character varying(256) NOT NULL, "state" character varying(32) NOT NULL, "max_players" integer NOT NULL, "settings" bytea NOT NULL, "content_checksum" character varying(64) NOT NULL, "created_at" timestamptz NOT NULL, "started_at" timestamptz NULL, PRIMARY KEY ("id"), CONSTRAINT "games_host_id_fkey" FOREIGN KEY ("host_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "games_map_id_fkey" FOREIGN KEY ("map_id") REFERENCES "public"."maps" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "games_state_idx" to table: "games"
+CREATE INDEX "games_state_idx" ON "public"."games" ("state");
+-- Create "game_players" table
+CREATE TABLE "public"."game_players" ("game_id" uuid NOT NULL, "slot" integer NOT NULL, "account_id" uuid NOT NULL, "ready" boolean NOT NULL DEFAULT false, "joined_at" timestamptz NOT NULL, PRIMARY KEY ("game_id", "slot"), CONSTRAINT "game_players_game_id_account_id_key" UNIQUE ("game_id", "account_id"), CONSTRAINT "game_players_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "game_players_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "game_players_account_id_idx" to table: "game_players"
+CREATE INDEX "game_players_account_id_idx" ON "public"."game_players" ("account_id");
@@ -0,0 +1,2 @@
+-- Create "game_states" table
+CREATE TABLE "public"."game_states" ("game_id" uuid NOT NULL, "day" integer NOT NULL, "turn" bytea NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("game_id"), CONSTRAINT "game_states_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
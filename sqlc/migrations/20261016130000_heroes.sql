@@ -0,0 +1,4 @@
+-- Create "heroes" table
+CREATE TABLE "public"."heroes" ("id" uuid NOT NULL, "game_id" uuid NOT NULL, "owner_id" uuid NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "heroes_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "heroes_owner_id_fkey" FOREIGN KEY ("owner_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "heroes_game_id_idx" to table: "heroes"
+CREATE INDEX "heroes_game_id_idx" ON "public"."heroes" ("game_id");
@@ -0,0 +1,2 @@
+-- Create "game_visibility" table
+CREATE TABLE "public"."game_visibility" ("game_id" uuid NOT NULL, "account_id" uuid NOT NULL, "explored" bytea NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("game_id", "account_id"), CONSTRAINT "game_visibility_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "game_visibility_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
@@ -0,0 +1,4 @@
+-- Create "towns" table
+CREATE TABLE "public"."towns" ("id" uuid NOT NULL, "game_id" uuid NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "towns_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "towns_game_id_idx" to table: "towns"
+CREATE INDEX "towns_game_id_idx" ON "public"."towns" ("game_id");
@@ -0,0 +1,14 @@
+-- Create "resource_sites" table
+CREATE TABLE "public"."resource_sites" ("id" uuid NOT NULL, "game_id" uuid NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "resource_sites_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "resource_sites_game_id_idx" to table: "resource_sites"
+CREATE INDEX "resource_sites_game_id_idx" ON "public"."resource_sites" ("game_id");
+-- Create "resource_transactions" table
+CREATE TABLE "public"."resource_transactions" ("id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY, "game_id" uuid NOT NULL, "account_id" uuid NOT NULL, "day" integer NOT NULL, "resource_id" character varying(256) NOT NULL, "delta" bigint NOT NULL, "balance" bigint NOT NULL, "reason" character varying(32) NOT NULL, "reference" character varying(256) NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "resource_transactions_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "resource_transactions_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "resource_transactions_game_id_account_id_idx" to table: "resource_transactions"
+CREATE INDEX "resource_transactions_game_id_account_id_idx" ON "public"."resource_transactions" ("game_id", "account_id", "id");
+-- Create "trades" table
+CREATE TABLE "public"."trades" ("id" uuid NOT NULL, "game_id" uuid NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "trades_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "trades_game_id_idx" to table: "trades"
+CREATE INDEX "trades_game_id_idx" ON "public"."trades" ("game_id");
+-- Create "treasuries" table
+CREATE TABLE "public"."treasuries" ("game_id" uuid NOT NULL, "account_id" uuid NOT NULL, "data" bytea NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("game_id", "account_id"), CONSTRAINT "treasuries_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "treasuries_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
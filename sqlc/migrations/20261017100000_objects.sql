@@ -0,0 +1,4 @@
+-- Create "map_objects" table
+CREATE TABLE "public"."map_objects" ("id" uuid NOT NULL, "game_id" uuid NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "map_objects_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "map_objects_game_id_idx" to table: "map_objects"
+CREATE INDEX "map_objects_game_id_idx" ON "public"."map_objects" ("game_id");
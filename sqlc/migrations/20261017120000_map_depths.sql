@@ -0,0 +1,2 @@
+-- Modify "maps" table
+ALTER TABLE "public"."maps" ADD COLUMN "depths" integer NOT NULL DEFAULT 1;
@@ -0,0 +1,4 @@
+-- Create "account_activations" table
+CREATE TABLE "public"."account_activations" ("id" bigint NOT NULL GENERATED ALWAYS AS IDENTITY, "account_id" uuid NOT NULL, "active" boolean NOT NULL, "changed_by" uuid NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "account_activations_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "account_activations_changed_by_fkey" FOREIGN KEY ("changed_by") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "account_activations_account_id_idx" to table: "account_activations"
+CREATE INDEX "account_activations_account_id_idx" ON "public"."account_activations" ("account_id", "id");
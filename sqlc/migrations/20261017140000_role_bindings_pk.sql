@@ -0,0 +1,4 @@
+-- Remove duplicate "role_bindings" rows
+DELETE FROM "public"."role_bindings" a USING "public"."role_bindings" b WHERE a.ctid < b.ctid AND a.account_id = b.account_id AND a.role_id = b.role_id;
+-- Modify "role_bindings" table
+ALTER TABLE "public"."role_bindings" ADD PRIMARY KEY ("account_id", "role_id");
@@ -0,0 +1,4 @@
+-- Create "linked_identities" table
+CREATE TABLE "public"."linked_identities" ("provider" character varying(64) NOT NULL, "subject" character varying(256) NOT NULL, "account_id" uuid NOT NULL, "email" character varying(256) NOT NULL, "password_hash" character varying(256) NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("provider", "subject"), CONSTRAINT "linked_identities_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "linked_identities_account_id_idx" to table: "linked_identities"
+CREATE INDEX "linked_identities_account_id_idx" ON "public"."linked_identities" ("account_id");
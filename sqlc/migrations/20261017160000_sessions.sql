@@ -0,0 +1,4 @@
+-- Create "sessions" table
+CREATE TABLE "public"."sessions" ("id" uuid NOT NULL, "account_id" uuid NOT NULL, "provider" character varying(64) NOT NULL, "user_agent" character varying(256) NOT NULL, "refresh_hash" character varying(64) NOT NULL, "created_at" timestamptz NOT NULL, "refreshed_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "sessions_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "public"."accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "sessions_account_id_idx" to table: "sessions"
+CREATE INDEX "sessions_account_id_idx" ON "public"."sessions" ("account_id");
@@ -0,0 +1,4 @@
+-- Modify "game_states" table
+ALTER TABLE "public"."game_states" ADD COLUMN "deadline" timestamptz NULL;
+-- Create index "game_states_deadline_idx" to table: "game_states"
+CREATE INDEX "game_states_deadline_idx" ON "public"."game_states" ("deadline");
@@ -0,0 +1,2 @@
+-- Modify "sessions" table
+ALTER TABLE "public"."sessions" ADD COLUMN "prev_refresh_hash" character varying(64) NULL;
@@ -0,0 +1,11 @@
+-- Modify "games" table
+ALTER TABLE "public"."games" DROP CONSTRAINT "games_map_id_fkey", ADD CONSTRAINT "games_map_id_fkey" FOREIGN KEY ("map_id") REFERENCES "public"."maps" ("id") ON UPDATE NO ACTION ON DELETE RESTRICT;
+-- Create index "games_map_id_idx" to table: "games"
+CREATE INDEX "games_map_id_idx" ON "public"."games" ("map_id");
+-- Create "game_map_segments" table
+CREATE TABLE "public"."game_map_segments" ("game_id" uuid NOT NULL, "depth" integer NOT NULL, "segment_row" integer NOT NULL, "segment_column" integer NOT NULL, "data" bytea NOT NULL, PRIMARY KEY ("game_id", "depth", "segment_row", "segment_column"), CONSTRAINT "game_map_segments_game_id_fkey" FOREIGN KEY ("game_id") REFERENCES "public"."games" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Copy maps of games already started
+INSERT INTO "public"."game_map_segments" ("game_id", "depth", "segment_row", "segment_column", "data")
+SELECT "g"."id", "s"."depth", "s"."segment_row", "s"."segment_column", "s"."data"
+FROM "public"."games" AS "g" JOIN "public"."map_segments" AS "s" ON "s"."map_id" = "g"."map_id"
+WHERE "g"."state" <> 'STATE_OPEN';
@@ -0,0 +1,4 @@
+-- Create "map_features" table
+CREATE TABLE "public"."map_features" ("id" uuid NOT NULL, "map_id" uuid NOT NULL, "kind" character varying(16) NOT NULL, "data" bytea NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "map_features_map_id_fkey" FOREIGN KEY ("map_id") REFERENCES "public"."maps" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "map_features_map_id_kind_idx" to table: "map_features"
+CREATE INDEX "map_features_map_id_kind_idx" ON "public"."map_features" ("map_id", "kind");
@@ -1,11 +1,31 @@
 -- name: ListAccounts :many
-select * from accounts 
-where (active = sqlc.narg('active') or sqlc.narg('active') is null)
-order by id;
+select * from accounts a
+where (a.active = sqlc.narg('active') or sqlc.narg('active') is null)
+and (sqlc.narg('email')::text is null or a.email ilike '%' || sqlc.narg('email')::text || '%')
+and (sqlc.narg('role_id')::varchar is null or exists (
+    select 1 from role_bindings b where b.account_id = a.id and b.role_id = sqlc.narg('role_id')::varchar
+))
+and (sqlc.narg('before_created_at')::timestamptz is null or (a.created_at, a.id) < (sqlc.narg('before_created_at')::timestamptz, sqlc.narg('before_id')::uuid))
+order by a.created_at desc, a.id desc
+limit @row_limit;
+
+-- name: ListAccountsByEmail :many
+select * from accounts a
+where (a.active = sqlc.narg('active') or sqlc.narg('active') is null)
+and (sqlc.narg('email')::text is null or a.email ilike '%' || sqlc.narg('email')::text || '%')
+and (sqlc.narg('role_id')::varchar is null or exists (
+    select 1 from role_bindings b where b.account_id = a.id and b.role_id = sqlc.narg('role_id')::varchar
+))
+and (sqlc.narg('after_email')::varchar is null or a.email > sqlc.narg('after_email')::varchar)
+order by a.email
+limit @row_limit;
 
 -- name: GetAccount :one
 select * from accounts where email = @email;
 
+-- name: GetAccountByID :one
+select * from accounts where id = @id;
+
 -- name: CreateAccount :one
 insert into accounts (active, created_at, email, display_name, picture)
 values (@active, now(), @email, @display_name, @picture)
@@ -24,12 +44,275 @@ insert into role_bindings (role_id, account_id)
 values (@role_id, @account_id)
 on conflict do nothing;
 
--- name: RevokeRole :exec
+-- name: RevokeRole :execrows
 delete from role_bindings
 where role_id = @role_id and account_id = @account_id;
 
 -- name: ListAccountRoles :many
 select role_id from role_bindings where account_id = @id;
 
--- name: UpdateAccountActivation :exec
-update accounts set active = @active where id = any(@ids::uuid[]);
+-- name: GetLinkedIdentity :one
+select * from linked_identities where provider = @provider and subject = @subject;
+
+-- name: ListLinkedIdentities :many
+select * from linked_identities where account_id = @account_id order by created_at, provider;
+
+-- name: CreateLinkedIdentity :one
+insert into linked_identities (provider, subject, account_id, email, password_hash, created_at)
+values (@provider, @subject, @account_id, @email, @password_hash, now())
+returning *;
+
+-- name: UpdateLinkedIdentityPassword :one
+update linked_identities set password_hash = @password_hash
+where provider = @provider and subject = @subject and account_id = @account_id
+returning *;
+
+-- name: DeleteLinkedIdentity :execrows
+delete from linked_identities
+where provider = @provider and subject = @subject and account_id = @account_id;
+
+-- name: CreateSession :exec
+insert into sessions (id, account_id, provider, user_agent, refresh_hash, created_at, refreshed_at, expires_at)
+values (@id, @account_id, @provider, @user_agent, @refresh_hash, now(), now(), @expires_at);
+
+-- name: GetSession :one
+select * from sessions where id = @id and expires_at > now();
+
+-- name: RefreshSession :one
+update sessions set prev_refresh_hash = refresh_hash, refresh_hash = @new_refresh_hash, refreshed_at = now(), expires_at = @expires_at
+where id = @id and refresh_hash = @refresh_hash and expires_at > now()
+returning *;
+
+-- name: ListSessions :many
+select * from sessions where account_id = @account_id and expires_at > now()
+order by refreshed_at desc;
+
+-- name: DeleteSession :execrows
+delete from sessions where id = @id and account_id = @account_id;
+
+-- name: DeleteSessions :many
+delete from sessions
+where account_id = @account_id
+and (sqlc.narg('keep_id')::uuid is null or id <> sqlc.narg('keep_id')::uuid)
+and (sqlc.narg('provider')::varchar is null or provider = sqlc.narg('provider')::varchar)
+returning id;
+
+-- name: DeleteExpiredSessions :exec
+delete from sessions where account_id = @account_id and expires_at <= now();
+
+-- name: UpdateAccountActivation :many
+update accounts set active = @active where id = any(@ids::uuid[]) and active <> @active
+returning id;
+
+-- name: CreateAccountActivation :exec
+insert into account_activations (account_id, active, changed_by, created_at)
+values (@account_id, @active, @changed_by, now());
+
+-- name: CreateMap :one
+insert into maps (owner_id, name, total_rows, total_columns, rows_per_segment, columns_per_segment, depths, seed, created_at, updated_at)
+values (@owner_id, @name, @total_rows, @total_columns, @rows_per_segment, @columns_per_segment, @depths, @seed, now(), now())
+returning *;
+
+-- name: GetMap :one
+select * from maps where id = @id and owner_id = @owner_id;
+
+-- name: GetMapForUpdate :one
+select * from maps where id = @id and owner_id = @owner_id for update;
+
+-- name: ListMaps :many
+select * from maps where owner_id = @owner_id order by created_at desc, id;
+
+-- name: TouchMap :one
+update maps set updated_at = now() where id = @id
+returning *;
+
+-- name: DeleteMap :execrows
+delete from maps where id = @id and owner_id = @owner_id;
+
+-- name: UpsertMapSegment :exec
+insert into map_segments (map_id, depth, segment_row, segment_column, data)
+values (@map_id, @depth, @segment_row, @segment_column, @data)
+on conflict (map_id, depth, segment_row, segment_column) do update set data = excluded.data;
+
+-- name: GetMapSegment :one
+select data from map_segments
+where map_id = @map_id and depth = @depth and segment_row = @segment_row and segment_column = @segment_column;
+
+-- name: ListMapSegments :many
+select * from map_segments
+where map_id = @map_id
+order by depth, segment_row, segment_column;
+
+-- name: CreateMapFeature :exec
+insert into map_features (id, map_id, kind, data, created_at)
+values (@id, @map_id, @kind, @data, now());
+
+-- name: ListMapFeatures :many
+select * from map_features where map_id = @map_id and kind = @kind order by created_at, id;
+
+-- name: CountMapGames :one
+select count(*) from games where map_id = @map_id;
+
+-- name: CopyGameMapSegments :exec
+insert into game_map_segments (game_id, depth, segment_row, segment_column, data)
+select @game_id::uuid, depth, segment_row, segment_column, data from map_segments
+where map_id = @map_id;
+
+-- name: GetGameMapSegment :one
+select data from game_map_segments
+where game_id = @game_id and depth = @depth and segment_row = @segment_row and segment_column = @segment_column;
+
+-- name: CreateGame :one
+insert into games (host_id, map_id, name, state, max_players, settings, content_checksum, created_at)
+values (@host_id, @map_id, @name, @state, @max_players, @settings, @content_checksum, now())
+returning *;
+
+-- name: GetGame :one
+select * from games where id = @id;
+
+-- name: GetGameForUpdate :one
+select * from games where id = @id for update;
+
+-- name: ListGames :many
+select * from games g
+where (g.state = sqlc.narg('state') or sqlc.narg('state') is null)
+and (sqlc.narg('account_id')::uuid is null or exists (
+    select 1 from game_players p where p.game_id = g.id and p.account_id = sqlc.narg('account_id')::uuid
+))
+and (sqlc.narg('before_created_at')::timestamptz is null or (g.created_at, g.id) < (sqlc.narg('before_created_at')::timestamptz, sqlc.narg('before_id')::uuid))
+order by g.created_at desc, g.id desc
+limit @row_limit;
+
+-- name: StartGame :one
+update games set state = @state, started_at = now() where id = @id
+returning *;
+
+-- name: DeleteGame :exec
+delete from games where id = @id;
+
+-- name: ListGamePlayers :many
+select p.game_id, p.slot, p.account_id, p.ready, p.joined_at, a.display_name, a.picture
+from game_players p join accounts a on a.id = p.account_id
+where p.game_id = any(@game_ids::uuid[])
+order by p.game_id, p.slot;
+
+-- name: AddGamePlayer :exec
+insert into game_players (game_id, slot, account_id, ready, joined_at)
+values (@game_id, @slot, @account_id, false, now());
+
+-- name: RemoveGamePlayer :execrows
+delete from game_players where game_id = @game_id and account_id = @account_id;
+
+-- name: SetGamePlayerReady :execrows
+update game_players set ready = @ready where game_id = @game_id and account_id = @account_id;
+
+-- name: GetGamePlayer :one
+select * from game_players where game_id = @game_id and account_id = @account_id;
+
+-- name: CreateGameState :exec
+insert into game_states (game_id, day, turn, updated_at, deadline)
+values (@game_id, @day, @turn, now(), @deadline);
+
+-- name: GetGameState :one
+select * from game_states where game_id = @game_id;
+
+-- name: GetGameStateForUpdate :one
+select * from game_states where game_id = @game_id for update;
+
+-- name: UpdateGameState :exec
+update game_states set day = @day, turn = @turn, updated_at = now(), deadline = @deadline
+where game_id = @game_id;
+
+-- name: ListExpiredGameStates :many
+select game_id from game_states
+where deadline <= now()
+order by deadline
+limit @row_limit;
+
+-- name: PostponeGameState :exec
+update game_states set deadline = @deadline where game_id = @game_id;
+
+-- name: CreateHero :exec
+insert into heroes (id, game_id, owner_id, data, created_at)
+values (@id, @game_id, @owner_id, @data, now());
+
+-- name: ListHeroes :many
+select * from heroes where game_id = @game_id order by created_at, id;
+
+-- name: UpdateHero :exec
+update heroes set data = @data where id = @id;
+
+-- name: DeleteHero :exec
+delete from heroes where id = @id;
+
+-- name: GetGameVisibility :one
+select explored from game_visibility where game_id = @game_id and account_id = @account_id;
+
+-- name: UpsertGameVisibility :exec
+insert into game_visibility (game_id, account_id, explored, updated_at)
+values (@game_id, @account_id, @explored, now())
+on conflict (game_id, account_id) do update set explored = excluded.explored, updated_at = excluded.updated_at;
+
+-- name: CreateTown :exec
+insert into towns (id, game_id, data, created_at)
+values (@id, @game_id, @data, now());
+
+-- name: ListTowns :many
+select * from towns where game_id = @game_id order by created_at, id;
+
+-- name: UpdateTown :exec
+update towns set data = @data where id = @id;
+
+-- name: GetTreasury :one
+select data from treasuries where game_id = @game_id and account_id = @account_id;
+
+-- name: UpsertTreasury :exec
+insert into treasuries (game_id, account_id, data, updated_at)
+values (@game_id, @account_id, @data, now())
+on conflict (game_id, account_id) do update set data = excluded.data, updated_at = excluded.updated_at;
+
+-- name: CreateResourceTransaction :exec
+insert into resource_transactions (game_id, account_id, day, resource_id, delta, balance, reason, reference, created_at)
+values (@game_id, @account_id, @day, @resource_id, @delta, @balance, @reason, @reference, now());
+
+-- name: ListResourceTransactions :many
+select * from resource_transactions
+where game_id = @game_id and account_id = @account_id and id < @before_id
+order by id desc
+limit @row_limit;
+
+-- name: CreateResourceSite :exec
+insert into resource_sites (id, game_id, data, created_at)
+values (@id, @game_id, @data, now());
+
+-- name: ListResourceSites :many
+select * from resource_sites where game_id = @game_id order by created_at, id;
+
+-- name: UpdateResourceSite :exec
+update resource_sites set data = @data where id = @id;
+
+-- name: DeleteResourceSite :exec
+delete from resource_sites where id = @id;
+
+-- name: CreateTrade :exec
+insert into trades (id, game_id, data, created_at)
+values (@id, @game_id, @data, now());
+
+-- name: ListTrades :many
+select * from trades where game_id = @game_id order by created_at, id;
+
+-- name: UpdateTrade :exec
+update trades set data = @data where id = @id;
+
+-- name: CreateMapObject :exec
+insert into map_objects (id, game_id, data, created_at)
+values (@id, @game_id, @data, now());
+
+-- name: ListMapObjects :many
+select * from map_objects where game_id = @game_id order by created_at, id;
+
+-- name: UpdateMapObject :exec
+update map_objects set data = @data where id = @id;
+
+-- name: DeleteMapObject :exec
+delete from map_objects where id = @id;
@@ -18,5 +18,239 @@ create table roles
 create table role_bindings
 (
     account_id uuid references accounts (id) on delete cascade not null,
-    role_id varchar(256) references roles (id) on delete cascade not null
+    role_id varchar(256) references roles (id) on delete cascade not null,
+    primary key (account_id, role_id)
 );
+
+-- logins of accounts, password_hash is only set for the password provider
+create table linked_identities
+(
+    provider      varchar(64) not null,
+    subject       varchar(256) not null,
+    account_id    uuid references accounts (id) on delete cascade not null,
+    email         varchar(256) not null,
+    password_hash varchar(256),
+    created_at    timestamptz not null,
+    primary key (provider, subject)
+);
+
+create index on linked_identities (account_id);
+
+-- signed in devices, refresh_hash is the sha256 of the secret part of the current refresh token,
+-- prev_refresh_hash the one it replaced at refreshed_at
+create table sessions
+(
+    id                uuid primary key,
+    account_id        uuid references accounts (id) on delete cascade not null,
+    provider          varchar(64) not null,
+    user_agent        varchar(256) not null,
+    refresh_hash      varchar(64) not null,
+    created_at        timestamptz not null,
+    refreshed_at      timestamptz not null,
+    expires_at        timestamptz not null,
+    prev_refresh_hash varchar(64)
+);
+
+create index on sessions (account_id);
+
+-- history of activation changes, changed_by is the owner who made the change
+create table account_activations
+(
+    id          bigint generated always as identity primary key,
+    account_id  uuid references accounts (id) on delete cascade not null,
+    active      bool not null,
+    changed_by  uuid references accounts (id) on delete set null,
+    created_at  timestamptz not null
+);
+
+create index account_activations_account_id_idx on account_activations (account_id, id);
+
+create table maps
+(
+    id                  uuid default gen_random_uuid() primary key,
+    owner_id            uuid references accounts (id) on delete cascade not null,
+    name                varchar(256) not null,
+    total_rows          integer not null,
+    total_columns       integer not null,
+    rows_per_segment    integer not null,
+    columns_per_segment integer not null,
+    seed                bigint not null,
+    created_at          timestamptz not null,
+    updated_at          timestamptz not null,
+    depths              integer not null default 1
+);
+
+create index maps_owner_id_idx on maps (owner_id);
+
+-- data is a serialized map.v1.Segment, large values are compressed by postgres
+create table map_segments
+(
+    map_id          uuid references maps (id) on delete cascade not null,
+    depth           integer not null,
+    segment_row     integer not null,
+    segment_column  integer not null,
+    data            bytea not null,
+    primary key (map_id, depth, segment_row, segment_column)
+);
+
+-- map_features are towns, sites & objects placed on a map by its owner, copied into every game played on it.
+-- data is a serialized towns.v1.Town, economy.v1.Site or objects.v1.Object depending on kind
+create table map_features
+(
+    id          uuid primary key,
+    map_id      uuid references maps (id) on delete cascade not null,
+    kind        varchar(16) not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index map_features_map_id_kind_idx on map_features (map_id, kind);
+
+-- settings is a serialized lobby.v1.Settings
+create table games
+(
+    id                  uuid default gen_random_uuid() primary key,
+    host_id             uuid references accounts (id) on delete cascade not null,
+    map_id              uuid references maps (id) on delete restrict not null,
+    name                varchar(256) not null,
+    state               varchar(32) not null,
+    max_players         integer not null,
+    settings            bytea not null,
+    content_checksum    varchar(64) not null,
+    created_at          timestamptz not null,
+    started_at          timestamptz
+);
+
+create index games_state_idx on games (state);
+create index games_map_id_idx on games (map_id);
+
+-- segments of the map copied when a game starts, so editing the map doesn't affect games played on it
+create table game_map_segments
+(
+    game_id         uuid references games (id) on delete cascade not null,
+    depth           integer not null,
+    segment_row     integer not null,
+    segment_column  integer not null,
+    data            bytea not null,
+    primary key (game_id, depth, segment_row, segment_column)
+);
+
+create table game_players
+(
+    game_id     uuid references games (id) on delete cascade not null,
+    slot        integer not null,
+    account_id  uuid references accounts (id) on delete cascade not null,
+    ready       bool default false not null,
+    joined_at   timestamptz not null,
+    primary key (game_id, slot),
+    unique (game_id, account_id)
+);
+
+create index game_players_account_id_idx on game_players (account_id);
+
+-- turn is a serialized game.v1.TurnState
+create table game_states
+(
+    game_id     uuid references games (id) on delete cascade primary key,
+    day         integer not null,
+    turn        bytea not null,
+    updated_at  timestamptz not null,
+    deadline    timestamptz
+);
+
+create index game_states_deadline_idx on game_states (deadline);
+
+-- data is a serialized heroes.v1.Hero
+create table heroes
+(
+    id          uuid primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    owner_id    uuid references accounts (id) on delete cascade not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index heroes_game_id_idx on heroes (game_id);
+
+-- explored is a serialized game.v1.Visibility
+create table game_visibility
+(
+    game_id     uuid references games (id) on delete cascade not null,
+    account_id  uuid references accounts (id) on delete cascade not null,
+    explored    bytea not null,
+    updated_at  timestamptz not null,
+
+    primary key (game_id, account_id)
+);
+
+-- data is a serialized towns.v1.Town, owner isn't a column as towns change hands
+create table towns
+(
+    id          uuid primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index towns_game_id_idx on towns (game_id);
+
+-- data is a serialized economy.v1.Treasury
+create table treasuries
+(
+    game_id     uuid references games (id) on delete cascade not null,
+    account_id  uuid references accounts (id) on delete cascade not null,
+    data        bytea not null,
+    updated_at  timestamptz not null,
+
+    primary key (game_id, account_id)
+);
+
+-- ledger of every change of treasuries, never updated so disputes & bugs can be audited
+create table resource_transactions
+(
+    id          bigint generated always as identity primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    account_id  uuid references accounts (id) on delete cascade not null,
+    day         integer not null,
+    resource_id varchar(256) not null,
+    delta       bigint not null,
+    balance     bigint not null,
+    reason      varchar(32) not null,
+    reference   varchar(256) not null,
+    created_at  timestamptz not null
+);
+
+create index resource_transactions_game_id_account_id_idx on resource_transactions (game_id, account_id, id);
+
+-- data is a serialized economy.v1.Site
+create table resource_sites
+(
+    id          uuid primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index resource_sites_game_id_idx on resource_sites (game_id);
+
+-- data is a serialized economy.v1.Trade
+create table trades
+(
+    id          uuid primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index trades_game_id_idx on trades (game_id);
+
+-- data is a serialized objects.v1.Object
+create table map_objects
+(
+    id          uuid primary key,
+    game_id     uuid references games (id) on delete cascade not null,
+    data        bytea not null,
+    created_at  timestamptz not null
+);
+
+create index map_objects_game_id_idx on map_objects (game_id);
@@ -1,10 +1,23 @@
 import { expect, test } from "@playwright/test"
 
-test.beforeEach(async ({ context }) => {
+test.beforeEach(async ({ context, request }) => {
+    // requests other than ones to ContentService need the content checksum
+    const content = await request.post("/content.v1.ContentService/GetContent", { data: {} })
+    expect(content.ok()).toBeTruthy()
+    const { checksum } = (await content.json()) as { checksum: string }
+
+    // test users sign in with their test token as the credential
+    const response = await request.post("/iam.v1.IAMService/SignIn", {
+        headers: { "Hexes-Content-Checksum": checksum },
+        data: { provider: "google", credential: "owner" },
+    })
+    expect(response.ok()).toBeTruthy()
+    const { tokens } = (await response.json()) as { tokens: { accessToken: string } }
+
     await context.addCookies([
         {
-            name: "hexes.auth.google",
-            value: "owner",
+            name: "hexes.session",
+            value: tokens.accessToken,
             domain: "localhost",
             path: "/",
             sameSite: "Strict",
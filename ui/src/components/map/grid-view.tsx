@@ -2,14 +2,24 @@ import { useTileDimensions } from "@/hooks/use-tiles"
 import * as tileUtil from "@/lib/tiles"
 import { create } from "@bufbuild/protobuf"
 import { useWindowSize } from "@uidotdev/usehooks"
-import { type Grid, type Tile as PTile, Segment_BoundsSchema } from "proto/ts/map/v1/tile_pb"
+import type { Terrain } from "proto/ts/map/v1/terrain_pb"
+import {
+    type Grid,
+    type Tile as PTile,
+    type Segment_Bounds,
+    Segment_BoundsSchema,
+} from "proto/ts/map/v1/tile_pb"
 import React from "react"
 
 import "./grid-view.css"
 import { TileView } from "./tile-view"
 
 interface MapProps {
-    grid: Grid
+    grid: Grid // dimensions only, tiles come separately as they are fetched
+    tiles: Map<string, PTile> // by tile key
+    version: number // changes whenever tiles are added
+    terrains: Map<string, Terrain>
+    onViewport: (viewport: Segment_Bounds) => void
 }
 
 interface Position {
@@ -17,7 +27,7 @@ interface Position {
     y: number
 }
 
-export const GridView: React.FC<MapProps> = ({ grid }) => {
+export const GridView: React.FC<MapProps> = ({ grid, tiles, version, terrains, onViewport }) => {
     const windowSize = useWindowSize()
     const { tileHeight, tileWidth, rowHeight, triangleHeight } = useTileDimensions()
 
@@ -42,7 +52,7 @@ export const GridView: React.FC<MapProps> = ({ grid }) => {
     const mapHeight = Math.ceil((((grid.totalRows + 0.4) * tileHeight) / 2) * 1.5)
     const mapWidth = (grid.totalColumns + 1) * tileWidth
 
-    React.useEffect(() => handlePan(0, 0), [windowSize.height, windowSize.width]) // todo
+    React.useEffect(() => handlePan(0, 0), [windowSize.height, windowSize.width, version]) // todo
 
     const flushPan = () => {
         if (!pending.current) return
@@ -98,42 +108,19 @@ export const GridView: React.FC<MapProps> = ({ grid }) => {
             maxColumn: skippedColumnCount + maxVisibleColumns,
         })
 
-        const rowStartingIndex =
-            Math.floor((skippedRowCount / grid.totalRows) * grid.segmentRows.length) - 1
+        onViewport(visibleBounds)
 
         const visibleTiles: PTile[] = []
-        for (let i = rowStartingIndex; i < grid.segmentRows.length; i++) {
-            const row = grid.segmentRows[i]
-            if (row === undefined) {
-                continue
-            }
-
-            let segmentsFound = false
-            const columnStartingIndex =
-                Math.floor((skippedColumnCount / grid.totalColumns) * row.segments.length) - 1
-
-            for (let j = columnStartingIndex; j < row.segments.length; j++) {
-                const segment = row.segments[j]
-                if (segment === undefined) {
-                    continue
+        const minRow = Math.max(visibleBounds.minRow - 2, 0)
+        const maxRow = Math.min(visibleBounds.maxRow + 2, grid.totalRows)
+        const minColumn = Math.max(visibleBounds.minColumn - 2, 0)
+        const maxColumn = Math.min(visibleBounds.maxColumn + 2, grid.totalColumns)
+        for (let row = minRow; row < maxRow; row++) {
+            for (let column = minColumn; column < maxColumn; column++) {
+                const tile = tiles.get(tileUtil.coordinateKey(row, column, visibleBounds.depth))
+                if (tile !== undefined) {
+                    visibleTiles.push(tile)
                 }
-
-                if (tileUtil.boundsIntersect(segment.bounds, visibleBounds)) {
-                    visibleTiles.push(
-                        ...segment.tiles.filter((tile) =>
-                            tileUtil.boundsInclude(tile, visibleBounds, 2),
-                        ),
-                    )
-                    segmentsFound = true
-                } else {
-                    if (segmentsFound) {
-                        break
-                    }
-                }
-            }
-
-            if (!segmentsFound && visibleTiles.length > 0) {
-                break
             }
         }
         setVisibleTiles(visibleTiles)
@@ -198,7 +185,7 @@ export const GridView: React.FC<MapProps> = ({ grid }) => {
                 }}
             >
                 {visibleTiles.map((tile) => (
-                    <TileView tile={tile} key={tileUtil.getKey(tile)} />
+                    <TileView tile={tile} terrains={terrains} key={tileUtil.getKey(tile)} />
                 ))}
             </div>
         </div>
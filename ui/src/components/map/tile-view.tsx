@@ -1,6 +1,7 @@
 import { useTileDimensions } from "@/hooks/use-tiles"
 import { getCoordinates, getTerrainRenderingSpec } from "@/lib/tiles"
 import { cn } from "@/lib/utils"
+import type { Terrain } from "proto/ts/map/v1/terrain_pb"
 import type { Tile } from "proto/ts/map/v1/tile_pb"
 import React from "react"
 
@@ -8,9 +9,10 @@ import "./tile.css"
 
 export interface TileProps {
     tile: Tile
+    terrains: Map<string, Terrain>
 }
 
-export const TileView: React.FC<TileProps> = ({ tile }) => {
+export const TileView: React.FC<TileProps> = ({ tile, terrains }) => {
     const { tileHeight, tileWidth } = useTileDimensions()
 
     const { row, column } = getCoordinates(tile)
@@ -24,12 +26,13 @@ export const TileView: React.FC<TileProps> = ({ tile }) => {
         </div>
     )
 
-    const terrain = getTerrainRenderingSpec(tile)
+    const terrain = getTerrainRenderingSpec(tile, terrains)
 
     const className = cn(
         "tile",
         "select-none flex items-center justify-center absolute",
         "text-xs",
+        "bg-gray-950 text-transparent hover:text-gray-500",
         terrain.className,
     )
 
@@ -43,10 +46,7 @@ export const TileView: React.FC<TileProps> = ({ tile }) => {
     } as React.CSSProperties
 
     return (
-        <div
-            className={cn(className, "bg-gray-950 text-transparent hover:text-gray-500")}
-            style={style}
-        >
+        <div className={className} style={style}>
             {content}
         </div>
     )
@@ -1,6 +1,4 @@
-import { useCurrentAccount } from "@/hooks/fetch"
-import { cookieName } from "@/lib/const"
-import Cookies from "js-cookie"
+import { hasSession, useCurrentAccount } from "@/hooks/fetch"
 import { Loader2 } from "lucide-react"
 import React from "react"
 
@@ -22,7 +20,7 @@ export const AuthWall: React.FC<React.PropsWithChildren> = ({ children }) => {
         )
     }
 
-    if (!Cookies.get(cookieName)) {
+    if (!hasSession()) {
         return <LoginScreen />
     }
 
@@ -1,21 +1,11 @@
+import { signIn } from "@/hooks/fetch"
 import { useTitle } from "@/hooks/use-title"
-import { cookieName } from "@/lib/const"
 import { GoogleLogin, googleLogout } from "@react-oauth/google"
-import Cookies from "js-cookie"
 import React from "react"
 import { toast } from "sonner"
 
 import { LogOutButton } from "./logout"
 
-const logOut = (reload = true) => {
-    Cookies.remove(cookieName, { path: "/", sameSite: "strict" })
-    googleLogout()
-
-    if (reload) {
-        document.location.reload()
-    }
-}
-
 // todo: default button
 
 export const LoginScreen: React.FC = () => {
@@ -27,12 +17,16 @@ export const LoginScreen: React.FC = () => {
                 type="icon"
                 shape="circle"
                 onSuccess={(credentialResponse) => {
-                    const credential = credentialResponse.credential ?? ""
-                    Cookies.set(cookieName, credential, { path: "/", sameSite: "strict" })
+                    signIn("google", credentialResponse.credential ?? "")
+                        .then(() => document.location.reload())
+                        .catch((e: Error) => {
+                            toast.error("login failed", { description: e.message })
+                            googleLogout()
+                        })
                 }}
                 onError={() => {
                     toast.error("login failed")
-                    logOut(false)
+                    googleLogout()
                 }}
             />
             <LogOutButton />
@@ -1,26 +1,28 @@
 import { Button } from "@/components/ui/button"
-import { cookieName } from "@/lib/const"
+import { hasSession, signOut } from "@/hooks/fetch"
 import { googleLogout } from "@react-oauth/google"
-import Cookies from "js-cookie"
 import { LogOut } from "lucide-react"
 import React from "react"
 
-const logOut = (reload = true) => {
-    Cookies.remove(cookieName, { path: "/", sameSite: "strict" })
-    googleLogout()
+const logOut = async (reload = true) => {
+    try {
+        await signOut()
+    } finally {
+        googleLogout()
 
-    if (reload) {
-        document.location.reload()
+        if (reload) {
+            document.location.reload()
+        }
     }
 }
 
 export const LogOutButton: React.FC = () => {
-    if (Cookies.get(cookieName) === undefined) {
+    if (!hasSession()) {
         return null
     }
 
     return (
-        <Button variant="outline" onClick={() => logOut()}>
+        <Button variant="outline" onClick={() => void logOut()}>
             <LogOut />
             Log out
         </Button>
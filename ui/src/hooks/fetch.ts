@@ -1,30 +1,145 @@
-import { cookieName } from "@/lib/const"
+import { cookieName, signedInCookieName } from "@/lib/const"
 import { create, toJson } from "@bufbuild/protobuf"
-import { createClient } from "@connectrpc/connect"
+import { timestampDate } from "@bufbuild/protobuf/wkt"
+import { Code, ConnectError, type Interceptor, createClient } from "@connectrpc/connect"
 import { createGrpcWebTransport } from "@connectrpc/connect-web"
 import { useQuery } from "@tanstack/react-query"
 import Cookies from "js-cookie"
+import { ContentService } from "proto/ts/content/v1/content_pb"
 import { GameService } from "proto/ts/game/v1/game_pb"
 import {
     type Account,
     IAMService,
     type ListAccountsRequest,
     ListAccountsRequestSchema,
+    type Tokens,
 } from "proto/ts/iam/v1/iam_pb"
 import { toast } from "sonner"
 
 const noCookieErrorMessage = "auth cookie not set"
 const invalidArgumentMessage = "[invalid_argument]"
 
-const transport = createGrpcWebTransport({
+const transportOptions = {
     baseUrl: (import.meta.env.VITE_API_ADDRESS as string) || "http://localhost:8080",
     useBinaryFormat: true, // switch to false to use JSON, bodies will be readable in devtools
     defaultTimeoutMs: 30000,
-    fetch: (input, init) => fetch(input, { ...init, credentials: "include" }),
+    fetch: (input: RequestInfo | URL, init?: RequestInit) =>
+        fetch(input, { ...init, credentials: "include" }),
+}
+
+// checksum of content this client has loaded,
+// server rejects requests to game services without it or with another one
+let contentChecksum = ""
+let loadingChecksum: Promise<void> | undefined
+
+// ContentService is reachable without checksum,
+// it's asked for it if requests precede loading content
+const loadChecksum = () => {
+    loadingChecksum ??= createClient(ContentService, createGrpcWebTransport(transportOptions))
+        .getContent({})
+        .then((content) => {
+            contentChecksum ||= content.checksum
+        })
+        .finally(() => {
+            loadingChecksum = undefined
+        })
+    return loadingChecksum
+}
+
+const contentChecksumInterceptor: Interceptor = (next) => async (request) => {
+    if (request.service.typeName !== ContentService.typeName) {
+        if (!contentChecksum) {
+            await loadChecksum()
+        }
+        request.header.set("Hexes-Content-Checksum", contentChecksum)
+    }
+    return await next(request)
+}
+
+// sessions are refreshed with a client of their own,
+// so refreshing doesn't go through the refresh interceptor
+const sessionClient = createClient(
+    IAMService,
+    createGrpcWebTransport({ ...transportOptions, interceptors: [contentChecksumInterceptor] }),
+)
+
+const cookieOptions = { path: "/", sameSite: "strict" } as const
+
+const saveTokens = (tokens?: Tokens) => {
+    if (!tokens?.accessExpiresAt || !tokens.refreshExpiresAt) {
+        throw new Error("server didn't issue tokens")
+    }
+    // cookies expire with their tokens, a missing access cookie means it's time to refresh,
+    // server sets the refresh cookie itself
+    Cookies.set(cookieName, tokens.accessToken, {
+        ...cookieOptions,
+        expires: timestampDate(tokens.accessExpiresAt),
+    })
+    Cookies.set(signedInCookieName, "1", {
+        ...cookieOptions,
+        expires: timestampDate(tokens.refreshExpiresAt),
+    })
+}
+
+const clearTokens = () => {
+    Cookies.remove(cookieName, cookieOptions)
+    Cookies.remove(signedInCookieName, cookieOptions)
+}
+
+export const hasSession = () => Boolean(Cookies.get(cookieName) || Cookies.get(signedInCookieName))
+
+export const signIn = async (provider: string, credential: string) => {
+    saveTokens((await sessionClient.signIn({ provider, credential, refreshCookie: true })).tokens)
+}
+
+export const signOut = async () => {
+    try {
+        if (hasSession()) {
+            await IAMClient.revokeSession({})
+        }
+    } finally {
+        clearTokens()
+    }
+}
+
+// refresh tokens are single use, concurrent requests share one refresh
+let refreshing: Promise<void> | undefined
+
+const refresh = () => {
+    refreshing ??= (async () => {
+        if (!Cookies.get(signedInCookieName)) {
+            return
+        }
+        try {
+            // refresh token is sent in its cookie
+            saveTokens((await sessionClient.refresh({})).tokens)
+        } catch (e) {
+            if (ConnectError.from(e).code === Code.Unauthenticated) {
+                clearTokens() // session ended, sign in again
+            }
+            throw e
+        }
+    })().finally(() => {
+        refreshing = undefined
+    })
+    return refreshing
+}
+
+const refreshInterceptor: Interceptor = (next) => async (request) => {
+    if (!Cookies.get(cookieName)) {
+        await refresh()
+    }
+    return await next(request)
+}
+
+const transport = createGrpcWebTransport({
+    ...transportOptions,
+    interceptors: [refreshInterceptor, contentChecksumInterceptor],
 })
 
 export const IAMClient = createClient(IAMService, transport)
 export const GameClient = createClient(GameService, transport)
+export const ContentClient = createClient(ContentService, transport)
 
 const handleError =
     (op: string, maxAttempts = 3) =>
@@ -34,6 +149,7 @@ const handleError =
             error.message.includes(noCookieErrorMessage) ||
             error.message.includes("[unauthenticated]") ||
             error.message.includes("[permission_denied]") ||
+            error.message.includes("[failed_precondition]") ||
             error.message.includes(invalidArgumentMessage) ||
             error.message.includes("[not_found]")
         ) {
@@ -46,7 +162,7 @@ export const useCurrentAccount = () => {
     return useQuery({
         queryKey: ["accounts", "self"],
         queryFn: async ({ signal }) => {
-            if (!Cookies.get(cookieName)) {
+            if (!hasSession()) {
                 throw new Error(noCookieErrorMessage)
             }
             return (await IAMClient.resolveAccount({}, { signal })).account
@@ -70,3 +186,16 @@ export const useAccounts = (request: ListAccountsRequest) => {
         retry: handleError("Failed to fetch accounts"),
     })
 }
+
+export const useContent = () => {
+    return useQuery({
+        queryKey: ["content"],
+        queryFn: async ({ signal }) => {
+            const content = await ContentClient.getContent({}, { signal })
+            contentChecksum = content.checksum
+            return content
+        },
+        staleTime: Infinity, // content doesn't change while server is running
+        retry: handleError("Failed to fetch content"),
+    })
+}
@@ -1,7 +1,7 @@
+import { coordinateKey, getKey } from "@/lib/tiles"
 import { create } from "@bufbuild/protobuf"
-import { GetSampleGridRequestSchema } from "proto/ts/game/v1/game_pb"
-import { type Grid, GridSchema } from "proto/ts/map/v1/tile_pb"
-import type { Progress } from "proto/ts/progress/v1/progress_pb"
+import { StreamSegmentsRequestSchema } from "proto/ts/game/v1/game_pb"
+import type { Grid, Segment_Bounds, Tile } from "proto/ts/map/v1/tile_pb"
 import React from "react"
 
 import { GameClient } from "./fetch"
@@ -17,70 +17,94 @@ export const useTileDimensions = () => {
     return { tileHeight, tileWidth, rowHeight, triangleHeight }
 }
 
-const sleep = async (ms: number) => {
-    return await new Promise((resolve) => setTimeout(resolve, ms))
-}
+// tiles around the viewport to prefetch, viewport with margin may span up to 256 rows & columns
+const margin = 15
 
-const buildTileGrid = async (
+// useSegments fetches segments of a sample grid as they come into the viewport, see GetSegments
+export const useSegments = (
     totalRows: number,
     totalColumns: number,
-    maxRowsPerSegment: number,
-    maxColumnsPerSegment: number,
-    setProgress: React.Dispatch<React.SetStateAction<Progress | undefined>>,
-): Promise<Grid> => {
-    const request = create(GetSampleGridRequestSchema, {
-        totalRows,
-        totalColumns,
-        maxRowsPerSegment,
-        maxColumnsPerSegment,
-    })
-
-    const grid = create(GridSchema)
+    rowsPerSegment = 15,
+    columnsPerSegment = 15,
+) => {
+    const [grid, setGrid] = React.useState<Grid | undefined>(undefined)
+    const [error, setError] = React.useState<Error | undefined>(undefined)
+    // tiles are kept outside of state to avoid copying them, version changes when new ones arrive
+    const tiles = React.useRef(new Map<string, Tile>())
+    const [version, setVersion] = React.useState(0)
+    const seed = React.useRef(BigInt(0))
+    const requested = React.useRef(new Set<string>()) // segments received or on their way
 
-    for await (const response of GameClient.getSampleGrid(request, { timeoutMs: 60000 })) {
-        console.info(response)
-        if (response.progress) {
-            setProgress(response.progress)
-        }
-        if (response.grid) {
-            if (response.grid.totalRows > 0) {
-                grid.totalRows = response.grid.totalRows
+    const fetchSegments = React.useCallback(
+        async (viewport?: Segment_Bounds) => {
+            const request = create(StreamSegmentsRequestSchema, {
+                totalRows,
+                totalColumns,
+                maxRowsPerSegment: rowsPerSegment,
+                maxColumnsPerSegment: columnsPerSegment,
+                seed: seed.current,
+                viewport,
+                margin,
+            })
+            for await (const response of GameClient.getSegments(request)) {
+                if (response.grid && !seed.current) {
+                    seed.current = response.seed // further requests ask for the same grid
+                    setGrid(response.grid)
+                }
+                for (const segment of response.segments) {
+                    for (const tile of segment.tiles) {
+                        tiles.current.set(getKey(tile), tile)
+                    }
+                }
+                if (response.segments.length > 0) {
+                    setVersion((v) => v + 1)
+                }
             }
-            if (response.grid.totalColumns > 0) {
-                grid.totalColumns = response.grid.totalColumns
-            }
-            if (response.grid.segmentRows) {
-                grid.segmentRows.push(...response.grid.segmentRows)
-            }
-        }
-    }
+        },
+        [totalRows, totalColumns, rowsPerSegment, columnsPerSegment],
+    )
 
-    await sleep(250)
+    React.useEffect(() => {
+        fetchSegments().catch(setError) // grid without segments
+    }, [fetchSegments])
 
-    return grid
-}
+    const setViewport = React.useCallback(
+        (viewport: Segment_Bounds) => {
+            if (!grid) {
+                return
+            }
+            const minRow = Math.max(viewport.minRow - margin, 0)
+            const maxRow = Math.min(viewport.maxRow + margin, grid.totalRows)
+            const minColumn = Math.max(viewport.minColumn - margin, 0)
+            const maxColumn = Math.min(viewport.maxColumn + margin, grid.totalColumns)
 
-export const useTileGrid = (
-    totalRows: number,
-    totalColumns: number,
-    maxRowsPerSegment = 15,
-    maxColumnsPerSegment = 15,
-) => {
-    const [progress, setProgress] = React.useState<Progress | undefined>(undefined)
-    const [isLoading, setIsLoading] = React.useState<boolean>(true)
-    const [error, setError] = React.useState<Error | undefined>(undefined)
-    const [grid, setGrid] = React.useState<Grid | undefined>(undefined)
+            const firstRow = Math.floor(minRow / rowsPerSegment)
+            const endRow = Math.ceil(maxRow / rowsPerSegment)
+            const firstColumn = Math.floor(minColumn / columnsPerSegment)
+            const endColumn = Math.ceil(maxColumn / columnsPerSegment)
+
+            const missing: string[] = []
+            for (let row = firstRow; row < endRow; row++) {
+                for (let column = firstColumn; column < endColumn; column++) {
+                    const key = coordinateKey(row, column, viewport.depth)
+                    if (!requested.current.has(key)) {
+                        missing.push(key)
+                    }
+                }
+            }
+            if (missing.length === 0) {
+                return
+            }
 
-    const promise = buildTileGrid(
-        totalRows,
-        totalColumns,
-        maxRowsPerSegment,
-        maxColumnsPerSegment,
-        setProgress,
+            missing.forEach((key) => requested.current.add(key))
+            fetchSegments(viewport).catch((e: Error) => {
+                missing.forEach((key) => requested.current.delete(key))
+                setError(e)
+            })
+        },
+        [grid, rowsPerSegment, columnsPerSegment, fetchSegments],
     )
-        .then(setGrid)
-        .catch(setError)
-        .finally(() => setIsLoading(false))
 
-    return { promise, isLoading, grid, error, progress }
+    const isLoading = grid === undefined && error === undefined
+    return { grid, tiles: tiles.current, version, setViewport, isLoading, error }
 }
@@ -1 +1,3 @@
-export const cookieName = "hexes.auth.google"
+export const cookieName = "hexes.session"
+// expires along with the refresh token, which is kept in a cookie scripts can't read
+export const signedInCookieName = "hexes.signed-in"
@@ -1,22 +1,13 @@
 import { create } from "@bufbuild/protobuf"
-import { type Terrain_RenderingSpec, Terrain_RenderingSpecSchema } from "proto/ts/map/v1/terrain_pb"
+import {
+    type Terrain,
+    type Terrain_RenderingSpec,
+    Terrain_RenderingSpecSchema,
+} from "proto/ts/map/v1/terrain_pb"
 import { type Segment_Bounds, Segment_BoundsSchema, type Tile } from "proto/ts/map/v1/tile_pb"
 
 const emptyBounds = create(Segment_BoundsSchema)
 
-export const boundsIntersect = (
-    a: Segment_Bounds = emptyBounds,
-    b: Segment_Bounds = emptyBounds,
-): boolean => {
-    if (a.maxRow < b.minRow || a.minRow > b.maxRow) {
-        return false
-    }
-    if (a.maxColumn < b.minColumn || a.minRow > b.maxRow) {
-        return false
-    }
-    return true
-}
-
 export const getCoordinates = (p: Tile) => {
     const row = p.coordinate?.row ?? 0
     const column = p.coordinate?.column ?? 0
@@ -24,9 +15,12 @@ export const getCoordinates = (p: Tile) => {
     return { row, column, depth }
 }
 
+export const coordinateKey = (row: number, column: number, depth: number) =>
+    `${row},${column},${depth}`
+
 export const getKey = (p: Tile) => {
     const { row, column, depth } = getCoordinates(p)
-    return `${row},${column},${depth}`
+    return coordinateKey(row, column, depth)
 }
 
 export const boundsInclude = (
@@ -44,17 +38,18 @@ export const boundsInclude = (
     )
 }
 
-const ash: Terrain_RenderingSpec = create(Terrain_RenderingSpecSchema, {
+// fallback for terrains missing from content
+const unknownTerrain: Terrain_RenderingSpec = create(Terrain_RenderingSpecSchema, {
     className: "bg-gray-800 hover:bg-gray-900",
 })
-const grass: Terrain_RenderingSpec = create(Terrain_RenderingSpecSchema, {
-    className: "bg-green-800 hover:bg-green-900",
-})
 
-export const getTerrainRenderingSpec = (tile: Tile): Terrain_RenderingSpec => {
-    switch (tile.terrainId) {
-        case "core/terrain/grass":
-            return grass
-    }
-    return ash
+export const indexTerrains = (terrains: Terrain[] = []): Map<string, Terrain> => {
+    return new Map(terrains.map((terrain) => [terrain.id, terrain]))
+}
+
+export const getTerrainRenderingSpec = (
+    tile: Tile,
+    terrains: Map<string, Terrain>,
+): Terrain_RenderingSpec => {
+    return terrains.get(tile.terrainId)?.renderingSpec ?? unknownTerrain
 }
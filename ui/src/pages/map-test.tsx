@@ -1,6 +1,8 @@
 import { ErrorView } from "@/components/utils/error"
 import { ProgressView } from "@/components/utils/progress-view"
-import { useTileGrid } from "@/hooks/use-tiles"
+import { useContent } from "@/hooks/fetch"
+import { useSegments } from "@/hooks/use-tiles"
+import { indexTerrains } from "@/lib/tiles"
 import React from "react"
 
 const Map = React.lazy(() => import("@/components/map/grid-view"))
@@ -9,17 +11,32 @@ const rowCount = 300
 const columnCount = 300
 
 export const MapTest = () => {
-    const { grid, isLoading, progress, error } = useTileGrid(rowCount, columnCount, 30, 30)
+    const { grid, tiles, version, setViewport, isLoading, error } = useSegments(
+        rowCount,
+        columnCount,
+        30,
+        30,
+    )
+    const content = useContent()
+    const terrains = React.useMemo(() => indexTerrains(content.data?.terrains), [content.data])
 
-    if (isLoading) {
-        return <ProgressView progress={progress} />
+    if (isLoading || content.isLoading) {
+        return <ProgressView />
     }
 
-    if (grid !== undefined) {
-        return <Map grid={grid} />
+    if (grid !== undefined && content.data !== undefined) {
+        return (
+            <Map
+                grid={grid}
+                tiles={tiles}
+                version={version}
+                terrains={terrains}
+                onViewport={setViewport}
+            />
+        )
     }
 
-    return <ErrorView error={error ?? new Error("unknown error")} />
+    return <ErrorView error={error ?? content.error ?? new Error("unknown error")} />
 }
 
 export default MapTest